@@ -1,6 +1,7 @@
 package main
 
 import (
+	sqlmigrations "2024_2_FIGHT-CLUB/db/migrations"
 	"2024_2_FIGHT-CLUB/domain"
 	"2024_2_FIGHT-CLUB/internal/service/dsn"
 	"context"
@@ -51,10 +52,17 @@ func migrate() (err error) {
 	if err != nil {
 		return err
 	}
-	err = db.AutoMigrate(&domain.User{}, &domain.City{}, &domain.Ad{}, &domain.AdPosition{}, &domain.AdAvailableDate{}, &domain.Image{}, &domain.VisitedRegions{}, &domain.Review{}, &domain.Message{}, &domain.Favorites{}, &domain.AdRooms{})
+	err = db.AutoMigrate(&domain.User{}, &domain.City{}, &domain.Ad{}, &domain.AdPosition{}, &domain.AdAvailableDate{}, &domain.AdBlockedDate{}, &domain.Image{}, &domain.VisitedRegions{}, &domain.Review{}, &domain.Message{}, &domain.Favorites{}, &domain.AdRooms{}, &domain.Booking{}, &domain.Report{}, &domain.RecoveryCode{}, &domain.UserIdentity{})
 	if err != nil {
 		return err
 	}
+	migrations, err := loadSQLMigrations(sqlmigrations.Files)
+	if err != nil {
+		return err
+	}
+	if err := applySQLMigrations(db, migrations); err != nil {
+		return err
+	}
 	if err := seedCities(db, minioClient); err != nil {
 		return err
 	}
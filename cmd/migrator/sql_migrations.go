@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// схему из миграций до 010 создаёт AutoMigrate, файлы начиная с 010 добавляют то, чего он не умеет:
+	// индексы, генерируемые колонки и разовые пересчёты существующих строк
+	firstSQLMigration  = "010"
+	migrationSeparator = "---- create above / drop below ----"
+)
+
+type sqlMigration struct {
+	Name string
+	Up   string
+}
+
+// loadSQLMigrations возвращает up-части файлов начиная с firstSQLMigration в порядке номеров
+func loadSQLMigrations(files fs.FS) ([]sqlMigration, error) {
+	names, err := fs.Glob(files, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	var migrations []sqlMigration
+	for _, name := range names {
+		if name < firstSQLMigration {
+			continue
+		}
+		content, err := fs.ReadFile(files, name)
+		if err != nil {
+			return nil, err
+		}
+		// у необратимой миграции разделителя нет, весь файл - up-часть
+		up, _, _ := strings.Cut(string(content), migrationSeparator)
+		migrations = append(migrations, sqlMigration{Name: name, Up: strings.TrimSpace(up)})
+	}
+	return migrations, nil
+}
+
+// applySQLMigrations выполняет ещё не применённые миграции, каждую в своей транзакции вместе с отметкой о ней,
+// поэтому пересчёты вроде подтверждения почты у существующих аккаунтов не повторяются при следующем запуске
+func applySQLMigrations(db *gorm.DB, migrations []sqlMigration) error {
+	if err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		name VARCHAR(255) PRIMARY KEY,
+		"appliedAt" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`).Error; err != nil {
+		return err
+	}
+	for _, migration := range migrations {
+		var applied int64
+		if err := db.Raw("SELECT COUNT(*) FROM schema_migrations WHERE name = ?", migration.Name).Scan(&applied).Error; err != nil {
+			return err
+		}
+		if applied > 0 {
+			continue
+		}
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(migration.Up).Error; err != nil {
+				return err
+			}
+			return tx.Exec("INSERT INTO schema_migrations (name) VALUES (?)", migration.Name).Error
+		})
+		if err != nil {
+			return fmt.Errorf("migration %s: %w", migration.Name, err)
+		}
+		fmt.Println("Applied migration", migration.Name)
+	}
+	return nil
+}
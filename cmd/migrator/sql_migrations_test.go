@@ -0,0 +1,109 @@
+package main
+
+import (
+	sqlmigrations "2024_2_FIGHT-CLUB/db/migrations"
+	"errors"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func setupTestDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	require.NoError(t, err)
+
+	return gormDB, mock
+}
+
+func expectApplied(mock sqlmock.Sqlmock, name string, applied int) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM schema_migrations WHERE name = $1`)).
+		WithArgs(name).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(applied))
+}
+
+func TestApplySQLMigrations(t *testing.T) {
+	migrations := []sqlMigration{
+		{Name: "013_user_roles.sql", Up: `ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20)`},
+		{Name: "014_email_verification.sql", Up: `UPDATE users SET "emailVerified" = true`},
+	}
+
+	t.Run("Backfill Runs Once", func(t *testing.T) {
+		db, mock := setupTestDB(t)
+		mock.ExpectExec(`CREATE TABLE IF NOT EXISTS schema_migrations`).WillReturnResult(sqlmock.NewResult(0, 0))
+		expectApplied(mock, "013_user_roles.sql", 1)
+		expectApplied(mock, "014_email_verification.sql", 0)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE users SET "emailVerified" = true`)).WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO schema_migrations (name) VALUES ($1)`)).
+			WithArgs("014_email_verification.sql").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, applySQLMigrations(db, migrations))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failed Migration Not Recorded", func(t *testing.T) {
+		db, mock := setupTestDB(t)
+		mock.ExpectExec(`CREATE TABLE IF NOT EXISTS schema_migrations`).WillReturnResult(sqlmock.NewResult(0, 0))
+		expectApplied(mock, "013_user_roles.sql", 0)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER TABLE users`)).WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		err := applySQLMigrations(db, migrations)
+		assert.EqualError(t, err, "migration 013_user_roles.sql: db error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSQLMigrations_AllFilesAfter010(t *testing.T) {
+	entries, err := os.ReadDir("../../db/migrations")
+	require.NoError(t, err)
+	var expected []string
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), ".sql") && entry.Name() >= firstSQLMigration {
+			expected = append(expected, entry.Name())
+		}
+	}
+
+	migrations, err := loadSQLMigrations(sqlmigrations.Files)
+	require.NoError(t, err)
+	var names []string
+	for _, migration := range migrations {
+		names = append(names, migration.Name)
+		assert.NotEmpty(t, migration.Up, migration.Name)
+		assert.NotContains(t, migration.Up, "DROP INDEX IF EXISTS idx_reviews_host_id", migration.Name)
+	}
+	assert.Equal(t, expected, names)
+	require.Equal(t, "011_host_weighted_score.sql", names[1])
+	assert.Contains(t, migrations[1].Up, `UPDATE users SET (score, "reviewsCount", "weightedScore")`)
+	assert.Contains(t, migrations[1].Up, "CREATE INDEX IF NOT EXISTS idx_reviews_host_id")
+
+	// на новой базе выполняется каждый файл по порядку
+	db, mock := setupTestDB(t)
+	mock.ExpectExec(`CREATE TABLE IF NOT EXISTS schema_migrations`).WillReturnResult(sqlmock.NewResult(0, 0))
+	for _, migration := range migrations {
+		expectApplied(mock, migration.Name, 0)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(migration.Up)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO schema_migrations (name) VALUES ($1)`)).
+			WithArgs(migration.Name).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+	}
+	require.NoError(t, applySQLMigrations(db, migrations))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -4,15 +4,14 @@ import (
 	adHttpDelivery "2024_2_FIGHT-CLUB/internal/ads/controller"
 	authHttpDelivery "2024_2_FIGHT-CLUB/internal/auth/controller"
 	chatHttpDelivery "2024_2_FIGHT-CLUB/internal/chat/controller"
-	chatRepository "2024_2_FIGHT-CLUB/internal/chat/repository"
-	chatUseCase "2024_2_FIGHT-CLUB/internal/chat/usecase"
 	cityHttpDelivery "2024_2_FIGHT-CLUB/internal/cities/controller"
+	moderationController "2024_2_FIGHT-CLUB/internal/moderation/controller"
+	moderationRepository "2024_2_FIGHT-CLUB/internal/moderation/repository"
+	moderationUsecase "2024_2_FIGHT-CLUB/internal/moderation/usecase"
 	regionsContoller "2024_2_FIGHT-CLUB/internal/regions/controller"
 	regionsRepository "2024_2_FIGHT-CLUB/internal/regions/repository"
 	regionsUsecase "2024_2_FIGHT-CLUB/internal/regions/usecase"
 	reviewContoller "2024_2_FIGHT-CLUB/internal/reviews/contoller"
-	reviewRepository "2024_2_FIGHT-CLUB/internal/reviews/repository"
-	reviewUsecase "2024_2_FIGHT-CLUB/internal/reviews/usecase"
 	"2024_2_FIGHT-CLUB/internal/service/logger"
 	"2024_2_FIGHT-CLUB/internal/service/metrics"
 	"2024_2_FIGHT-CLUB/internal/service/middleware"
@@ -21,7 +20,9 @@ import (
 	"2024_2_FIGHT-CLUB/internal/service/utils"
 	generatedAds "2024_2_FIGHT-CLUB/microservices/ads_service/controller/gen"
 	generatedAuth "2024_2_FIGHT-CLUB/microservices/auth_service/controller/gen"
+	generatedChat "2024_2_FIGHT-CLUB/microservices/chat_service/controller/gen"
 	generatedCity "2024_2_FIGHT-CLUB/microservices/city_service/controller/gen"
+	generatedReviews "2024_2_FIGHT-CLUB/microservices/reviews_service/controller/gen"
 	"fmt"
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
@@ -36,10 +37,13 @@ func main() {
 	middleware.InitRedis()
 	redisStore := session.NewRedisSessionStore(middleware.RedisClient)
 	db := middleware.DbConnect()
-	jwtToken, err := middleware.NewJwtToken("secret-key")
+	jwtToken, err := middleware.NewJwtTokenFromEnv()
 	if err != nil {
 		log.Fatalf("Failed to create JWT token: %v", err)
 	}
+	if err := middleware.SetTrustedProxies(os.Getenv("TRUSTED_PROXIES")); err != nil {
+		log.Fatalf("Failed to parse TRUSTED_PROXIES: %v", err)
+	}
 
 	if err := logger.InitLoggers(); err != nil {
 		log.Fatalf("Failed to initialize loggers: %v", err)
@@ -84,6 +88,26 @@ func main() {
 	}
 	defer cityConn.Close()
 
+	chatAdress := os.Getenv("CHAT_SERVICE_ADDRESS")
+	if chatAdress == "" {
+		log.Fatalf("CHAT_SERVICE_ADDRESS is not set")
+	}
+	chatConn, err := grpc.NewClient(chatAdress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to ChatService: %v", err)
+	}
+	defer chatConn.Close()
+
+	reviewsAdress := os.Getenv("REVIEWS_SERVICE_ADDRESS")
+	if reviewsAdress == "" {
+		log.Fatalf("REVIEWS_SERVICE_ADDRESS is not set")
+	}
+	reviewsConn, err := grpc.NewClient(reviewsAdress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to ReviewsService: %v", err)
+	}
+	defer reviewsConn.Close()
+
 	sessionService := session.NewSessionService(redisStore)
 	utilsService := utils.NewUtilsInterface()
 	authClient := generatedAuth.NewAuthClient(authConn)
@@ -96,19 +120,21 @@ func main() {
 	cityClient := generatedCity.NewCityServiceClient(cityConn)
 	cityHandler := cityHttpDelivery.NewCityHandler(cityClient, utilsService)
 
-	chatsRepository := chatRepository.NewChatRepository(db)
-	chatsUseCase := chatUseCase.NewChatService(chatsRepository)
-	chatsHandler := chatHttpDelivery.NewChatController(chatsUseCase, sessionService)
+	chatClient := generatedChat.NewChatServiceClient(chatConn)
+	chatsHandler := chatHttpDelivery.NewChatController(chatClient, sessionService, utilsService)
 
-	reviewsRepository := reviewRepository.NewReviewRepository(db)
-	reviewsUsecase := reviewUsecase.NewReviewUsecase(reviewsRepository)
-	reviewsHandler := reviewContoller.NewReviewHandler(reviewsUsecase, sessionService, jwtToken)
+	reviewsClient := generatedReviews.NewReviewsServiceClient(reviewsConn)
+	reviewsHandler := reviewContoller.NewReviewHandler(reviewsClient, utilsService)
 
 	regionRepository := regionsRepository.NewRegionRepository(db)
 	regionUsecase := regionsUsecase.NewRegionUsecase(regionRepository)
 	regionHandler := regionsContoller.NewRegionHandler(regionUsecase, sessionService, jwtToken)
 
-	mainRouter := router.SetUpRoutes(authHandler, adsHandler, cityHandler, chatsHandler, reviewsHandler, regionHandler)
+	moderationRepo := moderationRepository.NewModerationRepository(db)
+	moderationUse := moderationUsecase.NewModerationUsecase(moderationRepo, sessionService)
+	moderationHandler := moderationController.NewModerationHandler(moderationUse, sessionService, jwtToken)
+
+	mainRouter := router.SetUpRoutes(authHandler, adsHandler, cityHandler, chatsHandler, reviewsHandler, regionHandler, moderationHandler, sessionService)
 	mainRouter.Use(middleware.RequestIDMiddleware)
 	mainRouter.Use(middleware.RateLimitMiddleware)
 	http.Handle("/", middleware.RecoverWrap(middleware.EnableCORS(mainRouter)))
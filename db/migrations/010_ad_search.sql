@@ -0,0 +1,20 @@
+-- Write your migrate up statements here
+
+-- Поисковый вектор по описанию и адресу объявления сразу в двух конфигурациях
+ALTER TABLE ads ADD COLUMN IF NOT EXISTS "searchVector" tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(address, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(address, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(description, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS ads_search_vector_idx ON ads USING GIN ("searchVector");
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS ads_search_vector_idx;
+ALTER TABLE ads DROP COLUMN IF EXISTS "searchVector";
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,23 @@
+-- Write your migrate up statements here
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS "reviewsCount" INT NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS "weightedScore" NUMERIC NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS idx_reviews_host_id ON reviews ("hostId");
+
+-- Пересчёт для уже существующих отзывов, дальше оценки ведёт сервис отзывов
+-- (вес 10 и средняя 3.5 совпадают с domain.HostScorePriorWeight и domain.HostScorePriorMean)
+UPDATE users SET (score, "reviewsCount", "weightedScore") = (
+    SELECT COALESCE(ROUND(AVG(rating)::numeric, 1), 0),
+        COUNT(*),
+        CASE WHEN COUNT(*) = 0 THEN 0
+            ELSE ROUND((10 * 3.5 + SUM(rating)) / (10 + COUNT(*)), 2) END
+    FROM reviews WHERE reviews."hostId" = users.uuid);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS idx_reviews_host_id;
+ALTER TABLE users DROP COLUMN IF EXISTS "weightedScore";
+ALTER TABLE users DROP COLUMN IF EXISTS "reviewsCount";
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
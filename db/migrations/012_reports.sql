@@ -0,0 +1,34 @@
+-- Write your migrate up statements here
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS "isAdmin" BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS "isBanned" BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS hidden BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE ads ADD COLUMN IF NOT EXISTS hidden BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS hidden BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS reports (
+    id SERIAL PRIMARY KEY,
+    "reporterId" UUID NOT NULL REFERENCES users(uuid),
+    "targetType" VARCHAR(20) NOT NULL,
+    "targetId" VARCHAR(255) NOT NULL,
+    "authorId" UUID NOT NULL REFERENCES users(uuid),
+    reason TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    "createdAt" TIMESTAMP,
+    "resolvedAt" TIMESTAMP,
+    "resolvedBy" UUID REFERENCES users(uuid)
+);
+CREATE INDEX IF NOT EXISTS idx_reports_target ON reports ("targetType", "targetId");
+CREATE INDEX IF NOT EXISTS idx_reports_status ON reports (status);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS reports;
+ALTER TABLE messages DROP COLUMN IF EXISTS hidden;
+ALTER TABLE ads DROP COLUMN IF EXISTS hidden;
+ALTER TABLE reviews DROP COLUMN IF EXISTS hidden;
+ALTER TABLE users DROP COLUMN IF EXISTS "isBanned";
+ALTER TABLE users DROP COLUMN IF EXISTS "isAdmin";
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,14 @@
+-- Write your migrate up statements here
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'guest';
+UPDATE users SET role = 'admin' WHERE "isAdmin";
+ALTER TABLE users DROP COLUMN IF EXISTS "isAdmin";
+
+---- create above / drop below ----
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS "isAdmin" BOOLEAN NOT NULL DEFAULT false;
+UPDATE users SET "isAdmin" = true WHERE role IN ('moderator', 'admin');
+ALTER TABLE users DROP COLUMN IF EXISTS role;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,12 @@
+-- Write your migrate up statements here
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS "emailVerified" BOOLEAN NOT NULL DEFAULT false;
+-- существующие аккаунты не блокируем
+UPDATE users SET "emailVerified" = true;
+
+---- create above / drop below ----
+
+ALTER TABLE users DROP COLUMN IF EXISTS "emailVerified";
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,21 @@
+-- Write your migrate up statements here
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS "totpSecret" VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS "totpEnabled" BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS recovery_codes (
+    id SERIAL PRIMARY KEY,
+    "userId" UUID NOT NULL REFERENCES users(uuid) ON DELETE CASCADE,
+    "codeHash" VARCHAR(64) NOT NULL,
+    "usedAt" TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_recovery_codes_user_id ON recovery_codes ("userId");
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS recovery_codes;
+ALTER TABLE users DROP COLUMN IF EXISTS "totpEnabled";
+ALTER TABLE users DROP COLUMN IF EXISTS "totpSecret";
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
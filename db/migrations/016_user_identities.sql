@@ -0,0 +1,18 @@
+-- Write your migrate up statements here
+
+CREATE TABLE IF NOT EXISTS user_identities (
+    id SERIAL PRIMARY KEY,
+    "userId" UUID NOT NULL REFERENCES users(uuid) ON DELETE CASCADE,
+    provider VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    "createdAt" TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_identity_subject ON user_identities (provider, subject);
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities ("userId");
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS user_identities;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
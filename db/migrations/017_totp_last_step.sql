@@ -0,0 +1,10 @@
+-- Write your migrate up statements here
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS "totpLastStep" BIGINT NOT NULL DEFAULT 0;
+
+---- create above / drop below ----
+
+ALTER TABLE users DROP COLUMN IF EXISTS "totpLastStep";
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
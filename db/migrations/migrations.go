@@ -0,0 +1,7 @@
+// Package migrations встраивает SQL-миграции в бинарник мигратора
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var Files embed.FS
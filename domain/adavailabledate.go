@@ -9,3 +9,20 @@ type AdAvailableDate struct {
 	AvailableDateTo   time.Time `gorm:"type:date;column:availableDateTo" json:"availableDateTo"`
 	Ad                Ad        `gorm:"foreignKey:adId;references:UUID"`
 }
+
+type AdBlockedDate struct {
+	ID          int       `gorm:"primary_key;auto_increment;column:id" json:"id"`
+	AdID        string    `gorm:"column:adId;not null;index" json:"adId"`
+	BlockedDate time.Time `gorm:"type:date;column:blockedDate;not null" json:"blockedDate"`
+	Ad          Ad        `gorm:"foreignKey:adId;references:UUID"`
+}
+
+type AvailableDateRange struct {
+	DateFrom time.Time `json:"dateFrom"`
+	DateTo   time.Time `json:"dateTo"`
+}
+
+type AdCalendar struct {
+	AvailableDates []AvailableDateRange `json:"availableDates"`
+	BlockedDates   []time.Time          `json:"blockedDates"`
+}
@@ -2,8 +2,21 @@ package domain
 
 type AdPosition struct {
 	ID        int     `gorm:"primary_key;auto_increment;column:id" json:"id"`
-	AdID      string  `gorm:"column:adId;not null" json:"adId"`
+	AdID      string  `gorm:"column:adId;not null;uniqueIndex" json:"adId"`
 	Latitude  float64 `gorm:"type:numeric;column:latitude" json:"latitude"`
 	Longitude float64 `gorm:"type:numeric;column:longitude" json:"longitude"`
 	Ad        Ad      `gorm:"foreignKey:adId;references:UUID"`
 }
+
+type GeoPoint struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// GeoBounds - прямоугольная область карты
+type GeoBounds struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+}
@@ -14,7 +14,8 @@ type PlacesResponse struct {
 
 //easyjson:json
 type GetAllAdsListResponse struct {
-	Housing []GetAllAdsResponse `json:"housing"`
+	Housing    []GetAllAdsResponse `json:"housing"`
+	NextCursor string              `json:"nextCursor,omitempty"`
 }
 
 //easyjson:json
@@ -39,8 +40,14 @@ type Ad struct {
 	HasElevator     bool      `gorm:"type:bool;default:false;column:hasElevator" json:"hasElevator"`
 	HasGas          bool      `gorm:"type:bool;default:false;column:hasGas" json:"hasGas"`
 	LikesCount      int       `gorm:"column:likesCount;default:0" json:"likesCount"`
+	ReviewsRating   float64   `gorm:"column:reviewsRating;default:0" json:"rating"`
+	ReviewsCount    int       `gorm:"column:reviewsCount;default:0" json:"reviewsCount"`
 	Priority        int       `gorm:"column:priority;default:0" json:"priority"`
 	EndBoostDate    time.Time `gorm:"type:date;column:endBoostDate" json:"endBoostDate"`
+	Price           int       `gorm:"column:price;default:0;not null" json:"price"`
+	WeekendPrice    int       `gorm:"column:weekendPrice;default:0" json:"weekendPrice"`
+	CleaningFee     int       `gorm:"column:cleaningFee;default:0" json:"cleaningFee"`
+	Hidden          bool      `gorm:"column:hidden;default:false;not null;<-:false" json:"-"` // скрыто модератором
 	City            City      `gorm:"foreignKey:CityID;references:ID" json:"-"`
 	Author          User      `gorm:"foreignKey:AuthorUUID;references:UUID" json:"-"`
 }
@@ -53,69 +60,120 @@ type Favorites struct {
 }
 
 type GetAllAdsResponse struct {
-	UUID            string            `gorm:"type:uuid;primaryKey;default:gen_random_uuid();column:uuid" json:"id"`
-	CityID          int               `gorm:"column:cityId;not null" json:"cityId"`
-	AuthorUUID      string            `gorm:"column:authorUUID;not null" json:"authorUUID"`
-	Address         string            `gorm:"type:varchar(255);column:address" json:"address"`
-	PublicationDate time.Time         `gorm:"type:date;column:publicationDate" json:"publicationDate"`
-	Description     string            `gorm:"type:text;size:1000;column:description" json:"description"`
-	RoomsNumber     int               `gorm:"column:roomsNumber" json:"roomsNumber"`
-	City            City              `gorm:"foreignKey:CityID;references:ID" json:"-"`
-	Author          User              `gorm:"foreignKey:AuthorUUID;references:UUID" json:"-"`
-	ViewsCount      int               `gorm:"column:viewsCount;default:0" json:"viewsCount"`
-	SquareMeters    int               `gorm:"column:squareMeters" json:"squareMeters"`
-	Floor           int               `gorm:"column:floor" json:"floor"`
-	BuildingType    string            `gorm:"type:text;size:255;column:buildingType" json:"buildingType"`
-	HasBalcony      bool              `gorm:"type:bool;default:false;column:hasBalcony" json:"hasBalcony"`
-	HasElevator     bool              `gorm:"type:bool;default:false;column:hasElevator" json:"hasElevator"`
-	HasGas          bool              `gorm:"type:bool;default:false;column:hasGas" json:"hasGas"`
-	LikesCount      int               `gorm:"column:likesCount;default:0" json:"likesCount"`
-	Priority        int               `gorm:"column:priority;default:0" json:"priority"`
-	EndBoostDate    time.Time         `gorm:"type:date;column:endBoostDate" json:"endBoostDate"`
-	CityName        string            `json:"cityName"`
-	AdDateFrom      time.Time         `json:"adDateFrom"`
-	AdDateTo        time.Time         `json:"adDateTo"`
-	IsFavorite      bool              `json:"isFavorite"`
-	AdAuthor        UserResponce      `gorm:"-" json:"author"`
-	Images          []ImageResponse   `gorm:"-" json:"images"`
-	Rooms           []AdRoomsResponse `gorm:"-" json:"rooms"`
+	UUID                 string            `gorm:"type:uuid;primaryKey;default:gen_random_uuid();column:uuid" json:"id"`
+	CityID               int               `gorm:"column:cityId;not null" json:"cityId"`
+	AuthorUUID           string            `gorm:"column:authorUUID;not null" json:"authorUUID"`
+	Address              string            `gorm:"type:varchar(255);column:address" json:"address"`
+	PublicationDate      time.Time         `gorm:"type:date;column:publicationDate" json:"publicationDate"`
+	Description          string            `gorm:"type:text;size:1000;column:description" json:"description"`
+	RoomsNumber          int               `gorm:"column:roomsNumber" json:"roomsNumber"`
+	City                 City              `gorm:"foreignKey:CityID;references:ID" json:"-"`
+	Author               User              `gorm:"foreignKey:AuthorUUID;references:UUID" json:"-"`
+	ViewsCount           int               `gorm:"column:viewsCount;default:0" json:"viewsCount"`
+	SquareMeters         int               `gorm:"column:squareMeters" json:"squareMeters"`
+	Floor                int               `gorm:"column:floor" json:"floor"`
+	BuildingType         string            `gorm:"type:text;size:255;column:buildingType" json:"buildingType"`
+	HasBalcony           bool              `gorm:"type:bool;default:false;column:hasBalcony" json:"hasBalcony"`
+	HasElevator          bool              `gorm:"type:bool;default:false;column:hasElevator" json:"hasElevator"`
+	HasGas               bool              `gorm:"type:bool;default:false;column:hasGas" json:"hasGas"`
+	LikesCount           int               `gorm:"column:likesCount;default:0" json:"likesCount"`
+	ReviewsRating        float64           `gorm:"column:reviewsRating;default:0" json:"rating"`
+	ReviewsCount         int               `gorm:"column:reviewsCount;default:0" json:"reviewsCount"`
+	Priority             int               `gorm:"column:priority;default:0" json:"priority"`
+	EndBoostDate         time.Time         `gorm:"type:date;column:endBoostDate" json:"endBoostDate"`
+	Price                int               `gorm:"column:price;default:0" json:"price"`
+	WeekendPrice         int               `gorm:"column:weekendPrice;default:0" json:"weekendPrice"`
+	CleaningFee          int               `gorm:"column:cleaningFee;default:0" json:"cleaningFee"`
+	Hidden               bool              `gorm:"column:hidden;<-:false" json:"-"`
+	Latitude             *float64          `json:"latitude,omitempty"`
+	Longitude            *float64          `json:"longitude,omitempty"`
+	Distance             *float64          `json:"distance,omitempty"`
+	SearchRank           *float64          `json:"searchRank,omitempty"`
+	DescriptionHighlight string            `json:"descriptionHighlight,omitempty"`
+	AddressHighlight     string            `json:"addressHighlight,omitempty"`
+	CityName             string            `json:"cityName"`
+	AdDateFrom           time.Time         `json:"adDateFrom"`
+	AdDateTo             time.Time         `json:"adDateTo"`
+	IsFavorite           bool              `json:"isFavorite"`
+	AdAuthor             UserResponce      `gorm:"-" json:"author"`
+	Images               []ImageResponse   `gorm:"-" json:"images"`
+	Rooms                []AdRoomsResponse `gorm:"-" json:"rooms"`
+	Calendar             *AdCalendar       `gorm:"-" json:"calendar,omitempty"`
+	StayPrice            *StayPrice        `gorm:"-" json:"stayPrice,omitempty"`
+}
+
+// StayPrice - расчёт стоимости проживания на выбранные даты
+type StayPrice struct {
+	DateFrom    time.Time `json:"dateFrom"`
+	DateTo      time.Time `json:"dateTo"`
+	Nights      int       `json:"nights"`
+	NightsPrice int       `json:"nightsPrice"`
+	CleaningFee int       `json:"cleaningFee"`
+	Total       int       `json:"total"`
 }
 
 type CreateAdRequest struct {
-	CityName     string            `form:"cityName" json:"cityName"`
-	Address      string            `form:"address" json:"address"`
-	Description  string            `form:"description" json:"description"`
-	RoomsNumber  int               `form:"roomsNumber" json:"roomsNumber"`
-	DateFrom     time.Time         `form:"dateFrom" json:"dateFrom"`
-	DateTo       time.Time         `form:"dateTo" json:"dateTo"`
-	Rooms        []AdRoomsResponse `form:"rooms" json:"rooms"`
-	SquareMeters int               `form:"squareMeters" json:"squareMeters"`
-	Floor        int               `form:"floor" json:"floor"`
-	BuildingType string            `form:"buildingType" json:"buildingType"`
-	HasBalcony   bool              `form:"hasBalcony" json:"hasBalcony"`
-	HasElevator  bool              `form:"hasElevator" json:"hasElevator"`
-	HasGas       bool              `form:"hasGas" json:"hasGas"`
+	CityName       string               `form:"cityName" json:"cityName"`
+	Address        string               `form:"address" json:"address"`
+	Description    string               `form:"description" json:"description"`
+	RoomsNumber    int                  `form:"roomsNumber" json:"roomsNumber"`
+	DateFrom       time.Time            `form:"dateFrom" json:"dateFrom"`
+	DateTo         time.Time            `form:"dateTo" json:"dateTo"`
+	AvailableDates []AvailableDateRange `form:"availableDates" json:"availableDates"`
+	BlockedDates   []time.Time          `form:"blockedDates" json:"blockedDates"`
+	Rooms          []AdRoomsResponse    `form:"rooms" json:"rooms"`
+	SquareMeters   int                  `form:"squareMeters" json:"squareMeters"`
+	Floor          int                  `form:"floor" json:"floor"`
+	BuildingType   string               `form:"buildingType" json:"buildingType"`
+	HasBalcony     bool                 `form:"hasBalcony" json:"hasBalcony"`
+	HasElevator    bool                 `form:"hasElevator" json:"hasElevator"`
+	HasGas         bool                 `form:"hasGas" json:"hasGas"`
+	Price          int                  `form:"price" json:"price"`
+	WeekendPrice   int                  `form:"weekendPrice" json:"weekendPrice"`
+	CleaningFee    int                  `form:"cleaningFee" json:"cleaningFee"`
+	Position       *GeoPoint            `form:"position" json:"position,omitempty"`
 }
 
 type UpdateAdRequest struct {
-	CityName     string            `form:"cityName" json:"cityName"`
-	Address      string            `form:"address" json:"address"`
-	Description  string            `form:"description" json:"description"`
-	RoomsNumber  int               `form:"roomsNumber" json:"roomsNumber"`
-	DateFrom     time.Time         `form:"dateFrom" json:"dateFrom"`
-	DateTo       time.Time         `form:"dateTo" json:"dateTo"`
-	Rooms        []AdRoomsResponse `form:"rooms" json:"rooms"`
-	SquareMeters int               `form:"squareMeters" json:"squareMeters"`
-	Floor        int               `form:"floor" json:"floor"`
-	BuildingType string            `form:"buildingType" json:"buildingType"`
-	HasBalcony   bool              `form:"hasBalcony" json:"hasBalcony"`
-	HasElevator  bool              `form:"hasElevator" json:"hasElevator"`
-	HasGas       bool              `form:"hasGas" json:"hasGas"`
+	CityName       string               `form:"cityName" json:"cityName"`
+	Address        string               `form:"address" json:"address"`
+	Description    string               `form:"description" json:"description"`
+	RoomsNumber    int                  `form:"roomsNumber" json:"roomsNumber"`
+	DateFrom       time.Time            `form:"dateFrom" json:"dateFrom"`
+	DateTo         time.Time            `form:"dateTo" json:"dateTo"`
+	AvailableDates []AvailableDateRange `form:"availableDates" json:"availableDates"`
+	BlockedDates   []time.Time          `form:"blockedDates" json:"blockedDates"`
+	Rooms          []AdRoomsResponse    `form:"rooms" json:"rooms"`
+	SquareMeters   int                  `form:"squareMeters" json:"squareMeters"`
+	Floor          int                  `form:"floor" json:"floor"`
+	BuildingType   string               `form:"buildingType" json:"buildingType"`
+	HasBalcony     bool                 `form:"hasBalcony" json:"hasBalcony"`
+	HasElevator    bool                 `form:"hasElevator" json:"hasElevator"`
+	HasGas         bool                 `form:"hasGas" json:"hasGas"`
+	Price          int                  `form:"price" json:"price"`
+	WeekendPrice   int                  `form:"weekendPrice" json:"weekendPrice"`
+	CleaningFee    int                  `form:"cleaningFee" json:"cleaningFee"`
+	Position       *GeoPoint            `form:"position" json:"position,omitempty"`
+	// Календарь заменяется, только если в запросе были его поля
+	ReplaceCalendar bool `form:"-" json:"-"`
 }
 
+const (
+	AdSortPriceAsc  = "price_asc"
+	AdSortPriceDesc = "price_desc"
+)
+
+// Режимы фильтра по рейтингу хозяина: среднее по отзывам или байесовская оценка,
+// которая не даёт одному отзыву на 5 обогнать сотни отзывов на 4.8
+const (
+	RatingModeAverage  = "average"
+	RatingModeWeighted = "weighted"
+)
+
 type AdFilter struct {
 	Location    string
 	Rating      string
+	RatingMode  string
 	NewThisWeek string
 	HostGender  string
 	GuestCount  string
@@ -124,6 +182,20 @@ type AdFilter struct {
 	DateFrom    time.Time
 	DateTo      time.Time
 	Favorites   string
+	PriceMin    int
+	PriceMax    int
+	Sort        string
+	Near        *GeoPoint
+	RadiusKm    float64
+	Cursor      *AdCursor
+	Query       string
+}
+
+// AdCursor — ключ последнего объявления страницы в порядке (priority, publicationDate, uuid)
+type AdCursor struct {
+	Priority        int       `json:"p"`
+	PublicationDate time.Time `json:"d"`
+	UUID            string    `json:"id"`
 }
 
 type PaymentInfo struct {
@@ -151,4 +223,14 @@ type AdRepository interface {
 	UpdateFavoritesCount(ctx context.Context, adId string) error
 	UpdatePriority(ctx context.Context, adId string, userId string, amount int) error
 	ResetExpiredPriorities(ctx context.Context) error
+	CreateBooking(ctx context.Context, booking *Booking) error
+	GetBookingById(ctx context.Context, bookingId int) (Booking, error)
+	GetAdBookings(ctx context.Context, adId string) ([]Booking, error)
+	GetUserBookings(ctx context.Context, userId string) ([]Booking, error)
+	HasApprovedBookingOverlap(ctx context.Context, adId string, dateFrom time.Time, dateTo time.Time, excludeId int) (bool, error)
+	// UpdateBookingStatus меняет статус, только если заявка всё ещё в статусе fromStatus,
+	// при одобрении заново проверяет пересечение с одобренными заявками
+	UpdateBookingStatus(ctx context.Context, booking *Booking, fromStatus string) error
+	IsAdAvailable(ctx context.Context, adId string, dateFrom time.Time, dateTo time.Time) (bool, error)
+	GetPlacesInBounds(ctx context.Context, bounds GeoBounds, limit int) ([]GetAllAdsResponse, error)
 }
@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -52,6 +53,54 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain(in *jlexer.Lexer, out *UpdateAdR
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.DateTo).UnmarshalJSON(data))
 			}
+		case "availableDates":
+			if in.IsNull() {
+				in.Skip()
+				out.AvailableDates = nil
+			} else {
+				in.Delim('[')
+				if out.AvailableDates == nil {
+					if !in.IsDelim(']') {
+						out.AvailableDates = make([]AvailableDateRange, 0, 1)
+					} else {
+						out.AvailableDates = []AvailableDateRange{}
+					}
+				} else {
+					out.AvailableDates = (out.AvailableDates)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 AvailableDateRange
+					easyjson3a862f94Decode20242FIGHTCLUBDomain1(in, &v1)
+					out.AvailableDates = append(out.AvailableDates, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "blockedDates":
+			if in.IsNull() {
+				in.Skip()
+				out.BlockedDates = nil
+			} else {
+				in.Delim('[')
+				if out.BlockedDates == nil {
+					if !in.IsDelim(']') {
+						out.BlockedDates = make([]time.Time, 0, 2)
+					} else {
+						out.BlockedDates = []time.Time{}
+					}
+				} else {
+					out.BlockedDates = (out.BlockedDates)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 time.Time
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v2).UnmarshalJSON(data))
+					}
+					out.BlockedDates = append(out.BlockedDates, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "rooms":
 			if in.IsNull() {
 				in.Skip()
@@ -68,9 +117,9 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain(in *jlexer.Lexer, out *UpdateAdR
 					out.Rooms = (out.Rooms)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 AdRoomsResponse
-					easyjson3a862f94Decode20242FIGHTCLUBDomain1(in, &v1)
-					out.Rooms = append(out.Rooms, v1)
+					var v3 AdRoomsResponse
+					easyjson3a862f94Decode20242FIGHTCLUBDomain2(in, &v3)
+					out.Rooms = append(out.Rooms, v3)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -87,6 +136,22 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain(in *jlexer.Lexer, out *UpdateAdR
 			out.HasElevator = bool(in.Bool())
 		case "hasGas":
 			out.HasGas = bool(in.Bool())
+		case "price":
+			out.Price = int(in.Int())
+		case "weekendPrice":
+			out.WeekendPrice = int(in.Int())
+		case "cleaningFee":
+			out.CleaningFee = int(in.Int())
+		case "position":
+			if in.IsNull() {
+				in.Skip()
+				out.Position = nil
+			} else {
+				if out.Position == nil {
+					out.Position = new(GeoPoint)
+				}
+				easyjson3a862f94Decode20242FIGHTCLUBDomain3(in, out.Position)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -131,6 +196,38 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain(out *jwriter.Writer, in UpdateAd
 		out.RawString(prefix)
 		out.Raw((in.DateTo).MarshalJSON())
 	}
+	{
+		const prefix string = ",\"availableDates\":"
+		out.RawString(prefix)
+		if in.AvailableDates == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.AvailableDates {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				easyjson3a862f94Encode20242FIGHTCLUBDomain1(out, v5)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"blockedDates\":"
+		out.RawString(prefix)
+		if in.BlockedDates == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.BlockedDates {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				out.Raw((v7).MarshalJSON())
+			}
+			out.RawByte(']')
+		}
+	}
 	{
 		const prefix string = ",\"rooms\":"
 		out.RawString(prefix)
@@ -138,11 +235,11 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain(out *jwriter.Writer, in UpdateAd
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Rooms {
-				if v2 > 0 {
+			for v8, v9 := range in.Rooms {
+				if v8 > 0 {
 					out.RawByte(',')
 				}
-				easyjson3a862f94Encode20242FIGHTCLUBDomain1(out, v3)
+				easyjson3a862f94Encode20242FIGHTCLUBDomain2(out, v9)
 			}
 			out.RawByte(']')
 		}
@@ -177,6 +274,26 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain(out *jwriter.Writer, in UpdateAd
 		out.RawString(prefix)
 		out.Bool(bool(in.HasGas))
 	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Int(int(in.Price))
+	}
+	{
+		const prefix string = ",\"weekendPrice\":"
+		out.RawString(prefix)
+		out.Int(int(in.WeekendPrice))
+	}
+	{
+		const prefix string = ",\"cleaningFee\":"
+		out.RawString(prefix)
+		out.Int(int(in.CleaningFee))
+	}
+	if in.Position != nil {
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		easyjson3a862f94Encode20242FIGHTCLUBDomain3(out, *in.Position)
+	}
 	out.RawByte('}')
 }
 
@@ -203,7 +320,56 @@ func (v *UpdateAdRequest) UnmarshalJSON(data []byte) error {
 func (v *UpdateAdRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson3a862f94Decode20242FIGHTCLUBDomain(l, v)
 }
-func easyjson3a862f94Decode20242FIGHTCLUBDomain1(in *jlexer.Lexer, out *AdRoomsResponse) {
+func easyjson3a862f94Decode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *GeoPoint) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "latitude":
+			out.Latitude = float64(in.Float64())
+		case "longitude":
+			out.Longitude = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3a862f94Encode20242FIGHTCLUBDomain3(out *jwriter.Writer, in GeoPoint) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"latitude\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Latitude))
+	}
+	{
+		const prefix string = ",\"longitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Longitude))
+	}
+	out.RawByte('}')
+}
+func easyjson3a862f94Decode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *AdRoomsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -236,7 +402,7 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain1(in *jlexer.Lexer, out *AdRoomsR
 		in.Consumed()
 	}
 }
-func easyjson3a862f94Encode20242FIGHTCLUBDomain1(out *jwriter.Writer, in AdRoomsResponse) {
+func easyjson3a862f94Encode20242FIGHTCLUBDomain2(out *jwriter.Writer, in AdRoomsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -252,7 +418,165 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain1(out *jwriter.Writer, in AdRooms
 	}
 	out.RawByte('}')
 }
-func easyjson3a862f94Decode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *PlacesResponse) {
+func easyjson3a862f94Decode20242FIGHTCLUBDomain1(in *jlexer.Lexer, out *AvailableDateRange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "dateFrom":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateFrom).UnmarshalJSON(data))
+			}
+		case "dateTo":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateTo).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3a862f94Encode20242FIGHTCLUBDomain1(out *jwriter.Writer, in AvailableDateRange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"dateFrom\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.DateFrom).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"dateTo\":"
+		out.RawString(prefix)
+		out.Raw((in.DateTo).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjson3a862f94Decode20242FIGHTCLUBDomain4(in *jlexer.Lexer, out *StayPrice) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "dateFrom":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateFrom).UnmarshalJSON(data))
+			}
+		case "dateTo":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateTo).UnmarshalJSON(data))
+			}
+		case "nights":
+			out.Nights = int(in.Int())
+		case "nightsPrice":
+			out.NightsPrice = int(in.Int())
+		case "cleaningFee":
+			out.CleaningFee = int(in.Int())
+		case "total":
+			out.Total = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3a862f94Encode20242FIGHTCLUBDomain4(out *jwriter.Writer, in StayPrice) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"dateFrom\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.DateFrom).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"dateTo\":"
+		out.RawString(prefix)
+		out.Raw((in.DateTo).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"nights\":"
+		out.RawString(prefix)
+		out.Int(int(in.Nights))
+	}
+	{
+		const prefix string = ",\"nightsPrice\":"
+		out.RawString(prefix)
+		out.Int(int(in.NightsPrice))
+	}
+	{
+		const prefix string = ",\"cleaningFee\":"
+		out.RawString(prefix)
+		out.Int(int(in.CleaningFee))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StayPrice) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3a862f94Encode20242FIGHTCLUBDomain4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StayPrice) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3a862f94Encode20242FIGHTCLUBDomain4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StayPrice) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3a862f94Decode20242FIGHTCLUBDomain4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StayPrice) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3a862f94Decode20242FIGHTCLUBDomain4(l, v)
+}
+func easyjson3a862f94Decode20242FIGHTCLUBDomain5(in *jlexer.Lexer, out *PlacesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -283,7 +607,7 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *PlacesRe
 		in.Consumed()
 	}
 }
-func easyjson3a862f94Encode20242FIGHTCLUBDomain2(out *jwriter.Writer, in PlacesResponse) {
+func easyjson3a862f94Encode20242FIGHTCLUBDomain5(out *jwriter.Writer, in PlacesResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -298,27 +622,27 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain2(out *jwriter.Writer, in PlacesR
 // MarshalJSON supports json.Marshaler interface
 func (v PlacesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3a862f94Encode20242FIGHTCLUBDomain2(&w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PlacesResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3a862f94Encode20242FIGHTCLUBDomain2(w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PlacesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3a862f94Decode20242FIGHTCLUBDomain2(&r, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PlacesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3a862f94Decode20242FIGHTCLUBDomain2(l, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain5(l, v)
 }
-func easyjson3a862f94Decode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *PaymentInfo) {
+func easyjson3a862f94Decode20242FIGHTCLUBDomain6(in *jlexer.Lexer, out *PaymentInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -355,7 +679,7 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *PaymentI
 		in.Consumed()
 	}
 }
-func easyjson3a862f94Encode20242FIGHTCLUBDomain3(out *jwriter.Writer, in PaymentInfo) {
+func easyjson3a862f94Encode20242FIGHTCLUBDomain6(out *jwriter.Writer, in PaymentInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -385,27 +709,27 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain3(out *jwriter.Writer, in Payment
 // MarshalJSON supports json.Marshaler interface
 func (v PaymentInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3a862f94Encode20242FIGHTCLUBDomain3(&w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PaymentInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3a862f94Encode20242FIGHTCLUBDomain3(w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PaymentInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3a862f94Decode20242FIGHTCLUBDomain3(&r, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PaymentInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3a862f94Decode20242FIGHTCLUBDomain3(l, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain6(l, v)
 }
-func easyjson3a862f94Decode20242FIGHTCLUBDomain4(in *jlexer.Lexer, out *GetOneAdResponse) {
+func easyjson3a862f94Decode20242FIGHTCLUBDomain7(in *jlexer.Lexer, out *GetOneAdResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -436,7 +760,7 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain4(in *jlexer.Lexer, out *GetOneAd
 		in.Consumed()
 	}
 }
-func easyjson3a862f94Encode20242FIGHTCLUBDomain4(out *jwriter.Writer, in GetOneAdResponse) {
+func easyjson3a862f94Encode20242FIGHTCLUBDomain7(out *jwriter.Writer, in GetOneAdResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -451,27 +775,27 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain4(out *jwriter.Writer, in GetOneA
 // MarshalJSON supports json.Marshaler interface
 func (v GetOneAdResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3a862f94Encode20242FIGHTCLUBDomain4(&w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GetOneAdResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3a862f94Encode20242FIGHTCLUBDomain4(w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GetOneAdResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3a862f94Decode20242FIGHTCLUBDomain4(&r, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GetOneAdResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3a862f94Decode20242FIGHTCLUBDomain4(l, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain7(l, v)
 }
-func easyjson3a862f94Decode20242FIGHTCLUBDomain5(in *jlexer.Lexer, out *GetAllAdsResponse) {
+func easyjson3a862f94Decode20242FIGHTCLUBDomain8(in *jlexer.Lexer, out *GetAllAdsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -522,12 +846,66 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain5(in *jlexer.Lexer, out *GetAllAd
 			out.HasGas = bool(in.Bool())
 		case "likesCount":
 			out.LikesCount = int(in.Int())
+		case "rating":
+			out.ReviewsRating = float64(in.Float64())
+		case "reviewsCount":
+			out.ReviewsCount = int(in.Int())
 		case "priority":
 			out.Priority = int(in.Int())
 		case "endBoostDate":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.EndBoostDate).UnmarshalJSON(data))
 			}
+		case "price":
+			out.Price = int(in.Int())
+		case "weekendPrice":
+			out.WeekendPrice = int(in.Int())
+		case "cleaningFee":
+			out.CleaningFee = int(in.Int())
+		case "latitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Latitude = nil
+			} else {
+				if out.Latitude == nil {
+					out.Latitude = new(float64)
+				}
+				*out.Latitude = float64(in.Float64())
+			}
+		case "longitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Longitude = nil
+			} else {
+				if out.Longitude == nil {
+					out.Longitude = new(float64)
+				}
+				*out.Longitude = float64(in.Float64())
+			}
+		case "distance":
+			if in.IsNull() {
+				in.Skip()
+				out.Distance = nil
+			} else {
+				if out.Distance == nil {
+					out.Distance = new(float64)
+				}
+				*out.Distance = float64(in.Float64())
+			}
+		case "searchRank":
+			if in.IsNull() {
+				in.Skip()
+				out.SearchRank = nil
+			} else {
+				if out.SearchRank == nil {
+					out.SearchRank = new(float64)
+				}
+				*out.SearchRank = float64(in.Float64())
+			}
+		case "descriptionHighlight":
+			out.DescriptionHighlight = string(in.String())
+		case "addressHighlight":
+			out.AddressHighlight = string(in.String())
 		case "cityName":
 			out.CityName = string(in.String())
 		case "adDateFrom":
@@ -541,7 +919,7 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain5(in *jlexer.Lexer, out *GetAllAd
 		case "isFavorite":
 			out.IsFavorite = bool(in.Bool())
 		case "author":
-			easyjson3a862f94Decode20242FIGHTCLUBDomain6(in, &out.AdAuthor)
+			(out.AdAuthor).UnmarshalEasyJSON(in)
 		case "images":
 			if in.IsNull() {
 				in.Skip()
@@ -558,9 +936,9 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain5(in *jlexer.Lexer, out *GetAllAd
 					out.Images = (out.Images)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 ImageResponse
-					easyjson3a862f94Decode20242FIGHTCLUBDomain7(in, &v4)
-					out.Images = append(out.Images, v4)
+					var v10 ImageResponse
+					easyjson3a862f94Decode20242FIGHTCLUBDomain9(in, &v10)
+					out.Images = append(out.Images, v10)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -581,13 +959,33 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain5(in *jlexer.Lexer, out *GetAllAd
 					out.Rooms = (out.Rooms)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v5 AdRoomsResponse
-					easyjson3a862f94Decode20242FIGHTCLUBDomain1(in, &v5)
-					out.Rooms = append(out.Rooms, v5)
+					var v11 AdRoomsResponse
+					easyjson3a862f94Decode20242FIGHTCLUBDomain2(in, &v11)
+					out.Rooms = append(out.Rooms, v11)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "calendar":
+			if in.IsNull() {
+				in.Skip()
+				out.Calendar = nil
+			} else {
+				if out.Calendar == nil {
+					out.Calendar = new(AdCalendar)
+				}
+				easyjson3a862f94Decode20242FIGHTCLUBDomain10(in, out.Calendar)
+			}
+		case "stayPrice":
+			if in.IsNull() {
+				in.Skip()
+				out.StayPrice = nil
+			} else {
+				if out.StayPrice == nil {
+					out.StayPrice = new(StayPrice)
+				}
+				(*out.StayPrice).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -598,7 +996,7 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain5(in *jlexer.Lexer, out *GetAllAd
 		in.Consumed()
 	}
 }
-func easyjson3a862f94Encode20242FIGHTCLUBDomain5(out *jwriter.Writer, in GetAllAdsResponse) {
+func easyjson3a862f94Encode20242FIGHTCLUBDomain8(out *jwriter.Writer, in GetAllAdsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -677,6 +1075,16 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain5(out *jwriter.Writer, in GetAllA
 		out.RawString(prefix)
 		out.Int(int(in.LikesCount))
 	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ReviewsRating))
+	}
+	{
+		const prefix string = ",\"reviewsCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReviewsCount))
+	}
 	{
 		const prefix string = ",\"priority\":"
 		out.RawString(prefix)
@@ -687,6 +1095,51 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain5(out *jwriter.Writer, in GetAllA
 		out.RawString(prefix)
 		out.Raw((in.EndBoostDate).MarshalJSON())
 	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Int(int(in.Price))
+	}
+	{
+		const prefix string = ",\"weekendPrice\":"
+		out.RawString(prefix)
+		out.Int(int(in.WeekendPrice))
+	}
+	{
+		const prefix string = ",\"cleaningFee\":"
+		out.RawString(prefix)
+		out.Int(int(in.CleaningFee))
+	}
+	if in.Latitude != nil {
+		const prefix string = ",\"latitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Latitude))
+	}
+	if in.Longitude != nil {
+		const prefix string = ",\"longitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Longitude))
+	}
+	if in.Distance != nil {
+		const prefix string = ",\"distance\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Distance))
+	}
+	if in.SearchRank != nil {
+		const prefix string = ",\"searchRank\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.SearchRank))
+	}
+	if in.DescriptionHighlight != "" {
+		const prefix string = ",\"descriptionHighlight\":"
+		out.RawString(prefix)
+		out.String(string(in.DescriptionHighlight))
+	}
+	if in.AddressHighlight != "" {
+		const prefix string = ",\"addressHighlight\":"
+		out.RawString(prefix)
+		out.String(string(in.AddressHighlight))
+	}
 	{
 		const prefix string = ",\"cityName\":"
 		out.RawString(prefix)
@@ -710,7 +1163,7 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain5(out *jwriter.Writer, in GetAllA
 	{
 		const prefix string = ",\"author\":"
 		out.RawString(prefix)
-		easyjson3a862f94Encode20242FIGHTCLUBDomain6(out, in.AdAuthor)
+		(in.AdAuthor).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"images\":"
@@ -719,11 +1172,11 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain5(out *jwriter.Writer, in GetAllA
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v6, v7 := range in.Images {
-				if v6 > 0 {
+			for v12, v13 := range in.Images {
+				if v12 > 0 {
 					out.RawByte(',')
 				}
-				easyjson3a862f94Encode20242FIGHTCLUBDomain7(out, v7)
+				easyjson3a862f94Encode20242FIGHTCLUBDomain9(out, v13)
 			}
 			out.RawByte(']')
 		}
@@ -735,42 +1188,52 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain5(out *jwriter.Writer, in GetAllA
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v8, v9 := range in.Rooms {
-				if v8 > 0 {
+			for v14, v15 := range in.Rooms {
+				if v14 > 0 {
 					out.RawByte(',')
 				}
-				easyjson3a862f94Encode20242FIGHTCLUBDomain1(out, v9)
+				easyjson3a862f94Encode20242FIGHTCLUBDomain2(out, v15)
 			}
 			out.RawByte(']')
 		}
 	}
+	if in.Calendar != nil {
+		const prefix string = ",\"calendar\":"
+		out.RawString(prefix)
+		easyjson3a862f94Encode20242FIGHTCLUBDomain10(out, *in.Calendar)
+	}
+	if in.StayPrice != nil {
+		const prefix string = ",\"stayPrice\":"
+		out.RawString(prefix)
+		(*in.StayPrice).MarshalEasyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v GetAllAdsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3a862f94Encode20242FIGHTCLUBDomain5(&w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GetAllAdsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3a862f94Encode20242FIGHTCLUBDomain5(w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GetAllAdsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3a862f94Decode20242FIGHTCLUBDomain5(&r, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GetAllAdsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3a862f94Decode20242FIGHTCLUBDomain5(l, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain8(l, v)
 }
-func easyjson3a862f94Decode20242FIGHTCLUBDomain7(in *jlexer.Lexer, out *ImageResponse) {
+func easyjson3a862f94Decode20242FIGHTCLUBDomain10(in *jlexer.Lexer, out *AdCalendar) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -789,10 +1252,54 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain7(in *jlexer.Lexer, out *ImageRes
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int(in.Int())
-		case "path":
-			out.ImagePath = string(in.String())
+		case "availableDates":
+			if in.IsNull() {
+				in.Skip()
+				out.AvailableDates = nil
+			} else {
+				in.Delim('[')
+				if out.AvailableDates == nil {
+					if !in.IsDelim(']') {
+						out.AvailableDates = make([]AvailableDateRange, 0, 1)
+					} else {
+						out.AvailableDates = []AvailableDateRange{}
+					}
+				} else {
+					out.AvailableDates = (out.AvailableDates)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 AvailableDateRange
+					easyjson3a862f94Decode20242FIGHTCLUBDomain1(in, &v16)
+					out.AvailableDates = append(out.AvailableDates, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "blockedDates":
+			if in.IsNull() {
+				in.Skip()
+				out.BlockedDates = nil
+			} else {
+				in.Delim('[')
+				if out.BlockedDates == nil {
+					if !in.IsDelim(']') {
+						out.BlockedDates = make([]time.Time, 0, 2)
+					} else {
+						out.BlockedDates = []time.Time{}
+					}
+				} else {
+					out.BlockedDates = (out.BlockedDates)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v17 time.Time
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v17).UnmarshalJSON(data))
+					}
+					out.BlockedDates = append(out.BlockedDates, v17)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -803,23 +1310,45 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain7(in *jlexer.Lexer, out *ImageRes
 		in.Consumed()
 	}
 }
-func easyjson3a862f94Encode20242FIGHTCLUBDomain7(out *jwriter.Writer, in ImageResponse) {
+func easyjson3a862f94Encode20242FIGHTCLUBDomain10(out *jwriter.Writer, in AdCalendar) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"availableDates\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ID))
+		if in.AvailableDates == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v18, v19 := range in.AvailableDates {
+				if v18 > 0 {
+					out.RawByte(',')
+				}
+				easyjson3a862f94Encode20242FIGHTCLUBDomain1(out, v19)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"path\":"
+		const prefix string = ",\"blockedDates\":"
 		out.RawString(prefix)
-		out.String(string(in.ImagePath))
+		if in.BlockedDates == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.BlockedDates {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				out.Raw((v21).MarshalJSON())
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
-func easyjson3a862f94Decode20242FIGHTCLUBDomain6(in *jlexer.Lexer, out *UserResponce) {
+func easyjson3a862f94Decode20242FIGHTCLUBDomain9(in *jlexer.Lexer, out *ImageResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -838,20 +1367,10 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain6(in *jlexer.Lexer, out *UserResp
 			continue
 		}
 		switch key {
-		case "rating":
-			out.Rating = float64(in.Float64())
-		case "avatar":
-			out.Avatar = string(in.String())
-		case "name":
-			out.Name = string(in.String())
-		case "sex":
-			out.Sex = string(in.String())
-		case "birthDate":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Birthdate).UnmarshalJSON(data))
-			}
-		case "guestCount":
-			out.GuestCount = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "path":
+			out.ImagePath = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -862,43 +1381,23 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain6(in *jlexer.Lexer, out *UserResp
 		in.Consumed()
 	}
 }
-func easyjson3a862f94Encode20242FIGHTCLUBDomain6(out *jwriter.Writer, in UserResponce) {
+func easyjson3a862f94Encode20242FIGHTCLUBDomain9(out *jwriter.Writer, in ImageResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"rating\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Float64(float64(in.Rating))
-	}
-	{
-		const prefix string = ",\"avatar\":"
-		out.RawString(prefix)
-		out.String(string(in.Avatar))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"sex\":"
-		out.RawString(prefix)
-		out.String(string(in.Sex))
-	}
-	{
-		const prefix string = ",\"birthDate\":"
-		out.RawString(prefix)
-		out.Raw((in.Birthdate).MarshalJSON())
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"guestCount\":"
+		const prefix string = ",\"path\":"
 		out.RawString(prefix)
-		out.Int(int(in.GuestCount))
+		out.String(string(in.ImagePath))
 	}
 	out.RawByte('}')
 }
-func easyjson3a862f94Decode20242FIGHTCLUBDomain8(in *jlexer.Lexer, out *GetAllAdsListResponse) {
+func easyjson3a862f94Decode20242FIGHTCLUBDomain11(in *jlexer.Lexer, out *GetAllAdsListResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -933,13 +1432,15 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain8(in *jlexer.Lexer, out *GetAllAd
 					out.Housing = (out.Housing)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 GetAllAdsResponse
-					(v10).UnmarshalEasyJSON(in)
-					out.Housing = append(out.Housing, v10)
+					var v22 GetAllAdsResponse
+					(v22).UnmarshalEasyJSON(in)
+					out.Housing = append(out.Housing, v22)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "nextCursor":
+			out.NextCursor = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -950,7 +1451,7 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain8(in *jlexer.Lexer, out *GetAllAd
 		in.Consumed()
 	}
 }
-func easyjson3a862f94Encode20242FIGHTCLUBDomain8(out *jwriter.Writer, in GetAllAdsListResponse) {
+func easyjson3a862f94Encode20242FIGHTCLUBDomain11(out *jwriter.Writer, in GetAllAdsListResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -961,42 +1462,47 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain8(out *jwriter.Writer, in GetAllA
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v11, v12 := range in.Housing {
-				if v11 > 0 {
+			for v23, v24 := range in.Housing {
+				if v23 > 0 {
 					out.RawByte(',')
 				}
-				(v12).MarshalEasyJSON(out)
+				(v24).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"nextCursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v GetAllAdsListResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3a862f94Encode20242FIGHTCLUBDomain8(&w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GetAllAdsListResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3a862f94Encode20242FIGHTCLUBDomain8(w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GetAllAdsListResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3a862f94Decode20242FIGHTCLUBDomain8(&r, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GetAllAdsListResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3a862f94Decode20242FIGHTCLUBDomain8(l, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain11(l, v)
 }
-func easyjson3a862f94Decode20242FIGHTCLUBDomain9(in *jlexer.Lexer, out *Favorites) {
+func easyjson3a862f94Decode20242FIGHTCLUBDomain12(in *jlexer.Lexer, out *Favorites) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1029,7 +1535,7 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain9(in *jlexer.Lexer, out *Favorite
 		in.Consumed()
 	}
 }
-func easyjson3a862f94Encode20242FIGHTCLUBDomain9(out *jwriter.Writer, in Favorites) {
+func easyjson3a862f94Encode20242FIGHTCLUBDomain12(out *jwriter.Writer, in Favorites) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1049,27 +1555,27 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain9(out *jwriter.Writer, in Favorit
 // MarshalJSON supports json.Marshaler interface
 func (v Favorites) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3a862f94Encode20242FIGHTCLUBDomain9(&w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Favorites) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3a862f94Encode20242FIGHTCLUBDomain9(w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Favorites) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3a862f94Decode20242FIGHTCLUBDomain9(&r, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Favorites) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3a862f94Decode20242FIGHTCLUBDomain9(l, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain12(l, v)
 }
-func easyjson3a862f94Decode20242FIGHTCLUBDomain10(in *jlexer.Lexer, out *CreateAdRequest) {
+func easyjson3a862f94Decode20242FIGHTCLUBDomain13(in *jlexer.Lexer, out *CreateAdRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1104,6 +1610,54 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain10(in *jlexer.Lexer, out *CreateA
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.DateTo).UnmarshalJSON(data))
 			}
+		case "availableDates":
+			if in.IsNull() {
+				in.Skip()
+				out.AvailableDates = nil
+			} else {
+				in.Delim('[')
+				if out.AvailableDates == nil {
+					if !in.IsDelim(']') {
+						out.AvailableDates = make([]AvailableDateRange, 0, 1)
+					} else {
+						out.AvailableDates = []AvailableDateRange{}
+					}
+				} else {
+					out.AvailableDates = (out.AvailableDates)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 AvailableDateRange
+					easyjson3a862f94Decode20242FIGHTCLUBDomain1(in, &v25)
+					out.AvailableDates = append(out.AvailableDates, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "blockedDates":
+			if in.IsNull() {
+				in.Skip()
+				out.BlockedDates = nil
+			} else {
+				in.Delim('[')
+				if out.BlockedDates == nil {
+					if !in.IsDelim(']') {
+						out.BlockedDates = make([]time.Time, 0, 2)
+					} else {
+						out.BlockedDates = []time.Time{}
+					}
+				} else {
+					out.BlockedDates = (out.BlockedDates)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v26 time.Time
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v26).UnmarshalJSON(data))
+					}
+					out.BlockedDates = append(out.BlockedDates, v26)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "rooms":
 			if in.IsNull() {
 				in.Skip()
@@ -1120,9 +1674,9 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain10(in *jlexer.Lexer, out *CreateA
 					out.Rooms = (out.Rooms)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v13 AdRoomsResponse
-					easyjson3a862f94Decode20242FIGHTCLUBDomain1(in, &v13)
-					out.Rooms = append(out.Rooms, v13)
+					var v27 AdRoomsResponse
+					easyjson3a862f94Decode20242FIGHTCLUBDomain2(in, &v27)
+					out.Rooms = append(out.Rooms, v27)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1139,6 +1693,22 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain10(in *jlexer.Lexer, out *CreateA
 			out.HasElevator = bool(in.Bool())
 		case "hasGas":
 			out.HasGas = bool(in.Bool())
+		case "price":
+			out.Price = int(in.Int())
+		case "weekendPrice":
+			out.WeekendPrice = int(in.Int())
+		case "cleaningFee":
+			out.CleaningFee = int(in.Int())
+		case "position":
+			if in.IsNull() {
+				in.Skip()
+				out.Position = nil
+			} else {
+				if out.Position == nil {
+					out.Position = new(GeoPoint)
+				}
+				easyjson3a862f94Decode20242FIGHTCLUBDomain3(in, out.Position)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1149,7 +1719,7 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain10(in *jlexer.Lexer, out *CreateA
 		in.Consumed()
 	}
 }
-func easyjson3a862f94Encode20242FIGHTCLUBDomain10(out *jwriter.Writer, in CreateAdRequest) {
+func easyjson3a862f94Encode20242FIGHTCLUBDomain13(out *jwriter.Writer, in CreateAdRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1183,6 +1753,38 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain10(out *jwriter.Writer, in Create
 		out.RawString(prefix)
 		out.Raw((in.DateTo).MarshalJSON())
 	}
+	{
+		const prefix string = ",\"availableDates\":"
+		out.RawString(prefix)
+		if in.AvailableDates == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v28, v29 := range in.AvailableDates {
+				if v28 > 0 {
+					out.RawByte(',')
+				}
+				easyjson3a862f94Encode20242FIGHTCLUBDomain1(out, v29)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"blockedDates\":"
+		out.RawString(prefix)
+		if in.BlockedDates == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v30, v31 := range in.BlockedDates {
+				if v30 > 0 {
+					out.RawByte(',')
+				}
+				out.Raw((v31).MarshalJSON())
+			}
+			out.RawByte(']')
+		}
+	}
 	{
 		const prefix string = ",\"rooms\":"
 		out.RawString(prefix)
@@ -1190,11 +1792,11 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain10(out *jwriter.Writer, in Create
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v14, v15 := range in.Rooms {
-				if v14 > 0 {
+			for v32, v33 := range in.Rooms {
+				if v32 > 0 {
 					out.RawByte(',')
 				}
-				easyjson3a862f94Encode20242FIGHTCLUBDomain1(out, v15)
+				easyjson3a862f94Encode20242FIGHTCLUBDomain2(out, v33)
 			}
 			out.RawByte(']')
 		}
@@ -1229,33 +1831,53 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain10(out *jwriter.Writer, in Create
 		out.RawString(prefix)
 		out.Bool(bool(in.HasGas))
 	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Int(int(in.Price))
+	}
+	{
+		const prefix string = ",\"weekendPrice\":"
+		out.RawString(prefix)
+		out.Int(int(in.WeekendPrice))
+	}
+	{
+		const prefix string = ",\"cleaningFee\":"
+		out.RawString(prefix)
+		out.Int(int(in.CleaningFee))
+	}
+	if in.Position != nil {
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		easyjson3a862f94Encode20242FIGHTCLUBDomain3(out, *in.Position)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v CreateAdRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3a862f94Encode20242FIGHTCLUBDomain10(&w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateAdRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3a862f94Encode20242FIGHTCLUBDomain10(w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateAdRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3a862f94Decode20242FIGHTCLUBDomain10(&r, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateAdRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3a862f94Decode20242FIGHTCLUBDomain10(l, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain13(l, v)
 }
-func easyjson3a862f94Decode20242FIGHTCLUBDomain11(in *jlexer.Lexer, out *AdFilter) {
+func easyjson3a862f94Decode20242FIGHTCLUBDomain14(in *jlexer.Lexer, out *AdFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1278,6 +1900,8 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain11(in *jlexer.Lexer, out *AdFilte
 			out.Location = string(in.String())
 		case "Rating":
 			out.Rating = string(in.String())
+		case "RatingMode":
+			out.RatingMode = string(in.String())
 		case "NewThisWeek":
 			out.NewThisWeek = string(in.String())
 		case "HostGender":
@@ -1298,6 +1922,36 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain11(in *jlexer.Lexer, out *AdFilte
 			}
 		case "Favorites":
 			out.Favorites = string(in.String())
+		case "PriceMin":
+			out.PriceMin = int(in.Int())
+		case "PriceMax":
+			out.PriceMax = int(in.Int())
+		case "Sort":
+			out.Sort = string(in.String())
+		case "Near":
+			if in.IsNull() {
+				in.Skip()
+				out.Near = nil
+			} else {
+				if out.Near == nil {
+					out.Near = new(GeoPoint)
+				}
+				easyjson3a862f94Decode20242FIGHTCLUBDomain3(in, out.Near)
+			}
+		case "RadiusKm":
+			out.RadiusKm = float64(in.Float64())
+		case "Cursor":
+			if in.IsNull() {
+				in.Skip()
+				out.Cursor = nil
+			} else {
+				if out.Cursor == nil {
+					out.Cursor = new(AdCursor)
+				}
+				(*out.Cursor).UnmarshalEasyJSON(in)
+			}
+		case "Query":
+			out.Query = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1308,7 +1962,7 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain11(in *jlexer.Lexer, out *AdFilte
 		in.Consumed()
 	}
 }
-func easyjson3a862f94Encode20242FIGHTCLUBDomain11(out *jwriter.Writer, in AdFilter) {
+func easyjson3a862f94Encode20242FIGHTCLUBDomain14(out *jwriter.Writer, in AdFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1322,6 +1976,11 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain11(out *jwriter.Writer, in AdFilt
 		out.RawString(prefix)
 		out.String(string(in.Rating))
 	}
+	{
+		const prefix string = ",\"RatingMode\":"
+		out.RawString(prefix)
+		out.String(string(in.RatingMode))
+	}
 	{
 		const prefix string = ",\"NewThisWeek\":"
 		out.RawString(prefix)
@@ -1362,33 +2021,158 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain11(out *jwriter.Writer, in AdFilt
 		out.RawString(prefix)
 		out.String(string(in.Favorites))
 	}
+	{
+		const prefix string = ",\"PriceMin\":"
+		out.RawString(prefix)
+		out.Int(int(in.PriceMin))
+	}
+	{
+		const prefix string = ",\"PriceMax\":"
+		out.RawString(prefix)
+		out.Int(int(in.PriceMax))
+	}
+	{
+		const prefix string = ",\"Sort\":"
+		out.RawString(prefix)
+		out.String(string(in.Sort))
+	}
+	{
+		const prefix string = ",\"Near\":"
+		out.RawString(prefix)
+		if in.Near == nil {
+			out.RawString("null")
+		} else {
+			easyjson3a862f94Encode20242FIGHTCLUBDomain3(out, *in.Near)
+		}
+	}
+	{
+		const prefix string = ",\"RadiusKm\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RadiusKm))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		if in.Cursor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cursor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Query\":"
+		out.RawString(prefix)
+		out.String(string(in.Query))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v AdFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3a862f94Encode20242FIGHTCLUBDomain11(&w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AdFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3a862f94Encode20242FIGHTCLUBDomain11(w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AdFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3a862f94Decode20242FIGHTCLUBDomain11(&r, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AdFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3a862f94Decode20242FIGHTCLUBDomain11(l, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain14(l, v)
+}
+func easyjson3a862f94Decode20242FIGHTCLUBDomain15(in *jlexer.Lexer, out *AdCursor) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "p":
+			out.Priority = int(in.Int())
+		case "d":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PublicationDate).UnmarshalJSON(data))
+			}
+		case "id":
+			out.UUID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3a862f94Encode20242FIGHTCLUBDomain15(out *jwriter.Writer, in AdCursor) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Priority))
+	}
+	{
+		const prefix string = ",\"d\":"
+		out.RawString(prefix)
+		out.Raw((in.PublicationDate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.UUID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdCursor) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3a862f94Encode20242FIGHTCLUBDomain15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
 }
-func easyjson3a862f94Decode20242FIGHTCLUBDomain12(in *jlexer.Lexer, out *Ad) {
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdCursor) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3a862f94Encode20242FIGHTCLUBDomain15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdCursor) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3a862f94Decode20242FIGHTCLUBDomain15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdCursor) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3a862f94Decode20242FIGHTCLUBDomain15(l, v)
+}
+func easyjson3a862f94Decode20242FIGHTCLUBDomain16(in *jlexer.Lexer, out *Ad) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1439,12 +2223,22 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain12(in *jlexer.Lexer, out *Ad) {
 			out.HasGas = bool(in.Bool())
 		case "likesCount":
 			out.LikesCount = int(in.Int())
+		case "rating":
+			out.ReviewsRating = float64(in.Float64())
+		case "reviewsCount":
+			out.ReviewsCount = int(in.Int())
 		case "priority":
 			out.Priority = int(in.Int())
 		case "endBoostDate":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.EndBoostDate).UnmarshalJSON(data))
 			}
+		case "price":
+			out.Price = int(in.Int())
+		case "weekendPrice":
+			out.WeekendPrice = int(in.Int())
+		case "cleaningFee":
+			out.CleaningFee = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -1455,7 +2249,7 @@ func easyjson3a862f94Decode20242FIGHTCLUBDomain12(in *jlexer.Lexer, out *Ad) {
 		in.Consumed()
 	}
 }
-func easyjson3a862f94Encode20242FIGHTCLUBDomain12(out *jwriter.Writer, in Ad) {
+func easyjson3a862f94Encode20242FIGHTCLUBDomain16(out *jwriter.Writer, in Ad) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1534,6 +2328,16 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain12(out *jwriter.Writer, in Ad) {
 		out.RawString(prefix)
 		out.Int(int(in.LikesCount))
 	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ReviewsRating))
+	}
+	{
+		const prefix string = ",\"reviewsCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReviewsCount))
+	}
 	{
 		const prefix string = ",\"priority\":"
 		out.RawString(prefix)
@@ -1544,29 +2348,44 @@ func easyjson3a862f94Encode20242FIGHTCLUBDomain12(out *jwriter.Writer, in Ad) {
 		out.RawString(prefix)
 		out.Raw((in.EndBoostDate).MarshalJSON())
 	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Int(int(in.Price))
+	}
+	{
+		const prefix string = ",\"weekendPrice\":"
+		out.RawString(prefix)
+		out.Int(int(in.WeekendPrice))
+	}
+	{
+		const prefix string = ",\"cleaningFee\":"
+		out.RawString(prefix)
+		out.Int(int(in.CleaningFee))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Ad) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3a862f94Encode20242FIGHTCLUBDomain12(&w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Ad) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3a862f94Encode20242FIGHTCLUBDomain12(w, v)
+	easyjson3a862f94Encode20242FIGHTCLUBDomain16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Ad) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3a862f94Decode20242FIGHTCLUBDomain12(&r, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Ad) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3a862f94Decode20242FIGHTCLUBDomain12(l, v)
+	easyjson3a862f94Decode20242FIGHTCLUBDomain16(l, v)
 }
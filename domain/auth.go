@@ -16,6 +16,39 @@ type CSRFTokenResponse struct {
 type SessionData struct {
 	Id     string `json:"id"`
 	Avatar string `json:"avatar"`
+	Role   string `json:"role"`
+	// Сведения для списка активных сессий, время в unix-секундах
+	Device    string `json:"device,omitempty"`
+	IP        string `json:"ip,omitempty"`
+	CreatedAt int64  `json:"createdAt,omitempty"`
+	LastSeen  int64  `json:"lastSeen,omitempty"`
+	// Флаг отрицательный, чтобы сессии, созданные до подтверждения почты, считались подтверждёнными,
+	// как и их владельцы после миграции
+	EmailUnverified bool `json:"emailUnverified,omitempty"`
+}
+
+// SessionClient - устройство, с которого создаётся сессия
+type SessionClient struct {
+	Device string
+	IP     string
+}
+
+// ActiveSession - сессия в списке активных. ID не совпадает с session_id из cookie,
+// чтобы список нельзя было использовать для входа
+//
+//easyjson:json
+type ActiveSession struct {
+	ID        string    `json:"id"`
+	Device    string    `json:"device"`
+	IP        string    `json:"ip"`
+	CreatedAt time.Time `json:"createdAt"`
+	LastSeen  time.Time `json:"lastSeen"`
+	Current   bool      `json:"current"`
+}
+
+//easyjson:json
+type ActiveSessionsList struct {
+	Sessions []ActiveSession `json:"sessions"`
 }
 
 //easyjson:json
@@ -55,6 +88,77 @@ type User struct {
 	GuestCount int       `gorm:"column:guestCount" json:"guestCount"`
 	Birthdate  time.Time `gorm:"type:date;column:birthDate" json:"birthDate"`
 	IsHost     bool      `gorm:"type:boolean;default:false;column:isHost" form:"isHost" json:"isHost"`
+	// Считаются только сервисом отзывов, поэтому gorm их не перезаписывает
+	ReviewsCount  int     `gorm:"column:reviewsCount;default:0;<-:false" json:"reviewsCount"`
+	WeightedScore float64 `gorm:"type:numeric;column:weightedScore;default:0;<-:false" json:"weightedScore"`
+	// Модератора и администратора назначают в БД, хозяином пользователь становится по IsHost, см. RoleOf
+	Role string `gorm:"type:varchar(20);column:role;default:guest;not null;<-:false" json:"-"`
+	// Выставляется только модерацией
+	IsBanned bool `gorm:"column:isBanned;default:false;not null;<-:false" json:"-"`
+	// Выставляется только подтверждением по ссылке из письма
+	EmailVerified bool `gorm:"column:emailVerified;default:false;not null;<-:false" json:"emailVerified"`
+	// Секрет TOTP сохраняется при подключении, а действует только после подтверждения кодом
+	TOTPSecret  string `gorm:"type:varchar(64);column:totpSecret;default:'';not null;<-:false" json:"-"`
+	TOTPEnabled bool   `gorm:"column:totpEnabled;default:false;not null;<-:false" json:"-"`
+	// Последний принятый шаг TOTP, код того же или более раннего шага повторно не принимается
+	TOTPLastStep int64 `gorm:"column:totpLastStep;default:0;not null;<-:false" json:"-"`
+}
+
+// RecoveryCode - одноразовый код входа на случай потери приложения-аутентификатора,
+// в БД хранится только хэш
+type RecoveryCode struct {
+	ID       int        `gorm:"primary_key;auto_increment;column:id" json:"-"`
+	UserID   string     `gorm:"column:userId;not null;index" json:"-"`
+	CodeHash string     `gorm:"type:varchar(64);column:codeHash;not null" json:"-"`
+	UsedAt   *time.Time `gorm:"type:timestamp;column:usedAt" json:"-"`
+	User     User       `gorm:"foreignkey:UserID;references:UUID" json:"-"`
+}
+
+// UserIdentity связывает пользователя с аккаунтом у внешнего OIDC-провайдера
+type UserIdentity struct {
+	ID        int       `gorm:"primary_key;auto_increment;column:id" json:"-"`
+	UserID    string    `gorm:"column:userId;not null;index" json:"-"`
+	Provider  string    `gorm:"type:varchar(255);column:provider;not null;uniqueIndex:idx_identity_subject" json:"-"`
+	Subject   string    `gorm:"type:varchar(255);column:subject;not null;uniqueIndex:idx_identity_subject" json:"-"`
+	CreatedAt time.Time `gorm:"type:timestamp;column:createdAt;default:CURRENT_TIMESTAMP" json:"-"`
+	User      User      `gorm:"foreignkey:UserID;references:UUID" json:"-"`
+}
+
+// Роли пользователей по возрастанию прав
+const (
+	RoleGuest     = "guest"
+	RoleHost      = "host"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+var roleRanks = map[string]int{
+	RoleGuest:     1,
+	RoleHost:      2,
+	RoleModerator: 3,
+	RoleAdmin:     4,
+}
+
+// RoleOf возвращает роль, которая попадает в сессию пользователя
+func RoleOf(user *User) string {
+	switch user.Role {
+	case RoleModerator, RoleAdmin:
+		return user.Role
+	}
+	if user.IsHost {
+		return RoleHost
+	}
+	return RoleGuest
+}
+
+// HasRole сообщает, хватает ли роли role для действия, требующего required.
+// Сессии, созданные до появления ролей, считаются гостевыми, неизвестная роль прав не даёт
+func HasRole(role, required string) bool {
+	if role == "" {
+		role = RoleGuest
+	}
+	rank, ok := roleRanks[role]
+	return ok && rank >= roleRanks[required]
 }
 
 type UserResponce struct {
@@ -80,6 +184,77 @@ type UserDataResponse struct {
 	IsHost     bool      `json:"isHost"`
 }
 
+//easyjson:json
+type ChangePasswordRequest struct {
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+}
+
+//easyjson:json
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+//easyjson:json
+type ResetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"newPassword"`
+}
+
+//easyjson:json
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+//easyjson:json
+type TOTPSetupResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+//easyjson:json
+type TOTPCodeRequest struct {
+	Code string `json:"code"`
+}
+
+//easyjson:json
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// SecondFactorChallenge отдаётся вместо сессии, если у пользователя включена двухфакторная аутентификация
+//
+//easyjson:json
+type SecondFactorChallenge struct {
+	SecondFactorRequired bool   `json:"secondFactorRequired"`
+	Challenge            string `json:"challenge"`
+}
+
+//easyjson:json
+type SecondFactorRequest struct {
+	Challenge string `json:"challenge"`
+	Code      string `json:"code"`
+}
+
+//easyjson:json
+type OIDCLoginResponse struct {
+	AuthURL string `json:"authUrl"`
+}
+
+//easyjson:json
+type OIDCCallbackRequest struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+}
+
+// OIDCState - данные начатого входа через провайдера, хранятся по state до возврата пользователя
+//
+//easyjson:json
+type OIDCState struct {
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"codeVerifier"`
+}
+
 type AuthRepository interface {
 	CreateUser(ctx context.Context, creds *User) error
 	SaveUser(ctx context.Context, creds *User) error
@@ -90,4 +265,47 @@ type AuthRepository interface {
 	GetUserByEmail(ctx context.Context, email string) (*User, error)
 	UpdateUserRegion(ctx context.Context, region UpdateUserRegion, userId string) error
 	DeleteUserRegion(ctx context.Context, regionName string, userId string) error
+	UpdatePassword(ctx context.Context, userID string, hashedPassword string) error
+	// MarkEmailVerified подтверждает почту, только если она не менялась после отправки письма
+	MarkEmailVerified(ctx context.Context, userID string, email string) error
+	SetTOTPSecret(ctx context.Context, userID string, secret string) error
+	// EnableTOTP включает второй фактор и заменяет коды восстановления
+	EnableTOTP(ctx context.Context, userID string, recoveryCodeHashes []string) error
+	DisableTOTP(ctx context.Context, userID string) error
+	// UseRecoveryCode гасит неиспользованный код восстановления
+	UseRecoveryCode(ctx context.Context, userID string, codeHash string) error
+	// UseTOTPStep запоминает шаг принятого кода, если он позже последнего принятого
+	UseTOTPStep(ctx context.Context, userID string, step int64) error
+	GetUserByIdentity(ctx context.Context, provider string, subject string) (*User, error)
+	// CreateIdentityUser создаёт пользователя с подтверждённой провайдером почтой и привязывает к нему внешний аккаунт
+	CreateIdentityUser(ctx context.Context, user *User, provider string, subject string) error
+	LinkIdentity(ctx context.Context, userID string, provider string, subject string) error
+}
+
+// Назначение одноразовых токенов
+const (
+	TokenPasswordReset  = "password_reset"
+	TokenLoginChallenge = "login_challenge"
+)
+
+// LoginLimiter считает неудачные входы по логину и по IP и временно блокирует перебор
+type LoginLimiter interface {
+	// Check возвращает оставшееся время блокировки, ноль - вход разрешён
+	Check(ctx context.Context, username string, ip string) (time.Duration, error)
+	RegisterFailure(ctx context.Context, username string, ip string) error
+	// Reset снимает блокировку логина и обнуляет его счётчик, блокировка IP остаётся
+	Reset(ctx context.Context, username string) error
+}
+
+// OIDCStateStore хранит state входа через провайдера, каждый state можно использовать один раз
+type OIDCStateStore interface {
+	Save(ctx context.Context, state string, data OIDCState, ttl time.Duration) error
+	Consume(ctx context.Context, state string) (*OIDCState, error)
+}
+
+// AuthTokenStore хранит одноразовые токены с ограниченным временем жизни
+type AuthTokenStore interface {
+	CreateToken(ctx context.Context, purpose string, userID string, ttl time.Duration) (string, error)
+	// ConsumeToken возвращает id пользователя и удаляет токен
+	ConsumeToken(ctx context.Context, purpose string, token string) (string, error)
 }
@@ -17,7 +17,73 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson4a0f95aaDecode20242FIGHTCLUBDomain(in *jlexer.Lexer, out *UserResponce) {
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain(in *jlexer.Lexer, out *VerifyEmailRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain(out *jwriter.Writer, in VerifyEmailRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VerifyEmailRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VerifyEmailRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VerifyEmailRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VerifyEmailRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain1(in *jlexer.Lexer, out *UserResponce) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -60,7 +126,7 @@ func easyjson4a0f95aaDecode20242FIGHTCLUBDomain(in *jlexer.Lexer, out *UserRespo
 		in.Consumed()
 	}
 }
-func easyjson4a0f95aaEncode20242FIGHTCLUBDomain(out *jwriter.Writer, in UserResponce) {
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain1(out *jwriter.Writer, in UserResponce) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -100,27 +166,86 @@ func easyjson4a0f95aaEncode20242FIGHTCLUBDomain(out *jwriter.Writer, in UserResp
 // MarshalJSON supports json.Marshaler interface
 func (v UserResponce) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain(&w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserResponce) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain(w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserResponce) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain(&r, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserResponce) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain(l, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain1(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *UserIdentity) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain2(out *jwriter.Writer, in UserIdentity) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserIdentity) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserIdentity) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserIdentity) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserIdentity) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain2(l, v)
 }
-func easyjson4a0f95aaDecode20242FIGHTCLUBDomain1(in *jlexer.Lexer, out *UserDataResponse) {
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *UserDataResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -171,7 +296,7 @@ func easyjson4a0f95aaDecode20242FIGHTCLUBDomain1(in *jlexer.Lexer, out *UserData
 		in.Consumed()
 	}
 }
-func easyjson4a0f95aaEncode20242FIGHTCLUBDomain1(out *jwriter.Writer, in UserDataResponse) {
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain3(out *jwriter.Writer, in UserDataResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -231,27 +356,27 @@ func easyjson4a0f95aaEncode20242FIGHTCLUBDomain1(out *jwriter.Writer, in UserDat
 // MarshalJSON supports json.Marshaler interface
 func (v UserDataResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain1(&w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserDataResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain1(w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserDataResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain1(&r, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserDataResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain1(l, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain3(l, v)
 }
-func easyjson4a0f95aaDecode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *User) {
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain4(in *jlexer.Lexer, out *User) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -294,6 +419,12 @@ func easyjson4a0f95aaDecode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *User) {
 			}
 		case "isHost":
 			out.IsHost = bool(in.Bool())
+		case "reviewsCount":
+			out.ReviewsCount = int(in.Int())
+		case "weightedScore":
+			out.WeightedScore = float64(in.Float64())
+		case "emailVerified":
+			out.EmailVerified = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -304,7 +435,7 @@ func easyjson4a0f95aaDecode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *User) {
 		in.Consumed()
 	}
 }
-func easyjson4a0f95aaEncode20242FIGHTCLUBDomain2(out *jwriter.Writer, in User) {
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain4(out *jwriter.Writer, in User) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -363,33 +494,48 @@ func easyjson4a0f95aaEncode20242FIGHTCLUBDomain2(out *jwriter.Writer, in User) {
 		out.RawString(prefix)
 		out.Bool(bool(in.IsHost))
 	}
+	{
+		const prefix string = ",\"reviewsCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReviewsCount))
+	}
+	{
+		const prefix string = ",\"weightedScore\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.WeightedScore))
+	}
+	{
+		const prefix string = ",\"emailVerified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.EmailVerified))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v User) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain2(&w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v User) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain2(w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *User) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain2(&r, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain2(l, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain4(l, v)
 }
-func easyjson4a0f95aaDecode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *UpdateUserRegion) {
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain5(in *jlexer.Lexer, out *UpdateUserRegion) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -424,7 +570,7 @@ func easyjson4a0f95aaDecode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *UpdateUs
 		in.Consumed()
 	}
 }
-func easyjson4a0f95aaEncode20242FIGHTCLUBDomain3(out *jwriter.Writer, in UpdateUserRegion) {
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain5(out *jwriter.Writer, in UpdateUserRegion) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -449,27 +595,27 @@ func easyjson4a0f95aaEncode20242FIGHTCLUBDomain3(out *jwriter.Writer, in UpdateU
 // MarshalJSON supports json.Marshaler interface
 func (v UpdateUserRegion) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain3(&w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UpdateUserRegion) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain3(w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UpdateUserRegion) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain3(&r, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UpdateUserRegion) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain3(l, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain5(l, v)
 }
-func easyjson4a0f95aaDecode20242FIGHTCLUBDomain4(in *jlexer.Lexer, out *SessionData) {
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain6(in *jlexer.Lexer, out *TOTPSetupResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -488,10 +634,10 @@ func easyjson4a0f95aaDecode20242FIGHTCLUBDomain4(in *jlexer.Lexer, out *SessionD
 			continue
 		}
 		switch key {
-		case "id":
-			out.Id = string(in.String())
-		case "avatar":
-			out.Avatar = string(in.String())
+		case "secret":
+			out.Secret = string(in.String())
+		case "uri":
+			out.URI = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -502,47 +648,47 @@ func easyjson4a0f95aaDecode20242FIGHTCLUBDomain4(in *jlexer.Lexer, out *SessionD
 		in.Consumed()
 	}
 }
-func easyjson4a0f95aaEncode20242FIGHTCLUBDomain4(out *jwriter.Writer, in SessionData) {
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain6(out *jwriter.Writer, in TOTPSetupResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"secret\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Id))
+		out.String(string(in.Secret))
 	}
 	{
-		const prefix string = ",\"avatar\":"
+		const prefix string = ",\"uri\":"
 		out.RawString(prefix)
-		out.String(string(in.Avatar))
+		out.String(string(in.URI))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SessionData) MarshalJSON() ([]byte, error) {
+func (v TOTPSetupResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain4(&w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SessionData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain4(w, v)
+func (v TOTPSetupResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SessionData) UnmarshalJSON(data []byte) error {
+func (v *TOTPSetupResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain4(&r, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SessionData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain4(l, v)
+func (v *TOTPSetupResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain6(l, v)
 }
-func easyjson4a0f95aaDecode20242FIGHTCLUBDomain5(in *jlexer.Lexer, out *GetAllUsersResponse) {
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain7(in *jlexer.Lexer, out *TOTPCodeRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -561,37 +707,8 @@ func easyjson4a0f95aaDecode20242FIGHTCLUBDomain5(in *jlexer.Lexer, out *GetAllUs
 			continue
 		}
 		switch key {
-		case "users":
-			if in.IsNull() {
-				in.Skip()
-				out.Users = nil
-			} else {
-				in.Delim('[')
-				if out.Users == nil {
-					if !in.IsDelim(']') {
-						out.Users = make([]*UserDataResponse, 0, 8)
-					} else {
-						out.Users = []*UserDataResponse{}
-					}
-				} else {
-					out.Users = (out.Users)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v1 *UserDataResponse
-					if in.IsNull() {
-						in.Skip()
-						v1 = nil
-					} else {
-						if v1 == nil {
-							v1 = new(UserDataResponse)
-						}
-						(*v1).UnmarshalEasyJSON(in)
-					}
-					out.Users = append(out.Users, v1)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "code":
+			out.Code = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -602,57 +719,42 @@ func easyjson4a0f95aaDecode20242FIGHTCLUBDomain5(in *jlexer.Lexer, out *GetAllUs
 		in.Consumed()
 	}
 }
-func easyjson4a0f95aaEncode20242FIGHTCLUBDomain5(out *jwriter.Writer, in GetAllUsersResponse) {
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain7(out *jwriter.Writer, in TOTPCodeRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"users\":"
+		const prefix string = ",\"code\":"
 		out.RawString(prefix[1:])
-		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v2, v3 := range in.Users {
-				if v2 > 0 {
-					out.RawByte(',')
-				}
-				if v3 == nil {
-					out.RawString("null")
-				} else {
-					(*v3).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Code))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GetAllUsersResponse) MarshalJSON() ([]byte, error) {
+func (v TOTPCodeRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain5(&w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GetAllUsersResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain5(w, v)
+func (v TOTPCodeRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GetAllUsersResponse) UnmarshalJSON(data []byte) error {
+func (v *TOTPCodeRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain5(&r, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GetAllUsersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain5(l, v)
+func (v *TOTPCodeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain7(l, v)
 }
-func easyjson4a0f95aaDecode20242FIGHTCLUBDomain6(in *jlexer.Lexer, out *CSRFTokenResponse) {
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain8(in *jlexer.Lexer, out *SessionData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -671,8 +773,22 @@ func easyjson4a0f95aaDecode20242FIGHTCLUBDomain6(in *jlexer.Lexer, out *CSRFToke
 			continue
 		}
 		switch key {
-		case "csrf_token":
-			out.Token = string(in.String())
+		case "id":
+			out.Id = string(in.String())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		case "device":
+			out.Device = string(in.String())
+		case "ip":
+			out.IP = string(in.String())
+		case "createdAt":
+			out.CreatedAt = int64(in.Int64())
+		case "lastSeen":
+			out.LastSeen = int64(in.Int64())
+		case "emailUnverified":
+			out.EmailUnverified = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -683,42 +799,1053 @@ func easyjson4a0f95aaDecode20242FIGHTCLUBDomain6(in *jlexer.Lexer, out *CSRFToke
 		in.Consumed()
 	}
 }
-func easyjson4a0f95aaEncode20242FIGHTCLUBDomain6(out *jwriter.Writer, in CSRFTokenResponse) {
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain8(out *jwriter.Writer, in SessionData) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"csrf_token\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Token))
+		out.String(string(in.Id))
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	if in.Device != "" {
+		const prefix string = ",\"device\":"
+		out.RawString(prefix)
+		out.String(string(in.Device))
+	}
+	if in.IP != "" {
+		const prefix string = ",\"ip\":"
+		out.RawString(prefix)
+		out.String(string(in.IP))
+	}
+	if in.CreatedAt != 0 {
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatedAt))
+	}
+	if in.LastSeen != 0 {
+		const prefix string = ",\"lastSeen\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastSeen))
+	}
+	if in.EmailUnverified {
+		const prefix string = ",\"emailUnverified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.EmailUnverified))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CSRFTokenResponse) MarshalJSON() ([]byte, error) {
+func (v SessionData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain6(&w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CSRFTokenResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain6(w, v)
+func (v SessionData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SessionData) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SessionData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain8(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain9(in *jlexer.Lexer, out *SessionClient) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Device":
+			out.Device = string(in.String())
+		case "IP":
+			out.IP = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain9(out *jwriter.Writer, in SessionClient) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Device\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Device))
+	}
+	{
+		const prefix string = ",\"IP\":"
+		out.RawString(prefix)
+		out.String(string(in.IP))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SessionClient) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SessionClient) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SessionClient) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SessionClient) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain9(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain10(in *jlexer.Lexer, out *SecondFactorRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "challenge":
+			out.Challenge = string(in.String())
+		case "code":
+			out.Code = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain10(out *jwriter.Writer, in SecondFactorRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"challenge\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Challenge))
+	}
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SecondFactorRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SecondFactorRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SecondFactorRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SecondFactorRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain10(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain11(in *jlexer.Lexer, out *SecondFactorChallenge) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "secondFactorRequired":
+			out.SecondFactorRequired = bool(in.Bool())
+		case "challenge":
+			out.Challenge = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain11(out *jwriter.Writer, in SecondFactorChallenge) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"secondFactorRequired\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.SecondFactorRequired))
+	}
+	{
+		const prefix string = ",\"challenge\":"
+		out.RawString(prefix)
+		out.String(string(in.Challenge))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SecondFactorChallenge) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SecondFactorChallenge) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SecondFactorChallenge) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SecondFactorChallenge) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain11(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain12(in *jlexer.Lexer, out *ResetPasswordRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		case "newPassword":
+			out.NewPassword = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain12(out *jwriter.Writer, in ResetPasswordRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"newPassword\":"
+		out.RawString(prefix)
+		out.String(string(in.NewPassword))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResetPasswordRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResetPasswordRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResetPasswordRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResetPasswordRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain12(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain13(in *jlexer.Lexer, out *RecoveryCodesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "recoveryCodes":
+			if in.IsNull() {
+				in.Skip()
+				out.RecoveryCodes = nil
+			} else {
+				in.Delim('[')
+				if out.RecoveryCodes == nil {
+					if !in.IsDelim(']') {
+						out.RecoveryCodes = make([]string, 0, 4)
+					} else {
+						out.RecoveryCodes = []string{}
+					}
+				} else {
+					out.RecoveryCodes = (out.RecoveryCodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.RecoveryCodes = append(out.RecoveryCodes, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain13(out *jwriter.Writer, in RecoveryCodesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"recoveryCodes\":"
+		out.RawString(prefix[1:])
+		if in.RecoveryCodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.RecoveryCodes {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecoveryCodesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecoveryCodesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecoveryCodesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecoveryCodesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain13(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain14(in *jlexer.Lexer, out *RecoveryCode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain14(out *jwriter.Writer, in RecoveryCode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecoveryCode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecoveryCode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecoveryCode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecoveryCode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain14(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain15(in *jlexer.Lexer, out *OIDCState) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "nonce":
+			out.Nonce = string(in.String())
+		case "codeVerifier":
+			out.CodeVerifier = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain15(out *jwriter.Writer, in OIDCState) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"nonce\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Nonce))
+	}
+	{
+		const prefix string = ",\"codeVerifier\":"
+		out.RawString(prefix)
+		out.String(string(in.CodeVerifier))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OIDCState) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OIDCState) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OIDCState) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OIDCState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain15(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain16(in *jlexer.Lexer, out *OIDCLoginResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "authUrl":
+			out.AuthURL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain16(out *jwriter.Writer, in OIDCLoginResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"authUrl\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AuthURL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OIDCLoginResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OIDCLoginResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OIDCLoginResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OIDCLoginResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain16(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain17(in *jlexer.Lexer, out *OIDCCallbackRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "state":
+			out.State = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain17(out *jwriter.Writer, in OIDCCallbackRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OIDCCallbackRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OIDCCallbackRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OIDCCallbackRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OIDCCallbackRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain17(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain18(in *jlexer.Lexer, out *GetAllUsersResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "users":
+			if in.IsNull() {
+				in.Skip()
+				out.Users = nil
+			} else {
+				in.Delim('[')
+				if out.Users == nil {
+					if !in.IsDelim(']') {
+						out.Users = make([]*UserDataResponse, 0, 8)
+					} else {
+						out.Users = []*UserDataResponse{}
+					}
+				} else {
+					out.Users = (out.Users)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 *UserDataResponse
+					if in.IsNull() {
+						in.Skip()
+						v4 = nil
+					} else {
+						if v4 == nil {
+							v4 = new(UserDataResponse)
+						}
+						(*v4).UnmarshalEasyJSON(in)
+					}
+					out.Users = append(out.Users, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain18(out *jwriter.Writer, in GetAllUsersResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"users\":"
+		out.RawString(prefix[1:])
+		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Users {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				if v6 == nil {
+					out.RawString("null")
+				} else {
+					(*v6).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GetAllUsersResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GetAllUsersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GetAllUsersResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GetAllUsersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain18(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain19(in *jlexer.Lexer, out *ForgotPasswordRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "email":
+			out.Email = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain19(out *jwriter.Writer, in ForgotPasswordRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Email))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ForgotPasswordRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ForgotPasswordRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ForgotPasswordRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ForgotPasswordRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain19(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain20(in *jlexer.Lexer, out *ChangePasswordRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "oldPassword":
+			out.OldPassword = string(in.String())
+		case "newPassword":
+			out.NewPassword = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain20(out *jwriter.Writer, in ChangePasswordRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"oldPassword\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.OldPassword))
+	}
+	{
+		const prefix string = ",\"newPassword\":"
+		out.RawString(prefix)
+		out.String(string(in.NewPassword))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChangePasswordRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChangePasswordRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChangePasswordRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChangePasswordRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain20(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain21(in *jlexer.Lexer, out *CSRFTokenResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "csrf_token":
+			out.Token = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain21(out *jwriter.Writer, in CSRFTokenResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"csrf_token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CSRFTokenResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CSRFTokenResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CSRFTokenResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain6(&r, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CSRFTokenResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain6(l, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain21(l, v)
 }
-func easyjson4a0f95aaDecode20242FIGHTCLUBDomain7(in *jlexer.Lexer, out *AuthResponse) {
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain22(in *jlexer.Lexer, out *AuthResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -751,7 +1878,7 @@ func easyjson4a0f95aaDecode20242FIGHTCLUBDomain7(in *jlexer.Lexer, out *AuthResp
 		in.Consumed()
 	}
 }
-func easyjson4a0f95aaEncode20242FIGHTCLUBDomain7(out *jwriter.Writer, in AuthResponse) {
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain22(out *jwriter.Writer, in AuthResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -771,27 +1898,27 @@ func easyjson4a0f95aaEncode20242FIGHTCLUBDomain7(out *jwriter.Writer, in AuthRes
 // MarshalJSON supports json.Marshaler interface
 func (v AuthResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain7(&w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain22(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AuthResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain7(w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain22(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AuthResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain7(&r, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain22(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AuthResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain7(l, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain22(l, v)
 }
-func easyjson4a0f95aaDecode20242FIGHTCLUBDomain8(in *jlexer.Lexer, out *AuthData) {
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain23(in *jlexer.Lexer, out *AuthData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -826,7 +1953,7 @@ func easyjson4a0f95aaDecode20242FIGHTCLUBDomain8(in *jlexer.Lexer, out *AuthData
 		in.Consumed()
 	}
 }
-func easyjson4a0f95aaEncode20242FIGHTCLUBDomain8(out *jwriter.Writer, in AuthData) {
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain23(out *jwriter.Writer, in AuthData) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -851,23 +1978,226 @@ func easyjson4a0f95aaEncode20242FIGHTCLUBDomain8(out *jwriter.Writer, in AuthDat
 // MarshalJSON supports json.Marshaler interface
 func (v AuthData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain8(&w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain23(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AuthData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4a0f95aaEncode20242FIGHTCLUBDomain8(w, v)
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain23(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AuthData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain8(&r, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain23(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AuthData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4a0f95aaDecode20242FIGHTCLUBDomain8(l, v)
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain23(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain24(in *jlexer.Lexer, out *ActiveSessionsList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sessions":
+			if in.IsNull() {
+				in.Skip()
+				out.Sessions = nil
+			} else {
+				in.Delim('[')
+				if out.Sessions == nil {
+					if !in.IsDelim(']') {
+						out.Sessions = make([]ActiveSession, 0, 0)
+					} else {
+						out.Sessions = []ActiveSession{}
+					}
+				} else {
+					out.Sessions = (out.Sessions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 ActiveSession
+					(v7).UnmarshalEasyJSON(in)
+					out.Sessions = append(out.Sessions, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain24(out *jwriter.Writer, in ActiveSessionsList) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"sessions\":"
+		out.RawString(prefix[1:])
+		if in.Sessions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Sessions {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ActiveSessionsList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ActiveSessionsList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ActiveSessionsList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ActiveSessionsList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain24(l, v)
+}
+func easyjson4a0f95aaDecode20242FIGHTCLUBDomain25(in *jlexer.Lexer, out *ActiveSession) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "device":
+			out.Device = string(in.String())
+		case "ip":
+			out.IP = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "lastSeen":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastSeen).UnmarshalJSON(data))
+			}
+		case "current":
+			out.Current = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncode20242FIGHTCLUBDomain25(out *jwriter.Writer, in ActiveSession) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"device\":"
+		out.RawString(prefix)
+		out.String(string(in.Device))
+	}
+	{
+		const prefix string = ",\"ip\":"
+		out.RawString(prefix)
+		out.String(string(in.IP))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"lastSeen\":"
+		out.RawString(prefix)
+		out.Raw((in.LastSeen).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"current\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Current))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ActiveSession) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ActiveSession) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncode20242FIGHTCLUBDomain25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ActiveSession) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ActiveSession) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecode20242FIGHTCLUBDomain25(l, v)
 }
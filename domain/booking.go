@@ -0,0 +1,53 @@
+package domain
+
+//go:generate easyjson -all booking.go
+
+import (
+	"time"
+)
+
+const (
+	BookingStatusPending   = "pending"
+	BookingStatusApproved  = "approved"
+	BookingStatusDeclined  = "declined"
+	BookingStatusCancelled = "cancelled"
+	BookingStatusCompleted = "completed"
+)
+
+//easyjson:json
+type Booking struct {
+	ID         int        `gorm:"primary_key;auto_increment;column:id" json:"id"`
+	AdID       string     `gorm:"column:adId;not null" json:"adId"`
+	UserID     string     `gorm:"column:userId;not null" json:"userId"`
+	HostID     string     `gorm:"column:hostId;not null" json:"hostId"`
+	Status     string     `gorm:"type:varchar(255);column:status;default:pending;not null" json:"status"`
+	DateFrom   time.Time  `gorm:"type:date;column:dateFrom;not null" json:"dateFrom"`
+	DateTo     time.Time  `gorm:"type:date;column:dateTo;not null" json:"dateTo"`
+	CreateDate time.Time  `gorm:"type:timestamp;column:createDate" json:"createDate"`
+	UpdateDate time.Time  `gorm:"type:timestamp;column:updateDate" json:"updateDate"`
+	CloseDate  *time.Time `gorm:"type:timestamp;column:closeDate" json:"closeDate,omitempty"`
+	Ad         Ad         `gorm:"foreignKey:AdID;references:UUID" json:"-"`
+	User       User       `gorm:"foreignKey:UserID;references:UUID" json:"-"`
+	Host       User       `gorm:"foreignKey:HostID;references:UUID" json:"-"`
+}
+
+// Таблица заявок на бронирование описана ещё в 007_request.sql
+func (Booking) TableName() string {
+	return "requests"
+}
+
+//easyjson:json
+type BookingsResponse struct {
+	Bookings []Booking `json:"bookings"`
+}
+
+//easyjson:json
+type CreateBookingRequest struct {
+	DateFrom time.Time `json:"dateFrom"`
+	DateTo   time.Time `json:"dateTo"`
+}
+
+//easyjson:json
+type UpdateBookingStatusRequest struct {
+	Status string `json:"status"`
+}
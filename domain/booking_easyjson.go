@@ -0,0 +1,408 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson6341a807Decode20242FIGHTCLUBDomain(in *jlexer.Lexer, out *UpdateBookingStatusRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6341a807Encode20242FIGHTCLUBDomain(out *jwriter.Writer, in UpdateBookingStatusRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdateBookingStatusRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6341a807Encode20242FIGHTCLUBDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdateBookingStatusRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6341a807Encode20242FIGHTCLUBDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdateBookingStatusRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6341a807Decode20242FIGHTCLUBDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdateBookingStatusRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6341a807Decode20242FIGHTCLUBDomain(l, v)
+}
+func easyjson6341a807Decode20242FIGHTCLUBDomain1(in *jlexer.Lexer, out *CreateBookingRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "dateFrom":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateFrom).UnmarshalJSON(data))
+			}
+		case "dateTo":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateTo).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6341a807Encode20242FIGHTCLUBDomain1(out *jwriter.Writer, in CreateBookingRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"dateFrom\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.DateFrom).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"dateTo\":"
+		out.RawString(prefix)
+		out.Raw((in.DateTo).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateBookingRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6341a807Encode20242FIGHTCLUBDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateBookingRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6341a807Encode20242FIGHTCLUBDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateBookingRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6341a807Decode20242FIGHTCLUBDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateBookingRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6341a807Decode20242FIGHTCLUBDomain1(l, v)
+}
+func easyjson6341a807Decode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *BookingsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "bookings":
+			if in.IsNull() {
+				in.Skip()
+				out.Bookings = nil
+			} else {
+				in.Delim('[')
+				if out.Bookings == nil {
+					if !in.IsDelim(']') {
+						out.Bookings = make([]Booking, 0, 0)
+					} else {
+						out.Bookings = []Booking{}
+					}
+				} else {
+					out.Bookings = (out.Bookings)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 Booking
+					(v1).UnmarshalEasyJSON(in)
+					out.Bookings = append(out.Bookings, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6341a807Encode20242FIGHTCLUBDomain2(out *jwriter.Writer, in BookingsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"bookings\":"
+		out.RawString(prefix[1:])
+		if in.Bookings == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Bookings {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BookingsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6341a807Encode20242FIGHTCLUBDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BookingsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6341a807Encode20242FIGHTCLUBDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BookingsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6341a807Decode20242FIGHTCLUBDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BookingsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6341a807Decode20242FIGHTCLUBDomain2(l, v)
+}
+func easyjson6341a807Decode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *Booking) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "adId":
+			out.AdID = string(in.String())
+		case "userId":
+			out.UserID = string(in.String())
+		case "hostId":
+			out.HostID = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "dateFrom":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateFrom).UnmarshalJSON(data))
+			}
+		case "dateTo":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateTo).UnmarshalJSON(data))
+			}
+		case "createDate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreateDate).UnmarshalJSON(data))
+			}
+		case "updateDate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdateDate).UnmarshalJSON(data))
+			}
+		case "closeDate":
+			if in.IsNull() {
+				in.Skip()
+				out.CloseDate = nil
+			} else {
+				if out.CloseDate == nil {
+					out.CloseDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CloseDate).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6341a807Encode20242FIGHTCLUBDomain3(out *jwriter.Writer, in Booking) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"adId\":"
+		out.RawString(prefix)
+		out.String(string(in.AdID))
+	}
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"hostId\":"
+		out.RawString(prefix)
+		out.String(string(in.HostID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"dateFrom\":"
+		out.RawString(prefix)
+		out.Raw((in.DateFrom).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"dateTo\":"
+		out.RawString(prefix)
+		out.Raw((in.DateTo).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"createDate\":"
+		out.RawString(prefix)
+		out.Raw((in.CreateDate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updateDate\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdateDate).MarshalJSON())
+	}
+	if in.CloseDate != nil {
+		const prefix string = ",\"closeDate\":"
+		out.RawString(prefix)
+		out.Raw((*in.CloseDate).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Booking) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6341a807Encode20242FIGHTCLUBDomain3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Booking) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6341a807Encode20242FIGHTCLUBDomain3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Booking) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6341a807Decode20242FIGHTCLUBDomain3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Booking) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6341a807Decode20242FIGHTCLUBDomain3(l, v)
+}
@@ -4,6 +4,7 @@ package domain
 
 import (
 	"context"
+	"github.com/mailru/easyjson"
 	"time"
 )
 
@@ -14,7 +15,14 @@ type AllMessages struct {
 
 //easyjson:json
 type AllChats struct {
-	Chats []*Chat `json:"chats"`
+	Chats      []*Chat `json:"chats"`
+	NextCursor string  `json:"nextCursor,omitempty"`
+}
+
+// ChatCursor — ключ последнего чата страницы в порядке (lastDate, authorUuid)
+type ChatCursor struct {
+	LastDate   time.Time `json:"d"`
+	AuthorUUID string    `json:"id"`
 }
 
 //easyjson:json
@@ -24,21 +32,133 @@ type Chat struct {
 	AuthorName   string    `gorm:"type:text;size:255;column:authorName" json:"authorName"`
 	AuthorAvatar string    `gorm:"type:text;size:255;column:authorAvatar" json:"authorAvatar"`
 	AuthorUUID   string    `gorm:"column:authorUuid;not null" json:"authorUuid"`
+	UnreadCount  int       `gorm:"column:unreadCount" json:"unreadCount"`
 }
 
 //easyjson:json
 type Message struct {
-	ID         int       `gorm:"primary_key;auto_increment;column:id" json:"id"`
-	SenderID   string    `gorm:"column:senderId;not null" json:"senderId"`
-	ReceiverID string    `gorm:"column:receiverId;not null" json:"receiverId"`
-	Content    string    `gorm:"type:text;size:1000;column:content" json:"content"`
-	CreatedAt  time.Time `gorm:"type:timestamp;column:createdAt;default:CURRENT_TIMESTAMP" json:"createdAt"`
-	Sender     User      `gorm:"foreignkey:SenderID;references:UUID" json:"-"`
-	Receiver   User      `gorm:"foreignkey:ReceiverID;references:UUID" json:"-"`
+	ID          int        `gorm:"primary_key;auto_increment;column:id" json:"id"`
+	SenderID    string     `gorm:"column:senderId;not null" json:"senderId"`
+	ReceiverID  string     `gorm:"column:receiverId;not null" json:"receiverId"`
+	Content     string     `gorm:"type:text;size:1000;column:content" json:"content"`
+	CreatedAt   time.Time  `gorm:"type:timestamp;column:createdAt;default:CURRENT_TIMESTAMP" json:"createdAt"`
+	DeliveredAt *time.Time `gorm:"type:timestamp;column:deliveredAt" json:"deliveredAt,omitempty"`
+	ReadAt      *time.Time `gorm:"type:timestamp;column:readAt" json:"readAt,omitempty"`
+	Sender      User       `gorm:"foreignkey:SenderID;references:UUID" json:"-"`
+	Receiver    User       `gorm:"foreignkey:ReceiverID;references:UUID" json:"-"`
+	Hidden      bool       `gorm:"column:hidden;default:false;not null;<-:false" json:"-"`
+}
+
+const (
+	ChatReceiptDelivered = "delivered"
+	ChatReceiptRead      = "read"
+)
+
+// ChatReceipt - подтверждение доставки или прочтения, payload событий message.delivered и read.
+// delivered относится к одному сообщению (MessageID), read - ко всей переписке с UserID
+//
+//easyjson:json
+type ChatReceipt struct {
+	Type      string    `json:"type"`
+	MessageID int       `json:"messageId,omitempty"`
+	UserID    string    `json:"userId,omitempty"`
+	At        time.Time `json:"at"`
+}
+
+// ChatProtocolVersion - версия протокола веб-сокета чата, кадры другой версии отклоняются
+const ChatProtocolVersion = 1
+
+// Типы событий веб-сокета чата. Клиент отправляет message.send, typing, presence и read,
+// сервер отвечает message.ack или error с тем же id и сам присылает остальные события
+const (
+	ChatEventMessageSend      = "message.send"
+	ChatEventMessageAck       = "message.ack"
+	ChatEventMessageNew       = "message.new"
+	ChatEventMessageDelivered = "message.delivered"
+	ChatEventTyping           = "typing"
+	ChatEventPresence         = "presence"
+	ChatEventRead             = "read"
+	ChatEventError            = "error"
+)
+
+// Коды ошибок в событии error
+const (
+	ChatErrorInvalidFrame       = "invalid_frame"
+	ChatErrorUnsupportedVersion = "unsupported_version"
+	ChatErrorUnknownType        = "unknown_type"
+	ChatErrorInvalidPayload     = "invalid_payload"
+	ChatErrorRateLimited        = "rate_limited"
+	ChatErrorEmailUnverified    = "email_unverified"
+	ChatErrorInternal           = "internal"
+)
+
+// ChatEvent - конверт любого кадра веб-сокета чата. ID задаёт клиент,
+// чтобы сопоставить с запросом ответный message.ack или error
+//
+//easyjson:json
+type ChatEvent struct {
+	Version int                 `json:"v"`
+	Type    string              `json:"type"`
+	ID      string              `json:"id,omitempty"`
+	Payload easyjson.RawMessage `json:"payload,omitempty"`
+}
+
+// NewChatEvent упаковывает payload в конверт текущей версии
+func NewChatEvent(eventType string, id string, payload easyjson.Marshaler) (*ChatEvent, error) {
+	data, err := easyjson.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &ChatEvent{
+		Version: ChatProtocolVersion,
+		Type:    eventType,
+		ID:      id,
+		Payload: data,
+	}, nil
+}
+
+// MessageSendPayload - payload события message.send
+//
+//easyjson:json
+type MessageSendPayload struct {
+	ReceiverID string `json:"receiverId"`
+	Content    string `json:"content"`
+}
+
+// MessageAckPayload - payload события message.ack: сообщение сохранено под этим id
+//
+//easyjson:json
+type MessageAckPayload struct {
+	MessageID int       `json:"messageId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// TypingPayload - payload события typing. От клиента UserID - собеседник, от сервера - тот, кто печатает
+//
+//easyjson:json
+type TypingPayload struct {
+	UserID string `json:"userId"`
+	Typing bool   `json:"typing"`
+}
+
+// PresencePayload - payload события presence. Клиент спрашивает о UserID, сервер отвечает Online
+//
+//easyjson:json
+type PresencePayload struct {
+	UserID string `json:"userId"`
+	Online bool   `json:"online"`
+}
+
+//easyjson:json
+type ChatErrorPayload struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
 type ChatRepository interface {
-	GetChats(ctx context.Context, userID string, lastUpdateTime time.Time) ([]*Chat, error)
-	SendNewMessage(ctx context.Context, receiver string, sender string, message string) error
+	GetChats(ctx context.Context, userID string, after *ChatCursor, limit int) ([]*Chat, error)
+	SendNewMessage(ctx context.Context, receiver string, sender string, message string) (*Message, error)
 	GetMessages(ctx context.Context, userID1 string, userID2 string, lastSentTime time.Time) ([]*Message, error)
+	MarkDelivered(ctx context.Context, messageID int, receiverID string, deliveredAt time.Time) (string, int64, error)
+	MarkChatRead(ctx context.Context, readerID string, partnerID string, readAt time.Time) (int64, error)
 }
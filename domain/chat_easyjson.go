@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -17,7 +18,301 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson9b8f5552Decode20242FIGHTCLUBDomain(in *jlexer.Lexer, out *Message) {
+func easyjson9b8f5552Decode20242FIGHTCLUBDomain(in *jlexer.Lexer, out *TypingPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "userId":
+			out.UserID = string(in.String())
+		case "typing":
+			out.Typing = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9b8f5552Encode20242FIGHTCLUBDomain(out *jwriter.Writer, in TypingPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"typing\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Typing))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TypingPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TypingPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TypingPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TypingPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain(l, v)
+}
+func easyjson9b8f5552Decode20242FIGHTCLUBDomain1(in *jlexer.Lexer, out *PresencePayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "userId":
+			out.UserID = string(in.String())
+		case "online":
+			out.Online = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9b8f5552Encode20242FIGHTCLUBDomain1(out *jwriter.Writer, in PresencePayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"online\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Online))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PresencePayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PresencePayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PresencePayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PresencePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain1(l, v)
+}
+func easyjson9b8f5552Decode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *MessageSendPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "receiverId":
+			out.ReceiverID = string(in.String())
+		case "content":
+			out.Content = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9b8f5552Encode20242FIGHTCLUBDomain2(out *jwriter.Writer, in MessageSendPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"receiverId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ReceiverID))
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageSendPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageSendPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageSendPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageSendPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain2(l, v)
+}
+func easyjson9b8f5552Decode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *MessageAckPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "messageId":
+			out.MessageID = int(in.Int())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9b8f5552Encode20242FIGHTCLUBDomain3(out *jwriter.Writer, in MessageAckPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"messageId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.MessageID))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageAckPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageAckPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageAckPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageAckPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain3(l, v)
+}
+func easyjson9b8f5552Decode20242FIGHTCLUBDomain4(in *jlexer.Lexer, out *Message) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -46,7 +341,135 @@ func easyjson9b8f5552Decode20242FIGHTCLUBDomain(in *jlexer.Lexer, out *Message)
 			out.Content = string(in.String())
 		case "createdAt":
 			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "deliveredAt":
+			if in.IsNull() {
+				in.Skip()
+				out.DeliveredAt = nil
+			} else {
+				if out.DeliveredAt == nil {
+					out.DeliveredAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DeliveredAt).UnmarshalJSON(data))
+				}
+			}
+		case "readAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ReadAt = nil
+			} else {
+				if out.ReadAt == nil {
+					out.ReadAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReadAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9b8f5552Encode20242FIGHTCLUBDomain4(out *jwriter.Writer, in Message) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"senderId\":"
+		out.RawString(prefix)
+		out.String(string(in.SenderID))
+	}
+	{
+		const prefix string = ",\"receiverId\":"
+		out.RawString(prefix)
+		out.String(string(in.ReceiverID))
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if in.DeliveredAt != nil {
+		const prefix string = ",\"deliveredAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.DeliveredAt).MarshalJSON())
+	}
+	if in.ReadAt != nil {
+		const prefix string = ",\"readAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.ReadAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Message) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Message) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain4(l, v)
+}
+func easyjson9b8f5552Decode20242FIGHTCLUBDomain5(in *jlexer.Lexer, out *ChatReceipt) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "messageId":
+			out.MessageID = int(in.Int())
+		case "userId":
+			out.UserID = string(in.String())
+		case "at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.At).UnmarshalJSON(data))
 			}
 		default:
 			in.SkipRecursive()
@@ -58,62 +481,292 @@ func easyjson9b8f5552Decode20242FIGHTCLUBDomain(in *jlexer.Lexer, out *Message)
 		in.Consumed()
 	}
 }
-func easyjson9b8f5552Encode20242FIGHTCLUBDomain(out *jwriter.Writer, in Message) {
+func easyjson9b8f5552Encode20242FIGHTCLUBDomain5(out *jwriter.Writer, in ChatReceipt) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ID))
+		out.String(string(in.Type))
+	}
+	if in.MessageID != 0 {
+		const prefix string = ",\"messageId\":"
+		out.RawString(prefix)
+		out.Int(int(in.MessageID))
+	}
+	if in.UserID != "" {
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
 	}
 	{
-		const prefix string = ",\"senderId\":"
+		const prefix string = ",\"at\":"
 		out.RawString(prefix)
-		out.String(string(in.SenderID))
+		out.Raw((in.At).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChatReceipt) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChatReceipt) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChatReceipt) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChatReceipt) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain5(l, v)
+}
+func easyjson9b8f5552Decode20242FIGHTCLUBDomain6(in *jlexer.Lexer, out *ChatEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "v":
+			out.Version = int(in.Int())
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "payload":
+			(out.Payload).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9b8f5552Encode20242FIGHTCLUBDomain6(out *jwriter.Writer, in ChatEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"v\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Version))
 	}
 	{
-		const prefix string = ",\"receiverId\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix)
-		out.String(string(in.ReceiverID))
+		out.String(string(in.Type))
+	}
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	if (in.Payload).IsDefined() {
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		(in.Payload).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChatEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChatEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChatEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChatEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain6(l, v)
+}
+func easyjson9b8f5552Decode20242FIGHTCLUBDomain7(in *jlexer.Lexer, out *ChatErrorPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "message":
+			out.Message = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson9b8f5552Encode20242FIGHTCLUBDomain7(out *jwriter.Writer, in ChatErrorPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"content\":"
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"message\":"
 		out.RawString(prefix)
-		out.String(string(in.Content))
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChatErrorPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChatErrorPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChatErrorPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChatErrorPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain7(l, v)
+}
+func easyjson9b8f5552Decode20242FIGHTCLUBDomain8(in *jlexer.Lexer, out *ChatCursor) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "d":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastDate).UnmarshalJSON(data))
+			}
+		case "id":
+			out.AuthorUUID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson9b8f5552Encode20242FIGHTCLUBDomain8(out *jwriter.Writer, in ChatCursor) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"createdAt\":"
+		const prefix string = ",\"d\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.LastDate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"id\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.String(string(in.AuthorUUID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Message) MarshalJSON() ([]byte, error) {
+func (v ChatCursor) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9b8f5552Encode20242FIGHTCLUBDomain(&w, v)
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9b8f5552Encode20242FIGHTCLUBDomain(w, v)
+func (v ChatCursor) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Message) UnmarshalJSON(data []byte) error {
+func (v *ChatCursor) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9b8f5552Decode20242FIGHTCLUBDomain(&r, v)
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9b8f5552Decode20242FIGHTCLUBDomain(l, v)
+func (v *ChatCursor) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain8(l, v)
 }
-func easyjson9b8f5552Decode20242FIGHTCLUBDomain1(in *jlexer.Lexer, out *Chat) {
+func easyjson9b8f5552Decode20242FIGHTCLUBDomain9(in *jlexer.Lexer, out *Chat) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -144,6 +797,8 @@ func easyjson9b8f5552Decode20242FIGHTCLUBDomain1(in *jlexer.Lexer, out *Chat) {
 			out.AuthorAvatar = string(in.String())
 		case "authorUuid":
 			out.AuthorUUID = string(in.String())
+		case "unreadCount":
+			out.UnreadCount = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -154,7 +809,7 @@ func easyjson9b8f5552Decode20242FIGHTCLUBDomain1(in *jlexer.Lexer, out *Chat) {
 		in.Consumed()
 	}
 }
-func easyjson9b8f5552Encode20242FIGHTCLUBDomain1(out *jwriter.Writer, in Chat) {
+func easyjson9b8f5552Encode20242FIGHTCLUBDomain9(out *jwriter.Writer, in Chat) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -183,33 +838,38 @@ func easyjson9b8f5552Encode20242FIGHTCLUBDomain1(out *jwriter.Writer, in Chat) {
 		out.RawString(prefix)
 		out.String(string(in.AuthorUUID))
 	}
+	{
+		const prefix string = ",\"unreadCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.UnreadCount))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Chat) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9b8f5552Encode20242FIGHTCLUBDomain1(&w, v)
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Chat) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9b8f5552Encode20242FIGHTCLUBDomain1(w, v)
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Chat) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9b8f5552Decode20242FIGHTCLUBDomain1(&r, v)
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Chat) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9b8f5552Decode20242FIGHTCLUBDomain1(l, v)
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain9(l, v)
 }
-func easyjson9b8f5552Decode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *AllMessages) {
+func easyjson9b8f5552Decode20242FIGHTCLUBDomain10(in *jlexer.Lexer, out *AllMessages) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -269,7 +929,7 @@ func easyjson9b8f5552Decode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *AllMessa
 		in.Consumed()
 	}
 }
-func easyjson9b8f5552Encode20242FIGHTCLUBDomain2(out *jwriter.Writer, in AllMessages) {
+func easyjson9b8f5552Encode20242FIGHTCLUBDomain10(out *jwriter.Writer, in AllMessages) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -299,27 +959,27 @@ func easyjson9b8f5552Encode20242FIGHTCLUBDomain2(out *jwriter.Writer, in AllMess
 // MarshalJSON supports json.Marshaler interface
 func (v AllMessages) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9b8f5552Encode20242FIGHTCLUBDomain2(&w, v)
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AllMessages) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9b8f5552Encode20242FIGHTCLUBDomain2(w, v)
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AllMessages) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9b8f5552Decode20242FIGHTCLUBDomain2(&r, v)
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AllMessages) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9b8f5552Decode20242FIGHTCLUBDomain2(l, v)
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain10(l, v)
 }
-func easyjson9b8f5552Decode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *AllChats) {
+func easyjson9b8f5552Decode20242FIGHTCLUBDomain11(in *jlexer.Lexer, out *AllChats) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -369,6 +1029,8 @@ func easyjson9b8f5552Decode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *AllChats
 				}
 				in.Delim(']')
 			}
+		case "nextCursor":
+			out.NextCursor = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -379,7 +1041,7 @@ func easyjson9b8f5552Decode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *AllChats
 		in.Consumed()
 	}
 }
-func easyjson9b8f5552Encode20242FIGHTCLUBDomain3(out *jwriter.Writer, in AllChats) {
+func easyjson9b8f5552Encode20242FIGHTCLUBDomain11(out *jwriter.Writer, in AllChats) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -403,29 +1065,34 @@ func easyjson9b8f5552Encode20242FIGHTCLUBDomain3(out *jwriter.Writer, in AllChat
 			out.RawByte(']')
 		}
 	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"nextCursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v AllChats) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9b8f5552Encode20242FIGHTCLUBDomain3(&w, v)
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AllChats) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9b8f5552Encode20242FIGHTCLUBDomain3(w, v)
+	easyjson9b8f5552Encode20242FIGHTCLUBDomain11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AllChats) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9b8f5552Decode20242FIGHTCLUBDomain3(&r, v)
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AllChats) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9b8f5552Decode20242FIGHTCLUBDomain3(l, v)
+	easyjson9b8f5552Decode20242FIGHTCLUBDomain11(l, v)
 }
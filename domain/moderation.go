@@ -0,0 +1,68 @@
+package domain
+
+//go:generate easyjson -all moderation.go
+
+import (
+	"context"
+	"time"
+)
+
+// Что можно пожаловаться: отзыв, объявление или сообщение в чате
+const (
+	ReportTargetReview  = "review"
+	ReportTargetAd      = "ad"
+	ReportTargetMessage = "message"
+)
+
+// Жалоба открыта, пока модератор не скроет контент, не отклонит её или не забанит автора
+const (
+	ReportStatusOpen      = "open"
+	ReportStatusHidden    = "hidden"
+	ReportStatusDismissed = "dismissed"
+	ReportStatusBanned    = "banned"
+)
+
+//easyjson:json
+type Report struct {
+	ID         int        `gorm:"primary_key;auto_increment;column:id" json:"id"`
+	ReporterID string     `gorm:"column:reporterId;not null" json:"reporterId"`
+	TargetType string     `gorm:"type:varchar(20);column:targetType;not null;index:idx_reports_target" json:"targetType"`
+	TargetID   string     `gorm:"type:varchar(255);column:targetId;not null;index:idx_reports_target" json:"targetId"`
+	AuthorID   string     `gorm:"column:authorId;not null" json:"authorId"`
+	Reason     string     `gorm:"type:text;size:500;column:reason;not null" json:"reason"`
+	Status     string     `gorm:"type:varchar(20);column:status;default:open;not null;index" json:"status"`
+	CreatedAt  time.Time  `gorm:"type:timestamp;column:createdAt" json:"createdAt"`
+	ResolvedAt *time.Time `gorm:"type:timestamp;column:resolvedAt" json:"resolvedAt,omitempty"`
+	ResolvedBy *string    `gorm:"column:resolvedBy" json:"resolvedBy,omitempty"`
+	Reporter   User       `gorm:"foreignkey:ReporterID;references:UUID" json:"-"`
+	Author     User       `gorm:"foreignkey:AuthorID;references:UUID" json:"-"`
+}
+
+//easyjson:json
+type CreateReportRequest struct {
+	TargetType string `json:"targetType"`
+	TargetID   string `json:"targetId"`
+	Reason     string `json:"reason"`
+}
+
+//easyjson:json
+type ReportsList struct {
+	Reports    []Report `json:"reports"`
+	NextCursor string   `json:"nextCursor,omitempty"`
+}
+
+// ReportCursor — id последней жалобы страницы, очередь идёт от старых к новым
+type ReportCursor struct {
+	ID int `json:"id"`
+}
+
+type ModerationRepository interface {
+	// GetContentAuthor возвращает автора контента, на который жалуются
+	GetContentAuthor(ctx context.Context, targetType, targetID string) (string, error)
+	CreateReport(ctx context.Context, report *Report) error
+	GetReports(ctx context.Context, status string, after *ReportCursor, limit int) ([]Report, error)
+	GetReportByID(ctx context.Context, reportID int) (*Report, error)
+	// ResolveReports закрывает все открытые жалобы на контент отчёта, при hide скрывает контент,
+	// при ban ещё и блокирует автора
+	ResolveReports(ctx context.Context, report *Report, status string, moderatorID string) error
+}
@@ -0,0 +1,420 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonE913b498Decode20242FIGHTCLUBDomain(in *jlexer.Lexer, out *ReportsList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reports":
+			if in.IsNull() {
+				in.Skip()
+				out.Reports = nil
+			} else {
+				in.Delim('[')
+				if out.Reports == nil {
+					if !in.IsDelim(']') {
+						out.Reports = make([]Report, 0, 0)
+					} else {
+						out.Reports = []Report{}
+					}
+				} else {
+					out.Reports = (out.Reports)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 Report
+					(v1).UnmarshalEasyJSON(in)
+					out.Reports = append(out.Reports, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "nextCursor":
+			out.NextCursor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498Encode20242FIGHTCLUBDomain(out *jwriter.Writer, in ReportsList) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reports\":"
+		out.RawString(prefix[1:])
+		if in.Reports == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Reports {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"nextCursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReportsList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498Encode20242FIGHTCLUBDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReportsList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498Encode20242FIGHTCLUBDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReportsList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498Decode20242FIGHTCLUBDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReportsList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498Decode20242FIGHTCLUBDomain(l, v)
+}
+func easyjsonE913b498Decode20242FIGHTCLUBDomain1(in *jlexer.Lexer, out *ReportCursor) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498Encode20242FIGHTCLUBDomain1(out *jwriter.Writer, in ReportCursor) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReportCursor) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498Encode20242FIGHTCLUBDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReportCursor) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498Encode20242FIGHTCLUBDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReportCursor) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498Decode20242FIGHTCLUBDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReportCursor) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498Decode20242FIGHTCLUBDomain1(l, v)
+}
+func easyjsonE913b498Decode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *Report) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "reporterId":
+			out.ReporterID = string(in.String())
+		case "targetType":
+			out.TargetType = string(in.String())
+		case "targetId":
+			out.TargetID = string(in.String())
+		case "authorId":
+			out.AuthorID = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "resolvedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ResolvedAt = nil
+			} else {
+				if out.ResolvedAt == nil {
+					out.ResolvedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ResolvedAt).UnmarshalJSON(data))
+				}
+			}
+		case "resolvedBy":
+			if in.IsNull() {
+				in.Skip()
+				out.ResolvedBy = nil
+			} else {
+				if out.ResolvedBy == nil {
+					out.ResolvedBy = new(string)
+				}
+				*out.ResolvedBy = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498Encode20242FIGHTCLUBDomain2(out *jwriter.Writer, in Report) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"reporterId\":"
+		out.RawString(prefix)
+		out.String(string(in.ReporterID))
+	}
+	{
+		const prefix string = ",\"targetType\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetType))
+	}
+	{
+		const prefix string = ",\"targetId\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetID))
+	}
+	{
+		const prefix string = ",\"authorId\":"
+		out.RawString(prefix)
+		out.String(string(in.AuthorID))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if in.ResolvedAt != nil {
+		const prefix string = ",\"resolvedAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.ResolvedAt).MarshalJSON())
+	}
+	if in.ResolvedBy != nil {
+		const prefix string = ",\"resolvedBy\":"
+		out.RawString(prefix)
+		out.String(string(*in.ResolvedBy))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Report) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498Encode20242FIGHTCLUBDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Report) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498Encode20242FIGHTCLUBDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Report) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498Decode20242FIGHTCLUBDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Report) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498Decode20242FIGHTCLUBDomain2(l, v)
+}
+func easyjsonE913b498Decode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *CreateReportRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "targetType":
+			out.TargetType = string(in.String())
+		case "targetId":
+			out.TargetID = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498Encode20242FIGHTCLUBDomain3(out *jwriter.Writer, in CreateReportRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"targetType\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.TargetType))
+	}
+	{
+		const prefix string = ",\"targetId\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetID))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateReportRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498Encode20242FIGHTCLUBDomain3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateReportRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498Encode20242FIGHTCLUBDomain3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateReportRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498Decode20242FIGHTCLUBDomain3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateReportRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498Decode20242FIGHTCLUBDomain3(l, v)
+}
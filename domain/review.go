@@ -13,39 +13,97 @@ type ReviewBody struct {
 }
 
 //easyjson:json
-type UserReviewsList []UserReviews
+type UserReviewsList struct {
+	Reviews    []UserReviews `json:"reviews"`
+	NextCursor string        `json:"nextCursor,omitempty"`
+}
+
+// Отзыв можно оставить только после завершённого проживания и не позже ReviewWindow после выезда
+const ReviewWindow = 14 * 24 * time.Hour
+
+// Причины отказа в отзыве, которые получает клиент
+const (
+	ReviewReasonNoCompletedStay = "no_completed_stay"
+	ReviewReasonWindowClosed    = "review_window_closed"
+)
+
+//easyjson:json
+type ReviewNotAllowedResponse struct {
+	Error   string `json:"error"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// Байесовская оценка хозяина: (HostScorePriorWeight*HostScorePriorMean + сумма оценок) /
+// (HostScorePriorWeight + число отзывов), пока отзывов мало, она близка к HostScorePriorMean
+const (
+	HostScorePriorMean   = 3.5
+	HostScorePriorWeight = 10
+)
+
+// ReviewCursor — ключ последнего отзыва страницы в порядке (createdAt, id)
+type ReviewCursor struct {
+	CreatedAt time.Time `json:"d"`
+	ID        int       `json:"id"`
+}
 
 //easyjson:json
 type Review struct {
-	ID        int       `gorm:"primary_key;auto_increment;column:id" json:"id"`
-	UserID    string    `gorm:"column:userId;not null" json:"userId"`
-	HostID    string    `gorm:"column:hostId;not null" json:"hostId"`
+	ID     int    `gorm:"primary_key;auto_increment;column:id" json:"id"`
+	UserID string `gorm:"column:userId;not null" json:"userId"`
+	HostID string `gorm:"column:hostId;not null;index:idx_reviews_host_id" json:"hostId"`
+	// AdID пустой у отзывов о хозяине, у отзывов об объявлении HostID берётся из объявления
+	AdID      *string   `gorm:"type:uuid;column:adId;index" json:"adId,omitempty"`
 	Title     string    `gorm:"type:text;size:250;column:title;not null" json:"title"`
 	Text      string    `gorm:"type:text;size:1000;column:text;not null" json:"text"`
 	Rating    int       `gorm:"column:rating" json:"rating"`
 	CreatedAt time.Time `gorm:"type:timestamp;column:createdAt" json:"createdAt"`
-	User      User      `gorm:"foreignkey:UserID;references:UUID" json:"-"`
-	Host      User      `gorm:"foreignkey:HostID;references:UUID" json:"-"`
+	// Публичный ответ хозяина, у отзыва он может быть только один
+	Reply          *string    `gorm:"type:text;size:1000;column:reply" json:"reply,omitempty"`
+	ReplyUpdatedAt *time.Time `gorm:"type:timestamp;column:replyUpdatedAt" json:"replyUpdatedAt,omitempty"`
+	User           User       `gorm:"foreignkey:UserID;references:UUID" json:"-"`
+	Host           User       `gorm:"foreignkey:HostID;references:UUID" json:"-"`
+	Ad             *Ad        `gorm:"foreignkey:AdID;references:UUID" json:"-"`
+	// Скрытые модератором отзывы не попадают в выдачу
+	Hidden bool `gorm:"column:hidden;default:false;not null;<-:false" json:"-"`
+}
+
+//easyjson:json
+type ReviewReply struct {
+	Text string `json:"text"`
 }
 
 //easyjson:json
 type UserReviews struct {
-	ID         int       `gorm:"primary_key;auto_increment;column:id" json:"id"`
-	UserID     string    `gorm:"column:userId;not null" json:"userId"`
-	HostID     string    `gorm:"column:hostId;not null" json:"hostId"`
-	Title      string    `gorm:"type:text;size:250;column:title;not null" json:"title"`
-	Text       string    `gorm:"type:text;size:1000;column:text;not null" json:"text"`
-	Rating     int       `gorm:"column:rating" json:"rating"`
-	CreatedAt  time.Time `gorm:"type:timestamp;column:createdAt" json:"createdAt"`
-	User       User      `gorm:"foreignkey:UserID;references:UUID" json:"-"`
-	Host       User      `gorm:"foreignkey:HostID;references:UUID" json:"-"`
-	UserAvatar string    `json:"userAvatar"`
-	UserName   string    `json:"userName"`
+	ID             int        `gorm:"primary_key;auto_increment;column:id" json:"id"`
+	UserID         string     `gorm:"column:userId;not null" json:"userId"`
+	HostID         string     `gorm:"column:hostId;not null" json:"hostId"`
+	AdID           *string    `gorm:"column:adId" json:"adId,omitempty"`
+	Title          string     `gorm:"type:text;size:250;column:title;not null" json:"title"`
+	Text           string     `gorm:"type:text;size:1000;column:text;not null" json:"text"`
+	Rating         int        `gorm:"column:rating" json:"rating"`
+	CreatedAt      time.Time  `gorm:"type:timestamp;column:createdAt" json:"createdAt"`
+	Reply          *string    `gorm:"column:reply" json:"reply,omitempty"`
+	ReplyUpdatedAt *time.Time `gorm:"column:replyUpdatedAt" json:"replyUpdatedAt,omitempty"`
+	User           User       `gorm:"foreignkey:UserID;references:UUID" json:"-"`
+	Host           User       `gorm:"foreignkey:HostID;references:UUID" json:"-"`
+	UserAvatar     string     `json:"userAvatar"`
+	UserName       string     `json:"userName"`
 }
 
 type ReviewRepository interface {
 	CreateReview(ctx context.Context, review *Review) error
-	GetUserReviews(ctx context.Context, userID string) ([]UserReviews, error)
+	GetUserReviews(ctx context.Context, userID string, after *ReviewCursor, limit int) ([]UserReviews, error)
+	GetAdReviews(ctx context.Context, adID string, after *ReviewCursor, limit int) ([]UserReviews, error)
 	DeleteReview(ctx context.Context, userID, hostID string) error
 	UpdateReview(ctx context.Context, userID, hostID string, updatedReview *Review) error
+	// UpdateReviewByID и DeleteReviewByID работают только с отзывом автора userID и пересчитывают оценки
+	UpdateReviewByID(ctx context.Context, reviewID int, userID string, updatedReview *Review) error
+	DeleteReviewByID(ctx context.Context, reviewID int, userID string) error
+	// GetLastCompletedStay возвращает дату выезда по последней завершённой или прошедшей одобренной заявке гостя
+	// на объявление (если adID задан) или у хозяина, nil - если проживаний не было
+	GetLastCompletedStay(ctx context.Context, userID, hostID string, adID *string) (*time.Time, error)
+	GetReviewByID(ctx context.Context, reviewID int) (*Review, error)
+	// SetReviewReply сохраняет ответ хозяина, nil удаляет его
+	SetReviewReply(ctx context.Context, reviewID int, reply *string) error
 }
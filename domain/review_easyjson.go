@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -20,44 +21,83 @@ var (
 func easyjson2f096870Decode20242FIGHTCLUBDomain(in *jlexer.Lexer, out *UserReviewsList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
 		in.Skip()
-		*out = nil
-	} else {
-		in.Delim('[')
-		if *out == nil {
-			if !in.IsDelim(']') {
-				*out = make(UserReviewsList, 0, 0)
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reviews":
+			if in.IsNull() {
+				in.Skip()
+				out.Reviews = nil
 			} else {
-				*out = UserReviewsList{}
+				in.Delim('[')
+				if out.Reviews == nil {
+					if !in.IsDelim(']') {
+						out.Reviews = make([]UserReviews, 0, 0)
+					} else {
+						out.Reviews = []UserReviews{}
+					}
+				} else {
+					out.Reviews = (out.Reviews)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 UserReviews
+					(v1).UnmarshalEasyJSON(in)
+					out.Reviews = append(out.Reviews, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		} else {
-			*out = (*out)[:0]
-		}
-		for !in.IsDelim(']') {
-			var v1 UserReviews
-			(v1).UnmarshalEasyJSON(in)
-			*out = append(*out, v1)
-			in.WantComma()
+		case "nextCursor":
+			out.NextCursor = string(in.String())
+		default:
+			in.SkipRecursive()
 		}
-		in.Delim(']')
+		in.WantComma()
 	}
+	in.Delim('}')
 	if isTopLevel {
 		in.Consumed()
 	}
 }
 func easyjson2f096870Encode20242FIGHTCLUBDomain(out *jwriter.Writer, in UserReviewsList) {
-	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-		out.RawString("null")
-	} else {
-		out.RawByte('[')
-		for v2, v3 := range in {
-			if v2 > 0 {
-				out.RawByte(',')
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reviews\":"
+		out.RawString(prefix[1:])
+		if in.Reviews == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Reviews {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
 			}
-			(v3).MarshalEasyJSON(out)
+			out.RawByte(']')
 		}
-		out.RawByte(']')
 	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"nextCursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
@@ -108,6 +148,16 @@ func easyjson2f096870Decode20242FIGHTCLUBDomain1(in *jlexer.Lexer, out *UserRevi
 			out.UserID = string(in.String())
 		case "hostId":
 			out.HostID = string(in.String())
+		case "adId":
+			if in.IsNull() {
+				in.Skip()
+				out.AdID = nil
+			} else {
+				if out.AdID == nil {
+					out.AdID = new(string)
+				}
+				*out.AdID = string(in.String())
+			}
 		case "title":
 			out.Title = string(in.String())
 		case "text":
@@ -118,6 +168,28 @@ func easyjson2f096870Decode20242FIGHTCLUBDomain1(in *jlexer.Lexer, out *UserRevi
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
 			}
+		case "reply":
+			if in.IsNull() {
+				in.Skip()
+				out.Reply = nil
+			} else {
+				if out.Reply == nil {
+					out.Reply = new(string)
+				}
+				*out.Reply = string(in.String())
+			}
+		case "replyUpdatedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ReplyUpdatedAt = nil
+			} else {
+				if out.ReplyUpdatedAt == nil {
+					out.ReplyUpdatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReplyUpdatedAt).UnmarshalJSON(data))
+				}
+			}
 		case "userAvatar":
 			out.UserAvatar = string(in.String())
 		case "userName":
@@ -151,6 +223,11 @@ func easyjson2f096870Encode20242FIGHTCLUBDomain1(out *jwriter.Writer, in UserRev
 		out.RawString(prefix)
 		out.String(string(in.HostID))
 	}
+	if in.AdID != nil {
+		const prefix string = ",\"adId\":"
+		out.RawString(prefix)
+		out.String(string(*in.AdID))
+	}
 	{
 		const prefix string = ",\"title\":"
 		out.RawString(prefix)
@@ -171,6 +248,16 @@ func easyjson2f096870Encode20242FIGHTCLUBDomain1(out *jwriter.Writer, in UserRev
 		out.RawString(prefix)
 		out.Raw((in.CreatedAt).MarshalJSON())
 	}
+	if in.Reply != nil {
+		const prefix string = ",\"reply\":"
+		out.RawString(prefix)
+		out.String(string(*in.Reply))
+	}
+	if in.ReplyUpdatedAt != nil {
+		const prefix string = ",\"replyUpdatedAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.ReplyUpdatedAt).MarshalJSON())
+	}
 	{
 		const prefix string = ",\"userAvatar\":"
 		out.RawString(prefix)
@@ -207,7 +294,228 @@ func (v *UserReviews) UnmarshalJSON(data []byte) error {
 func (v *UserReviews) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson2f096870Decode20242FIGHTCLUBDomain1(l, v)
 }
-func easyjson2f096870Decode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *ReviewBody) {
+func easyjson2f096870Decode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *ReviewReply) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870Encode20242FIGHTCLUBDomain2(out *jwriter.Writer, in ReviewReply) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewReply) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870Encode20242FIGHTCLUBDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewReply) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870Encode20242FIGHTCLUBDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewReply) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870Decode20242FIGHTCLUBDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewReply) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870Decode20242FIGHTCLUBDomain2(l, v)
+}
+func easyjson2f096870Decode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *ReviewNotAllowedResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "error":
+			out.Error = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "message":
+			out.Message = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870Encode20242FIGHTCLUBDomain3(out *jwriter.Writer, in ReviewNotAllowedResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"error\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Error))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewNotAllowedResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870Encode20242FIGHTCLUBDomain3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewNotAllowedResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870Encode20242FIGHTCLUBDomain3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewNotAllowedResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870Decode20242FIGHTCLUBDomain3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewNotAllowedResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870Decode20242FIGHTCLUBDomain3(l, v)
+}
+func easyjson2f096870Decode20242FIGHTCLUBDomain4(in *jlexer.Lexer, out *ReviewCursor) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "d":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "id":
+			out.ID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870Encode20242FIGHTCLUBDomain4(out *jwriter.Writer, in ReviewCursor) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"d\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewCursor) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870Encode20242FIGHTCLUBDomain4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewCursor) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870Encode20242FIGHTCLUBDomain4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewCursor) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870Decode20242FIGHTCLUBDomain4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewCursor) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870Decode20242FIGHTCLUBDomain4(l, v)
+}
+func easyjson2f096870Decode20242FIGHTCLUBDomain5(in *jlexer.Lexer, out *ReviewBody) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -238,7 +546,7 @@ func easyjson2f096870Decode20242FIGHTCLUBDomain2(in *jlexer.Lexer, out *ReviewBo
 		in.Consumed()
 	}
 }
-func easyjson2f096870Encode20242FIGHTCLUBDomain2(out *jwriter.Writer, in ReviewBody) {
+func easyjson2f096870Encode20242FIGHTCLUBDomain5(out *jwriter.Writer, in ReviewBody) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -253,27 +561,27 @@ func easyjson2f096870Encode20242FIGHTCLUBDomain2(out *jwriter.Writer, in ReviewB
 // MarshalJSON supports json.Marshaler interface
 func (v ReviewBody) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2f096870Encode20242FIGHTCLUBDomain2(&w, v)
+	easyjson2f096870Encode20242FIGHTCLUBDomain5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ReviewBody) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2f096870Encode20242FIGHTCLUBDomain2(w, v)
+	easyjson2f096870Encode20242FIGHTCLUBDomain5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ReviewBody) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2f096870Decode20242FIGHTCLUBDomain2(&r, v)
+	easyjson2f096870Decode20242FIGHTCLUBDomain5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ReviewBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2f096870Decode20242FIGHTCLUBDomain2(l, v)
+	easyjson2f096870Decode20242FIGHTCLUBDomain5(l, v)
 }
-func easyjson2f096870Decode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *Review) {
+func easyjson2f096870Decode20242FIGHTCLUBDomain6(in *jlexer.Lexer, out *Review) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -298,6 +606,16 @@ func easyjson2f096870Decode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *Review)
 			out.UserID = string(in.String())
 		case "hostId":
 			out.HostID = string(in.String())
+		case "adId":
+			if in.IsNull() {
+				in.Skip()
+				out.AdID = nil
+			} else {
+				if out.AdID == nil {
+					out.AdID = new(string)
+				}
+				*out.AdID = string(in.String())
+			}
 		case "title":
 			out.Title = string(in.String())
 		case "text":
@@ -308,6 +626,28 @@ func easyjson2f096870Decode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *Review)
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
 			}
+		case "reply":
+			if in.IsNull() {
+				in.Skip()
+				out.Reply = nil
+			} else {
+				if out.Reply == nil {
+					out.Reply = new(string)
+				}
+				*out.Reply = string(in.String())
+			}
+		case "replyUpdatedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ReplyUpdatedAt = nil
+			} else {
+				if out.ReplyUpdatedAt == nil {
+					out.ReplyUpdatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReplyUpdatedAt).UnmarshalJSON(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -318,7 +658,7 @@ func easyjson2f096870Decode20242FIGHTCLUBDomain3(in *jlexer.Lexer, out *Review)
 		in.Consumed()
 	}
 }
-func easyjson2f096870Encode20242FIGHTCLUBDomain3(out *jwriter.Writer, in Review) {
+func easyjson2f096870Encode20242FIGHTCLUBDomain6(out *jwriter.Writer, in Review) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -337,6 +677,11 @@ func easyjson2f096870Encode20242FIGHTCLUBDomain3(out *jwriter.Writer, in Review)
 		out.RawString(prefix)
 		out.String(string(in.HostID))
 	}
+	if in.AdID != nil {
+		const prefix string = ",\"adId\":"
+		out.RawString(prefix)
+		out.String(string(*in.AdID))
+	}
 	{
 		const prefix string = ",\"title\":"
 		out.RawString(prefix)
@@ -357,29 +702,39 @@ func easyjson2f096870Encode20242FIGHTCLUBDomain3(out *jwriter.Writer, in Review)
 		out.RawString(prefix)
 		out.Raw((in.CreatedAt).MarshalJSON())
 	}
+	if in.Reply != nil {
+		const prefix string = ",\"reply\":"
+		out.RawString(prefix)
+		out.String(string(*in.Reply))
+	}
+	if in.ReplyUpdatedAt != nil {
+		const prefix string = ",\"replyUpdatedAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.ReplyUpdatedAt).MarshalJSON())
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Review) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2f096870Encode20242FIGHTCLUBDomain3(&w, v)
+	easyjson2f096870Encode20242FIGHTCLUBDomain6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Review) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2f096870Encode20242FIGHTCLUBDomain3(w, v)
+	easyjson2f096870Encode20242FIGHTCLUBDomain6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Review) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2f096870Decode20242FIGHTCLUBDomain3(&r, v)
+	easyjson2f096870Decode20242FIGHTCLUBDomain6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Review) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2f096870Decode20242FIGHTCLUBDomain3(l, v)
+	easyjson2f096870Decode20242FIGHTCLUBDomain6(l, v)
 }
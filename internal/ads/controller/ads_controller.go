@@ -16,6 +16,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -44,12 +45,7 @@ func (h *AdHandler) GetAllPlaces(w http.ResponseWriter, r *http.Request) {
 	statusCode := http.StatusOK
 	var err error
 
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		sanitizedPath := metrics.SanitizeAdIdPath(r.URL.Path)
 		if statusCode == http.StatusOK {
@@ -80,6 +76,7 @@ func (h *AdHandler) GetAllPlaces(w http.ResponseWriter, r *http.Request) {
 	response, err := h.client.GetAllPlaces(ctx, &gen.AdFilterRequest{
 		Location:    queryParams.Get("location"),
 		Rating:      queryParams.Get("rating"),
+		RatingMode:  queryParams.Get("ratingMode"),
 		NewThisWeek: queryParams.Get("new"),
 		HostGender:  queryParams.Get("gender"),
 		GuestCount:  queryParams.Get("guests"),
@@ -88,6 +85,14 @@ func (h *AdHandler) GetAllPlaces(w http.ResponseWriter, r *http.Request) {
 		DateFrom:    queryParams.Get("dateFrom"),
 		DateTo:      queryParams.Get("dateTo"),
 		SessionId:   sessionID,
+		PriceMin:    queryParams.Get("priceMin"),
+		PriceMax:    queryParams.Get("priceMax"),
+		Sort:        queryParams.Get("sort"),
+		Latitude:    queryParams.Get("lat"),
+		Longitude:   queryParams.Get("lng"),
+		Radius:      queryParams.Get("radius"),
+		Cursor:      queryParams.Get("cursor"),
+		Q:           queryParams.Get("q"),
 	})
 	if err != nil {
 		logger.AccessLogger.Error("Failed to GetAllPlaces",
@@ -136,12 +141,7 @@ func (h *AdHandler) GetOnePlace(w http.ResponseWriter, r *http.Request) {
 
 	statusCode := http.StatusOK
 	var err error
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		sanitizedPath := metrics.SanitizeAdIdPath(r.URL.Path)
 		if statusCode == http.StatusOK {
@@ -158,7 +158,8 @@ func (h *AdHandler) GetOnePlace(w http.ResponseWriter, r *http.Request) {
 		zap.String("adId", adId),
 	)
 
-	isAuthorized := false
+	// сессия нужна для счётчика просмотров и доступа владельца и модераторов к скрытому объявлению
+	viewerSession := ""
 
 	sessionID, err := session.GetSessionId(r)
 	if err != nil || sessionID == "" {
@@ -170,12 +171,14 @@ func (h *AdHandler) GetOnePlace(w http.ResponseWriter, r *http.Request) {
 			zap.String("request_id", requestID),
 			zap.Error(err))
 	} else {
-		isAuthorized = true
+		viewerSession = sessionID
 	}
 
 	place, err := h.client.GetOnePlace(ctx, &gen.GetPlaceByIdRequest{
-		AdId:         adId,
-		IsAuthorized: isAuthorized,
+		AdId:      adId,
+		SessionId: viewerSession,
+		DateFrom:  r.URL.Query().Get("dateFrom"),
+		DateTo:    r.URL.Query().Get("dateTo"),
 	})
 	if err != nil {
 		logger.AccessLogger.Error("Failed to GetOnePlace",
@@ -226,12 +229,7 @@ func (h *AdHandler) CreatePlace(w http.ResponseWriter, r *http.Request) {
 
 	statusCode := http.StatusCreated
 	var err error
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		sanitizedPath := metrics.SanitizeAdIdPath(r.URL.Path)
 		if statusCode == http.StatusCreated {
@@ -303,22 +301,28 @@ func (h *AdHandler) CreatePlace(w http.ResponseWriter, r *http.Request) {
 	}
 
 	_, err = h.client.CreatePlace(ctx, &gen.CreateAdRequest{
-		CityName:     newPlace.CityName,
-		Description:  newPlace.Description,
-		Address:      newPlace.Address,
-		RoomsNumber:  int32(newPlace.RoomsNumber),
-		DateFrom:     timestamppb.New(newPlace.DateFrom),
-		DateTo:       timestamppb.New(newPlace.DateTo),
-		Images:       files,
-		AuthHeader:   authHeader,
-		SessionID:    sessionID,
-		SquareMeters: int32(newPlace.SquareMeters),
-		Floor:        int32(newPlace.Floor),
-		BuildingType: newPlace.BuildingType,
-		HasBalcony:   newPlace.HasBalcony,
-		HasElevator:  newPlace.HasElevator,
-		HasGas:       newPlace.HasGas,
-		Rooms:        middleware.ConvertRoomsToGRPC(newPlace.Rooms),
+		CityName:       newPlace.CityName,
+		Description:    newPlace.Description,
+		Address:        newPlace.Address,
+		RoomsNumber:    int32(newPlace.RoomsNumber),
+		DateFrom:       timestamppb.New(newPlace.DateFrom),
+		DateTo:         timestamppb.New(newPlace.DateTo),
+		Images:         files,
+		AuthHeader:     authHeader,
+		SessionID:      sessionID,
+		SquareMeters:   int32(newPlace.SquareMeters),
+		Floor:          int32(newPlace.Floor),
+		BuildingType:   newPlace.BuildingType,
+		HasBalcony:     newPlace.HasBalcony,
+		HasElevator:    newPlace.HasElevator,
+		HasGas:         newPlace.HasGas,
+		Rooms:          middleware.ConvertRoomsToGRPC(newPlace.Rooms),
+		AvailableDates: middleware.ConvertDateRangesToGRPC(newPlace.AvailableDates),
+		BlockedDates:   middleware.ConvertDatesToGRPC(newPlace.BlockedDates),
+		Price:          int32(newPlace.Price),
+		WeekendPrice:   int32(newPlace.WeekendPrice),
+		CleaningFee:    int32(newPlace.CleaningFee),
+		Position:       middleware.ConvertPositionToGRPC(newPlace.Position),
 	})
 	if err != nil {
 		logger.AccessLogger.Error("Failed to create place", zap.String("request_id", requestID), zap.Error(err))
@@ -357,12 +361,7 @@ func (h *AdHandler) UpdatePlace(w http.ResponseWriter, r *http.Request) {
 
 	statusCode := http.StatusOK
 	var err error
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		sanitizedPath := metrics.SanitizeAdIdPath(r.URL.Path)
 		if statusCode == http.StatusOK {
@@ -429,23 +428,32 @@ func (h *AdHandler) UpdatePlace(w http.ResponseWriter, r *http.Request) {
 	}
 
 	_, err = h.client.UpdatePlace(ctx, &gen.UpdateAdRequest{
-		AdId:         adId,
-		CityName:     updatedPlace.CityName,
-		Address:      updatedPlace.Address,
-		Description:  updatedPlace.Description,
-		RoomsNumber:  int32(updatedPlace.RoomsNumber),
-		SessionID:    sessionID,
-		AuthHeader:   authHeader,
-		Images:       files,
-		DateFrom:     timestamppb.New(updatedPlace.DateFrom),
-		DateTo:       timestamppb.New(updatedPlace.DateTo),
-		SquareMeters: int32(updatedPlace.SquareMeters),
-		Floor:        int32(updatedPlace.Floor),
-		BuildingType: updatedPlace.BuildingType,
-		HasBalcony:   updatedPlace.HasBalcony,
-		HasElevator:  updatedPlace.HasElevator,
-		HasGas:       updatedPlace.HasGas,
-		Rooms:        middleware.ConvertRoomsToGRPC(updatedPlace.Rooms),
+		AdId:           adId,
+		CityName:       updatedPlace.CityName,
+		Address:        updatedPlace.Address,
+		Description:    updatedPlace.Description,
+		RoomsNumber:    int32(updatedPlace.RoomsNumber),
+		SessionID:      sessionID,
+		AuthHeader:     authHeader,
+		Images:         files,
+		DateFrom:       timestamppb.New(updatedPlace.DateFrom),
+		DateTo:         timestamppb.New(updatedPlace.DateTo),
+		SquareMeters:   int32(updatedPlace.SquareMeters),
+		Floor:          int32(updatedPlace.Floor),
+		BuildingType:   updatedPlace.BuildingType,
+		HasBalcony:     updatedPlace.HasBalcony,
+		HasElevator:    updatedPlace.HasElevator,
+		HasGas:         updatedPlace.HasGas,
+		Rooms:          middleware.ConvertRoomsToGRPC(updatedPlace.Rooms),
+		AvailableDates: middleware.ConvertDateRangesToGRPC(updatedPlace.AvailableDates),
+		BlockedDates:   middleware.ConvertDatesToGRPC(updatedPlace.BlockedDates),
+		Price:          int32(updatedPlace.Price),
+		WeekendPrice:   int32(updatedPlace.WeekendPrice),
+		CleaningFee:    int32(updatedPlace.CleaningFee),
+		Position:       middleware.ConvertPositionToGRPC(updatedPlace.Position),
+		// Пустой список в metadata очищает календарь, отсутствующие поля оставляют его как есть
+		ReplaceCalendar: updatedPlace.AvailableDates != nil || updatedPlace.BlockedDates != nil ||
+			!updatedPlace.DateFrom.IsZero() || !updatedPlace.DateTo.IsZero(),
 	})
 	if err != nil {
 		logger.AccessLogger.Error("Failed to update place", zap.String("request_id", requestID), zap.Error(err))
@@ -483,12 +491,7 @@ func (h *AdHandler) DeletePlace(w http.ResponseWriter, r *http.Request) {
 
 	statusCode := http.StatusOK
 	var err error
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		sanitizedPath := metrics.SanitizeAdIdPath(r.URL.Path)
 		if statusCode == http.StatusOK {
@@ -551,12 +554,7 @@ func (h *AdHandler) GetPlacesPerCity(w http.ResponseWriter, r *http.Request) {
 
 	statusCode := http.StatusOK
 	var err error
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		sanitizedPath := metrics.SanitizeAdIdPath(r.URL.Path)
 		if statusCode == http.StatusOK {
@@ -615,6 +613,73 @@ func (h *AdHandler) GetPlacesPerCity(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
+func (h *AdHandler) GetPlacesInBounds(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+
+	statusCode := http.StatusOK
+	var err error
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	bbox := r.URL.Query().Get("bbox")
+	logger.AccessLogger.Info("Received GetPlacesInBounds request",
+		zap.String("request_id", requestID),
+		zap.String("bbox", bbox),
+	)
+
+	response, err := h.client.GetPlacesInBounds(ctx, &gen.GetPlacesInBoundsRequest{
+		Bbox: bbox,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get places in bounds", zap.String("request_id", requestID), zap.Error(err))
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	payload, err := h.utils.ConvertGetAllAdsResponseProtoToGo(response)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to Convert From Proto to Go",
+			zap.Error(err),
+			zap.String("request_id", requestID))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	body := domain.PlacesResponse{
+		Places: payload,
+	}
+	if _, err = easyjson.MarshalToWriter(body, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed GetPlacesInBounds request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
 func (h *AdHandler) GetUserPlaces(w http.ResponseWriter, r *http.Request) {
 	start := time.Now()
 	requestID := middleware.GetRequestID(r.Context())
@@ -624,12 +689,7 @@ func (h *AdHandler) GetUserPlaces(w http.ResponseWriter, r *http.Request) {
 
 	statusCode := http.StatusOK
 	var err error
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		sanitizedPath := metrics.SanitizeUserIdPath(r.URL.Path)
 		if statusCode == http.StatusOK {
@@ -700,12 +760,7 @@ func (h *AdHandler) DeleteAdImage(w http.ResponseWriter, r *http.Request) {
 
 	statusCode := http.StatusOK
 	var err error
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		sanitizedPath := metrics.SanitizeAdIdPath(r.URL.Path)
 		if statusCode == http.StatusOK {
@@ -777,12 +832,7 @@ func (h *AdHandler) AddToFavorites(w http.ResponseWriter, r *http.Request) {
 
 	statusCode := http.StatusOK
 	var err error
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		sanitizedPath := metrics.SanitizeAdIdPath(r.URL.Path)
 		if statusCode == http.StatusOK {
@@ -852,12 +902,7 @@ func (h *AdHandler) DeleteFromFavorites(w http.ResponseWriter, r *http.Request)
 
 	statusCode := http.StatusOK
 	var err error
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		sanitizedPath := metrics.SanitizeAdIdPath(r.URL.Path)
 		if statusCode == http.StatusOK {
@@ -927,12 +972,7 @@ func (h *AdHandler) GetUserFavorites(w http.ResponseWriter, r *http.Request) {
 
 	statusCode := http.StatusOK
 	var err error
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		sanitizedPath := metrics.SanitizeUserIdPath(r.URL.Path)
 		if statusCode == http.StatusOK {
@@ -1007,12 +1047,7 @@ func (h *AdHandler) UpdatePriorityWithPayment(w http.ResponseWriter, r *http.Req
 
 	statusCode := http.StatusOK
 	var err error
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		sanitizedPath := metrics.SanitizeAdIdPath(r.URL.Path)
 		if statusCode == http.StatusOK {
@@ -1094,21 +1129,32 @@ func (h *AdHandler) handleError(w http.ResponseWriter, err error, requestID stri
 		Error: err.Error(),
 	}
 	switch err.Error() {
-	case "ad not found", "ad date not found", "image not found", "error fetching all places":
+	case "ad not found", "ad date not found", "image not found", "error fetching all places",
+		"booking not found":
 		statusCode = http.StatusNotFound
-	case "ad already exists", "roomsNumber out of range", "not owner of ad":
+	case "ad already exists", "roomsNumber out of range", "not owner of ad",
+		"dates already booked", "invalid status transition", "host cannot book own ad",
+		"ad is not available for these dates", "booking status changed":
 		statusCode = http.StatusConflict
 	case "no active session", "missing X-CSRF-Token header",
 		"invalid JWT token", "user is not host", "session not found", "user ID not found in session":
 		statusCode = http.StatusUnauthorized
+	case "not participant of booking", "only host can approve or decline booking",
+		"only host can complete booking", "email not verified":
+		statusCode = http.StatusForbidden
 	case "invalid metadata JSON", "invalid multipart form", "input contains invalid characters",
 		"input exceeds character limit", "invalid size, type or resolution of image",
 		"query offset not int", "query limit not int", "query dateFrom not int",
 		"query dateTo not int", "URL contains invalid characters", "URL exceeds character limit",
 		"token parse error", "token invalid", "token expired", "bad sign method",
 		"failed to decode metadata", "no images provided", "failed to open file",
-		"failed to read file", "failed to encode response", "invalid rating value",
-		"cant access other user favorites":
+		"failed to read file", "failed to encode response", "invalid rating value", "invalid rating mode",
+		"cant access other user favorites", "invalid booking dates", "invalid booking id",
+		"failed to decode booking", "stay is not finished yet", "invalid available date range",
+		"available date ranges overlap", "price out of range", "invalid stay dates",
+		"stay too long", "blocked dates without available dates", "query priceMin not int", "query priceMax not int", "query sort invalid",
+		"invalid coordinates", "invalid bbox", "query point invalid", "query radius invalid",
+		"query cursor invalid", "cursor not supported with sort":
 		statusCode = http.StatusBadRequest
 	case "error fetching images for ad", "error fetching user",
 		"error finding user", "error finding city", "error creating place", "error creating date",
@@ -1119,7 +1165,11 @@ func (h *AdHandler) handleError(w http.ResponseWriter, err error, requestID stri
 		"failed to delete session", "error generating random bytes for session ID",
 		"failed to get session id from request cookie", "error fetching rooms for ad",
 		"error counting favorites", "error updating favorites count", "error creating room", "error parsing date",
-		"adAuthor is nil", "ad is nil":
+		"adAuthor is nil", "ad is nil", "error creating booking", "error fetching bookings",
+		"error fetching booking", "error checking booking overlap", "error updating booking status",
+		"booking is nil", "bookings is nil", "error parsing date for booking",
+		"error fetching calendar for ad", "error checking ad availability",
+		"error saving position", "error fetching places in bounds":
 		statusCode = http.StatusInternalServerError
 	default:
 		statusCode = http.StatusInternalServerError
@@ -1136,3 +1186,332 @@ func (h *AdHandler) handleError(w http.ResponseWriter, err error, requestID stri
 
 	return statusCode
 }
+
+func (h *AdHandler) CreateBooking(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	adId := mux.Vars(r)["adId"]
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+
+	statusCode := http.StatusCreated
+	var err error
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		sanitizedPath := metrics.SanitizeAdIdPath(r.URL.Path)
+		if statusCode == http.StatusCreated {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, sanitizedPath, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received CreateBooking request",
+		zap.String("request_id", requestID),
+		zap.String("adId", adId))
+
+	authHeader := r.Header.Get("X-CSRF-Token")
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	var request domain.CreateBookingRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &request); err != nil {
+		logger.AccessLogger.Error("Failed to decode request body",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		err = errors.New("failed to decode booking")
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	response, err := h.client.CreateBooking(ctx, &gen.CreateBookingRequest{
+		AdId:       adId,
+		DateFrom:   timestamppb.New(request.DateFrom),
+		DateTo:     timestamppb.New(request.DateTo),
+		AuthHeader: authHeader,
+		SessionID:  sessionID,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to create booking", zap.String("request_id", requestID), zap.Error(err))
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	body, err := h.utils.ConvertBookingProtoToGo(response)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to Convert From Proto to Go",
+			zap.Error(err),
+			zap.String("request_id", requestID))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusCreated)
+	if _, err = easyjson.MarshalToWriter(body, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed CreateBooking request",
+		zap.String("request_id", requestID),
+		zap.String("adId", adId),
+		zap.Duration("duration", duration),
+	)
+}
+
+func (h *AdHandler) GetAdBookings(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	adId := mux.Vars(r)["adId"]
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+
+	statusCode := http.StatusOK
+	var err error
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		sanitizedPath := metrics.SanitizeAdIdPath(r.URL.Path)
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, sanitizedPath, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received GetAdBookings request",
+		zap.String("request_id", requestID),
+		zap.String("adId", adId))
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	response, err := h.client.GetAdBookings(ctx, &gen.GetAdBookingsRequest{
+		AdId:      adId,
+		SessionID: sessionID,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get ad bookings", zap.String("request_id", requestID), zap.Error(err))
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	body, err := h.utils.ConvertBookingsProtoToGo(response)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to Convert From Proto to Go",
+			zap.Error(err),
+			zap.String("request_id", requestID))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err = easyjson.MarshalToWriter(body, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed GetAdBookings request",
+		zap.String("request_id", requestID),
+		zap.String("adId", adId),
+		zap.Duration("duration", duration),
+	)
+}
+
+func (h *AdHandler) GetUserBookings(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+
+	statusCode := http.StatusOK
+	var err error
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received GetUserBookings request",
+		zap.String("request_id", requestID))
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	response, err := h.client.GetUserBookings(ctx, &gen.GetUserBookingsRequest{
+		SessionID: sessionID,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get user bookings", zap.String("request_id", requestID), zap.Error(err))
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	body, err := h.utils.ConvertBookingsProtoToGo(response)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to Convert From Proto to Go",
+			zap.Error(err),
+			zap.String("request_id", requestID))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err = easyjson.MarshalToWriter(body, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed GetUserBookings request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+	)
+}
+
+func (h *AdHandler) UpdateBookingStatus(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	bookingIdParam := mux.Vars(r)["bookingId"]
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+
+	statusCode := http.StatusOK
+	var err error
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received UpdateBookingStatus request",
+		zap.String("request_id", requestID),
+		zap.String("bookingId", bookingIdParam))
+
+	bookingId, err := strconv.Atoi(bookingIdParam)
+	if err != nil {
+		logger.AccessLogger.Warn("Invalid booking id",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		err = errors.New("invalid booking id")
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	authHeader := r.Header.Get("X-CSRF-Token")
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	var request domain.UpdateBookingStatusRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &request); err != nil {
+		logger.AccessLogger.Error("Failed to decode request body",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		err = errors.New("failed to decode booking")
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	response, err := h.client.UpdateBookingStatus(ctx, &gen.UpdateBookingStatusRequest{
+		BookingId:  int32(bookingId),
+		Status:     request.Status,
+		AuthHeader: authHeader,
+		SessionID:  sessionID,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to update booking status", zap.String("request_id", requestID), zap.Error(err))
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	body, err := h.utils.ConvertBookingProtoToGo(response)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to Convert From Proto to Go",
+			zap.Error(err),
+			zap.String("request_id", requestID))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err = easyjson.MarshalToWriter(body, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed UpdateBookingStatus request",
+		zap.String("request_id", requestID),
+		zap.Int("bookingId", bookingId),
+		zap.Duration("duration", duration),
+	)
+}
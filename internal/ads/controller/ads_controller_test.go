@@ -554,6 +554,53 @@ func TestAdHandler_UpdatePlace_Success(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+func TestAdHandler_UpdatePlace_ReplaceCalendar(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	tests := map[string]struct {
+		metadata        string
+		replaceCalendar bool
+	}{
+		"Calendar Not Sent":     {metadata: `{"cityName": "TestCity"}`, replaceCalendar: false},
+		"Calendar Cleared":      {metadata: `{"cityName": "TestCity", "availableDates": [], "blockedDates": []}`, replaceCalendar: true},
+		"Blocked Dates Cleared": {metadata: `{"cityName": "TestCity", "blockedDates": []}`, replaceCalendar: true},
+		"Single Range":          {metadata: `{"cityName": "TestCity", "dateFrom": "2024-07-01T00:00:00Z", "dateTo": "2024-07-10T00:00:00Z"}`, replaceCalendar: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockClient := new(mocks.MockGrpcClient)
+			handler := &AdHandler{client: mockClient}
+
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			require.NoError(t, writer.WriteField("metadata", tt.metadata))
+			require.NoError(t, writer.Close())
+
+			req := httptest.NewRequest("PUT", "/housing/123", body)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+			req.Header.Set("X-CSRF-Token", "test-token")
+			req.AddCookie(&http.Cookie{Name: "session_id", Value: "test-session-id"})
+			w := httptest.NewRecorder()
+
+			mockClient.On("UpdatePlace", mock.Anything, mock.MatchedBy(func(in *gen.UpdateAdRequest) bool {
+				return in.ReplaceCalendar == tt.replaceCalendar
+			}), mock.Anything).Return(&gen.AdResponse{}, nil)
+
+			handler.UpdatePlace(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
 func TestAdHandler_UpdatePlace_ParseMultipartError(t *testing.T) {
 	require.NoError(t, logger.InitLoggers())
 	defer func() {
@@ -1670,4 +1717,207 @@ func TestAdHandler_UpdatePriorityWithPayment_GrpcError(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, result.StatusCode)
 
 	mockClient.AssertExpectations(t)
-}
\ No newline at end of file
+}
+func TestAdHandler_CreateBooking_Success(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	mockClient := new(mocks.MockGrpcClient)
+	mockUtils := new(utils.MockUtils)
+	handler := &AdHandler{client: mockClient, utils: mockUtils}
+
+	body := `{"dateFrom":"2030-01-10T00:00:00Z","dateTo":"2030-01-15T00:00:00Z"}`
+	req := httptest.NewRequest("POST", "/api/housing/ad-1/bookings", bytes.NewBufferString(body))
+	req = mux.SetURLVars(req, map[string]string{"adId": "ad-1"})
+	req.Header.Set("X-CSRF-Token", "Bearer test-token")
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "test-session-id"})
+	w := httptest.NewRecorder()
+
+	grpcBooking := &gen.Booking{Id: 1, AdId: "ad-1", Status: domain.BookingStatusPending}
+	mockClient.On("CreateBooking", mock.Anything, mock.MatchedBy(func(in *gen.CreateBookingRequest) bool {
+		return in.AdId == "ad-1" && in.SessionID == "test-session-id" && in.DateFrom.AsTime().Day() == 10
+	}), mock.Anything).Return(grpcBooking, nil)
+	mockUtils.On("ConvertBookingProtoToGo", grpcBooking).
+		Return(domain.Booking{ID: 1, AdID: "ad-1", Status: domain.BookingStatusPending}, nil)
+
+	handler.CreateBooking(w, req)
+
+	resp := w.Result()
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			return
+		}
+	}(resp.Body)
+
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.Contains(t, w.Body.String(), "\"status\":\"pending\"")
+	mockClient.AssertExpectations(t)
+	mockUtils.AssertExpectations(t)
+}
+
+func TestAdHandler_CreateBooking_DatesAlreadyBooked(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	mockClient := new(mocks.MockGrpcClient)
+	handler := &AdHandler{client: mockClient}
+
+	body := `{"dateFrom":"2030-01-10T00:00:00Z","dateTo":"2030-01-15T00:00:00Z"}`
+	req := httptest.NewRequest("POST", "/api/housing/ad-1/bookings", bytes.NewBufferString(body))
+	req = mux.SetURLVars(req, map[string]string{"adId": "ad-1"})
+	req.Header.Set("X-CSRF-Token", "Bearer test-token")
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "test-session-id"})
+	w := httptest.NewRecorder()
+
+	mockClient.On("CreateBooking", mock.Anything, mock.Anything, mock.Anything).
+		Return((*gen.Booking)(nil), status.Error(codes.Unknown, "dates already booked"))
+
+	handler.CreateBooking(w, req)
+
+	require.Equal(t, http.StatusConflict, w.Code)
+	require.Contains(t, w.Body.String(), "dates already booked")
+	mockClient.AssertExpectations(t)
+}
+
+func TestAdHandler_GetUserBookings_Success(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	mockClient := new(mocks.MockGrpcClient)
+	mockUtils := new(utils.MockUtils)
+	handler := &AdHandler{client: mockClient, utils: mockUtils}
+
+	req := httptest.NewRequest("GET", "/api/bookings", nil)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "test-session-id"})
+	w := httptest.NewRecorder()
+
+	grpcBookings := &gen.BookingList{Bookings: []*gen.Booking{{Id: 1}, {Id: 2}}}
+	mockClient.On("GetUserBookings", mock.Anything, &gen.GetUserBookingsRequest{SessionID: "test-session-id"}, mock.Anything).
+		Return(grpcBookings, nil)
+	mockUtils.On("ConvertBookingsProtoToGo", grpcBookings).
+		Return(domain.BookingsResponse{Bookings: []domain.Booking{{ID: 1}, {ID: 2}}}, nil)
+
+	handler.GetUserBookings(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), "\"bookings\"")
+	mockClient.AssertExpectations(t)
+	mockUtils.AssertExpectations(t)
+}
+
+func TestAdHandler_UpdateBookingStatus_InvalidId(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	handler := &AdHandler{}
+
+	req := httptest.NewRequest("PUT", "/api/bookings/abc", bytes.NewBufferString(`{"status":"approved"}`))
+	req = mux.SetURLVars(req, map[string]string{"bookingId": "abc"})
+	w := httptest.NewRecorder()
+
+	handler.UpdateBookingStatus(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "invalid booking id")
+}
+
+func TestAdHandler_UpdateBookingStatus_Forbidden(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	mockClient := new(mocks.MockGrpcClient)
+	handler := &AdHandler{client: mockClient}
+
+	req := httptest.NewRequest("PUT", "/api/bookings/7", bytes.NewBufferString(`{"status":"approved"}`))
+	req = mux.SetURLVars(req, map[string]string{"bookingId": "7"})
+	req.Header.Set("X-CSRF-Token", "Bearer test-token")
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "test-session-id"})
+	w := httptest.NewRecorder()
+
+	mockClient.On("UpdateBookingStatus", mock.Anything, mock.MatchedBy(func(in *gen.UpdateBookingStatusRequest) bool {
+		return in.BookingId == 7 && in.Status == "approved"
+	}), mock.Anything).Return((*gen.Booking)(nil), status.Error(codes.Unknown, "only host can approve or decline booking"))
+
+	handler.UpdateBookingStatus(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAdHandler_GetPlacesInBounds_Success(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	mockClient := new(mocks.MockGrpcClient)
+	utilsMock := &utils.MockUtils{}
+	handler := &AdHandler{client: mockClient, utils: utilsMock}
+
+	req := httptest.NewRequest("GET", "/api/housing/map?bbox=37.5,55.7,37.7,55.8", nil)
+	w := httptest.NewRecorder()
+
+	mockClient.On("GetPlacesInBounds", mock.Anything, &gen.GetPlacesInBoundsRequest{Bbox: "37.5,55.7,37.7,55.8"}, mock.Anything).
+		Return(&gen.GetAllAdsResponseList{}, nil)
+	utilsMock.On("ConvertGetAllAdsResponseProtoToGo", mock.Anything).
+		Return([]domain.GetAllAdsListResponse{}, nil)
+
+	handler.GetPlacesInBounds(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	mockClient.AssertExpectations(t)
+	utilsMock.AssertExpectations(t)
+}
+
+func TestAdHandler_GetPlacesInBounds_InvalidBbox(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	mockClient := new(mocks.MockGrpcClient)
+	handler := &AdHandler{client: mockClient}
+
+	req := httptest.NewRequest("GET", "/api/housing/map?bbox=abc", nil)
+	w := httptest.NewRecorder()
+
+	mockClient.On("GetPlacesInBounds", mock.Anything, mock.Anything, mock.Anything).
+		Return(&gen.GetAllAdsResponseList{}, status.Error(codes.InvalidArgument, "invalid bbox"))
+
+	handler.GetPlacesInBounds(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	mockClient.AssertExpectations(t)
+}
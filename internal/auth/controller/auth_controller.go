@@ -8,6 +8,9 @@ import (
 	"2024_2_FIGHT-CLUB/internal/service/session"
 	"2024_2_FIGHT-CLUB/internal/service/utils"
 	"2024_2_FIGHT-CLUB/microservices/auth_service/controller/gen"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"errors"
 	"io"
 	"mime/multipart"
@@ -44,12 +47,7 @@ func (h *AuthHandler) RegisterUser(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 	var err error
 	statusCode := http.StatusCreated
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusCreated {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -77,10 +75,12 @@ func (h *AuthHandler) RegisterUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	response, err := h.client.RegisterUser(ctx, &gen.RegisterUserRequest{
-		Username: creds.Username,
-		Email:    creds.Email,
-		Name:     creds.Name,
-		Password: creds.Password,
+		Username:  creds.Username,
+		Email:     creds.Email,
+		Name:      creds.Name,
+		Password:  creds.Password,
+		UserAgent: r.UserAgent(),
+		Ip:        clientIP,
 	})
 	if err != nil {
 		logger.AccessLogger.Error("Failed to register user",
@@ -150,12 +150,7 @@ func (h *AuthHandler) LoginUser(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -194,8 +189,10 @@ func (h *AuthHandler) LoginUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	response, err := h.client.LoginUser(ctx, &gen.LoginUserRequest{
-		Username: creds.Username,
-		Password: creds.Password,
+		Username:  creds.Username,
+		Password:  creds.Password,
+		UserAgent: r.UserAgent(),
+		Ip:        clientIP,
 	})
 	if err != nil {
 		logger.AccessLogger.Error("Failed to login user",
@@ -209,26 +206,32 @@ func (h *AuthHandler) LoginUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userSession := response.SessionId
-	jwtToken := response.Jwttoken
-
-	http.SetCookie(w, &http.Cookie{
-		Name:     "session_id",
-		Value:    userSession,
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   true,
-		SameSite: http.SameSiteStrictMode,
-	})
+	// пароль верный, но сессия будет выдана только после второго фактора
+	if response.Challenge != "" {
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.WriteHeader(http.StatusOK)
+		challenge := domain.SecondFactorChallenge{
+			SecondFactorRequired: true,
+			Challenge:            response.Challenge,
+		}
+		if _, err = easyjson.MarshalToWriter(challenge, w); err != nil {
+			logger.AccessLogger.Error("Failed to encode response",
+				zap.String("request_id", requestID),
+				zap.Error(err),
+			)
+			statusCode = h.handleError(w, err, requestID)
+			return
+		}
+		logger.AccessLogger.Info("Completed LoginUser request, second factor required",
+			zap.String("request_id", requestID),
+			zap.Duration("duration", time.Since(start)),
+			zap.Int("status", http.StatusOK),
+		)
+		return
+	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "csrf_token",
-		Value:    jwtToken,
-		Path:     "/",
-		HttpOnly: false,
-		Secure:   true,
-		SameSite: http.SameSiteStrictMode,
-	})
+	userSession := response.SessionId
+	setSessionCookies(w, userSession, response.Jwttoken)
 
 	body, err := h.utils.ConvertAuthResponseProtoToGo(response, userSession)
 	if err != nil {
@@ -264,12 +267,7 @@ func (h *AuthHandler) LogoutUser(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -312,25 +310,7 @@ func (h *AuthHandler) LogoutUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "session_id",
-		Value:    "",
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   true,
-		Expires:  time.Unix(0, 0),
-		SameSite: http.SameSiteStrictMode,
-	})
-
-	http.SetCookie(w, &http.Cookie{
-		Name:     "csrf_token",
-		Value:    "",
-		Path:     "/",
-		HttpOnly: false,
-		Secure:   true,
-		Expires:  time.Unix(0, 0),
-		SameSite: http.SameSiteStrictMode,
-	})
+	clearSessionCookies(w)
 
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.WriteHeader(http.StatusOK)
@@ -361,12 +341,7 @@ func (h *AuthHandler) PutUser(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -410,6 +385,13 @@ func (h *AuthHandler) PutUser(w http.ResponseWriter, r *http.Request) {
 		statusCode = h.handleError(w, errors.New("invalid metadata JSON"), requestID)
 		return
 	}
+	// Пароль меняется только через ChangePassword со старым паролем
+	if creds.Password != "" {
+		logger.AccessLogger.Warn("Password in profile update", zap.String("request_id", requestID))
+		err = errors.New("password change requires old password")
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
 	var fileBytes []byte
 	var avatar *multipart.FileHeader
 	if len(r.MultipartForm.File["avatar"]) > 0 {
@@ -438,7 +420,6 @@ func (h *AuthHandler) PutUser(w http.ResponseWriter, r *http.Request) {
 		Creds: &gen.Metadata{
 			Uuid:       creds.UUID,
 			Username:   creds.Username,
-			Password:   creds.Password,
 			Email:      creds.Email,
 			Name:       creds.Name,
 			Score:      float32(creds.Score),
@@ -463,6 +444,10 @@ func (h *AuthHandler) PutUser(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	// смена пароля завершила все сессии пользователя
+	if creds.Password != "" {
+		clearSessionCookies(w)
+	}
 
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.WriteHeader(http.StatusOK)
@@ -494,12 +479,7 @@ func (h *AuthHandler) GetUserById(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		sanitizedPath := metrics.SanitizeUserIdPath(r.URL.Path)
 		if statusCode == http.StatusOK {
@@ -565,12 +545,7 @@ func (h *AuthHandler) GetAllUsers(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -587,7 +562,18 @@ func (h *AuthHandler) GetAllUsers(w http.ResponseWriter, r *http.Request) {
 		zap.String("url", r.URL.String()),
 	)
 
-	users, err := h.client.GetAllUsers(ctx, &gen.Empty{})
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	users, err := h.client.GetAllUsers(ctx, &gen.GetAllUsersRequest{
+		SessionId: sessionID,
+	})
 	if err != nil {
 		logger.AccessLogger.Error("Failed to get all users data",
 			zap.String("request_id", requestID),
@@ -637,12 +623,7 @@ func (h *AuthHandler) GetSessionData(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -717,12 +698,7 @@ func (h *AuthHandler) RefreshCsrfToken(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -800,12 +776,7 @@ func (h *AuthHandler) UpdateUserRegion(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -910,12 +881,7 @@ func (h *AuthHandler) DeleteUserRegion(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -993,55 +959,1282 @@ func (h *AuthHandler) DeleteUserRegion(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
-func (h *AuthHandler) handleError(w http.ResponseWriter, err error, requestID string) int {
-	logger.AccessLogger.Error("Handling error",
+func (h *AuthHandler) GetSessions(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received GetSessions request",
 		zap.String("request_id", requestID),
-		zap.Error(err),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
 	)
 
-	w.Header().Set("Content-Type", "application/json")
-	errorResponse := domain.ErrorResponse{
-		Error: err.Error(),
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
 	}
-	var statusCode int
-	switch err.Error() {
-	case "username, password, and email are required",
-		"username and password are required",
-		"invalid credentials",
-		"csrf_token already exists",
-		"input contains invalid characters",
-		"input exceeds character limit",
-		"invalid size, type or resolution of image",
-		"invalid metadata JSON",
-		"missing X-CSRF-Token header",
-		"invalid JWT token",
-		"invalid type for id in session data",
-		"invalid type for avatar in session data",
-		"token invalid",
-		"token expired",
-		"file type is not allowed, please use (png, jpg, jpeg) types",
-		"unsupported image format",
-		"image resolution exceeds maximum allowed size of 2000 x 2000":
-		statusCode = http.StatusBadRequest
 
-	case "user already exists",
-		"email already exists",
-		"session already exists",
-		"already logged in",
-		"username or email already exists":
-		statusCode = http.StatusConflict
+	sessions, err := h.client.GetSessions(ctx, &gen.GetSessionsRequest{
+		SessionId: sessionID,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get sessions",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
 
-	case "no active session",
-		"session not found",
-		"user ID not found in session",
-		"failed to get session id from request cookie":
-		statusCode = http.StatusUnauthorized
+	response, err := h.utils.ConvertSessionsProtoToGo(sessions)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to convert sessions",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err = easyjson.MarshalToWriter(response, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode GetSessions response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed GetSessions request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+// RevokeSession завершает сессию с id из пути, без id - все сессии, кроме текущей
+func (h *AuthHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	publicID, revokeOne := mux.Vars(r)["sessionId"]
+	defer func() {
+		sanitizedPath := metrics.SanitizeSessionIdPath(r.URL.Path)
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, sanitizedPath, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received RevokeSession request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+	authHeader := r.Header.Get("X-CSRF-Token")
+
+	if revokeOne {
+		_, err = h.client.RevokeSession(ctx, &gen.RevokeSessionRequest{
+			SessionId:  sessionID,
+			AuthHeader: authHeader,
+			Id:         publicID,
+		})
+	} else {
+		_, err = h.client.RevokeOtherSessions(ctx, &gen.RevokeOtherSessionsRequest{
+			SessionId:  sessionID,
+			AuthHeader: authHeader,
+		})
+	}
+	if err != nil {
+		logger.AccessLogger.Error("Failed to revoke session",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+	if revokeOne && publicID == session.PublicSessionID(sessionID) {
+		clearSessionCookies(w)
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	revokeResponse := domain.ResponseMessage{
+		Message: "Successfully revoked",
+	}
+	if _, err = easyjson.MarshalToWriter(revokeResponse, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed RevokeSession request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received ChangePassword request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	var req domain.ChangePasswordRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.AccessLogger.Error("Failed to decode request body",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	_, err = h.client.ChangePassword(ctx, &gen.ChangePasswordRequest{
+		SessionId:   sessionID,
+		AuthHeader:  r.Header.Get("X-CSRF-Token"),
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to change password",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+	// смена пароля завершила все сессии пользователя
+	clearSessionCookies(w)
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	response := domain.ResponseMessage{
+		Message: "Password changed",
+	}
+	if _, err = easyjson.MarshalToWriter(response, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed ChangePassword request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+func (h *AuthHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received ForgotPassword request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	var req domain.ForgotPasswordRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.AccessLogger.Error("Failed to decode request body",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	_, err = h.client.ForgotPassword(ctx, &gen.ForgotPasswordRequest{
+		Email: req.Email,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to request password reset",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	response := domain.ResponseMessage{
+		Message: "If the email is registered, a reset link has been sent",
+	}
+	if _, err = easyjson.MarshalToWriter(response, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed ForgotPassword request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+func (h *AuthHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received ResetPassword request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	var req domain.ResetPasswordRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.AccessLogger.Error("Failed to decode request body",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	_, err = h.client.ResetPassword(ctx, &gen.ResetPasswordRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to reset password",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+	// смена пароля завершила все сессии пользователя
+	clearSessionCookies(w)
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	response := domain.ResponseMessage{
+		Message: "Password reset",
+	}
+	if _, err = easyjson.MarshalToWriter(response, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed ResetPassword request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+func (h *AuthHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received VerifyEmail request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	var req domain.VerifyEmailRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.AccessLogger.Error("Failed to decode request body",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	_, err = h.client.VerifyEmail(ctx, &gen.VerifyEmailRequest{
+		Token: req.Token,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to verify email",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	response := domain.ResponseMessage{
+		Message: "Email verified",
+	}
+	if _, err = easyjson.MarshalToWriter(response, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed VerifyEmail request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+func (h *AuthHandler) ResendVerificationEmail(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received ResendVerificationEmail request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	_, err = h.client.ResendVerificationEmail(ctx, &gen.ResendVerificationEmailRequest{
+		SessionId:  sessionID,
+		AuthHeader: r.Header.Get("X-CSRF-Token"),
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to resend verification email",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	response := domain.ResponseMessage{
+		Message: "Verification email sent",
+	}
+	if _, err = easyjson.MarshalToWriter(response, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed ResendVerificationEmail request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+func (h *AuthHandler) VerifySecondFactor(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received VerifySecondFactor request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	var req domain.SecondFactorRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.AccessLogger.Error("Failed to decode request body",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	response, err := h.client.VerifySecondFactor(ctx, &gen.VerifySecondFactorRequest{
+		Challenge: req.Challenge,
+		Code:      req.Code,
+		UserAgent: r.UserAgent(),
+		Ip:        clientIP,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to verify second factor",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	userSession := response.SessionId
+	setSessionCookies(w, userSession, response.Jwttoken)
+
+	body, err := h.utils.ConvertAuthResponseProtoToGo(response, userSession)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to convert auth response",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err = easyjson.MarshalToWriter(body, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed VerifySecondFactor request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+func (h *AuthHandler) SetupTOTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received SetupTOTP request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	response, err := h.client.SetupTOTP(ctx, &gen.SetupTOTPRequest{
+		SessionId:  sessionID,
+		AuthHeader: r.Header.Get("X-CSRF-Token"),
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to set up TOTP",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	body := domain.TOTPSetupResponse{
+		Secret: response.Secret,
+		URI:    response.Uri,
+	}
+	if _, err = easyjson.MarshalToWriter(body, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed SetupTOTP request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+func (h *AuthHandler) EnableTOTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received EnableTOTP request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	var req domain.TOTPCodeRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.AccessLogger.Error("Failed to decode request body",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	response, err := h.client.EnableTOTP(ctx, &gen.TOTPCodeRequest{
+		SessionId:  sessionID,
+		AuthHeader: r.Header.Get("X-CSRF-Token"),
+		Code:       req.Code,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to enable TOTP",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	body := domain.RecoveryCodesResponse{
+		RecoveryCodes: response.RecoveryCodes,
+	}
+	if _, err = easyjson.MarshalToWriter(body, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed EnableTOTP request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+func (h *AuthHandler) DisableTOTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received DisableTOTP request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	var req domain.TOTPCodeRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.AccessLogger.Error("Failed to decode request body",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	_, err = h.client.DisableTOTP(ctx, &gen.TOTPCodeRequest{
+		SessionId:  sessionID,
+		AuthHeader: r.Header.Get("X-CSRF-Token"),
+		Code:       req.Code,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to disable TOTP",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	response := domain.ResponseMessage{
+		Message: "Two-factor authentication disabled",
+	}
+	if _, err = easyjson.MarshalToWriter(response, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed DisableTOTP request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+func (h *AuthHandler) UnlockUser(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		sanitizedPath := metrics.SanitizeUserIdPath(r.URL.Path)
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, sanitizedPath, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received UnlockUser request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	userID := mux.Vars(r)["userId"]
+	_, err = h.client.UnlockUser(ctx, &gen.UnlockUserRequest{
+		SessionId:  sessionID,
+		AuthHeader: r.Header.Get("X-CSRF-Token"),
+		UserId:     userID,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to unlock user",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	response := domain.ResponseMessage{
+		Message: "User unlocked",
+	}
+	if _, err = easyjson.MarshalToWriter(response, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed UnlockUser request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+func (h *AuthHandler) StartOIDCLogin(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received StartOIDCLogin request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	response, err := h.client.StartOIDCLogin(ctx, &gen.StartOIDCLoginRequest{})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to start oidc login",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	setOIDCStateCookie(w, response.State)
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	body := domain.OIDCLoginResponse{
+		AuthURL: response.AuthUrl,
+	}
+	if _, err = easyjson.MarshalToWriter(body, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed StartOIDCLogin request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+func (h *AuthHandler) FinishOIDCLogin(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received FinishOIDCLogin request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	var req domain.OIDCCallbackRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.AccessLogger.Error("Failed to decode request body",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	// state одноразовый, cookie больше не нужна при любом исходе
+	stateCookie, _ := r.Cookie(oidcStateCookie)
+	clearOIDCStateCookie(w)
+	if stateCookie == nil || subtle.ConstantTimeCompare([]byte(stateCookie.Value), []byte(hashOIDCState(req.State))) != 1 {
+		logger.AccessLogger.Warn("OIDC state does not match browser",
+			zap.String("request_id", requestID),
+		)
+		err = errors.New("invalid or expired login state")
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	csrfToken, _ := r.Cookie("csrf_token")
+	if csrfToken != nil {
+		logger.AccessLogger.Error("csrf_token already exists",
+			zap.String("request_id", requestID),
+			zap.Error(errors.New("csrf_token already exists")),
+		)
+		err = errors.New("csrf_token already exists")
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	response, err := h.client.FinishOIDCLogin(ctx, &gen.FinishOIDCLoginRequest{
+		Code:      req.Code,
+		State:     req.State,
+		UserAgent: r.UserAgent(),
+		Ip:        clientIP,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to finish oidc login",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		st, ok := status.FromError(err)
+		if ok {
+			statusCode = h.handleError(w, errors.New(st.Message()), requestID)
+		}
+		return
+	}
+
+	// внешний аккаунт привязан к пользователю со вторым фактором
+	if response.Challenge != "" {
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.WriteHeader(http.StatusOK)
+		challenge := domain.SecondFactorChallenge{
+			SecondFactorRequired: true,
+			Challenge:            response.Challenge,
+		}
+		if _, err = easyjson.MarshalToWriter(challenge, w); err != nil {
+			logger.AccessLogger.Error("Failed to encode response",
+				zap.String("request_id", requestID),
+				zap.Error(err),
+			)
+			statusCode = h.handleError(w, err, requestID)
+			return
+		}
+		logger.AccessLogger.Info("Completed FinishOIDCLogin request, second factor required",
+			zap.String("request_id", requestID),
+			zap.Duration("duration", time.Since(start)),
+			zap.Int("status", http.StatusOK),
+		)
+		return
+	}
+
+	userSession := response.SessionId
+	setSessionCookies(w, userSession, response.Jwttoken)
+
+	body, err := h.utils.ConvertAuthResponseProtoToGo(response, userSession)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to convert auth response",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err = easyjson.MarshalToWriter(body, w); err != nil {
+		logger.AccessLogger.Error("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed FinishOIDCLogin request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+const (
+	oidcStateCookie = "oidc_state"
+	oidcStateTTL    = 10 * time.Minute
+)
+
+// hashOIDCState - в cookie хранится хеш state, а не сам state
+func hashOIDCState(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return hex.EncodeToString(sum[:])
+}
+
+// setOIDCStateCookie привязывает state к браузеру, начавшему вход, чтобы чужой code нельзя было подсунуть жертве.
+// Lax, потому что страница возврата открывается переходом с сайта провайдера
+func setOIDCStateCookie(w http.ResponseWriter, state string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    hashOIDCState(state),
+		Path:     "/api/auth/oidc",
+		MaxAge:   int(oidcStateTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func clearOIDCStateCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    "",
+		Path:     "/api/auth/oidc",
+		HttpOnly: true,
+		Secure:   true,
+		Expires:  time.Unix(0, 0),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func setSessionCookies(w http.ResponseWriter, sessionID string, csrfToken string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     "session_id",
+		Value:    sessionID,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "csrf_token",
+		Value:    csrfToken,
+		Path:     "/",
+		HttpOnly: false,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+func clearSessionCookies(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     "session_id",
+		Value:    "",
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true,
+		Expires:  time.Unix(0, 0),
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "csrf_token",
+		Value:    "",
+		Path:     "/",
+		HttpOnly: false,
+		Secure:   true,
+		Expires:  time.Unix(0, 0),
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+func (h *AuthHandler) handleError(w http.ResponseWriter, err error, requestID string) int {
+	logger.AccessLogger.Error("Handling error",
+		zap.String("request_id", requestID),
+		zap.Error(err),
+	)
+
+	w.Header().Set("Content-Type", "application/json")
+	errorResponse := domain.ErrorResponse{
+		Error: err.Error(),
+	}
+	var statusCode int
+	switch err.Error() {
+	case "username, password, and email are required",
+		"username and password are required",
+		"invalid credentials",
+		"csrf_token already exists",
+		"input contains invalid characters",
+		"input exceeds character limit",
+		"invalid size, type or resolution of image",
+		"invalid metadata JSON",
+		"password change requires old password",
+		"missing X-CSRF-Token header",
+		"invalid JWT token",
+		"invalid type for id in session data",
+		"invalid type for avatar in session data",
+		"token invalid",
+		"token expired",
+		"file type is not allowed, please use (png, jpg, jpeg) types",
+		"unsupported image format",
+		"image resolution exceeds maximum allowed size of 2000 x 2000",
+		"old and new passwords are required",
+		"token and new password are required",
+		"invalid new password",
+		"invalid email",
+		"invalid or expired token",
+		"challenge and code are required",
+		"two-factor setup not started",
+		"invalid code",
+		"code and state are required",
+		"invalid or expired login state":
+		statusCode = http.StatusBadRequest
+
+	case "user already exists",
+		"email already exists",
+		"session already exists",
+		"already logged in",
+		"username or email already exists",
+		"email already verified",
+		"two-factor authentication already enabled",
+		"two-factor authentication not enabled",
+		"email already registered",
+		"identity already linked":
+		statusCode = http.StatusConflict
+
+	case "no active session",
+		"session not found",
+		"user ID not found in session",
+		"failed to get session id from request cookie",
+		"failed to exchange authorization code",
+		"invalid id token":
+		statusCode = http.StatusUnauthorized
+
+	case "user is banned",
+		"access denied",
+		"wrong old password",
+		"email is not verified by provider":
+		statusCode = http.StatusForbidden
+
+	case "too many login attempts":
+		statusCode = http.StatusTooManyRequests
+
+	case "oidc login is not configured":
+		statusCode = http.StatusNotImplemented
+
+	case "failed to load oidc provider configuration":
+		statusCode = http.StatusBadGateway
 
 	case "user not found",
 		"error fetching user by ID",
 		"error fetching user by name",
 		"error fetching user by email",
-		"there is none user in db":
+		"there is none user in db",
+		"active session not found":
 		statusCode = http.StatusNotFound
 
 	case "error creating user",
@@ -1055,13 +2248,29 @@ func (h *AuthHandler) handleError(w http.ResponseWriter, err error, requestID st
 		"failed to generate session id",
 		"failed to save session",
 		"failed to delete session",
+		"failed to get user sessions",
 		"failed to get user ID",
 		"failed to get session data",
 		"failed to refresh csrf token",
 		"error generating random bytes for session ID",
 		"token parse error",
 		"bad sign method",
-		"could not decode image":
+		"could not decode image",
+		"failed to generate token",
+		"failed to save token",
+		"failed to get token",
+		"failed to send email",
+		"failed to generate secret",
+		"failed to generate recovery codes",
+		"error saving recovery codes",
+		"failed to check login attempts",
+		"failed to reset login attempts",
+		"failed to start oidc login",
+		"failed to save login state",
+		"failed to get login state",
+		"error fetching user by identity",
+		"error linking identity",
+		"failed to create user":
 		statusCode = http.StatusInternalServerError
 
 	default:
@@ -3,6 +3,7 @@ package controller
 import (
 	"2024_2_FIGHT-CLUB/domain"
 	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/session"
 	"2024_2_FIGHT-CLUB/internal/service/utils"
 	"2024_2_FIGHT-CLUB/microservices/auth_service/controller/gen"
 	"2024_2_FIGHT-CLUB/microservices/auth_service/mocks"
@@ -637,7 +638,6 @@ func TestAuthHandler_PutUser_Success(t *testing.T) {
 	_ = writer.WriteField("metadata", `{
 		"UUID": "123",
 		"Username": "test_user",
-		"Password": "password123",
 		"Email": "test@example.com",
 		"Name": "Test User",
 		"Score": 100,
@@ -778,6 +778,34 @@ func TestAuthHandler_PutUser_MetadataParseError(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, result.StatusCode)
 }
 
+func TestAuthHandler_PutUser_PasswordRejected(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() { _ = logger.SyncLoggers() }()
+
+	mockGrpcClient := new(mocks.MockGrpcClient)
+	authHandler := AuthHandler{
+		client:         mockGrpcClient,
+		sessionService: new(mocks.MockServiceSession),
+	}
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	require.NoError(t, writer.WriteField("metadata", `{"username": "test_user", "password": "NewPassword123"}`))
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPut, "/user", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("X-CSRF-Token", "csrf_token")
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "test_session_id"})
+	w := httptest.NewRecorder()
+
+	authHandler.PutUser(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "password change requires old password")
+	mockGrpcClient.AssertNotCalled(t, "PutUser", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestAuthHandler_PutUser_GrpcError(t *testing.T) {
 	require.NoError(t, logger.InitLoggers())
 	defer func() { _ = logger.SyncLoggers() }()
@@ -1054,7 +1082,7 @@ func TestAuthHandler_GetAllUsers_Success(t *testing.T) {
 		},
 	}
 
-	mockGrpcClient.On("GetAllUsers", mock.Anything, mock.Anything, mock.Anything).Return(mockUsers, nil)
+	mockGrpcClient.On("GetAllUsers", mock.Anything, &gen.GetAllUsersRequest{SessionId: "test_session"}, mock.Anything).Return(mockUsers, nil)
 	utilsMock.On("ConvertUsersProtoToGo", mockUsers).Return(convertedUsers, nil)
 
 	authHandler := AuthHandler{
@@ -1063,6 +1091,7 @@ func TestAuthHandler_GetAllUsers_Success(t *testing.T) {
 	}
 
 	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "test_session"})
 	req.Header.Set("X-Real-IP", "127.0.0.1")
 	w := httptest.NewRecorder()
 
@@ -1110,6 +1139,7 @@ func TestAuthHandler_GetAllUsers_GrpcError(t *testing.T) {
 	}
 
 	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "test_session"})
 	w := httptest.NewRecorder()
 
 	// Вызов метода
@@ -1178,6 +1208,7 @@ func TestAuthHandler_GetAllUsers_ConversionError(t *testing.T) {
 	}
 
 	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "test_session"})
 	w := httptest.NewRecorder()
 
 	// Вызов метода
@@ -1197,6 +1228,45 @@ func TestAuthHandler_GetAllUsers_ConversionError(t *testing.T) {
 	utilsMock.AssertExpectations(t)
 }
 
+func TestAuthHandler_GetAllUsers_AccessDenied(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	mockGrpcClient := new(mocks.MockGrpcClient)
+	grpcErr := status.Error(codes.PermissionDenied, "access denied")
+	mockGrpcClient.On("GetAllUsers", mock.Anything, &gen.GetAllUsersRequest{SessionId: "test_session"}, mock.Anything).Return(&gen.AllUsersResponse{}, grpcErr)
+
+	authHandler := AuthHandler{
+		client: mockGrpcClient,
+	}
+
+	t.Run("Not Admin", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/users", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "test_session"})
+		w := httptest.NewRecorder()
+
+		authHandler.GetAllUsers(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("No Session", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/users", nil)
+		w := httptest.NewRecorder()
+
+		authHandler.GetAllUsers(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	mockGrpcClient.AssertExpectations(t)
+}
+
 func TestAuthHandler_GetSessionData_Success(t *testing.T) {
 	require.NoError(t, logger.InitLoggers())
 	defer func() {
@@ -1525,4 +1595,628 @@ func TestAuthHandler_RefreshCsrfToken_GrpcError(t *testing.T) {
 
 	assert.Equal(t, http.StatusInternalServerError, result.StatusCode)
 	mockGrpcClient.AssertExpectations(t)
-}
\ No newline at end of file
+}
+func TestAuthHandler_GetSessions_Success(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	mockGrpcClient := new(mocks.MockGrpcClient)
+	utilsMock := &utils.MockUtils{}
+
+	mockResponse := &gen.SessionsResponse{
+		Sessions: []*gen.ActiveSession{{Id: "abc", Device: "Firefox", Current: true}},
+	}
+	convertedResponse := domain.ActiveSessionsList{
+		Sessions: []domain.ActiveSession{{ID: "abc", Device: "Firefox", Current: true}},
+	}
+
+	mockGrpcClient.On("GetSessions", mock.Anything, &gen.GetSessionsRequest{SessionId: "test_session"}, mock.Anything).Return(mockResponse, nil)
+	utilsMock.On("ConvertSessionsProtoToGo", mockResponse).Return(convertedResponse, nil)
+
+	authHandler := AuthHandler{
+		client: mockGrpcClient,
+		utils:  utilsMock,
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/sessions", nil)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "test_session"})
+	w := httptest.NewRecorder()
+
+	authHandler.GetSessions(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response domain.ActiveSessionsList
+	require.NoError(t, easyjson.UnmarshalFromReader(w.Body, &response))
+	assert.Equal(t, "abc", response.Sessions[0].ID)
+	assert.True(t, response.Sessions[0].Current)
+
+	mockGrpcClient.AssertExpectations(t)
+	utilsMock.AssertExpectations(t)
+}
+
+func TestAuthHandler_RevokeSession(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	currentID := session.PublicSessionID("test_session")
+
+	t.Run("Other Session", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("RevokeSession", mock.Anything, &gen.RevokeSessionRequest{SessionId: "test_session", AuthHeader: "Bearer token", Id: "abc"}, mock.Anything).Return(&gen.UpdateResponse{}, nil)
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/sessions/abc", nil)
+		req = mux.SetURLVars(req, map[string]string{"sessionId": "abc"})
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "test_session"})
+		req.Header.Set("X-CSRF-Token", "Bearer token")
+		w := httptest.NewRecorder()
+
+		authHandler.RevokeSession(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Result().Cookies())
+		mockGrpcClient.AssertExpectations(t)
+	})
+
+	t.Run("Current Session Clears Cookies", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("RevokeSession", mock.Anything, mock.Anything, mock.Anything).Return(&gen.UpdateResponse{}, nil)
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/sessions/"+currentID, nil)
+		req = mux.SetURLVars(req, map[string]string{"sessionId": currentID})
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "test_session"})
+		w := httptest.NewRecorder()
+
+		authHandler.RevokeSession(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, w.Result().Cookies(), 2)
+	})
+
+	t.Run("All Other Sessions", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("RevokeOtherSessions", mock.Anything, &gen.RevokeOtherSessionsRequest{SessionId: "test_session", AuthHeader: "Bearer token"}, mock.Anything).Return(&gen.UpdateResponse{}, nil)
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/sessions", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "test_session"})
+		req.Header.Set("X-CSRF-Token", "Bearer token")
+		w := httptest.NewRecorder()
+
+		authHandler.RevokeSession(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockGrpcClient.AssertExpectations(t)
+	})
+
+	t.Run("Unknown Session", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("RevokeSession", mock.Anything, mock.Anything, mock.Anything).Return(&gen.UpdateResponse{}, status.Error(codes.NotFound, "active session not found"))
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/sessions/abc", nil)
+		req = mux.SetURLVars(req, map[string]string{"sessionId": "abc"})
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "test_session"})
+		w := httptest.NewRecorder()
+
+		authHandler.RevokeSession(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestAuthHandler_ChangePassword(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	t.Run("Success Clears Cookies", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("ChangePassword", mock.Anything, &gen.ChangePasswordRequest{
+			SessionId:   "test_session",
+			AuthHeader:  "Bearer token",
+			OldPassword: "oldPassword1",
+			NewPassword: "newPassword1",
+		}, mock.Anything).Return(&gen.UpdateResponse{}, nil)
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		body := bytes.NewBufferString(`{"oldPassword":"oldPassword1","newPassword":"newPassword1"}`)
+		req := httptest.NewRequest(http.MethodPut, "/api/auth/password", body)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "test_session"})
+		req.Header.Set("X-CSRF-Token", "Bearer token")
+		w := httptest.NewRecorder()
+
+		authHandler.ChangePassword(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, w.Result().Cookies(), 2)
+		mockGrpcClient.AssertExpectations(t)
+	})
+
+	t.Run("Wrong Old Password", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("ChangePassword", mock.Anything, mock.Anything, mock.Anything).Return(&gen.UpdateResponse{}, status.Error(codes.PermissionDenied, "wrong old password"))
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		body := bytes.NewBufferString(`{"oldPassword":"nope","newPassword":"newPassword1"}`)
+		req := httptest.NewRequest(http.MethodPut, "/api/auth/password", body)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "test_session"})
+		w := httptest.NewRecorder()
+
+		authHandler.ChangePassword(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Empty(t, w.Result().Cookies())
+	})
+}
+
+func TestAuthHandler_ForgotPassword(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	mockGrpcClient := new(mocks.MockGrpcClient)
+	mockGrpcClient.On("ForgotPassword", mock.Anything, &gen.ForgotPasswordRequest{Email: "test@example.com"}, mock.Anything).Return(&gen.UpdateResponse{}, nil)
+	authHandler := AuthHandler{client: mockGrpcClient}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/password/forgot", bytes.NewBufferString(`{"email":"test@example.com"}`))
+	w := httptest.NewRecorder()
+
+	authHandler.ForgotPassword(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockGrpcClient.AssertExpectations(t)
+}
+
+func TestAuthHandler_ResetPassword(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	t.Run("Success", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("ResetPassword", mock.Anything, &gen.ResetPasswordRequest{Token: "reset-token", NewPassword: "newPassword1"}, mock.Anything).Return(&gen.UpdateResponse{}, nil)
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/password/reset", bytes.NewBufferString(`{"token":"reset-token","newPassword":"newPassword1"}`))
+		w := httptest.NewRecorder()
+
+		authHandler.ResetPassword(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockGrpcClient.AssertExpectations(t)
+	})
+
+	t.Run("Expired Token", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("ResetPassword", mock.Anything, mock.Anything, mock.Anything).Return(&gen.UpdateResponse{}, status.Error(codes.InvalidArgument, "invalid or expired token"))
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/password/reset", bytes.NewBufferString(`{"token":"old","newPassword":"newPassword1"}`))
+		w := httptest.NewRecorder()
+
+		authHandler.ResetPassword(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAuthHandler_VerifyEmail(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	t.Run("Success", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("VerifyEmail", mock.Anything, &gen.VerifyEmailRequest{Token: "email-token"}, mock.Anything).Return(&gen.UpdateResponse{}, nil)
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/email/verify", bytes.NewBufferString(`{"token":"email-token"}`))
+		w := httptest.NewRecorder()
+
+		authHandler.VerifyEmail(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Result().Cookies())
+		mockGrpcClient.AssertExpectations(t)
+	})
+
+	t.Run("Invalid Token", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("VerifyEmail", mock.Anything, mock.Anything, mock.Anything).Return(&gen.UpdateResponse{}, status.Error(codes.InvalidArgument, "invalid or expired token"))
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/email/verify", bytes.NewBufferString(`{"token":"bad"}`))
+		w := httptest.NewRecorder()
+
+		authHandler.VerifyEmail(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAuthHandler_ResendVerificationEmail(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	mockGrpcClient := new(mocks.MockGrpcClient)
+	mockGrpcClient.On("ResendVerificationEmail", mock.Anything, &gen.ResendVerificationEmailRequest{SessionId: "test_session", AuthHeader: "Bearer token"}, mock.Anything).
+		Return(&gen.UpdateResponse{}, status.Error(codes.FailedPrecondition, "email already verified"))
+	authHandler := AuthHandler{client: mockGrpcClient}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/email/resend", nil)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "test_session"})
+	req.Header.Set("X-CSRF-Token", "Bearer token")
+	w := httptest.NewRecorder()
+
+	authHandler.ResendVerificationEmail(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	mockGrpcClient.AssertExpectations(t)
+}
+
+func TestAuthHandler_LoginUser_SecondFactorRequired(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	mockGrpcClient := new(mocks.MockGrpcClient)
+	mockGrpcClient.On("LoginUser", mock.Anything, mock.Anything, mock.Anything).Return(&gen.UserResponse{Challenge: "challenge123"}, nil)
+	authHandler := AuthHandler{client: mockGrpcClient}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/login", bytes.NewBufferString(`{"username":"test_user","password":"password123"}`))
+	w := httptest.NewRecorder()
+
+	authHandler.LoginUser(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Result().Cookies())
+	var body domain.SecondFactorChallenge
+	require.NoError(t, easyjson.Unmarshal(w.Body.Bytes(), &body))
+	assert.True(t, body.SecondFactorRequired)
+	assert.Equal(t, "challenge123", body.Challenge)
+	mockGrpcClient.AssertExpectations(t)
+}
+
+func TestAuthHandler_VerifySecondFactor(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	t.Run("Success", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		utilsMock := &utils.MockUtils{}
+		mockResponse := &gen.UserResponse{
+			SessionId: "session123",
+			Jwttoken:  "token123",
+			User:      &gen.User{Id: "test_user_id"},
+		}
+		mockGrpcClient.On("VerifySecondFactor", mock.Anything, &gen.VerifySecondFactorRequest{
+			Challenge: "challenge123",
+			Code:      "123456",
+			UserAgent: "test-agent",
+			Ip:        "127.0.0.1",
+		}, mock.Anything).Return(mockResponse, nil)
+		utilsMock.On("ConvertAuthResponseProtoToGo", mockResponse, "session123").Return(domain.AuthResponse{SessionId: "session123"}, nil)
+		authHandler := AuthHandler{client: mockGrpcClient, utils: utilsMock}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/login/2fa", bytes.NewBufferString(`{"challenge":"challenge123","code":"123456"}`))
+		req.Header.Set("User-Agent", "test-agent")
+		req.RemoteAddr = "127.0.0.1:54321"
+		w := httptest.NewRecorder()
+
+		authHandler.VerifySecondFactor(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		cookies := map[string]string{}
+		for _, cookie := range w.Result().Cookies() {
+			cookies[cookie.Name] = cookie.Value
+		}
+		assert.Equal(t, "session123", cookies["session_id"])
+		assert.Equal(t, "token123", cookies["csrf_token"])
+		mockGrpcClient.AssertExpectations(t)
+		utilsMock.AssertExpectations(t)
+	})
+
+	t.Run("Invalid Code", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("VerifySecondFactor", mock.Anything, mock.Anything, mock.Anything).Return(&gen.UserResponse{}, status.Error(codes.Unauthenticated, "invalid code"))
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/login/2fa", bytes.NewBufferString(`{"challenge":"challenge123","code":"000000"}`))
+		w := httptest.NewRecorder()
+
+		authHandler.VerifySecondFactor(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Empty(t, w.Result().Cookies())
+	})
+}
+
+func TestAuthHandler_EnableTOTP(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	mockGrpcClient := new(mocks.MockGrpcClient)
+	mockGrpcClient.On("EnableTOTP", mock.Anything, &gen.TOTPCodeRequest{SessionId: "test_session", AuthHeader: "Bearer token", Code: "123456"}, mock.Anything).
+		Return(&gen.RecoveryCodesResponse{RecoveryCodes: []string{"abcde-fghij"}}, nil)
+	authHandler := AuthHandler{client: mockGrpcClient}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/2fa/enable", bytes.NewBufferString(`{"code":"123456"}`))
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "test_session"})
+	req.Header.Set("X-CSRF-Token", "Bearer token")
+	w := httptest.NewRecorder()
+
+	authHandler.EnableTOTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var body domain.RecoveryCodesResponse
+	require.NoError(t, easyjson.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, []string{"abcde-fghij"}, body.RecoveryCodes)
+	mockGrpcClient.AssertExpectations(t)
+}
+
+func TestAuthHandler_LoginUser_TooManyAttempts(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	mockGrpcClient := new(mocks.MockGrpcClient)
+	mockGrpcClient.On("LoginUser", mock.Anything, &gen.LoginUserRequest{
+		Username: "test_user",
+		Password: "password123",
+		Ip:       "10.0.0.1",
+	}, mock.Anything).Return(&gen.UserResponse{}, status.Error(codes.ResourceExhausted, "too many login attempts"))
+	authHandler := AuthHandler{client: mockGrpcClient}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/login", bytes.NewBufferString(`{"username":"test_user","password":"password123"}`))
+	req.RemoteAddr = "10.0.0.1:54321"
+	w := httptest.NewRecorder()
+
+	authHandler.LoginUser(w, req)
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Empty(t, w.Result().Cookies())
+	mockGrpcClient.AssertExpectations(t)
+}
+
+func TestAuthHandler_UnlockUser(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	mockGrpcClient := new(mocks.MockGrpcClient)
+	mockGrpcClient.On("UnlockUser", mock.Anything, &gen.UnlockUserRequest{SessionId: "admin_session", AuthHeader: "Bearer token", UserId: "user1"}, mock.Anything).
+		Return(&gen.UpdateResponse{}, nil)
+	authHandler := AuthHandler{client: mockGrpcClient}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/admin/users/user1/unlock", nil)
+	req = mux.SetURLVars(req, map[string]string{"userId": "user1"})
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+	req.Header.Set("X-CSRF-Token", "Bearer token")
+	w := httptest.NewRecorder()
+
+	authHandler.UnlockUser(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockGrpcClient.AssertExpectations(t)
+}
+
+func TestAuthHandler_StartOIDCLogin(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	t.Run("Success", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("StartOIDCLogin", mock.Anything, &gen.StartOIDCLoginRequest{}, mock.Anything).
+			Return(&gen.StartOIDCLoginResponse{AuthUrl: "https://issuer/authorize?state=abc", State: "abc"}, nil)
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/auth/oidc/login", nil)
+		w := httptest.NewRecorder()
+
+		authHandler.StartOIDCLogin(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"authUrl":"https://issuer/authorize?state=abc"}`, w.Body.String())
+		cookies := w.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, "oidc_state", cookies[0].Name)
+		assert.Equal(t, hashOIDCState("abc"), cookies[0].Value)
+		assert.True(t, cookies[0].HttpOnly)
+		assert.Equal(t, http.SameSiteLaxMode, cookies[0].SameSite)
+		mockGrpcClient.AssertExpectations(t)
+	})
+
+	t.Run("Not Configured", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("StartOIDCLogin", mock.Anything, mock.Anything, mock.Anything).
+			Return(&gen.StartOIDCLoginResponse{}, status.Error(codes.Unimplemented, "oidc login is not configured"))
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/auth/oidc/login", nil)
+		w := httptest.NewRecorder()
+
+		authHandler.StartOIDCLogin(w, req)
+
+		assert.Equal(t, http.StatusNotImplemented, w.Code)
+	})
+}
+
+func TestAuthHandler_FinishOIDCLogin(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	t.Run("Success", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		utilsMock := &utils.MockUtils{}
+		mockResponse := &gen.UserResponse{
+			SessionId: "session123",
+			Jwttoken:  "token123",
+			User:      &gen.User{Id: "test_user_id"},
+		}
+		mockGrpcClient.On("FinishOIDCLogin", mock.Anything, &gen.FinishOIDCLoginRequest{
+			Code:      "code123",
+			State:     "state123",
+			UserAgent: "test-agent",
+			Ip:        "127.0.0.1",
+		}, mock.Anything).Return(mockResponse, nil)
+		utilsMock.On("ConvertAuthResponseProtoToGo", mockResponse, "session123").Return(domain.AuthResponse{SessionId: "session123"}, nil)
+		authHandler := AuthHandler{client: mockGrpcClient, utils: utilsMock}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/oidc/callback", bytes.NewBufferString(`{"code":"code123","state":"state123"}`))
+		req.Header.Set("User-Agent", "test-agent")
+		req.RemoteAddr = "127.0.0.1:54321"
+		req.AddCookie(&http.Cookie{Name: "oidc_state", Value: hashOIDCState("state123")})
+		w := httptest.NewRecorder()
+
+		authHandler.FinishOIDCLogin(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		cookies := map[string]string{}
+		for _, cookie := range w.Result().Cookies() {
+			cookies[cookie.Name] = cookie.Value
+		}
+		assert.Equal(t, "session123", cookies["session_id"])
+		assert.Equal(t, "token123", cookies["csrf_token"])
+		assert.Equal(t, "", cookies["oidc_state"])
+		mockGrpcClient.AssertExpectations(t)
+		utilsMock.AssertExpectations(t)
+	})
+
+	t.Run("Second Factor Required", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("FinishOIDCLogin", mock.Anything, mock.Anything, mock.Anything).Return(&gen.UserResponse{Challenge: "challenge123"}, nil)
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/oidc/callback", bytes.NewBufferString(`{"code":"code123","state":"state123"}`))
+		req.AddCookie(&http.Cookie{Name: "oidc_state", Value: hashOIDCState("state123")})
+		w := httptest.NewRecorder()
+
+		authHandler.FinishOIDCLogin(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"secondFactorRequired":true,"challenge":"challenge123"}`, w.Body.String())
+		for _, cookie := range w.Result().Cookies() {
+			assert.NotEqual(t, "session_id", cookie.Name)
+		}
+	})
+
+	t.Run("Email Already Registered", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("FinishOIDCLogin", mock.Anything, mock.Anything, mock.Anything).Return(&gen.UserResponse{}, status.Error(codes.AlreadyExists, "email already registered"))
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/oidc/callback", bytes.NewBufferString(`{"code":"code123","state":"state123"}`))
+		req.AddCookie(&http.Cookie{Name: "oidc_state", Value: hashOIDCState("state123")})
+		w := httptest.NewRecorder()
+
+		authHandler.FinishOIDCLogin(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		for _, cookie := range w.Result().Cookies() {
+			assert.NotEqual(t, "session_id", cookie.Name)
+		}
+	})
+
+	t.Run("Expired State", func(t *testing.T) {
+		mockGrpcClient := new(mocks.MockGrpcClient)
+		mockGrpcClient.On("FinishOIDCLogin", mock.Anything, mock.Anything, mock.Anything).Return(&gen.UserResponse{}, status.Error(codes.InvalidArgument, "invalid or expired login state"))
+		authHandler := AuthHandler{client: mockGrpcClient}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/oidc/callback", bytes.NewBufferString(`{"code":"code123","state":"old"}`))
+		req.AddCookie(&http.Cookie{Name: "oidc_state", Value: hashOIDCState("old")})
+		w := httptest.NewRecorder()
+
+		authHandler.FinishOIDCLogin(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("State Not Bound To Browser", func(t *testing.T) {
+		tests := map[string]*http.Cookie{
+			"No Cookie":       nil,
+			"Another Browser": {Name: "oidc_state", Value: hashOIDCState("attacker-state")},
+		}
+		for name, cookie := range tests {
+			t.Run(name, func(t *testing.T) {
+				mockGrpcClient := new(mocks.MockGrpcClient)
+				authHandler := AuthHandler{client: mockGrpcClient}
+
+				req := httptest.NewRequest(http.MethodPost, "/api/auth/oidc/callback", bytes.NewBufferString(`{"code":"code123","state":"state123"}`))
+				if cookie != nil {
+					req.AddCookie(cookie)
+				}
+				w := httptest.NewRecorder()
+
+				authHandler.FinishOIDCLogin(w, req)
+
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+				mockGrpcClient.AssertNotCalled(t, "FinishOIDCLogin", mock.Anything, mock.Anything, mock.Anything)
+			})
+		}
+	})
+}
@@ -2,48 +2,50 @@ package controller
 
 import (
 	"2024_2_FIGHT-CLUB/domain"
-	"2024_2_FIGHT-CLUB/internal/chat/usecase"
 	"2024_2_FIGHT-CLUB/internal/service/logger"
 	"2024_2_FIGHT-CLUB/internal/service/metrics"
 	"2024_2_FIGHT-CLUB/internal/service/middleware"
 	"2024_2_FIGHT-CLUB/internal/service/session"
+	"2024_2_FIGHT-CLUB/internal/service/utils"
+	"2024_2_FIGHT-CLUB/microservices/chat_service/controller/gen"
 	"context"
 	"errors"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 	"github.com/mailru/easyjson"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
 	"net/http"
 	"sync"
 	"time"
 )
 
+// ChatHandler - шлюз к chat_service: REST-ручки проксируются в gRPC,
+// веб-сокет переводит события конверта в вызовы сервиса и обратно
 type ChatHandler struct {
-	chatUseCase    usecase.ChatUseCase
+	client         gen.ChatServiceClient
 	sessionService session.InterfaceSession
-	Messages       chan *domain.Message
+	utils          utils.UtilsInterface
+	connCounter    int
+	mu             sync.Mutex
 }
 
-func NewChatController(chatUseCase usecase.ChatUseCase, sessionService session.InterfaceSession) *ChatHandler {
+func NewChatController(client gen.ChatServiceClient, sessionService session.InterfaceSession, utils utils.UtilsInterface) *ChatHandler {
 	return &ChatHandler{
-		chatUseCase:    chatUseCase,
+		client:         client,
 		sessionService: sessionService,
-		Messages:       make(chan *domain.Message),
+		utils:          utils,
 	}
 }
 
 const (
-	socketBufferSize  = 1024
-	messageBufferSize = 256
-	maxConnections    = 100
-	messageRateLimit  = 5
+	socketBufferSize = 1024
+	maxConnections   = 100
+	messageRateLimit = 5
 )
 
 var (
-	upgrader    = websocket.Upgrader{ReadBufferSize: socketBufferSize, WriteBufferSize: socketBufferSize, CheckOrigin: func(r *http.Request) bool { return true }}
-	mapUserConn = make(map[string]*Client)
-	connCounter = 0
-	mu          sync.Mutex
+	upgrader = websocket.Upgrader{ReadBufferSize: socketBufferSize, WriteBufferSize: socketBufferSize, CheckOrigin: func(r *http.Request) bool { return true }}
 )
 
 func (cc *ChatHandler) SetConnection(w http.ResponseWriter, r *http.Request) {
@@ -56,27 +58,22 @@ func (cc *ChatHandler) SetConnection(w http.ResponseWriter, r *http.Request) {
 	)
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 
-	mu.Lock()
-	if connCounter >= maxConnections {
-		mu.Unlock()
+	cc.mu.Lock()
+	if cc.connCounter >= maxConnections {
+		cc.mu.Unlock()
 		err = errors.New("too many connections")
 		http.Error(w, "Too many connections", http.StatusTooManyRequests)
 		return
 	}
-	connCounter++
-	mu.Unlock()
+	cc.connCounter++
+	cc.mu.Unlock()
 
 	defer func() {
-		mu.Lock()
-		connCounter--
-		mu.Unlock()
+		cc.mu.Lock()
+		cc.connCounter--
+		cc.mu.Unlock()
 
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -92,7 +89,7 @@ func (cc *ChatHandler) SetConnection(w http.ResponseWriter, r *http.Request) {
 		logger.AccessLogger.Info("Failed to get sessionId",
 			zap.String("request_id", requestID),
 			zap.Error(err))
-		cc.handleError(w, err, requestID)
+		statusCode = cc.handleError(w, err, requestID)
 		return
 	}
 
@@ -101,7 +98,10 @@ func (cc *ChatHandler) SetConnection(w http.ResponseWriter, r *http.Request) {
 		logger.AccessLogger.Info("Unauthorized user",
 			zap.String("request_id", requestID),
 			zap.Error(err))
-		cc.handleError(w, err, requestID)
+		if err == nil {
+			err = errors.New("session not found")
+		}
+		statusCode = cc.handleError(w, err, requestID)
 		return
 	}
 
@@ -111,31 +111,37 @@ func (cc *ChatHandler) SetConnection(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// поток живёт, пока открыт сокет
+	streamCtx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	stream, err := cc.subscribe(streamCtx, sess)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to subscribe to chat events",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = cc.handleError(w, err, requestID)
+		return
+	}
+
 	socket, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
-		cc.handleError(w, errors.New("failed to upgrade connection"), requestID)
 		logger.AccessLogger.Info("Failed to get socket",
 			zap.String("request_id", requestID),
 			zap.Error(err))
+		err = errors.New("failed to upgrade connection")
 		return
 	}
 
 	client := &Client{
 		Socket:         socket,
-		Receive:        make(chan *domain.Message, messageBufferSize),
+		SessionID:      sess,
+		UserID:         UserID,
 		ChatController: cc,
 		RateLimiter:    NewRateLimiter(messageRateLimit, time.Second, 10*time.Second),
 	}
 
-	mapUserConn[UserID] = client
-	defer func() {
-		delete(mapUserConn, UserID)
-		close(client.Receive)
-	}()
-
-	go client.Write()
-	go client.Read(UserID)
-	cc.SendChatMsg(r.Context(), requestID)
+	go client.Write(stream)
+	client.Read(r.Context())
 
 	duration := time.Since(start)
 	logger.AccessLogger.Info("Completed SetConnection request",
@@ -145,36 +151,29 @@ func (cc *ChatHandler) SetConnection(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
-func (cc *ChatHandler) SendChatMsg(ctx context.Context, reqID string) {
-	start := time.Now()
-	ctx, cancel := middleware.WithTimeout(ctx)
-	defer cancel()
-
-	logger.AccessLogger.Info("Received SendChatMsg request",
-		zap.String("request_id", reqID))
-
-	for msg := range cc.Messages {
-		err := cc.chatUseCase.SendNewMessage(ctx, msg.ReceiverID, msg.SenderID, msg.Content)
-		if err != nil {
-			logger.AccessLogger.Info("Error sending message",
-				zap.String("request_id", reqID),
-				zap.Error(err))
-			return
-		}
-
-		resConn, ok := mapUserConn[msg.ReceiverID]
-		if ok {
-			resConn.Receive <- msg
-		}
+// subscribe открывает поток событий и ждёт заголовков: сервис присылает их,
+// когда подписка готова, а при ошибке поток завершается без них
+func (cc *ChatHandler) subscribe(ctx context.Context, sessionID string) (gen.ChatService_SubscribeClient, error) {
+	stream, err := cc.client.Subscribe(ctx, &gen.SubscribeRequest{SessionId: sessionID})
+	if err != nil {
+		return nil, rpcError(err)
 	}
+	header, err := stream.Header()
+	if err == nil && header == nil {
+		_, err = stream.Recv()
+	}
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return stream, nil
+}
 
-	duration := time.Since(start)
-	logger.AccessLogger.Info("Completed SendChatMsg request",
-		zap.String("request_id", reqID),
-		zap.Duration("duration", duration),
-		zap.Int("status", http.StatusOK),
-	)
-
+// rpcError превращает статус gRPC в ошибку с текстом из сервиса, который понимает handleError
+func rpcError(err error) error {
+	if st, ok := status.FromError(err); ok {
+		return errors.New(st.Message())
+	}
+	return err
 }
 
 func (cc *ChatHandler) GetAllChats(w http.ResponseWriter, r *http.Request) {
@@ -189,12 +188,7 @@ func (cc *ChatHandler) GetAllChats(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -205,53 +199,36 @@ func (cc *ChatHandler) GetAllChats(w http.ResponseWriter, r *http.Request) {
 		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
 	}()
 
-	var (
-		lastTimeQuery = r.URL.Query().Get("lastTime")
-		lastTime      time.Time
-	)
-
-	if lastTimeQuery == "" {
-		lastTime = time.Now()
-	} else {
-		lastTime, err = time.Parse("2006-01-02T15:04:05.000000Z", lastTimeQuery)
-		if err != nil {
-			logger.AccessLogger.Info("Failed to parse lastTime",
-				zap.String("request_id", requestID),
-				zap.Error(err))
-			cc.handleError(w, errors.New("failed to parse lastTime"), requestID)
-			return
-		}
-	}
-
 	sess, err := session.GetSessionId(r)
 	if err != nil || sess == "" {
 		logger.AccessLogger.Info("Failed to get sessionId",
 			zap.String("request_id", requestID),
 			zap.Error(err))
 		err = errors.New("session not found")
-		cc.handleError(w, err, requestID)
+		statusCode = cc.handleError(w, err, requestID)
 		return
 	}
-	UserID, err := cc.sessionService.GetUserID(ctx, sess)
+	response, err := cc.client.GetChats(ctx, &gen.GetChatsRequest{
+		SessionId: sess,
+		Cursor:    r.URL.Query().Get("cursor"),
+	})
 	if err != nil {
-		logger.AccessLogger.Info("Failed to get UserID",
+		logger.AccessLogger.Info("Failed to get all chats",
 			zap.String("request_id", requestID),
 			zap.Error(err))
-		cc.handleError(w, err, requestID)
+		err = rpcError(err)
+		statusCode = cc.handleError(w, err, requestID)
 		return
 	}
-	chats, err := cc.chatUseCase.GetAllChats(ctx, UserID, lastTime)
+
+	body, err := cc.utils.ConvertChatsProtoToGo(response)
 	if err != nil {
-		logger.AccessLogger.Info("Failed to get all chats",
+		logger.AccessLogger.Warn("Failed to convert chats",
 			zap.String("request_id", requestID),
 			zap.Error(err))
-		cc.handleError(w, err, requestID)
+		statusCode = cc.handleError(w, err, requestID)
 		return
 	}
-
-	body := domain.AllChats{
-		Chats: chats,
-	}
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.WriteHeader(http.StatusOK)
 	if _, err = easyjson.MarshalToWriter(&body, w); err != nil {
@@ -284,12 +261,7 @@ func (cc *ChatHandler) GetChat(w http.ResponseWriter, r *http.Request) {
 	)
 
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -314,7 +286,8 @@ func (cc *ChatHandler) GetChat(w http.ResponseWriter, r *http.Request) {
 			logger.AccessLogger.Info("Failed to parse lastTime",
 				zap.String("request_id", requestID),
 				zap.Error(err))
-			cc.handleError(w, errors.New("failed to parse lastTime"), requestID)
+			err = errors.New("failed to parse lastTime")
+			statusCode = cc.handleError(w, err, requestID)
 			return
 		}
 	}
@@ -325,29 +298,32 @@ func (cc *ChatHandler) GetChat(w http.ResponseWriter, r *http.Request) {
 			zap.String("request_id", requestID),
 			zap.Error(err))
 		err = errors.New("session not found")
-		cc.handleError(w, err, requestID)
+		statusCode = cc.handleError(w, err, requestID)
 		return
 	}
-	UserID, err := cc.sessionService.GetUserID(ctx, sess)
+	response, err := cc.client.GetMessages(ctx, &gen.GetMessagesRequest{
+		SessionId: sess,
+		PartnerId: id,
+		LastTime:  lastTime.Format(time.RFC3339Nano),
+	})
 	if err != nil {
-		logger.AccessLogger.Info("Failed to get UserID",
+		logger.AccessLogger.Info("Failed to get chat",
 			zap.String("request_id", requestID),
 			zap.Error(err))
-		cc.handleError(w, err, requestID)
+		err = rpcError(err)
+		statusCode = cc.handleError(w, err, requestID)
 		return
 	}
-	messages, err := cc.chatUseCase.GetChat(ctx, UserID, id, lastTime)
+
+	body, err := cc.utils.ConvertMessagesProtoToGo(response)
 	if err != nil {
-		logger.AccessLogger.Info("Failed to get chat",
+		logger.AccessLogger.Warn("Failed to convert messages",
 			zap.String("request_id", requestID),
 			zap.Error(err))
-		cc.handleError(w, err, requestID)
+		statusCode = cc.handleError(w, err, requestID)
 		return
 	}
 
-	body := domain.AllMessages{
-		Chat: messages,
-	}
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.WriteHeader(http.StatusOK)
 	if _, err = easyjson.MarshalToWriter(&body, w); err != nil {
@@ -366,6 +342,81 @@ func (cc *ChatHandler) GetChat(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
+func (cc *ChatHandler) MarkChatRead(w http.ResponseWriter, r *http.Request) {
+	partnerID := mux.Vars(r)["id"]
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received MarkChatRead request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	sess, err := session.GetSessionId(r)
+	if err != nil || sess == "" {
+		logger.AccessLogger.Info("Failed to get sessionId",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		err = errors.New("session not found")
+		statusCode = cc.handleError(w, err, requestID)
+		return
+	}
+
+	response, err := cc.client.MarkChatRead(ctx, &gen.MarkChatReadRequest{
+		SessionId: sess,
+		PartnerId: partnerID,
+	})
+	if err != nil {
+		logger.AccessLogger.Info("Failed to mark chat read",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		err = rpcError(err)
+		statusCode = cc.handleError(w, err, requestID)
+		return
+	}
+
+	body, err := cc.utils.ConvertReceiptProtoToGo(response)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to convert receipt",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = cc.handleError(w, err, requestID)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err = easyjson.MarshalToWriter(&body, w); err != nil {
+		logger.AccessLogger.Warn("Failed to encode response",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed MarkChatRead request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
 func (cc *ChatHandler) handleError(w http.ResponseWriter, err error, requestID string) int {
 	logger.AccessLogger.Error("Handling error",
 		zap.String("request_id", requestID),
@@ -378,12 +429,13 @@ func (cc *ChatHandler) handleError(w http.ResponseWriter, err error, requestID s
 	}
 	var status int
 	switch err.Error() {
-	case "error fetching chats", "error fetching messages",
+	case "error fetching chats", "error fetching messages", "error marking chat read",
+		"error subscribing to chat", "error parsing date for chat",
 		"failed to generate session id", "failed to save session", "error generating random bytes for session ID",
 		"failed to delete session", "failed to get session id from request cookie", "failed to upgrade connection":
 		status = http.StatusInternalServerError
 	case "error sending message",
-		"failed to parse lastTime":
+		"failed to parse lastTime", "invalid cursor", "invalid chat partner":
 		status = http.StatusBadRequest
 	case "session not found", "user ID not found in session":
 		status = http.StatusUnauthorized
@@ -0,0 +1,378 @@
+package controller
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/utils"
+	chatService "2024_2_FIGHT-CLUB/microservices/chat_service/controller"
+	"2024_2_FIGHT-CLUB/microservices/chat_service/controller/gen"
+	"2024_2_FIGHT-CLUB/microservices/chat_service/mocks"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/mailru/easyjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newChatService поднимает chat_service в памяти: он делит с другими репликами только Redis
+func newChatService(t *testing.T, addr string, useCase *mocks.MockChatUseCase, sessions *mocks.MockServiceSession) gen.ChatServiceClient {
+	rdb := redis.NewClient(&redis.Options{Addr: addr})
+	hub := chatService.NewHub(rdb)
+	server := grpc.NewServer()
+	gen.RegisterChatServiceServer(server, chatService.NewGrpcChatHandler(sessions, useCase, hub))
+
+	listener := bufconn.Listen(1 << 20)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		server.Stop()
+		_ = hub.Close()
+		_ = rdb.Close()
+	})
+	return gen.NewChatServiceClient(conn)
+}
+
+// newReplica поднимает шлюз со своим экземпляром chat_service
+func newReplica(t *testing.T, addr string, useCase *mocks.MockChatUseCase) (*ChatHandler, *httptest.Server) {
+	sessions := &mocks.MockServiceSession{
+		MockGetUserID: func(ctx context.Context, sessionID string) (string, error) {
+			return sessionID, nil
+		},
+	}
+	handler := NewChatController(newChatService(t, addr, useCase, sessions), sessions, utils.NewUtilsInterface())
+	server := httptest.NewServer(http.HandlerFunc(handler.SetConnection))
+	t.Cleanup(func() {
+		// веб-сокеты закрываются раньше (t.Cleanup выполняется в обратном порядке),
+		// дожидаемся завершения их обработчиков
+		assert.Eventually(t, func() bool {
+			handler.mu.Lock()
+			defer handler.mu.Unlock()
+			return handler.connCounter == 0
+		}, 2*time.Second, 10*time.Millisecond)
+		server.Close()
+	})
+	return handler, server
+}
+
+func userChannel(userID string) string {
+	return "chat:user:" + userID
+}
+
+func dial(t *testing.T, server *httptest.Server, userID string) *websocket.Conn {
+	header := http.Header{}
+	header.Set("Cookie", "session_id="+userID)
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), header)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func sendEvent(t *testing.T, conn *websocket.Conn, eventType string, id string, payload easyjson.Marshaler) {
+	event, err := domain.NewChatEvent(eventType, id, payload)
+	require.NoError(t, err)
+	data, err := easyjson.Marshal(event)
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, data))
+}
+
+func readEvent(t *testing.T, conn *websocket.Conn) *domain.ChatEvent {
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+	_, data, err := conn.ReadMessage()
+	require.NoError(t, err)
+	event := &domain.ChatEvent{}
+	require.NoError(t, easyjson.Unmarshal(data, event))
+	assert.Equal(t, domain.ChatProtocolVersion, event.Version)
+	return event
+}
+
+func TestChatHandler_DeliversAcrossReplicas(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	fakeRedis := miniredis.RunT(t)
+
+	var mu sync.Mutex
+	var saved []string
+	useCase := &mocks.MockChatUseCase{
+		MockSendNewMessage: func(ctx context.Context, receiver string, sender string, message string) (*domain.Message, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			saved = append(saved, sender+"->"+receiver+":"+message)
+			return &domain.Message{ID: len(saved), SenderID: sender, ReceiverID: receiver, Content: message, CreatedAt: time.Now()}, nil
+		},
+		MockMarkDelivered: func(ctx context.Context, messageID int, receiverID string) (time.Time, string, int64, error) {
+			return time.Now(), "alice", 1, nil
+		},
+	}
+
+	_, serverA := newReplica(t, fakeRedis.Addr(), useCase)
+	_, serverB := newReplica(t, fakeRedis.Addr(), useCase)
+
+	alice := dial(t, serverA, "alice")
+	bob := dial(t, serverB, "bob")
+
+	// реплика B должна успеть подписаться на канал bob
+	require.Eventually(t, func() bool {
+		return fakeRedis.PubSubNumSub(userChannel("bob"))[userChannel("bob")] == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	sendEvent(t, alice, domain.ChatEventMessageSend, "c1", &domain.MessageSendPayload{ReceiverID: "bob", Content: "hello from A"})
+
+	event := readEvent(t, bob)
+	assert.Equal(t, domain.ChatEventMessageNew, event.Type)
+	var received domain.Message
+	require.NoError(t, easyjson.Unmarshal(event.Payload, &received))
+	assert.Equal(t, 1, received.ID)
+	assert.Equal(t, "alice", received.SenderID)
+	assert.Equal(t, "bob", received.ReceiverID)
+	assert.Equal(t, "hello from A", received.Content)
+
+	// отправитель получает ack со своим id и квитанцию о доставке, порядок между ними не гарантирован
+	events := map[string]*domain.ChatEvent{}
+	for len(events) < 2 {
+		event = readEvent(t, alice)
+		events[event.Type] = event
+	}
+
+	ack := events[domain.ChatEventMessageAck]
+	require.NotNil(t, ack)
+	assert.Equal(t, "c1", ack.ID)
+	var ackPayload domain.MessageAckPayload
+	require.NoError(t, easyjson.Unmarshal(ack.Payload, &ackPayload))
+	assert.Equal(t, 1, ackPayload.MessageID)
+
+	delivered := events[domain.ChatEventMessageDelivered]
+	require.NotNil(t, delivered)
+	var receipt domain.ChatReceipt
+	require.NoError(t, easyjson.Unmarshal(delivered.Payload, &receipt))
+	assert.Equal(t, 1, receipt.MessageID)
+	assert.Equal(t, "bob", receipt.UserID)
+
+	mu.Lock()
+	assert.Equal(t, []string{"alice->bob:hello from A"}, saved)
+	mu.Unlock()
+}
+
+func TestChatHandler_UnsubscribesOnDisconnect(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	fakeRedis := miniredis.RunT(t)
+	_, server := newReplica(t, fakeRedis.Addr(), &mocks.MockChatUseCase{})
+
+	bob := dial(t, server, "bob")
+	require.Eventually(t, func() bool {
+		return fakeRedis.PubSubNumSub(userChannel("bob"))[userChannel("bob")] == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, bob.Close())
+	require.Eventually(t, func() bool {
+		return fakeRedis.PubSubNumSub(userChannel("bob"))[userChannel("bob")] == 0
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestChatHandler_ReadReceipt(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	fakeRedis := miniredis.RunT(t)
+	readAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	useCase := &mocks.MockChatUseCase{
+		MockMarkChatRead: func(ctx context.Context, readerID string, partnerID string) (time.Time, int64, error) {
+			assert.Equal(t, "bob", readerID)
+			assert.Equal(t, "alice", partnerID)
+			return readAt, 3, nil
+		},
+	}
+
+	_, serverA := newReplica(t, fakeRedis.Addr(), useCase)
+	handlerB, serverB := newReplica(t, fakeRedis.Addr(), useCase)
+
+	alice := dial(t, serverA, "alice")
+	require.Eventually(t, func() bool {
+		return fakeRedis.PubSubNumSub(userChannel("alice"))[userChannel("alice")] == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	t.Run("read event over websocket", func(t *testing.T) {
+		bob := dial(t, serverB, "bob")
+		sendEvent(t, bob, domain.ChatEventRead, "r1", &domain.ChatReceipt{UserID: "alice"})
+
+		event := readEvent(t, alice)
+		assert.Equal(t, domain.ChatEventRead, event.Type)
+		var receipt domain.ChatReceipt
+		require.NoError(t, easyjson.Unmarshal(event.Payload, &receipt))
+		assert.Equal(t, domain.ChatReceipt{Type: domain.ChatReceiptRead, UserID: "bob", At: readAt}, receipt)
+	})
+
+	t.Run("rest endpoint", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "/api/messages/chat/alice/read", nil)
+		request.AddCookie(&http.Cookie{Name: "session_id", Value: "bob"})
+		request = mux.SetURLVars(request, map[string]string{"id": "alice"})
+		recorder := httptest.NewRecorder()
+
+		handlerB.MarkChatRead(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		var body domain.ChatReceipt
+		require.NoError(t, easyjson.Unmarshal(recorder.Body.Bytes(), &body))
+		assert.Equal(t, domain.ChatReceipt{Type: domain.ChatReceiptRead, UserID: "alice", At: readAt}, body)
+
+		event := readEvent(t, alice)
+		assert.Equal(t, domain.ChatEventRead, event.Type)
+		var receipt domain.ChatReceipt
+		require.NoError(t, easyjson.Unmarshal(event.Payload, &receipt))
+		assert.Equal(t, "bob", receipt.UserID)
+	})
+}
+
+func TestChatHandler_TypingAndPresence(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	fakeRedis := miniredis.RunT(t)
+	_, serverA := newReplica(t, fakeRedis.Addr(), &mocks.MockChatUseCase{})
+	_, serverB := newReplica(t, fakeRedis.Addr(), &mocks.MockChatUseCase{})
+
+	alice := dial(t, serverA, "alice")
+	bob := dial(t, serverB, "bob")
+	require.Eventually(t, func() bool {
+		return fakeRedis.PubSubNumSub(userChannel("alice"))[userChannel("alice")] == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	t.Run("presence", func(t *testing.T) {
+		tests := []struct {
+			userID string
+			online bool
+		}{
+			{userID: "alice", online: true},
+			{userID: "carol", online: false},
+		}
+		for _, tt := range tests {
+			sendEvent(t, bob, domain.ChatEventPresence, "p-"+tt.userID, &domain.PresencePayload{UserID: tt.userID})
+
+			event := readEvent(t, bob)
+			assert.Equal(t, domain.ChatEventPresence, event.Type)
+			assert.Equal(t, "p-"+tt.userID, event.ID)
+			var presence domain.PresencePayload
+			require.NoError(t, easyjson.Unmarshal(event.Payload, &presence))
+			assert.Equal(t, domain.PresencePayload{UserID: tt.userID, Online: tt.online}, presence)
+		}
+	})
+
+	t.Run("typing", func(t *testing.T) {
+		sendEvent(t, bob, domain.ChatEventTyping, "", &domain.TypingPayload{UserID: "alice", Typing: true})
+
+		event := readEvent(t, alice)
+		assert.Equal(t, domain.ChatEventTyping, event.Type)
+		var typing domain.TypingPayload
+		require.NoError(t, easyjson.Unmarshal(event.Payload, &typing))
+		assert.Equal(t, domain.TypingPayload{UserID: "bob", Typing: true}, typing)
+	})
+}
+
+func TestChatHandler_ProtocolErrors(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	fakeRedis := miniredis.RunT(t)
+	_, server := newReplica(t, fakeRedis.Addr(), &mocks.MockChatUseCase{})
+	alice := dial(t, server, "alice")
+
+	tests := []struct {
+		name   string
+		frame  string
+		wantID string
+		code   string
+	}{
+		{
+			name:  "not json",
+			frame: `hello`,
+			code:  domain.ChatErrorInvalidFrame,
+		},
+		{
+			name:   "unsupported version",
+			frame:  `{"v":2,"type":"message.send","id":"m1","payload":{"receiverId":"bob","content":"hi"}}`,
+			wantID: "m1",
+			code:   domain.ChatErrorUnsupportedVersion,
+		},
+		{
+			name:   "unknown type",
+			frame:  `{"v":1,"type":"message.edit","id":"m2"}`,
+			wantID: "m2",
+			code:   domain.ChatErrorUnknownType,
+		},
+		{
+			name:   "whitespace content",
+			frame:  `{"v":1,"type":"message.send","id":"m3","payload":{"receiverId":"bob","content":" \n\t"}}`,
+			wantID: "m3",
+			code:   domain.ChatErrorInvalidPayload,
+		},
+		{
+			name:   "missing receiver",
+			frame:  `{"v":1,"type":"message.send","id":"m4","payload":{"content":"hi"}}`,
+			wantID: "m4",
+			code:   domain.ChatErrorInvalidPayload,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, alice.WriteMessage(websocket.TextMessage, []byte(tt.frame)))
+
+			event := readEvent(t, alice)
+			assert.Equal(t, domain.ChatEventError, event.Type)
+			assert.Equal(t, tt.wantID, event.ID)
+			var payload domain.ChatErrorPayload
+			require.NoError(t, easyjson.Unmarshal(event.Payload, &payload))
+			assert.Equal(t, tt.code, payload.Code)
+		})
+	}
+}
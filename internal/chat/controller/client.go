@@ -3,10 +3,14 @@ package controller
 import (
 	"2024_2_FIGHT-CLUB/domain"
 	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/microservices/chat_service/controller/gen"
+	"context"
 	"fmt"
 	"github.com/gorilla/websocket"
 	"github.com/mailru/easyjson"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
 	"strings"
 	"sync"
 	"time"
@@ -69,12 +73,16 @@ func (rl *RateLimiter) Allow() (bool, error) {
 
 type Client struct {
 	Socket         *websocket.Conn
-	Receive        chan *domain.Message
+	SessionID      string
+	UserID         string
 	ChatController *ChatHandler
 	RateLimiter    *RateLimiter
+	// websocket не допускает параллельной записи, а пишут и Read, и Write
+	writeMu sync.Mutex
 }
 
-func (c *Client) Read(userID string) {
+// Read переводит события клиента в вызовы chat_service
+func (c *Client) Read(ctx context.Context) {
 	defer c.Socket.Close()
 
 	// Создаем таймер для отслеживания времени бездействия
@@ -91,7 +99,7 @@ func (c *Client) Read(userID string) {
 			case <-time.After(10 * time.Second): // Проверяем тайм-аут каждые 10 секунд
 				if time.Since(lastActive) > idleTimeout {
 					logger.AccessLogger.Info("Connection closed due to inactivity",
-						zap.String("user_id", userID))
+						zap.String("user_id", c.UserID))
 					c.Socket.Close()
 					return
 				}
@@ -100,105 +108,236 @@ func (c *Client) Read(userID string) {
 	}()
 
 	for {
-		msg := &domain.Message{}
-
-		// Читаем JSON-сообщение из сокета
-		err := c.Socket.ReadJSON(&msg)
+		_, data, err := c.Socket.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				logger.AccessLogger.Info("Unexpected socket closure",
-					zap.String("user_id", userID),
+					zap.String("user_id", c.UserID),
 					zap.Error(err))
 			}
 			break
 		}
 
-		// Проверяем, является ли сообщение пустым или состоит только из пробелов/переводов строк/табуляций
-		if strings.TrimSpace(msg.Content) == "" {
-			// Логируем и отправляем клиенту сообщение об ошибке
-			logger.AccessLogger.Warn("Invalid message content received (only whitespace characters)",
-				zap.String("user_id", userID))
-			errMsg := map[string]interface{}{
-				"response": "Invalid message content: only whitespace characters, tabs, or line breaks are not allowed.",
-				"sent":     false,
-			}
-			if writeErr := c.Socket.WriteJSON(errMsg); writeErr != nil {
-				logger.AccessLogger.Error("Failed to send invalid message content error to client",
-					zap.String("user_id", userID),
-					zap.Error(writeErr))
-			}
+		event := &domain.ChatEvent{}
+		if err = easyjson.Unmarshal(data, event); err != nil {
+			logger.AccessLogger.Warn("Invalid frame received",
+				zap.String("user_id", c.UserID),
+				zap.Error(err))
+			c.writeError("", domain.ChatErrorInvalidFrame, "Invalid frame format.")
 			continue
 		}
-
-		// Обновляем последнее время активности
-		lastActive = time.Now()
-
-		// Проверяем лимит сообщений
-		allowed, rateErr := c.RateLimiter.Allow()
-		if !allowed {
-			logger.AccessLogger.Error("Rate limit exceeded",
-				zap.String("user_id", userID),
-				zap.Error(rateErr))
-
-			// Сообщаем клиенту о блокировке
-			errMsg := map[string]interface{}{
-				"response": rateErr.Error(),
-				"sent":     false,
-			}
-			if writeErr := c.Socket.WriteJSON(errMsg); writeErr != nil {
-				logger.AccessLogger.Error("Failed to send rate limit error to client",
-					zap.String("user_id", userID),
-					zap.Error(writeErr))
-			}
+		if event.Version != domain.ChatProtocolVersion {
+			c.writeError(event.ID, domain.ChatErrorUnsupportedVersion, "Unsupported protocol version.")
 			continue
 		}
 
-		// Устанавливаем SenderID на основе текущего пользователя
-		msg.SenderID = userID
+		// Обновляем последнее время активности
+		lastActive = time.Now()
 
-		// Отправляем сообщение в канал для обработки
-		select {
-		case c.ChatController.Messages <- msg:
-			// Возвращаем успешный ответ клиенту
-			successMsg := map[string]interface{}{
-				"response": "Message delivered successfully",
-				"sent":     true,
-			}
-			if writeErr := c.Socket.WriteJSON(successMsg); writeErr != nil {
-				logger.AccessLogger.Error("Failed to send success message to client",
-					zap.String("user_id", userID),
-					zap.Error(writeErr))
-			}
+		rpcCtx, cancel := middleware.WithTimeout(ctx)
+		switch event.Type {
+		case domain.ChatEventMessageSend:
+			c.handleSend(rpcCtx, event)
+		case domain.ChatEventTyping:
+			c.handleTyping(rpcCtx, event)
+		case domain.ChatEventPresence:
+			c.handlePresence(rpcCtx, event)
+		case domain.ChatEventRead:
+			c.handleRead(rpcCtx, event)
 		default:
-			logger.AccessLogger.Warn("Message channel is full, dropping message",
-				zap.String("user_id", userID))
-			errMsg := map[string]interface{}{
-				"response": "Message channel is full. Please try again later.",
-				"sent":     false,
-			}
-			if writeErr := c.Socket.WriteJSON(errMsg); writeErr != nil {
-				logger.AccessLogger.Error("Failed to send channel full error to client",
-					zap.String("user_id", userID),
-					zap.Error(writeErr))
-			}
+			c.writeError(event.ID, domain.ChatErrorUnknownType, "Unknown event type.")
 		}
+		cancel()
 	}
 
 	// Завершаем горутину таймера при выходе из цикла
 	close(closeOnIdle)
 }
 
-func (c *Client) Write() {
+func (c *Client) handleSend(ctx context.Context, event *domain.ChatEvent) {
+	payload := &domain.MessageSendPayload{}
+	if err := easyjson.Unmarshal(event.Payload, payload); err != nil || payload.ReceiverID == "" {
+		c.writeError(event.ID, domain.ChatErrorInvalidPayload, "Invalid message format.")
+		return
+	}
+
+	// Проверяем, является ли сообщение пустым или состоит только из пробелов/переводов строк/табуляций
+	if strings.TrimSpace(payload.Content) == "" {
+		logger.AccessLogger.Warn("Invalid message content received (only whitespace characters)",
+			zap.String("user_id", c.UserID))
+		c.writeError(event.ID, domain.ChatErrorInvalidPayload,
+			"Invalid message content: only whitespace characters, tabs, or line breaks are not allowed.")
+		return
+	}
+
+	// Проверяем лимит сообщений
+	allowed, rateErr := c.RateLimiter.Allow()
+	if !allowed {
+		logger.AccessLogger.Error("Rate limit exceeded",
+			zap.String("user_id", c.UserID),
+			zap.Error(rateErr))
+		c.writeError(event.ID, domain.ChatErrorRateLimited, rateErr.Error())
+		return
+	}
+
+	// Сервис сохранит сообщение и доставит его на реплику получателя
+	saved, err := c.ChatController.client.SendMessage(ctx, &gen.SendMessageRequest{
+		SessionId:  c.SessionID,
+		ReceiverId: payload.ReceiverID,
+		Content:    payload.Content,
+	})
+	if err != nil {
+		logger.AccessLogger.Error("Failed to send message",
+			zap.String("user_id", c.UserID),
+			zap.Error(err))
+		if status.Convert(err).Message() == "email not verified" {
+			c.writeError(event.ID, domain.ChatErrorEmailUnverified, "Confirm your email to send messages.")
+			return
+		}
+		c.writeError(event.ID, domain.ChatErrorInternal, "Failed to send message. Please try again later.")
+		return
+	}
+
+	message, err := c.ChatController.utils.ConvertMessageProtoToGo(saved)
+	if err != nil {
+		c.writeError(event.ID, domain.ChatErrorInternal, "Failed to send message. Please try again later.")
+		return
+	}
+	c.writeEvent(domain.ChatEventMessageAck, event.ID, &domain.MessageAckPayload{
+		MessageID: message.ID,
+		CreatedAt: message.CreatedAt,
+	})
+}
+
+func (c *Client) handleTyping(ctx context.Context, event *domain.ChatEvent) {
+	payload := &domain.TypingPayload{}
+	if err := easyjson.Unmarshal(event.Payload, payload); err != nil || payload.UserID == "" {
+		c.writeError(event.ID, domain.ChatErrorInvalidPayload, "Invalid typing event.")
+		return
+	}
+	_, err := c.ChatController.client.SendTyping(ctx, &gen.SendTypingRequest{
+		SessionId: c.SessionID,
+		PartnerId: payload.UserID,
+		Typing:    payload.Typing,
+	})
+	if err != nil {
+		c.writeRPCError(event.ID, err, "Failed to send typing event.")
+	}
+}
+
+func (c *Client) handlePresence(ctx context.Context, event *domain.ChatEvent) {
+	payload := &domain.PresencePayload{}
+	if err := easyjson.Unmarshal(event.Payload, payload); err != nil || payload.UserID == "" {
+		c.writeError(event.ID, domain.ChatErrorInvalidPayload, "Invalid presence event.")
+		return
+	}
+	presence, err := c.ChatController.client.GetPresence(ctx, &gen.GetPresenceRequest{
+		SessionId: c.SessionID,
+		UserId:    payload.UserID,
+	})
+	if err != nil {
+		c.writeRPCError(event.ID, err, "Failed to check presence.")
+		return
+	}
+	c.writeEvent(domain.ChatEventPresence, event.ID, &domain.PresencePayload{
+		UserID: presence.UserId,
+		Online: presence.Online,
+	})
+}
+
+func (c *Client) handleRead(ctx context.Context, event *domain.ChatEvent) {
+	payload := &domain.ChatReceipt{}
+	if err := easyjson.Unmarshal(event.Payload, payload); err != nil {
+		c.writeError(event.ID, domain.ChatErrorInvalidPayload, "Invalid read event.")
+		return
+	}
+	_, err := c.ChatController.client.MarkChatRead(ctx, &gen.MarkChatReadRequest{
+		SessionId: c.SessionID,
+		PartnerId: payload.UserID,
+	})
+	if err != nil {
+		c.writeRPCError(event.ID, err, "Failed to mark chat read.")
+	}
+}
+
+// writeRPCError отличает ошибки в запросе клиента от сбоев сервиса
+func (c *Client) writeRPCError(id string, err error, message string) {
+	logger.AccessLogger.Error("Chat service call failed",
+		zap.String("user_id", c.UserID),
+		zap.Error(err))
+	if rpcError(err).Error() == "invalid chat partner" {
+		c.writeError(id, domain.ChatErrorInvalidPayload, "Invalid chat partner.")
+		return
+	}
+	c.writeError(id, domain.ChatErrorInternal, message)
+}
+
+func (c *Client) writeError(id string, code string, message string) {
+	c.writeEvent(domain.ChatEventError, id, &domain.ChatErrorPayload{
+		Code:    code,
+		Message: message,
+	})
+}
+
+func (c *Client) writeEvent(eventType string, id string, payload easyjson.Marshaler) {
+	event, err := domain.NewChatEvent(eventType, id, payload)
+	if err == nil {
+		err = c.writeFrame(event)
+	}
+	if err != nil {
+		logger.AccessLogger.Error("Failed to send event to client",
+			zap.String("type", eventType),
+			zap.Error(err))
+	}
+}
+
+func (c *Client) writeFrame(event *domain.ChatEvent) error {
+	data, err := easyjson.Marshal(event)
+	if err != nil {
+		return err
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.Socket.WriteMessage(websocket.TextMessage, data)
+}
+
+// Write пересылает в сокет события из потока Subscribe
+func (c *Client) Write(stream gen.ChatService_SubscribeClient) {
 	defer c.Socket.Close()
-	for msg := range c.Receive {
-		msg.CreatedAt = time.Now()
-		jsonForSend, err := easyjson.Marshal(msg)
+	for {
+		protoEvent, err := stream.Recv()
 		if err != nil {
 			return
 		}
-		err = c.Socket.WriteMessage(websocket.TextMessage, jsonForSend)
+		event, err := c.ChatController.utils.ConvertChatEventProtoToGo(protoEvent)
 		if err != nil {
+			logger.AccessLogger.Warn("Failed to convert chat event",
+				zap.String("user_id", c.UserID),
+				zap.Error(err))
+			continue
+		}
+		if err = c.writeFrame(event); err != nil {
 			return
 		}
+
+		// сообщение ушло в сокет получателя - отмечаем доставку, сервис сообщит отправителю
+		if message := protoEvent.GetMessage(); message != nil {
+			c.markDelivered(stream.Context(), message)
+		}
+	}
+}
+
+func (c *Client) markDelivered(ctx context.Context, message *gen.Message) {
+	ctx, cancel := middleware.WithTimeout(ctx)
+	defer cancel()
+	_, err := c.ChatController.client.MarkDelivered(ctx, &gen.MarkDeliveredRequest{
+		SessionId: c.SessionID,
+		MessageId: message.Id,
+	})
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to mark message delivered",
+			zap.String("user_id", c.UserID),
+			zap.Int32("message_id", message.Id),
+			zap.Error(err))
 	}
 }
@@ -43,12 +43,7 @@ func (h *CityHandler) GetCities(w http.ResponseWriter, r *http.Request) {
 
 	statusCode := http.StatusOK
 	var err error
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -115,12 +110,7 @@ func (h *CityHandler) GetOneCity(w http.ResponseWriter, r *http.Request) {
 
 	statusCode := http.StatusOK
 	var err error
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
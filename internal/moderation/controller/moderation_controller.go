@@ -0,0 +1,304 @@
+package controller
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/moderation/usecase"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/metrics"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/pagination"
+	"2024_2_FIGHT-CLUB/internal/service/session"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/microcosm-cc/bluemonday"
+	"go.uber.org/zap"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type ModerationHandler struct {
+	usecase        usecase.ModerationUsecase
+	sessionService session.InterfaceSession
+	jwtToken       middleware.JwtTokenService
+}
+
+func NewModerationHandler(usecase usecase.ModerationUsecase, sessionService session.InterfaceSession, jwtToken middleware.JwtTokenService) *ModerationHandler {
+	return &ModerationHandler{
+		usecase:        usecase,
+		sessionService: sessionService,
+		jwtToken:       jwtToken,
+	}
+}
+
+// authorize проверяет CSRF-токен и возвращает пользователя сессии
+func (h *ModerationHandler) authorize(ctx context.Context, r *http.Request) (string, error) {
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		return "", err
+	}
+	authHeader := r.Header.Get("X-CSRF-Token")
+	if authHeader == "" {
+		return "", errors.New("missing X-CSRF-Token header")
+	}
+	if _, err = h.jwtToken.Validate(strings.TrimPrefix(authHeader, "Bearer "), sessionID); err != nil {
+		return "", errors.New("invalid JWT token")
+	}
+	return h.sessionService.GetUserID(ctx, sessionID)
+}
+
+func sanitizeReportPath(path string) string {
+	return regexp.MustCompile(`/reports/[0-9]+`).ReplaceAllString(path, "/reports/{reportId}")
+}
+
+func (h *ModerationHandler) CreateReport(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusCreated
+	ip := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusCreated {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), ip).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), ip).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, ip).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received CreateReport request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	userID, err := h.authorize(ctx, r)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to authorize", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	var request domain.CreateReportRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &request); err != nil {
+		logger.AccessLogger.Warn("Failed to decode report", zap.String("request_id", requestID), zap.Error(err))
+		err = errors.New("failed to decode report")
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+	sanitizer := bluemonday.UGCPolicy()
+	request.TargetType = sanitizer.Sanitize(request.TargetType)
+	request.TargetID = sanitizer.Sanitize(request.TargetID)
+	request.Reason = sanitizer.Sanitize(request.Reason)
+
+	report, err := h.usecase.CreateReport(ctx, userID, request)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to create report", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusCreated)
+	if _, err = easyjson.MarshalToWriter(report, w); err != nil {
+		logger.AccessLogger.Warn("Failed to encode response", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed CreateReport request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusCreated))
+}
+
+func (h *ModerationHandler) GetReports(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	ip := middleware.ClientIP(r)
+	defer func() {
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), ip).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), err.Error(), ip).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, ip).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received GetReports request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+		zap.String("query", r.URL.Query().Encode()),
+	)
+
+	var cursor *domain.ReportCursor
+	if value := r.URL.Query().Get("cursor"); value != "" {
+		cursor = &domain.ReportCursor{}
+		if err = pagination.DecodeCursor(value, cursor); err != nil {
+			statusCode = h.handleError(w, err, requestID)
+			return
+		}
+	}
+
+	reports, nextCursor, err := h.usecase.GetReports(ctx, r.URL.Query().Get("status"), cursor)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to get reports", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	body := domain.ReportsList{Reports: reports, NextCursor: nextCursor}
+	if body.Reports == nil {
+		body.Reports = []domain.Report{}
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err = easyjson.MarshalToWriter(&body, w); err != nil {
+		logger.AccessLogger.Warn("Failed to encode response", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed GetReports request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK))
+}
+
+func (h *ModerationHandler) ResolveReport(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	ip := middleware.ClientIP(r)
+	defer func() {
+		sanitizedPath := sanitizeReportPath(r.URL.Path)
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), ip).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), err.Error(), ip).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, sanitizedPath, ip).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received ResolveReport request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	reportID, err := strconv.Atoi(mux.Vars(r)["reportId"])
+	if err != nil || reportID <= 0 {
+		err = errors.New("invalid report id")
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	userID, err := h.authorize(ctx, r)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to authorize", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	if err = h.usecase.ResolveReport(ctx, userID, reportID, mux.Vars(r)["action"]); err != nil {
+		logger.AccessLogger.Warn("Failed to resolve report", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	response := domain.ResponseMessage{Message: "report resolved"}
+	if _, err = easyjson.MarshalToWriter(&response, w); err != nil {
+		logger.AccessLogger.Warn("Failed to encode response", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = h.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed ResolveReport request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK))
+}
+
+func (h *ModerationHandler) handleError(w http.ResponseWriter, err error, requestID string) int {
+	logger.AccessLogger.Error("Handling error",
+		zap.String("request_id", requestID),
+		zap.Error(err),
+	)
+
+	var statusCode int
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	errorResponse := domain.ErrorResponse{
+		Error: err.Error(),
+	}
+
+	switch err.Error() {
+	case "input contains invalid characters",
+		"input exceeds character limit",
+		"invalid report target",
+		"invalid report status",
+		"invalid report id",
+		"invalid moderation action",
+		"invalid cursor",
+		"report reason is empty",
+		"failed to decode report":
+		statusCode = http.StatusBadRequest
+
+	case "cannot report own content",
+		"report already exist",
+		"report already resolved":
+		statusCode = http.StatusConflict
+
+	case "reported content not found",
+		"report not found",
+		"user not found":
+		statusCode = http.StatusNotFound
+
+	case "access denied":
+		statusCode = http.StatusForbidden
+
+	case "token invalid",
+		"token expired",
+		"bad sign method",
+		"missing X-CSRF-Token header",
+		"invalid JWT token",
+		"session not found",
+		"failed to get session id from request cookie":
+		statusCode = http.StatusUnauthorized
+
+	default:
+		statusCode = http.StatusInternalServerError
+	}
+
+	w.WriteHeader(statusCode)
+	if _, jsonErr := easyjson.MarshalToWriter(&errorResponse, w); jsonErr != nil {
+		logger.AccessLogger.Error("Failed to encode error response",
+			zap.String("request_id", requestID),
+			zap.Error(jsonErr),
+		)
+		http.Error(w, jsonErr.Error(), http.StatusInternalServerError)
+	}
+
+	return statusCode
+}
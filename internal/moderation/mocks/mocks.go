@@ -0,0 +1,92 @@
+package mocks
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"context"
+)
+
+type MockModerationRepository struct {
+	MockGetContentAuthor func(ctx context.Context, targetType, targetID string) (string, error)
+	MockCreateReport     func(ctx context.Context, report *domain.Report) error
+	MockGetReports       func(ctx context.Context, status string, after *domain.ReportCursor, limit int) ([]domain.Report, error)
+	MockGetReportByID    func(ctx context.Context, reportID int) (*domain.Report, error)
+	MockResolveReports   func(ctx context.Context, report *domain.Report, status string, moderatorID string) error
+}
+
+func (m *MockModerationRepository) GetContentAuthor(ctx context.Context, targetType, targetID string) (string, error) {
+	return m.MockGetContentAuthor(ctx, targetType, targetID)
+}
+
+func (m *MockModerationRepository) CreateReport(ctx context.Context, report *domain.Report) error {
+	return m.MockCreateReport(ctx, report)
+}
+
+func (m *MockModerationRepository) GetReports(ctx context.Context, status string, after *domain.ReportCursor, limit int) ([]domain.Report, error) {
+	return m.MockGetReports(ctx, status, after, limit)
+}
+
+func (m *MockModerationRepository) GetReportByID(ctx context.Context, reportID int) (*domain.Report, error) {
+	return m.MockGetReportByID(ctx, reportID)
+}
+
+func (m *MockModerationRepository) ResolveReports(ctx context.Context, report *domain.Report, status string, moderatorID string) error {
+	return m.MockResolveReports(ctx, report, status, moderatorID)
+}
+
+type MockServiceSession struct {
+	MockGetUserID           func(ctx context.Context, sessionID string) (string, error)
+	MockLogoutSession       func(ctx context.Context, sessionID string) error
+	MockCreateSession       func(ctx context.Context, user *domain.User, client domain.SessionClient) (string, error)
+	MockGetSessionData      func(ctx context.Context, sessionID string) (*domain.SessionData, error)
+	MockGetUserSessions     func(ctx context.Context, sessionID string) ([]domain.ActiveSession, error)
+	MockRevokeSession       func(ctx context.Context, sessionID string, publicID string) error
+	MockRevokeOtherSessions func(ctx context.Context, sessionID string) error
+	MockRevokeUserSessions  func(ctx context.Context, userID string) error
+	MockMarkEmailVerified   func(ctx context.Context, userID string) error
+	MockMarkEmailUnverified func(ctx context.Context, userID string) error
+	MockUpdateUserRole      func(ctx context.Context, userID string, role string) error
+}
+
+func (m *MockServiceSession) GetUserID(ctx context.Context, sessionID string) (string, error) {
+	return m.MockGetUserID(ctx, sessionID)
+}
+
+func (m *MockServiceSession) LogoutSession(ctx context.Context, sessionID string) error {
+	return m.MockLogoutSession(ctx, sessionID)
+}
+
+func (m *MockServiceSession) CreateSession(ctx context.Context, user *domain.User, client domain.SessionClient) (string, error) {
+	return m.MockCreateSession(ctx, user, client)
+}
+
+func (m *MockServiceSession) GetSessionData(ctx context.Context, sessionID string) (*domain.SessionData, error) {
+	return m.MockGetSessionData(ctx, sessionID)
+}
+
+func (m *MockServiceSession) GetUserSessions(ctx context.Context, sessionID string) ([]domain.ActiveSession, error) {
+	return m.MockGetUserSessions(ctx, sessionID)
+}
+
+func (m *MockServiceSession) RevokeSession(ctx context.Context, sessionID string, publicID string) error {
+	return m.MockRevokeSession(ctx, sessionID, publicID)
+}
+
+func (m *MockServiceSession) RevokeOtherSessions(ctx context.Context, sessionID string) error {
+	return m.MockRevokeOtherSessions(ctx, sessionID)
+}
+
+func (m *MockServiceSession) RevokeUserSessions(ctx context.Context, userID string) error {
+	return m.MockRevokeUserSessions(ctx, userID)
+}
+
+func (m *MockServiceSession) MarkEmailVerified(ctx context.Context, userID string) error {
+	return m.MockMarkEmailVerified(ctx, userID)
+}
+
+func (m *MockServiceSession) MarkEmailUnverified(ctx context.Context, userID string) error {
+	return m.MockMarkEmailUnverified(ctx, userID)
+}
+
+func (m *MockServiceSession) UpdateUserRole(ctx context.Context, userID string, role string) error {
+	return m.MockUpdateUserRole(ctx, userID, role)
+}
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/metrics"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/scores"
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
+	"time"
+)
+
+type ModerationRepository struct {
+	db *gorm.DB
+}
+
+func NewModerationRepository(db *gorm.DB) domain.ModerationRepository {
+	return &ModerationRepository{
+		db: db,
+	}
+}
+
+type contentTable struct {
+	table     string
+	idColumn  string
+	authorCol string
+	numericID bool
+}
+
+// contentTables — таблица и колонка автора для каждого типа жалобы
+var contentTables = map[string]contentTable{
+	domain.ReportTargetReview:  {table: "reviews", idColumn: "id", authorCol: "\"userId\"", numericID: true},
+	domain.ReportTargetAd:      {table: "ads", idColumn: "uuid", authorCol: "\"authorUUID\""},
+	domain.ReportTargetMessage: {table: "messages", idColumn: "id", authorCol: "\"senderId\"", numericID: true},
+}
+
+// key приводит id из жалобы к типу колонки таблицы
+func (c contentTable) key(targetID string) (interface{}, error) {
+	if !c.numericID {
+		return targetID, nil
+	}
+	id, err := strconv.Atoi(targetID)
+	if err != nil {
+		return nil, errors.New("invalid report target")
+	}
+	return id, nil
+}
+
+func (r *ModerationRepository) GetContentAuthor(ctx context.Context, targetType, targetID string) (string, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("GetContentAuthor called", zap.String("targetType", targetType), zap.String("targetID", targetID), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("GetContentAuthor", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("GetContentAuthor", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("GetContentAuthor").Observe(duration)
+	}()
+	content, ok := contentTables[targetType]
+	if !ok {
+		err = errors.New("invalid report target")
+		return "", err
+	}
+	key, err := content.key(targetID)
+	if err != nil {
+		return "", err
+	}
+
+	var authors []string
+	if err = r.db.Table(content.table).
+		Where(content.idColumn+" = ? AND NOT hidden", key).
+		Limit(1).
+		Pluck(content.authorCol, &authors).Error; err != nil {
+		logger.DBLogger.Error("Error finding reported content", zap.String("targetID", targetID), zap.String("request_id", requestID), zap.Error(err))
+		err = errors.New("error finding reported content")
+		return "", err
+	}
+	if len(authors) == 0 {
+		err = errors.New("reported content not found")
+		return "", err
+	}
+	return authors[0], nil
+}
+
+func (r *ModerationRepository) CreateReport(ctx context.Context, report *domain.Report) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("CreateReport called", zap.String("reporterID", report.ReporterID), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("CreateReport", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("CreateReport", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("CreateReport").Observe(duration)
+	}()
+	// Одна открытая жалоба от пользователя на один и тот же контент
+	var count int64
+	if err = r.db.Model(&domain.Report{}).
+		Where("\"reporterId\" = ? AND \"targetType\" = ? AND \"targetId\" = ? AND status = ?",
+			report.ReporterID, report.TargetType, report.TargetID, domain.ReportStatusOpen).
+		Count(&count).Error; err != nil {
+		logger.DBLogger.Error("Error finding report", zap.String("request_id", requestID), zap.Error(err))
+		err = errors.New("error finding report")
+		return err
+	}
+	if count > 0 {
+		err = errors.New("report already exist")
+		return err
+	}
+
+	if err = r.db.Create(report).Error; err != nil {
+		logger.DBLogger.Error("Error creating report", zap.String("request_id", requestID), zap.Error(err))
+		err = errors.New("error creating report")
+		return err
+	}
+	return nil
+}
+
+func (r *ModerationRepository) GetReports(ctx context.Context, status string, after *domain.ReportCursor, limit int) ([]domain.Report, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("GetReports called", zap.String("status", status), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("GetReports", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("GetReports", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("GetReports").Observe(duration)
+	}()
+	var reports []domain.Report
+	query := r.db.Model(&domain.Report{}).Where("status = ?", status)
+	if after != nil {
+		query = query.Where("id > ?", after.ID)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if err = query.Order("id ASC").Find(&reports).Error; err != nil {
+		logger.DBLogger.Error("Error fetching reports", zap.String("request_id", requestID), zap.Error(err))
+		err = errors.New("error fetching reports")
+		return nil, err
+	}
+	return reports, nil
+}
+
+func (r *ModerationRepository) GetReportByID(ctx context.Context, reportID int) (*domain.Report, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("GetReportByID called", zap.Int("reportID", reportID), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("GetReportByID", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("GetReportByID", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("GetReportByID").Observe(duration)
+	}()
+	var report domain.Report
+	if err = r.db.Where("id = ?", reportID).First(&report).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = errors.New("report not found")
+			return nil, err
+		}
+		logger.DBLogger.Error("Error finding report", zap.Int("reportID", reportID), zap.String("request_id", requestID), zap.Error(err))
+		err = errors.New("error finding report")
+		return nil, err
+	}
+	return &report, nil
+}
+
+func (r *ModerationRepository) ResolveReports(ctx context.Context, report *domain.Report, status string, moderatorID string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("ResolveReports called", zap.Int("reportID", report.ID), zap.String("status", status), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("ResolveReports", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("ResolveReports", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("ResolveReports").Observe(duration)
+	}()
+	content, ok := contentTables[report.TargetType]
+	if !ok {
+		err = errors.New("invalid report target")
+		return err
+	}
+	key, err := content.key(report.TargetID)
+	if err != nil {
+		return err
+	}
+
+	hide := status == domain.ReportStatusHidden || status == domain.ReportStatusBanned
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		// скрытый отзыв перестаёт учитываться в оценках. Строка хозяина блокируется до изменения отзыва,
+		// в том же порядке, что и в сервисе отзывов
+		var review domain.Review
+		rescore := hide && report.TargetType == domain.ReportTargetReview
+		if rescore {
+			if err := tx.Select("\"hostId\"", "\"adId\"").Where("id = ?", key).First(&review).Error; err != nil {
+				logger.DBLogger.Error("Error finding review", zap.String("targetID", report.TargetID), zap.String("request_id", requestID), zap.Error(err))
+				return errors.New("error finding review")
+			}
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("uuid").Where("uuid = ?", review.HostID).First(&domain.User{}).Error; err != nil {
+				logger.DBLogger.Error("Error locking host", zap.String("hostId", review.HostID), zap.String("request_id", requestID), zap.Error(err))
+				return errors.New("error finding host")
+			}
+		}
+		if hide {
+			// hidden пишется только здесь, поэтому в моделях колонка закрыта от записи через gorm
+			if err := tx.Exec("UPDATE "+content.table+" SET hidden = true WHERE "+content.idColumn+" = ?", key).Error; err != nil {
+				logger.DBLogger.Error("Error hiding content", zap.String("targetID", report.TargetID), zap.String("request_id", requestID), zap.Error(err))
+				return errors.New("error hiding content")
+			}
+		}
+		if rescore {
+			if review.AdID != nil {
+				if err := scores.UpdateAd(tx, *review.AdID); err != nil {
+					logger.DBLogger.Error("Error updating ad score", zap.String("adId", *review.AdID), zap.String("request_id", requestID), zap.Error(err))
+					return errors.New("error updating ad score")
+				}
+			}
+			if err := scores.UpdateHost(tx, review.HostID); err != nil {
+				logger.DBLogger.Error("Error updating host score", zap.String("hostId", review.HostID), zap.String("request_id", requestID), zap.Error(err))
+				return errors.New("error updating host score")
+			}
+		}
+		if status == domain.ReportStatusBanned {
+			if err := tx.Exec("UPDATE users SET \"isBanned\" = true WHERE uuid = ?", report.AuthorID).Error; err != nil {
+				logger.DBLogger.Error("Error banning user", zap.String("authorID", report.AuthorID), zap.String("request_id", requestID), zap.Error(err))
+				return errors.New("error banning user")
+			}
+		}
+		if err := tx.Model(&domain.Report{}).
+			Where("\"targetType\" = ? AND \"targetId\" = ? AND status = ?", report.TargetType, report.TargetID, domain.ReportStatusOpen).
+			Updates(map[string]interface{}{
+				"status":     status,
+				"resolvedAt": time.Now(),
+				"resolvedBy": moderatorID,
+			}).Error; err != nil {
+			logger.DBLogger.Error("Error resolving reports", zap.Int("reportID", report.ID), zap.String("request_id", requestID), zap.Error(err))
+			return errors.New("error resolving reports")
+		}
+		return nil
+	})
+	return err
+}
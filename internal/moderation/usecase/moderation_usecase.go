@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/pagination"
+	"2024_2_FIGHT-CLUB/internal/service/session"
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type ModerationUsecase interface {
+	CreateReport(ctx context.Context, reporterID string, request domain.CreateReportRequest) (*domain.Report, error)
+	GetReports(ctx context.Context, status string, cursor *domain.ReportCursor) ([]domain.Report, string, error)
+	// ResolveReport применяет решение модератора: hide, dismiss или ban
+	ResolveReport(ctx context.Context, moderatorID string, reportID int, action string) error
+}
+
+type moderationUsecase struct {
+	repository     domain.ModerationRepository
+	sessionService session.InterfaceSession
+}
+
+func NewModerationUsecase(repository domain.ModerationRepository, sessionService session.InterfaceSession) ModerationUsecase {
+	return &moderationUsecase{
+		repository:     repository,
+		sessionService: sessionService,
+	}
+}
+
+const reportsPageSize = 20
+
+// Решение модератора и статус, в который переходят жалобы
+var reportActions = map[string]string{
+	"hide":    domain.ReportStatusHidden,
+	"dismiss": domain.ReportStatusDismissed,
+	"ban":     domain.ReportStatusBanned,
+}
+
+func (u *moderationUsecase) CreateReport(ctx context.Context, reporterID string, request domain.CreateReportRequest) (*domain.Report, error) {
+	requestID := middleware.GetRequestID(ctx)
+	const maxLenReason = 500
+	switch request.TargetType {
+	case domain.ReportTargetReview, domain.ReportTargetAd, domain.ReportTargetMessage:
+	default:
+		logger.AccessLogger.Warn("Invalid report target", zap.String("request_id", requestID))
+		return nil, errors.New("invalid report target")
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9\-]+$`).MatchString(request.TargetID) ||
+		!regexp.MustCompile(`^[a-zA-Zа-яА-Я0-9@.,\s\-!?:;_/()]*$`).MatchString(request.Reason) {
+		logger.AccessLogger.Warn("Input contains invalid characters", zap.String("request_id", requestID))
+		return nil, errors.New("input contains invalid characters")
+	}
+
+	if strings.TrimSpace(request.Reason) == "" {
+		return nil, errors.New("report reason is empty")
+	}
+	if len(request.Reason) > maxLenReason || len(request.TargetID) > 255 {
+		logger.AccessLogger.Warn("Input exceeds character limit", zap.String("request_id", requestID))
+		return nil, errors.New("input exceeds character limit")
+	}
+
+	authorID, err := u.repository.GetContentAuthor(ctx, request.TargetType, request.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if authorID == reporterID {
+		return nil, errors.New("cannot report own content")
+	}
+
+	report := &domain.Report{
+		ReporterID: reporterID,
+		TargetType: request.TargetType,
+		TargetID:   request.TargetID,
+		AuthorID:   authorID,
+		Reason:     request.Reason,
+		Status:     domain.ReportStatusOpen,
+		CreatedAt:  time.Now(),
+	}
+	if err = u.repository.CreateReport(ctx, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (u *moderationUsecase) GetReports(ctx context.Context, status string, cursor *domain.ReportCursor) ([]domain.Report, string, error) {
+	if status == "" {
+		status = domain.ReportStatusOpen
+	}
+	switch status {
+	case domain.ReportStatusOpen, domain.ReportStatusHidden, domain.ReportStatusDismissed, domain.ReportStatusBanned:
+	default:
+		return nil, "", errors.New("invalid report status")
+	}
+
+	// одна лишняя запись показывает, есть ли следующая страница
+	reports, err := u.repository.GetReports(ctx, status, cursor, reportsPageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(reports) <= reportsPageSize {
+		return reports, "", nil
+	}
+	reports = reports[:reportsPageSize]
+	return reports, pagination.EncodeCursor(domain.ReportCursor{ID: reports[reportsPageSize-1].ID}), nil
+}
+
+func (u *moderationUsecase) ResolveReport(ctx context.Context, moderatorID string, reportID int, action string) error {
+	requestID := middleware.GetRequestID(ctx)
+	status, ok := reportActions[action]
+	if !ok {
+		return errors.New("invalid moderation action")
+	}
+
+	report, err := u.repository.GetReportByID(ctx, reportID)
+	if err != nil {
+		return err
+	}
+	if report.Status != domain.ReportStatusOpen {
+		return errors.New("report already resolved")
+	}
+
+	if err = u.repository.ResolveReports(ctx, report, status, moderatorID); err != nil {
+		return err
+	}
+	// бан уже сохранён, но открытые сессии иначе продолжали бы работать до истечения
+	if status == domain.ReportStatusBanned {
+		if err = u.sessionService.RevokeUserSessions(ctx, report.AuthorID); err != nil {
+			logger.AccessLogger.Error("Failed to revoke sessions of banned user",
+				zap.String("request_id", requestID),
+				zap.String("authorID", report.AuthorID),
+				zap.Error(err))
+			return err
+		}
+	}
+	logger.AccessLogger.Info("Report resolved",
+		zap.String("request_id", requestID),
+		zap.Int("reportID", reportID),
+		zap.String("action", action),
+		zap.String("moderatorID", moderatorID))
+	return nil
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/moderation/mocks"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateReport(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockModerationRepository{}
+	mockSession := &mocks.MockServiceSession{}
+	moderationUsecase := NewModerationUsecase(mockRepo, mockSession)
+	mockRepo.MockGetContentAuthor = func(ctx context.Context, targetType, targetID string) (string, error) {
+		assert.Equal(t, domain.ReportTargetReview, targetType)
+		assert.Equal(t, "42", targetID)
+		return "author1", nil
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.MockCreateReport = func(ctx context.Context, report *domain.Report) error {
+			assert.Equal(t, "reporter1", report.ReporterID)
+			assert.Equal(t, "author1", report.AuthorID)
+			assert.Equal(t, domain.ReportStatusOpen, report.Status)
+			return nil
+		}
+		report, err := moderationUsecase.CreateReport(context.Background(), "reporter1", domain.CreateReportRequest{
+			TargetType: domain.ReportTargetReview,
+			TargetID:   "42",
+			Reason:     "Spam",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Spam", report.Reason)
+	})
+
+	t.Run("Own Content", func(t *testing.T) {
+		_, err := moderationUsecase.CreateReport(context.Background(), "author1", domain.CreateReportRequest{
+			TargetType: domain.ReportTargetReview,
+			TargetID:   "42",
+			Reason:     "Spam",
+		})
+		assert.EqualError(t, err, "cannot report own content")
+	})
+
+	t.Run("Invalid Target", func(t *testing.T) {
+		_, err := moderationUsecase.CreateReport(context.Background(), "reporter1", domain.CreateReportRequest{
+			TargetType: "user",
+			TargetID:   "42",
+			Reason:     "Spam",
+		})
+		assert.EqualError(t, err, "invalid report target")
+	})
+
+	t.Run("Empty Reason", func(t *testing.T) {
+		_, err := moderationUsecase.CreateReport(context.Background(), "reporter1", domain.CreateReportRequest{
+			TargetType: domain.ReportTargetReview,
+			TargetID:   "42",
+			Reason:     "  ",
+		})
+		assert.EqualError(t, err, "report reason is empty")
+	})
+}
+
+func TestGetReports(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockModerationRepository{}
+	mockSession := &mocks.MockServiceSession{}
+	moderationUsecase := NewModerationUsecase(mockRepo, mockSession)
+
+	t.Run("Next Page", func(t *testing.T) {
+		mockRepo.MockGetReports = func(ctx context.Context, status string, after *domain.ReportCursor, limit int) ([]domain.Report, error) {
+			assert.Equal(t, domain.ReportStatusOpen, status)
+			assert.Equal(t, reportsPageSize+1, limit)
+			reports := make([]domain.Report, limit)
+			for i := range reports {
+				reports[i].ID = i + 1
+			}
+			return reports, nil
+		}
+		reports, nextCursor, err := moderationUsecase.GetReports(context.Background(), "", nil)
+		require.NoError(t, err)
+		assert.Len(t, reports, reportsPageSize)
+		assert.NotEmpty(t, nextCursor)
+	})
+
+	t.Run("Invalid Status", func(t *testing.T) {
+		_, _, err := moderationUsecase.GetReports(context.Background(), "unknown", nil)
+		assert.EqualError(t, err, "invalid report status")
+	})
+}
+
+func TestResolveReport(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockModerationRepository{}
+	mockSession := &mocks.MockServiceSession{}
+	moderationUsecase := NewModerationUsecase(mockRepo, mockSession)
+	mockRepo.MockGetReportByID = func(ctx context.Context, reportID int) (*domain.Report, error) {
+		if reportID == 2 {
+			return &domain.Report{ID: 2, Status: domain.ReportStatusDismissed}, nil
+		}
+		return &domain.Report{ID: reportID, Status: domain.ReportStatusOpen, AuthorID: "author1"}, nil
+	}
+
+	t.Run("Ban", func(t *testing.T) {
+		var revoked []string
+		mockRepo.MockResolveReports = func(ctx context.Context, report *domain.Report, status string, moderatorID string) error {
+			assert.Equal(t, 1, report.ID)
+			assert.Equal(t, domain.ReportStatusBanned, status)
+			assert.Equal(t, "admin", moderatorID)
+			assert.Empty(t, revoked, "sessions must be revoked after the ban is saved")
+			return nil
+		}
+		mockSession.MockRevokeUserSessions = func(ctx context.Context, userID string) error {
+			revoked = append(revoked, userID)
+			return nil
+		}
+		assert.NoError(t, moderationUsecase.ResolveReport(context.Background(), "admin", 1, "ban"))
+		assert.Equal(t, []string{"author1"}, revoked)
+	})
+
+	t.Run("Hide Keeps Sessions", func(t *testing.T) {
+		mockRepo.MockResolveReports = func(ctx context.Context, report *domain.Report, status string, moderatorID string) error {
+			return nil
+		}
+		mockSession.MockRevokeUserSessions = func(ctx context.Context, userID string) error {
+			t.Fatal("sessions must not be revoked when content is only hidden")
+			return nil
+		}
+		assert.NoError(t, moderationUsecase.ResolveReport(context.Background(), "admin", 1, "hide"))
+	})
+
+	t.Run("Revoke Error", func(t *testing.T) {
+		mockRepo.MockResolveReports = func(ctx context.Context, report *domain.Report, status string, moderatorID string) error {
+			return nil
+		}
+		mockSession.MockRevokeUserSessions = func(ctx context.Context, userID string) error {
+			return errors.New("failed to get user sessions")
+		}
+		err := moderationUsecase.ResolveReport(context.Background(), "admin", 1, "ban")
+		assert.EqualError(t, err, "failed to get user sessions")
+	})
+
+	t.Run("Already Resolved", func(t *testing.T) {
+		err := moderationUsecase.ResolveReport(context.Background(), "admin", 2, "hide")
+		assert.EqualError(t, err, "report already resolved")
+	})
+
+	t.Run("Invalid Action", func(t *testing.T) {
+		err := moderationUsecase.ResolveReport(context.Background(), "admin", 1, "delete")
+		assert.EqualError(t, err, "invalid moderation action")
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockRepo.MockResolveReports = func(ctx context.Context, report *domain.Report, status string, moderatorID string) error {
+			return errors.New("error resolving reports")
+		}
+		err := moderationUsecase.ResolveReport(context.Background(), "admin", 1, "hide")
+		assert.EqualError(t, err, "error resolving reports")
+	})
+}
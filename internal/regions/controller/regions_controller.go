@@ -37,12 +37,7 @@ func (rh *RegionHandler) GetVisitedRegions(w http.ResponseWriter, r *http.Reques
 	defer cancel()
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -2,48 +2,52 @@ package contoller
 
 import (
 	"2024_2_FIGHT-CLUB/domain"
-	"2024_2_FIGHT-CLUB/internal/reviews/usecase"
 	"2024_2_FIGHT-CLUB/internal/service/logger"
 	"2024_2_FIGHT-CLUB/internal/service/metrics"
 	"2024_2_FIGHT-CLUB/internal/service/middleware"
 	"2024_2_FIGHT-CLUB/internal/service/session"
+	"2024_2_FIGHT-CLUB/internal/service/utils"
+	"2024_2_FIGHT-CLUB/microservices/reviews_service/controller/gen"
 	"errors"
 	"github.com/gorilla/mux"
 	"github.com/mailru/easyjson"
-	"github.com/microcosm-cc/bluemonday"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
 	"net/http"
+	"strconv"
 	"time"
 )
 
+// ReviewHandler проксирует запросы к сервису отзывов: проверка CSRF, сессии
+// и пересчёт рейтинга хоста выполняются там
 type ReviewHandler struct {
-	usecase        usecase.ReviewUsecase
-	sessionService session.InterfaceSession
-	jwtToken       middleware.JwtTokenService
+	client gen.ReviewsServiceClient
+	utils  utils.UtilsInterface
 }
 
-func NewReviewHandler(usecase usecase.ReviewUsecase, sessionService session.InterfaceSession, jwtToken middleware.JwtTokenService) *ReviewHandler {
+func NewReviewHandler(client gen.ReviewsServiceClient, utils utils.UtilsInterface) *ReviewHandler {
 	return &ReviewHandler{
-		usecase:        usecase,
-		sessionService: sessionService,
-		jwtToken:       jwtToken,
+		client: client,
+		utils:  utils,
 	}
 }
 
+// rpcError достаёт текст ошибки сервиса, чтобы handleError подобрал код ответа
+func rpcError(err error) error {
+	if st, ok := status.FromError(err); ok {
+		return errors.New(st.Message())
+	}
+	return err
+}
+
 func (rh *ReviewHandler) CreateReview(w http.ResponseWriter, r *http.Request) {
 	start := time.Now()
 	requestID := middleware.GetRequestID(r.Context())
 	ctx, cancel := middleware.WithTimeout(r.Context())
-	sanitizer := bluemonday.UGCPolicy()
 	defer cancel()
 	var err error
 	statusCode := http.StatusCreated
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusCreated {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -73,53 +77,40 @@ func (rh *ReviewHandler) CreateReview(w http.ResponseWriter, r *http.Request) {
 	}
 
 	authHeader := r.Header.Get("X-CSRF-Token")
-	if authHeader == "" {
-		logger.AccessLogger.Warn("Missing X-CSRF-Token header",
-			zap.String("request_id", requestID),
-			zap.Error(errors.New("missing X-CSRF-Token header")),
-		)
-		err = errors.New("missing X-CSRF-Token header")
+
+	var review domain.Review
+	if err = easyjson.UnmarshalFromReader(r.Body, &review); err != nil {
+		logger.AccessLogger.Warn("Failed to unmarshal review", zap.String("request_id", requestID), zap.Error(err))
 		statusCode = rh.handleError(w, err, requestID)
 		return
 	}
-
-	tokenString := authHeader[len("Bearer "):]
-	_, err = rh.jwtToken.Validate(tokenString, sessionID)
-	if err != nil {
-		logger.AccessLogger.Warn("Invalid JWT token", zap.String("request_id", requestID), zap.Error(err))
-		statusCode = rh.handleError(w, errors.New("invalid JWT token"), requestID)
-		return
+	// POST /housing/{adId}/reviews - отзыв об объявлении
+	if adId, ok := mux.Vars(r)["adId"]; ok {
+		review.AdID = &adId
 	}
 
-	userId, err := rh.sessionService.GetUserID(ctx, sessionID)
+	response, err := rh.client.CreateReview(ctx, &gen.CreateReviewRequest{
+		SessionId:  sessionID,
+		AuthHeader: authHeader,
+		Review:     reviewToProto(&review),
+	})
 	if err != nil {
-		logger.AccessLogger.Warn("Failed to get user ID", zap.String("request_id", requestID), zap.Error(err))
-		statusCode = rh.handleError(w, err, requestID)
-		return
-	}
-
-	var review domain.Review
-	if err := easyjson.UnmarshalFromReader(r.Body, &review); err != nil {
-		logger.AccessLogger.Warn("Failed to unmarshal review", zap.String("request_id", requestID), zap.Error(err))
+		logger.AccessLogger.Warn("Failed to create review", zap.String("request_id", requestID), zap.Error(err))
+		err = rpcError(err)
 		statusCode = rh.handleError(w, err, requestID)
 		return
 	}
 
-	review.Text = sanitizer.Sanitize(review.Text)
-	review.Title = sanitizer.Sanitize(review.Title)
-	review.HostID = sanitizer.Sanitize(review.HostID)
-	review.UserID = sanitizer.Sanitize(review.UserID)
-
-	err = rh.usecase.CreateReview(ctx, &review, userId)
+	created, err := rh.utils.ConvertReviewProtoToGo(response.Review)
 	if err != nil {
-		logger.AccessLogger.Warn("Failed to create review", zap.String("request_id", requestID), zap.Error(err))
+		logger.AccessLogger.Warn("Failed to convert review", zap.String("request_id", requestID), zap.Error(err))
 		statusCode = rh.handleError(w, err, requestID)
 		return
 	}
 
 	w.WriteHeader(http.StatusCreated)
 	body := domain.ReviewBody{
-		Review: review,
+		Review: created,
 	}
 	if _, err = easyjson.MarshalToWriter(&body, w); err != nil {
 		logger.AccessLogger.Warn("Failed to encode response", zap.String("request_id", requestID), zap.Error(err))
@@ -143,12 +134,7 @@ func (rh *ReviewHandler) GetUserReviews(w http.ResponseWriter, r *http.Request)
 	defer cancel()
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -159,8 +145,6 @@ func (rh *ReviewHandler) GetUserReviews(w http.ResponseWriter, r *http.Request)
 		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
 	}()
 	userId := mux.Vars(r)["userId"]
-	sanitizer := bluemonday.UGCPolicy()
-	userId = sanitizer.Sanitize(userId)
 
 	logger.AccessLogger.Info("Received GetUserReviews request",
 		zap.String("request_id", requestID),
@@ -171,11 +155,20 @@ func (rh *ReviewHandler) GetUserReviews(w http.ResponseWriter, r *http.Request)
 
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 
-	var reviews domain.UserReviewsList
-
-	reviews, err = rh.usecase.GetUserReviews(ctx, userId)
+	response, err := rh.client.GetUserReviews(ctx, &gen.GetUserReviewsRequest{
+		UserId: userId,
+		Cursor: r.URL.Query().Get("cursor"),
+	})
 	if err != nil {
 		logger.AccessLogger.Warn("Failed to get user reviews", zap.String("request_id", requestID), zap.Error(err))
+		err = rpcError(err)
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	reviews, err := rh.utils.ConvertUserReviewsProtoToGo(response)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to convert reviews", zap.String("request_id", requestID), zap.Error(err))
 		statusCode = rh.handleError(w, err, requestID)
 		return
 	}
@@ -193,20 +186,75 @@ func (rh *ReviewHandler) GetUserReviews(w http.ResponseWriter, r *http.Request)
 		zap.Int("status", http.StatusOK))
 }
 
-func (rh *ReviewHandler) DeleteReview(w http.ResponseWriter, r *http.Request) {
-	sanitizer := bluemonday.UGCPolicy()
+func (rh *ReviewHandler) GetAdReviews(w http.ResponseWriter, r *http.Request) {
 	start := time.Now()
 	requestID := middleware.GetRequestID(r.Context())
 	ctx, cancel := middleware.WithTimeout(r.Context())
+
 	defer cancel()
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		sanitizedPath := metrics.SanitizeAdIdPath(r.URL.Path)
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, sanitizedPath, clientIP).Observe(duration)
+	}()
+	adId := mux.Vars(r)["adId"]
+
+	logger.AccessLogger.Info("Received GetAdReviews request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+		zap.String("query", r.URL.Query().Encode()),
+	)
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	response, err := rh.client.GetAdReviews(ctx, &gen.GetAdReviewsRequest{
+		AdId:   adId,
+		Cursor: r.URL.Query().Get("cursor"),
+	})
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to get ad reviews", zap.String("request_id", requestID), zap.Error(err))
+		err = rpcError(err)
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	reviews, err := rh.utils.ConvertUserReviewsProtoToGo(response)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to convert reviews", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if _, err = easyjson.MarshalToWriter(&reviews, w); err != nil {
+		logger.AccessLogger.Warn("Failed to encode response", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = rh.handleError(w, err, requestID)
+		return
 	}
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed GetAdReviews request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK))
+}
+
+func (rh *ReviewHandler) DeleteReview(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -218,7 +266,6 @@ func (rh *ReviewHandler) DeleteReview(w http.ResponseWriter, r *http.Request) {
 	}()
 
 	hostId := mux.Vars(r)["hostId"]
-	hostId = sanitizer.Sanitize(hostId)
 
 	logger.AccessLogger.Info("Received DeleteReview request",
 		zap.String("request_id", requestID),
@@ -237,34 +284,15 @@ func (rh *ReviewHandler) DeleteReview(w http.ResponseWriter, r *http.Request) {
 	}
 
 	authHeader := r.Header.Get("X-CSRF-Token")
-	if authHeader == "" {
-		logger.AccessLogger.Warn("Missing X-CSRF-Token header",
-			zap.String("request_id", requestID),
-			zap.Error(errors.New("missing X-CSRF-Token header")),
-		)
-		err = errors.New("missing X-CSRF-Token header")
-		statusCode = rh.handleError(w, err, requestID)
-		return
-	}
-
-	tokenString := authHeader[len("Bearer "):]
-	_, err = rh.jwtToken.Validate(tokenString, sessionID)
-	if err != nil {
-		logger.AccessLogger.Warn("Invalid JWT token", zap.String("request_id", requestID), zap.Error(err))
-		statusCode = rh.handleError(w, errors.New("invalid JWT token"), requestID)
-		return
-	}
 
-	userId, err := rh.sessionService.GetUserID(ctx, sessionID)
-	if err != nil {
-		logger.AccessLogger.Warn("Failed to get user ID", zap.String("request_id", requestID), zap.Error(err))
-		statusCode = rh.handleError(w, err, requestID)
-		return
-	}
-
-	err = rh.usecase.DeleteReview(ctx, userId, hostId)
+	_, err = rh.client.DeleteReview(ctx, &gen.DeleteReviewRequest{
+		SessionId:  sessionID,
+		AuthHeader: authHeader,
+		HostId:     hostId,
+	})
 	if err != nil {
 		logger.AccessLogger.Warn("Failed to delete review", zap.String("request_id", requestID), zap.Error(err))
+		err = rpcError(err)
 		statusCode = rh.handleError(w, err, requestID)
 		return
 	}
@@ -292,12 +320,7 @@ func (rh *ReviewHandler) UpdateReview(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 	var err error
 	statusCode := http.StatusOK
-	clientIP := r.RemoteAddr
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		clientIP = realIP
-	} else if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		clientIP = forwarded
-	}
+	clientIP := middleware.ClientIP(r)
 	defer func() {
 		if statusCode == http.StatusOK {
 			metrics.HttpRequestsTotal.WithLabelValues(r.Method, r.URL.Path, http.StatusText(statusCode), clientIP).Inc()
@@ -307,10 +330,8 @@ func (rh *ReviewHandler) UpdateReview(w http.ResponseWriter, r *http.Request) {
 		duration := time.Since(start).Seconds()
 		metrics.HttpRequestDuration.WithLabelValues(r.Method, r.URL.Path, clientIP).Observe(duration)
 	}()
-	sanitizer := bluemonday.UGCPolicy()
 
 	hostId := mux.Vars(r)["hostId"]
-	hostId = sanitizer.Sanitize(hostId)
 
 	logger.AccessLogger.Info("Received UpdateReview request",
 		zap.String("request_id", requestID),
@@ -329,46 +350,111 @@ func (rh *ReviewHandler) UpdateReview(w http.ResponseWriter, r *http.Request) {
 	}
 
 	authHeader := r.Header.Get("X-CSRF-Token")
-	if authHeader == "" {
-		logger.AccessLogger.Warn("Missing X-CSRF-Token header",
-			zap.String("request_id", requestID),
-			zap.Error(errors.New("missing X-CSRF-Token header")),
-		)
-		err = errors.New("missing X-CSRF-Token header")
+
+	var updatedReview domain.Review
+	if err = easyjson.UnmarshalFromReader(r.Body, &updatedReview); err != nil {
+		logger.AccessLogger.Warn("Failed to unmarshal review", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	_, err = rh.client.UpdateReview(ctx, &gen.UpdateReviewRequest{
+		SessionId:  sessionID,
+		AuthHeader: authHeader,
+		HostId:     hostId,
+		Review:     reviewToProto(&updatedReview),
+	})
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to update review", zap.String("request_id", requestID), zap.Error(err))
+		err = rpcError(err)
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	response := domain.ResponseMessage{Message: "updated successfully"}
+	if _, err = easyjson.MarshalToWriter(&response, w); err != nil {
+		logger.AccessLogger.Warn("Failed to encode response", zap.String("request_id", requestID), zap.Error(err))
 		statusCode = rh.handleError(w, err, requestID)
 		return
 	}
 
-	tokenString := authHeader[len("Bearer "):]
-	_, err = rh.jwtToken.Validate(tokenString, sessionID)
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed UpdateReview request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK),
+	)
+}
+
+// reviewIDFromPath достаёт числовой id отзыва из /reviews/{reviewId} и /reviews/{reviewId}/reply
+func reviewIDFromPath(r *http.Request) (int, error) {
+	reviewID, err := strconv.Atoi(mux.Vars(r)["reviewId"])
+	if err != nil || reviewID <= 0 {
+		return 0, errors.New("invalid review id")
+	}
+	return reviewID, nil
+}
+
+func (rh *ReviewHandler) UpdateReviewByID(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		sanitizedPath := metrics.SanitizeReviewIdPath(r.URL.Path)
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, sanitizedPath, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received UpdateReviewByID request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	reviewID, err := reviewIDFromPath(r)
 	if err != nil {
-		logger.AccessLogger.Warn("Invalid JWT token", zap.String("request_id", requestID), zap.Error(err))
-		statusCode = rh.handleError(w, errors.New("invalid JWT token"), requestID)
+		statusCode = rh.handleError(w, err, requestID)
 		return
 	}
 
-	userId, err := rh.sessionService.GetUserID(ctx, sessionID)
+	sessionID, err := session.GetSessionId(r)
 	if err != nil {
-		logger.AccessLogger.Warn("Failed to get user ID", zap.String("request_id", requestID), zap.Error(err))
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
 		statusCode = rh.handleError(w, err, requestID)
 		return
 	}
 
+	authHeader := r.Header.Get("X-CSRF-Token")
+
 	var updatedReview domain.Review
-	if err := easyjson.UnmarshalFromReader(r.Body, &updatedReview); err != nil {
+	if err = easyjson.UnmarshalFromReader(r.Body, &updatedReview); err != nil {
 		logger.AccessLogger.Warn("Failed to unmarshal review", zap.String("request_id", requestID), zap.Error(err))
 		statusCode = rh.handleError(w, err, requestID)
 		return
 	}
 
-	updatedReview.Text = sanitizer.Sanitize(updatedReview.Text)
-	updatedReview.Title = sanitizer.Sanitize(updatedReview.Title)
-	updatedReview.HostID = sanitizer.Sanitize(updatedReview.HostID)
-	updatedReview.UserID = sanitizer.Sanitize(updatedReview.UserID)
-
-	err = rh.usecase.UpdateReview(ctx, userId, hostId, &updatedReview)
+	_, err = rh.client.UpdateReviewByID(ctx, &gen.UpdateReviewByIDRequest{
+		SessionId:  sessionID,
+		AuthHeader: authHeader,
+		ReviewId:   int32(reviewID),
+		Review:     reviewToProto(&updatedReview),
+	})
 	if err != nil {
 		logger.AccessLogger.Warn("Failed to update review", zap.String("request_id", requestID), zap.Error(err))
+		err = rpcError(err)
 		statusCode = rh.handleError(w, err, requestID)
 		return
 	}
@@ -383,13 +469,337 @@ func (rh *ReviewHandler) UpdateReview(w http.ResponseWriter, r *http.Request) {
 	}
 
 	duration := time.Since(start)
-	logger.AccessLogger.Info("Completed UpdateReview request",
+	logger.AccessLogger.Info("Completed UpdateReviewByID request",
 		zap.String("request_id", requestID),
 		zap.Duration("duration", duration),
 		zap.Int("status", http.StatusOK),
 	)
 }
 
+func (rh *ReviewHandler) DeleteReviewByID(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		sanitizedPath := metrics.SanitizeReviewIdPath(r.URL.Path)
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, sanitizedPath, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received DeleteReviewByID request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	reviewID, err := reviewIDFromPath(r)
+	if err != nil {
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	authHeader := r.Header.Get("X-CSRF-Token")
+
+	_, err = rh.client.DeleteReviewByID(ctx, &gen.DeleteReviewByIDRequest{
+		SessionId:  sessionID,
+		AuthHeader: authHeader,
+		ReviewId:   int32(reviewID),
+	})
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to delete review", zap.String("request_id", requestID), zap.Error(err))
+		err = rpcError(err)
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	response := domain.ResponseMessage{Message: "deleted successfully"}
+	if _, err = easyjson.MarshalToWriter(&response, w); err != nil {
+		logger.AccessLogger.Warn("Failed to encode response", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed DeleteReviewByID request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK))
+}
+
+func (rh *ReviewHandler) CreateReply(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusCreated
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		sanitizedPath := metrics.SanitizeReviewIdPath(r.URL.Path)
+		if statusCode == http.StatusCreated {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, sanitizedPath, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received CreateReply request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	reviewID, err := reviewIDFromPath(r)
+	if err != nil {
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	authHeader := r.Header.Get("X-CSRF-Token")
+
+	var reply domain.ReviewReply
+	if err = easyjson.UnmarshalFromReader(r.Body, &reply); err != nil {
+		logger.AccessLogger.Warn("Failed to unmarshal reply", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	_, err = rh.client.CreateReply(ctx, &gen.ReplyRequest{
+		SessionId:  sessionID,
+		AuthHeader: authHeader,
+		ReviewId:   int32(reviewID),
+		Text:       reply.Text,
+	})
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to create reply", zap.String("request_id", requestID), zap.Error(err))
+		err = rpcError(err)
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusCreated)
+	response := domain.ResponseMessage{Message: "reply created successfully"}
+	if _, err = easyjson.MarshalToWriter(&response, w); err != nil {
+		logger.AccessLogger.Warn("Failed to encode response", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed CreateReply request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusCreated))
+}
+
+func (rh *ReviewHandler) UpdateReply(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		sanitizedPath := metrics.SanitizeReviewIdPath(r.URL.Path)
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, sanitizedPath, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received UpdateReply request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	reviewID, err := reviewIDFromPath(r)
+	if err != nil {
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	authHeader := r.Header.Get("X-CSRF-Token")
+
+	var reply domain.ReviewReply
+	if err = easyjson.UnmarshalFromReader(r.Body, &reply); err != nil {
+		logger.AccessLogger.Warn("Failed to unmarshal reply", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	_, err = rh.client.UpdateReply(ctx, &gen.ReplyRequest{
+		SessionId:  sessionID,
+		AuthHeader: authHeader,
+		ReviewId:   int32(reviewID),
+		Text:       reply.Text,
+	})
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to update reply", zap.String("request_id", requestID), zap.Error(err))
+		err = rpcError(err)
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	response := domain.ResponseMessage{Message: "reply updated successfully"}
+	if _, err = easyjson.MarshalToWriter(&response, w); err != nil {
+		logger.AccessLogger.Warn("Failed to encode response", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed UpdateReply request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK))
+}
+
+func (rh *ReviewHandler) DeleteReply(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(r.Context())
+	ctx, cancel := middleware.WithTimeout(r.Context())
+	defer cancel()
+	var err error
+	statusCode := http.StatusOK
+	clientIP := middleware.ClientIP(r)
+	defer func() {
+		sanitizedPath := metrics.SanitizeReviewIdPath(r.URL.Path)
+		if statusCode == http.StatusOK {
+			metrics.HttpRequestsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), clientIP).Inc()
+		} else {
+			metrics.HttpErrorsTotal.WithLabelValues(r.Method, sanitizedPath, http.StatusText(statusCode), err.Error(), clientIP).Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.HttpRequestDuration.WithLabelValues(r.Method, sanitizedPath, clientIP).Observe(duration)
+	}()
+
+	logger.AccessLogger.Info("Received DeleteReply request",
+		zap.String("request_id", requestID),
+		zap.String("method", r.Method),
+		zap.String("url", r.URL.String()),
+	)
+
+	reviewID, err := reviewIDFromPath(r)
+	if err != nil {
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	sessionID, err := session.GetSessionId(r)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get session ID",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	authHeader := r.Header.Get("X-CSRF-Token")
+
+	_, err = rh.client.DeleteReply(ctx, &gen.DeleteReplyRequest{
+		SessionId:  sessionID,
+		AuthHeader: authHeader,
+		ReviewId:   int32(reviewID),
+	})
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to delete reply", zap.String("request_id", requestID), zap.Error(err))
+		err = rpcError(err)
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	response := domain.ResponseMessage{Message: "reply deleted successfully"}
+	if _, err = easyjson.MarshalToWriter(&response, w); err != nil {
+		logger.AccessLogger.Warn("Failed to encode response", zap.String("request_id", requestID), zap.Error(err))
+		statusCode = rh.handleError(w, err, requestID)
+		return
+	}
+
+	duration := time.Since(start)
+	logger.AccessLogger.Info("Completed DeleteReply request",
+		zap.String("request_id", requestID),
+		zap.Duration("duration", duration),
+		zap.Int("status", http.StatusOK))
+}
+
+func reviewToProto(review *domain.Review) *gen.Review {
+	converted := &gen.Review{
+		UserId: review.UserID,
+		HostId: review.HostID,
+		Title:  review.Title,
+		Text:   review.Text,
+		Rating: int32(review.Rating),
+	}
+	if review.AdID != nil {
+		converted.AdId = *review.AdID
+	}
+	return converted
+}
+
+// reviewNotAllowed описывает, почему гость пока не может оставить отзыв
+var reviewNotAllowed = map[string]domain.ReviewNotAllowedResponse{
+	"no completed stay": {
+		Error:   "review not allowed",
+		Reason:  domain.ReviewReasonNoCompletedStay,
+		Message: "Reviews can only be left after a completed stay",
+	},
+	"review window closed": {
+		Error:   "review not allowed",
+		Reason:  domain.ReviewReasonWindowClosed,
+		Message: "The review window for this stay has closed",
+	},
+}
+
 func (rh *ReviewHandler) handleError(w http.ResponseWriter, err error, requestID string) int {
 	logger.AccessLogger.Error("Handling error",
 		zap.String("request_id", requestID),
@@ -398,6 +808,16 @@ func (rh *ReviewHandler) handleError(w http.ResponseWriter, err error, requestID
 
 	var statusCode int
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if notAllowed, ok := reviewNotAllowed[err.Error()]; ok {
+		w.WriteHeader(http.StatusForbidden)
+		if _, jsonErr := easyjson.MarshalToWriter(&notAllowed, w); jsonErr != nil {
+			logger.AccessLogger.Error("Failed to encode error response",
+				zap.String("request_id", requestID),
+				zap.Error(jsonErr),
+			)
+		}
+		return http.StatusForbidden
+	}
 	errorResponse := domain.ErrorResponse{
 		Error: err.Error(),
 	}
@@ -405,20 +825,29 @@ func (rh *ReviewHandler) handleError(w http.ResponseWriter, err error, requestID
 	switch err.Error() {
 	case "input contains invalid characters",
 		"score out of range",
-		"input exceeds character limit":
+		"input exceeds character limit",
+		"invalid cursor",
+		"invalid review id",
+		"reply text is empty":
 
 		statusCode = http.StatusBadRequest
 
 	case "host and user are the same",
-		"review already exist":
+		"review already exist",
+		"reply already exist":
 		statusCode = http.StatusConflict
 
 	case "user not found",
+		"ad not found",
 		"review not found",
+		"reply not found",
 		"session not found",
 		"no reviews found":
 		statusCode = http.StatusNotFound
 
+	case "only the reviewed host can reply":
+		statusCode = http.StatusForbidden
+
 	case "token invalid",
 		"token expired",
 		"bad sign method",
@@ -436,7 +865,11 @@ func (rh *ReviewHandler) handleError(w http.ResponseWriter, err error, requestID
 		"error updating review",
 		"error finding review",
 		"error finding host",
+		"error finding ad",
+		"error updating ad score",
 		"error updating host score",
+		"error finding completed stay",
+		"error updating review reply",
 		"error fetching reviews",
 		"error fetching user by ID":
 		statusCode = http.StatusInternalServerError
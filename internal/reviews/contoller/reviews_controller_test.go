@@ -2,15 +2,17 @@ package contoller
 
 import (
 	"2024_2_FIGHT-CLUB/domain"
-	"2024_2_FIGHT-CLUB/internal/reviews/mocks"
 	"2024_2_FIGHT-CLUB/internal/service/logger"
-	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/utils"
+	"2024_2_FIGHT-CLUB/microservices/reviews_service/controller/gen"
+	"2024_2_FIGHT-CLUB/microservices/reviews_service/mocks"
 	"bytes"
-	"context"
-	"errors"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -24,24 +26,18 @@ func TestCreateReview(t *testing.T) {
 			return
 		}
 	}()
-	mockJwtService := &mocks.MockJwtTokenService{}
-	mockSessionService := &mocks.MockServiceSession{}
-	mockReviewUsecase := &mocks.MockReviewsUsecase{}
-
-	handler := NewReviewHandler(mockReviewUsecase, mockSessionService, mockJwtService)
 
 	t.Run("Successful Review Creation", func(t *testing.T) {
-		mockJwtService.MockValidate = func(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error) {
-			return &middleware.JwtCsrfClaims{}, nil
-		}
-		mockSessionService.MockGetUserID = func(ctx context.Context, sessionID string) (string, error) {
-			return "test-user-id", nil
-		}
-		mockReviewUsecase.MockCreateReview = func(ctx context.Context, review *domain.Review, userId string) error {
-			assert.Equal(t, "test-user-id", userId)
-			assert.Equal(t, "Test Review", review.Title)
-			return nil
-		}
+		mockClient := new(mocks.MockGrpcClient)
+		mockUtils := new(utils.MockUtils)
+		handler := NewReviewHandler(mockClient, mockUtils)
+
+		response := &gen.ReviewResponse{Review: &gen.Review{Title: "Test Review", UserId: "test-user-id"}}
+		mockClient.On("CreateReview", mock.Anything, mock.MatchedBy(func(in *gen.CreateReviewRequest) bool {
+			return in.SessionId == "test-session-id" && in.AuthHeader == "Bearer valid-token" && in.Review.Title == "Test Review"
+		}), mock.Anything).Return(response, nil)
+		mockUtils.On("ConvertReviewProtoToGo", response.Review).
+			Return(domain.Review{Title: "Test Review", UserID: "test-user-id"}, nil)
 
 		reviewPayload := `{"title":"Test Review","text":"Review Content","host_id":"host1","user_id":"user1"}`
 		request := httptest.NewRequest(http.MethodPost, "/reviews", bytes.NewReader([]byte(reviewPayload)))
@@ -52,9 +48,43 @@ func TestCreateReview(t *testing.T) {
 		handler.CreateReview(responseRecorder, request)
 
 		assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+		assert.Contains(t, responseRecorder.Body.String(), `"userId":"test-user-id"`)
+		mockClient.AssertExpectations(t)
+		mockUtils.AssertExpectations(t)
+	})
+
+	t.Run("Ad Review", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		mockUtils := new(utils.MockUtils)
+		handler := NewReviewHandler(mockClient, mockUtils)
+
+		response := &gen.ReviewResponse{Review: &gen.Review{AdId: "ad-uuid", HostId: "host"}}
+		mockClient.On("CreateReview", mock.Anything, mock.MatchedBy(func(in *gen.CreateReviewRequest) bool {
+			return in.Review.AdId == "ad-uuid"
+		}), mock.Anything).Return(response, nil)
+		adID := "ad-uuid"
+		mockUtils.On("ConvertReviewProtoToGo", response.Review).
+			Return(domain.Review{AdID: &adID, HostID: "host"}, nil)
+
+		request := httptest.NewRequest(http.MethodPost, "/housing/ad-uuid/reviews", bytes.NewBufferString(`{"title":"Nice","rating":4}`))
+		request = mux.SetURLVars(request, map[string]string{"adId": "ad-uuid"})
+		request.Header.Set("Cookie", "session_id=test-session-id")
+		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
+
+		responseRecorder := httptest.NewRecorder()
+		handler.CreateReview(responseRecorder, request)
+
+		assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+		assert.Contains(t, responseRecorder.Body.String(), `"adId":"ad-uuid"`)
+		mockClient.AssertExpectations(t)
 	})
 
 	t.Run("Missing CSRF Token", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("CreateReview", mock.Anything, mock.Anything, mock.Anything).
+			Return((*gen.ReviewResponse)(nil), status.Error(codes.Unauthenticated, "missing X-CSRF-Token header"))
+
 		reviewPayload := `{"title":"Test Review","text":"Review Content"}`
 		request := httptest.NewRequest(http.MethodPost, "/reviews", bytes.NewReader([]byte(reviewPayload)))
 		request.Header.Set("Cookie", "session_id=test-session-id")
@@ -65,56 +95,66 @@ func TestCreateReview(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, responseRecorder.Code)
 	})
 
-	t.Run("Invalid JWT Token", func(t *testing.T) {
-		mockJwtService.MockValidate = func(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error) {
-			return nil, errors.New("invalid JWT token")
-		}
+	t.Run("Review Already Exists", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("CreateReview", mock.Anything, mock.Anything, mock.Anything).
+			Return((*gen.ReviewResponse)(nil), status.Error(codes.AlreadyExists, "review already exist"))
 
 		reviewPayload := `{"title":"Test Review","text":"Review Content"}`
 		request := httptest.NewRequest(http.MethodPost, "/reviews", bytes.NewReader([]byte(reviewPayload)))
 		request.Header.Set("Cookie", "session_id=test-session-id")
-		request.Header.Set("X-CSRF-Token", "Bearer invalid-token")
+		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
 
 		responseRecorder := httptest.NewRecorder()
 		handler.CreateReview(responseRecorder, request)
 
-		assert.Equal(t, http.StatusUnauthorized, responseRecorder.Code)
+		assert.Equal(t, http.StatusConflict, responseRecorder.Code)
 	})
 
-	t.Run("Failed to Get User ID from Session", func(t *testing.T) {
-		mockJwtService.MockValidate = func(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error) {
-			return &middleware.JwtCsrfClaims{}, nil
-		}
-		mockSessionService.MockGetUserID = func(ctx context.Context, sessionID string) (string, error) {
-			return "", errors.New("failed to get user ID")
-		}
+	t.Run("No Completed Stay", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("CreateReview", mock.Anything, mock.Anything, mock.Anything).
+			Return((*gen.ReviewResponse)(nil), status.Error(codes.FailedPrecondition, "no completed stay"))
 
-		reviewPayload := `{"title":"Test Review","text":"Review Content"}`
-		request := httptest.NewRequest(http.MethodPost, "/reviews", bytes.NewReader([]byte(reviewPayload)))
+		request := httptest.NewRequest(http.MethodPost, "/reviews", bytes.NewBufferString(`{"title":"Nice","host_id":"host1"}`))
 		request.Header.Set("Cookie", "session_id=test-session-id")
 		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
 
 		responseRecorder := httptest.NewRecorder()
 		handler.CreateReview(responseRecorder, request)
 
-		assert.Equal(t, http.StatusInternalServerError, responseRecorder.Code)
+		assert.Equal(t, http.StatusForbidden, responseRecorder.Code)
+		assert.Contains(t, responseRecorder.Body.String(), `"reason":"no_completed_stay"`)
 	})
-	t.Run("Failed to Get User ID from Session", func(t *testing.T) {
-		mockJwtService.MockValidate = func(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error) {
-			return &middleware.JwtCsrfClaims{}, nil
-		}
-		mockSessionService.MockGetUserID = func(ctx context.Context, sessionID string) (string, error) {
-			return "", errors.New("failed to get user ID")
-		}
 
-		reviewPayload := `{"title":"Test Review","text":"Review Content"}`
-		request := httptest.NewRequest(http.MethodPost, "/reviews", bytes.NewReader([]byte(reviewPayload)))
+	t.Run("Review Window Closed", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("CreateReview", mock.Anything, mock.Anything, mock.Anything).
+			Return((*gen.ReviewResponse)(nil), status.Error(codes.FailedPrecondition, "review window closed"))
+
+		request := httptest.NewRequest(http.MethodPost, "/reviews", bytes.NewBufferString(`{"title":"Nice","host_id":"host1"}`))
 		request.Header.Set("Cookie", "session_id=test-session-id")
 		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
 
 		responseRecorder := httptest.NewRecorder()
 		handler.CreateReview(responseRecorder, request)
 
+		assert.Equal(t, http.StatusForbidden, responseRecorder.Code)
+		assert.Contains(t, responseRecorder.Body.String(), `"reason":"review_window_closed"`)
+	})
+
+	t.Run("Session ID Extraction Error", func(t *testing.T) {
+		handler := NewReviewHandler(new(mocks.MockGrpcClient), new(utils.MockUtils))
+
+		request := httptest.NewRequest(http.MethodPost, "/reviews", bytes.NewReader([]byte(`{}`)))
+		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
+
+		responseRecorder := httptest.NewRecorder()
+		handler.CreateReview(responseRecorder, request)
+
 		assert.Equal(t, http.StatusInternalServerError, responseRecorder.Code)
 	})
 }
@@ -127,40 +167,54 @@ func TestGetUserReviews(t *testing.T) {
 			return
 		}
 	}()
-	mockJwtService := &mocks.MockJwtTokenService{}
-	mockSessionService := &mocks.MockServiceSession{}
-	mockReviewUsecase := &mocks.MockReviewsUsecase{}
-
-	handler := NewReviewHandler(mockReviewUsecase, mockSessionService, mockJwtService)
 
 	t.Run("Successful GetUserReviews", func(t *testing.T) {
-		mockReviewUsecase.MockGetUserReviews = func(ctx context.Context, userId string) ([]domain.UserReviews, error) {
-			assert.Equal(t, "", userId)
-			return []domain.UserReviews{
-				{
-					HostID: "host1",
-					Title:  "Test Review 1",
-				},
-				{
-					HostID: "host2",
-					Title:  "Test Review 2",
-				},
-			}, nil
-		}
-
-		request := httptest.NewRequest(http.MethodGet, "/reviews/{userId}", nil)
+		mockClient := new(mocks.MockGrpcClient)
+		mockUtils := new(utils.MockUtils)
+		handler := NewReviewHandler(mockClient, mockUtils)
+
+		response := &gen.GetUserReviewsResponse{NextCursor: "next"}
+		mockClient.On("GetUserReviews", mock.Anything, mock.MatchedBy(func(in *gen.GetUserReviewsRequest) bool {
+			return in.UserId == "user1" && in.Cursor == "abc"
+		}), mock.Anything).Return(response, nil)
+		mockUtils.On("ConvertUserReviewsProtoToGo", response).Return(domain.UserReviewsList{
+			Reviews: []domain.UserReviews{
+				{HostID: "host1", Title: "Test Review 1"},
+				{HostID: "host2", Title: "Test Review 2"},
+			},
+			NextCursor: "next",
+		}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/reviews/user1?cursor=abc", nil)
+		request = mux.SetURLVars(request, map[string]string{"userId": "user1"})
 		responseRecorder := httptest.NewRecorder()
 
 		handler.GetUserReviews(responseRecorder, request)
 
 		assert.Equal(t, http.StatusOK, responseRecorder.Code)
+		assert.Contains(t, responseRecorder.Body.String(), `"nextCursor":"next"`)
+		mockClient.AssertExpectations(t)
 	})
 
-	t.Run("Error from GetUserReviews Usecase", func(t *testing.T) {
-		mockReviewUsecase.MockGetUserReviews = func(ctx context.Context, userId string) ([]domain.UserReviews, error) {
-			assert.Equal(t, "", userId)
-			return nil, errors.New("database error")
-		}
+	t.Run("Invalid Cursor", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("GetUserReviews", mock.Anything, mock.Anything, mock.Anything).
+			Return((*gen.GetUserReviewsResponse)(nil), status.Error(codes.InvalidArgument, "invalid cursor"))
+
+		request := httptest.NewRequest(http.MethodGet, "/reviews/user1?cursor=%25%25", nil)
+		responseRecorder := httptest.NewRecorder()
+
+		handler.GetUserReviews(responseRecorder, request)
+
+		assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	})
+
+	t.Run("Error from GetUserReviews Service", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("GetUserReviews", mock.Anything, mock.Anything, mock.Anything).
+			Return((*gen.GetUserReviewsResponse)(nil), status.Error(codes.Internal, "database error"))
 
 		request := httptest.NewRequest(http.MethodGet, "/reviews/{userId}", nil)
 		responseRecorder := httptest.NewRecorder()
@@ -171,6 +225,55 @@ func TestGetUserReviews(t *testing.T) {
 	})
 }
 
+func TestGetAdReviews(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	t.Run("Successful GetAdReviews", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		mockUtils := new(utils.MockUtils)
+		handler := NewReviewHandler(mockClient, mockUtils)
+
+		response := &gen.GetUserReviewsResponse{}
+		mockClient.On("GetAdReviews", mock.Anything, mock.MatchedBy(func(in *gen.GetAdReviewsRequest) bool {
+			return in.AdId == "ad-uuid"
+		}), mock.Anything).Return(response, nil)
+		mockUtils.On("ConvertUserReviewsProtoToGo", response).Return(domain.UserReviewsList{
+			Reviews: []domain.UserReviews{{ID: 1, Title: "Nice"}},
+		}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/housing/ad-uuid/reviews", nil)
+		request = mux.SetURLVars(request, map[string]string{"adId": "ad-uuid"})
+		responseRecorder := httptest.NewRecorder()
+
+		handler.GetAdReviews(responseRecorder, request)
+
+		assert.Equal(t, http.StatusOK, responseRecorder.Code)
+		assert.Contains(t, responseRecorder.Body.String(), `"title":"Nice"`)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Ad Not Found", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("GetAdReviews", mock.Anything, mock.Anything, mock.Anything).
+			Return((*gen.GetUserReviewsResponse)(nil), status.Error(codes.NotFound, "ad not found"))
+
+		request := httptest.NewRequest(http.MethodGet, "/housing/missing/reviews", nil)
+		request = mux.SetURLVars(request, map[string]string{"adId": "missing"})
+		responseRecorder := httptest.NewRecorder()
+
+		handler.GetAdReviews(responseRecorder, request)
+
+		assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+	})
+}
+
 func TestDeleteReview(t *testing.T) {
 	require.NoError(t, logger.InitLoggers())
 	defer func() {
@@ -179,25 +282,15 @@ func TestDeleteReview(t *testing.T) {
 			return
 		}
 	}()
-	mockJwtService := &mocks.MockJwtTokenService{}
-	mockSessionService := &mocks.MockServiceSession{}
-	mockReviewUsecase := &mocks.MockReviewsUsecase{}
-	handler := NewReviewHandler(mockReviewUsecase, mockSessionService, mockJwtService)
 
 	t.Run("Successful DeleteReview", func(t *testing.T) {
-		mockJwtService.MockValidate = func(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error) {
-			return &middleware.JwtCsrfClaims{}, nil
-		}
-		mockSessionService.MockGetUserID = func(ctx context.Context, sessionID string) (string, error) {
-			return "test-user-id", nil
-		}
-		mockReviewUsecase.MockDeleteReview = func(ctx context.Context, userId, hostId string) error {
-			assert.Equal(t, "test-user-id", userId)
-			assert.Equal(t, "test-host-id", hostId)
-			return nil
-		}
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("DeleteReview", mock.Anything, mock.MatchedBy(func(in *gen.DeleteReviewRequest) bool {
+			return in.HostId == "test-host-id" && in.SessionId == "test-session-id" && in.AuthHeader == "Bearer valid-token"
+		}), mock.Anything).Return(&gen.ReviewsResponse{Response: "deleted successfully"}, nil)
 
-		request := httptest.NewRequest(http.MethodDelete, "/reviews/{adId}", nil)
+		request := httptest.NewRequest(http.MethodDelete, "/reviews/{hostId}", nil)
 		request = mux.SetURLVars(request, map[string]string{"hostId": "test-host-id"})
 		request.Header.Set("Cookie", "session_id=test-session-id")
 		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
@@ -207,12 +300,19 @@ func TestDeleteReview(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, rr.Code)
 		assert.Contains(t, rr.Body.String(), "deleted successfully")
+		mockClient.AssertExpectations(t)
 	})
 
-	t.Run("Missing CSRF Token", func(t *testing.T) {
-		request := httptest.NewRequest(http.MethodDelete, "/reviews/{adId}", nil)
+	t.Run("Invalid JWT Token", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("DeleteReview", mock.Anything, mock.Anything, mock.Anything).
+			Return((*gen.ReviewsResponse)(nil), status.Error(codes.Unauthenticated, "invalid JWT token"))
+
+		request := httptest.NewRequest(http.MethodDelete, "/reviews/{hostId}", nil)
 		request = mux.SetURLVars(request, map[string]string{"hostId": "test-host-id"})
 		request.Header.Set("Cookie", "session_id=test-session-id")
+		request.Header.Set("X-CSRF-Token", "Bearer invalid-token")
 
 		rr := httptest.NewRecorder()
 		handler.DeleteReview(rr, request)
@@ -220,44 +320,45 @@ func TestDeleteReview(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, rr.Code)
 	})
 
-	t.Run("Invalid JWT Token", func(t *testing.T) {
-		mockJwtService.MockValidate = func(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error) {
-			return nil, errors.New("invalid JWT token")
-		}
+	t.Run("No Completed Stay", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("CreateReview", mock.Anything, mock.Anything, mock.Anything).
+			Return((*gen.ReviewResponse)(nil), status.Error(codes.FailedPrecondition, "no completed stay"))
 
-		request := httptest.NewRequest(http.MethodDelete, "/reviews/{adId}", nil)
-		request = mux.SetURLVars(request, map[string]string{"hostId": "test-host-id"})
+		request := httptest.NewRequest(http.MethodPost, "/reviews", bytes.NewBufferString(`{"title":"Nice","host_id":"host1"}`))
 		request.Header.Set("Cookie", "session_id=test-session-id")
-		request.Header.Set("X-CSRF-Token", "Bearer invalid-token")
+		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
 
-		rr := httptest.NewRecorder()
-		handler.DeleteReview(rr, request)
+		responseRecorder := httptest.NewRecorder()
+		handler.CreateReview(responseRecorder, request)
 
-		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Equal(t, http.StatusForbidden, responseRecorder.Code)
+		assert.Contains(t, responseRecorder.Body.String(), `"reason":"no_completed_stay"`)
 	})
 
-	t.Run("Session ID Extraction Error", func(t *testing.T) {
-		request := httptest.NewRequest(http.MethodDelete, "/reviews/{adId}", nil)
-		request = mux.SetURLVars(request, map[string]string{"hostId": "test-host-id"})
+	t.Run("Review Window Closed", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("CreateReview", mock.Anything, mock.Anything, mock.Anything).
+			Return((*gen.ReviewResponse)(nil), status.Error(codes.FailedPrecondition, "review window closed"))
 
-		rr := httptest.NewRecorder()
-		handler.DeleteReview(rr, request)
+		request := httptest.NewRequest(http.MethodPost, "/reviews", bytes.NewBufferString(`{"title":"Nice","host_id":"host1"}`))
+		request.Header.Set("Cookie", "session_id=test-session-id")
+		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
 
-		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		responseRecorder := httptest.NewRecorder()
+		handler.CreateReview(responseRecorder, request)
+
+		assert.Equal(t, http.StatusForbidden, responseRecorder.Code)
+		assert.Contains(t, responseRecorder.Body.String(), `"reason":"review_window_closed"`)
 	})
 
-	t.Run("Error Getting UserID from Session", func(t *testing.T) {
-		mockJwtService.MockValidate = func(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error) {
-			return &middleware.JwtCsrfClaims{}, nil
-		}
-		mockSessionService.MockGetUserID = func(ctx context.Context, sessionID string) (string, error) {
-			return "", errors.New("session error")
-		}
+	t.Run("Session ID Extraction Error", func(t *testing.T) {
+		handler := NewReviewHandler(new(mocks.MockGrpcClient), new(utils.MockUtils))
 
-		request := httptest.NewRequest(http.MethodDelete, "/reviews/{adId}", nil)
+		request := httptest.NewRequest(http.MethodDelete, "/reviews/{hostId}", nil)
 		request = mux.SetURLVars(request, map[string]string{"hostId": "test-host-id"})
-		request.Header.Set("Cookie", "session_id=test-session-id")
-		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
 
 		rr := httptest.NewRecorder()
 		handler.DeleteReview(rr, request)
@@ -265,16 +366,11 @@ func TestDeleteReview(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
 	})
 
-	t.Run("Error Deleting Review", func(t *testing.T) {
-		mockJwtService.MockValidate = func(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error) {
-			return &middleware.JwtCsrfClaims{}, nil
-		}
-		mockSessionService.MockGetUserID = func(ctx context.Context, sessionID string) (string, error) {
-			return "test-user-id", nil
-		}
-		mockReviewUsecase.MockDeleteReview = func(ctx context.Context, userId, hostId string) error {
-			return errors.New("delete error")
-		}
+	t.Run("Review Not Found", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("DeleteReview", mock.Anything, mock.Anything, mock.Anything).
+			Return((*gen.ReviewsResponse)(nil), status.Error(codes.NotFound, "review not found"))
 
 		request := httptest.NewRequest(http.MethodDelete, "/reviews/{hostId}", nil)
 		request = mux.SetURLVars(request, map[string]string{"hostId": "test-host-id"})
@@ -284,7 +380,7 @@ func TestDeleteReview(t *testing.T) {
 		rr := httptest.NewRecorder()
 		handler.DeleteReview(rr, request)
 
-		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
 	})
 }
 
@@ -296,24 +392,13 @@ func TestUpdateReview(t *testing.T) {
 			return
 		}
 	}()
-	mockJwtService := &mocks.MockJwtTokenService{}
-	mockSessionService := &mocks.MockServiceSession{}
-	mockReviewUsecase := &mocks.MockReviewsUsecase{}
-	handler := NewReviewHandler(mockReviewUsecase, mockSessionService, mockJwtService)
 
 	t.Run("Successful UpdateReview", func(t *testing.T) {
-		mockJwtService.MockValidate = func(tokenString string, sessionID string) (*middleware.JwtCsrfClaims, error) {
-			return &middleware.JwtCsrfClaims{}, nil
-		}
-		mockSessionService.MockGetUserID = func(ctx context.Context, sessionID string) (string, error) {
-			return "test-user-id", nil
-		}
-		mockReviewUsecase.MockUpdateReview = func(ctx context.Context, userID, hostID string, review *domain.Review) error {
-			assert.Equal(t, "test-host-id", hostID)
-			assert.Equal(t, "test-user-id", userID)
-			assert.Equal(t, "Test Title", review.Title)
-			return nil
-		}
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("UpdateReview", mock.Anything, mock.MatchedBy(func(in *gen.UpdateReviewRequest) bool {
+			return in.HostId == "test-host-id" && in.Review.Title == "Test Title"
+		}), mock.Anything).Return(&gen.ReviewsResponse{Response: "updated successfully"}, nil)
 
 		updatedReview := `{"title":"Test Title","text":"Updated text"}`
 
@@ -327,95 +412,205 @@ func TestUpdateReview(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, rr.Code)
 		assert.Contains(t, rr.Body.String(), "updated successfully")
+		mockClient.AssertExpectations(t)
 	})
 
-	t.Run("Missing CSRF Token", func(t *testing.T) {
+	t.Run("Session ID Error", func(t *testing.T) {
+		handler := NewReviewHandler(new(mocks.MockGrpcClient), new(utils.MockUtils))
+
 		request := httptest.NewRequest(http.MethodPut, "/reviews/{hostId}", nil)
 		request = mux.SetURLVars(request, map[string]string{"hostId": "test-host-id"})
-		request.Header.Set("Cookie", "session_id=test-session-id")
 
 		rr := httptest.NewRecorder()
 		handler.UpdateReview(rr, request)
 
-		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
 	})
 
-	t.Run("Invalid JWT Token", func(t *testing.T) {
-		mockJwtService.MockValidate = func(tokenString string, sessionID string) (*middleware.JwtCsrfClaims, error) {
-			return nil, errors.New("invalid token")
-		}
+	t.Run("Failed Unmarshal", func(t *testing.T) {
+		handler := NewReviewHandler(new(mocks.MockGrpcClient), new(utils.MockUtils))
 
-		request := httptest.NewRequest(http.MethodPut, "/reviews/{hostId}", nil)
+		request := httptest.NewRequest(http.MethodPut, "/reviews/{hostId}", bytes.NewBufferString("invalid-json"))
 		request = mux.SetURLVars(request, map[string]string{"hostId": "test-host-id"})
-		request.Header.Set("X-CSRF-Token", "Bearer invalid-token")
+		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
 		request.Header.Set("Cookie", "session_id=test-session-id")
 
 		rr := httptest.NewRecorder()
 		handler.UpdateReview(rr, request)
 
-		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
 	})
 
-	t.Run("Session ID Error", func(t *testing.T) {
-		request := httptest.NewRequest(http.MethodPut, "/reviews/{hostId}", nil)
+	t.Run("Score Out Of Range", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("UpdateReview", mock.Anything, mock.Anything, mock.Anything).
+			Return((*gen.ReviewsResponse)(nil), status.Error(codes.InvalidArgument, "score out of range"))
+
+		request := httptest.NewRequest(http.MethodPut, "/reviews/{hostId}", bytes.NewBufferString(`{"title":"Test","rating":9}`))
 		request = mux.SetURLVars(request, map[string]string{"hostId": "test-host-id"})
+		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
+		request.Header.Set("Cookie", "session_id=test-session-id")
 
 		rr := httptest.NewRecorder()
 		handler.UpdateReview(rr, request)
 
-		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
 	})
+}
 
-	t.Run("Failed to get UserID", func(t *testing.T) {
-		mockJwtService.MockValidate = func(tokenString string, sessionID string) (*middleware.JwtCsrfClaims, error) {
-			return &middleware.JwtCsrfClaims{}, nil
-		}
-		mockSessionService.MockGetUserID = func(ctx context.Context, sessionID string) (string, error) {
-			return "", errors.New("failed to get user ID")
+func TestReviewByID(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
 		}
+	}()
 
-		request := httptest.NewRequest(http.MethodPut, "/reviews/{hostId}", nil)
-		request = mux.SetURLVars(request, map[string]string{"hostId": "test-host-id"})
+	t.Run("Successful UpdateReviewByID", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("UpdateReviewByID", mock.Anything, mock.MatchedBy(func(in *gen.UpdateReviewByIDRequest) bool {
+			return in.ReviewId == 7 && in.SessionId == "test-session-id" && in.Review.Title == "Test Title"
+		}), mock.Anything).Return(&gen.ReviewsResponse{Response: "updated successfully"}, nil)
+
+		request := httptest.NewRequest(http.MethodPut, "/reviews/7", bytes.NewBufferString(`{"title":"Test Title","rating":4}`))
+		request = mux.SetURLVars(request, map[string]string{"reviewId": "7"})
 		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
 		request.Header.Set("Cookie", "session_id=test-session-id")
 
 		rr := httptest.NewRecorder()
-		handler.UpdateReview(rr, request)
+		handler.UpdateReviewByID(rr, request)
 
-		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "updated successfully")
+		mockClient.AssertExpectations(t)
 	})
 
-	t.Run("Failed Unmarshal", func(t *testing.T) {
-		request := httptest.NewRequest(http.MethodPut, "/reviews/{hostId}", bytes.NewBufferString("invalid-json"))
-		request = mux.SetURLVars(request, map[string]string{"hostId": "test-host-id"})
+	t.Run("Successful DeleteReviewByID", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("DeleteReviewByID", mock.Anything, mock.MatchedBy(func(in *gen.DeleteReviewByIDRequest) bool {
+			return in.ReviewId == 7 && in.SessionId == "test-session-id"
+		}), mock.Anything).Return(&gen.ReviewsResponse{Response: "deleted successfully"}, nil)
+
+		request := httptest.NewRequest(http.MethodDelete, "/reviews/7", nil)
+		request = mux.SetURLVars(request, map[string]string{"reviewId": "7"})
 		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
 		request.Header.Set("Cookie", "session_id=test-session-id")
 
 		rr := httptest.NewRecorder()
-		handler.UpdateReview(rr, request)
+		handler.DeleteReviewByID(rr, request)
 
-		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "deleted successfully")
+		mockClient.AssertExpectations(t)
 	})
 
-	t.Run("UpdateReview Usecase Error", func(t *testing.T) {
-		mockJwtService.MockValidate = func(tokenString string, sessionID string) (*middleware.JwtCsrfClaims, error) {
-			return &middleware.JwtCsrfClaims{}, nil
-		}
-		mockSessionService.MockGetUserID = func(ctx context.Context, sessionID string) (string, error) {
-			return "test-user-id", nil
-		}
-		mockReviewUsecase.MockUpdateReview = func(ctx context.Context, userID, hostID string, review *domain.Review) error {
-			return errors.New("update error")
-		}
+	t.Run("Invalid Review ID", func(t *testing.T) {
+		handler := NewReviewHandler(new(mocks.MockGrpcClient), new(utils.MockUtils))
 
-		request := httptest.NewRequest(http.MethodPut, "/reviews/{hostId}", bytes.NewBufferString(`{"title":"Test"}`))
-		request = mux.SetURLVars(request, map[string]string{"hostId": "test-host-id"})
+		request := httptest.NewRequest(http.MethodDelete, "/reviews/0", nil)
+		request = mux.SetURLVars(request, map[string]string{"reviewId": "0"})
+		request.Header.Set("Cookie", "session_id=test-session-id")
+
+		rr := httptest.NewRecorder()
+		handler.DeleteReviewByID(rr, request)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Not Author", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("DeleteReviewByID", mock.Anything, mock.Anything, mock.Anything).
+			Return((*gen.ReviewsResponse)(nil), status.Error(codes.NotFound, "review not found"))
+
+		request := httptest.NewRequest(http.MethodDelete, "/reviews/7", nil)
+		request = mux.SetURLVars(request, map[string]string{"reviewId": "7"})
 		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
 		request.Header.Set("Cookie", "session_id=test-session-id")
 
 		rr := httptest.NewRecorder()
-		handler.UpdateReview(rr, request)
+		handler.DeleteReviewByID(rr, request)
 
-		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestReviewReply(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	t.Run("Create Reply", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("CreateReply", mock.Anything, mock.MatchedBy(func(in *gen.ReplyRequest) bool {
+			return in.ReviewId == 7 && in.Text == "Thanks" && in.SessionId == "test-session-id" && in.AuthHeader == "Bearer valid-token"
+		}), mock.Anything).Return(&gen.ReviewsResponse{Response: "reply created successfully"}, nil)
+
+		request := httptest.NewRequest(http.MethodPost, "/reviews/7/reply", bytes.NewBufferString(`{"text":"Thanks"}`))
+		request = mux.SetURLVars(request, map[string]string{"reviewId": "7"})
+		request.Header.Set("Cookie", "session_id=test-session-id")
+		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
+
+		responseRecorder := httptest.NewRecorder()
+		handler.CreateReply(responseRecorder, request)
+
+		assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Reply From Another User", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("UpdateReply", mock.Anything, mock.Anything, mock.Anything).
+			Return((*gen.ReviewsResponse)(nil), status.Error(codes.PermissionDenied, "only the reviewed host can reply"))
+
+		request := httptest.NewRequest(http.MethodPut, "/reviews/7/reply", bytes.NewBufferString(`{"text":"Thanks"}`))
+		request = mux.SetURLVars(request, map[string]string{"reviewId": "7"})
+		request.Header.Set("Cookie", "session_id=test-session-id")
+		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
+
+		responseRecorder := httptest.NewRecorder()
+		handler.UpdateReply(responseRecorder, request)
+
+		assert.Equal(t, http.StatusForbidden, responseRecorder.Code)
+	})
+
+	t.Run("Delete Missing Reply", func(t *testing.T) {
+		mockClient := new(mocks.MockGrpcClient)
+		handler := NewReviewHandler(mockClient, new(utils.MockUtils))
+		mockClient.On("DeleteReply", mock.Anything, mock.MatchedBy(func(in *gen.DeleteReplyRequest) bool {
+			return in.ReviewId == 7
+		}), mock.Anything).Return((*gen.ReviewsResponse)(nil), status.Error(codes.NotFound, "reply not found"))
+
+		request := httptest.NewRequest(http.MethodDelete, "/reviews/7/reply", nil)
+		request = mux.SetURLVars(request, map[string]string{"reviewId": "7"})
+		request.Header.Set("Cookie", "session_id=test-session-id")
+		request.Header.Set("X-CSRF-Token", "Bearer valid-token")
+
+		responseRecorder := httptest.NewRecorder()
+		handler.DeleteReply(responseRecorder, request)
+
+		assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+	})
+
+	t.Run("Invalid Review ID", func(t *testing.T) {
+		handler := NewReviewHandler(new(mocks.MockGrpcClient), new(utils.MockUtils))
+
+		request := httptest.NewRequest(http.MethodPost, "/reviews/abc/reply", bytes.NewBufferString(`{"text":"Thanks"}`))
+		request = mux.SetURLVars(request, map[string]string{"reviewId": "abc"})
+
+		responseRecorder := httptest.NewRecorder()
+		handler.CreateReply(responseRecorder, request)
+
+		assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
 	})
 }
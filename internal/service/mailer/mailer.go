@@ -0,0 +1,106 @@
+package mailer
+
+import (
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type Sender interface {
+	Send(ctx context.Context, to string, subject string, body string) error
+}
+
+type SMTPSender struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+func NewSMTPSender(host, port, username, password, from string) Sender {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SMTPSender{
+		addr: net.JoinHostPort(host, port),
+		auth: auth,
+		from: from,
+	}
+}
+
+func (s *SMTPSender) Send(ctx context.Context, to string, subject string, body string) error {
+	requestID := middleware.GetRequestID(ctx)
+	if err := smtp.SendMail(s.addr, s.auth, s.from, []string{to}, buildMessage(s.from, to, subject, body)); err != nil {
+		logger.AccessLogger.Error("Failed to send email", zap.String("request_id", requestID), zap.Error(err))
+		return err
+	}
+	logger.AccessLogger.Info("Email sent", zap.String("request_id", requestID), zap.String("subject", subject))
+	return nil
+}
+
+// FileSender дописывает письма в файл вместо отправки, для локального запуска без SMTP
+type FileSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSender(path string) Sender {
+	return &FileSender{path: path}
+}
+
+func (s *FileSender) Send(ctx context.Context, to string, subject string, body string) error {
+	requestID := middleware.GetRequestID(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to open mail file", zap.String("request_id", requestID), zap.Error(err))
+		return err
+	}
+	defer file.Close()
+
+	message := fmt.Sprintf("Date: %s\r\n", time.Now().Format(time.RFC1123Z)) + string(buildMessage("noreply@localhost", to, subject, body)) + "\r\n\r\n"
+	if _, err = file.WriteString(message); err != nil {
+		logger.AccessLogger.Error("Failed to write mail file", zap.String("request_id", requestID), zap.Error(err))
+		return err
+	}
+	logger.AccessLogger.Info("Email written to file", zap.String("request_id", requestID), zap.String("path", s.path), zap.String("subject", subject))
+	return nil
+}
+
+func buildMessage(from, to, subject, body string) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + to + "\r\n")
+	b.WriteString("Subject: " + subject + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(body)
+	return []byte(b.String())
+}
+
+// FromEnv выбирает SMTP, если задан SMTP_HOST, иначе пишет письма в MAIL_FILE
+func FromEnv() Sender {
+	if host := os.Getenv("SMTP_HOST"); host != "" {
+		port := os.Getenv("SMTP_PORT")
+		if port == "" {
+			port = "587"
+		}
+		return NewSMTPSender(host, port, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), os.Getenv("SMTP_FROM"))
+	}
+	path := os.Getenv("MAIL_FILE")
+	if path == "" {
+		path = "mail.txt"
+	}
+	return NewFileSender(path)
+}
@@ -82,6 +82,36 @@ var (
 	)
 )
 
+var (
+	LoginFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "auth_login_failures_total",
+			Help: "Total number of failed login attempts",
+		},
+		[]string{"scope"},
+	)
+	LoginLockoutsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "auth_login_lockouts_total",
+			Help: "Total number of temporary login lockouts",
+		},
+		[]string{"scope"},
+	)
+	LoginRejectedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "auth_login_rejected_total",
+			Help: "Total number of login attempts rejected during lockout",
+		},
+		[]string{"scope"},
+	)
+	LoginUnlocksTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "auth_login_unlocks_total",
+			Help: "Total number of lockouts lifted by an admin",
+		},
+	)
+)
+
 // InitMetrics регистрирует метрики
 func InitMetrics() {
 	prometheus.MustRegister(GrpcRequestsTotal)
@@ -95,6 +125,13 @@ func InitHttpMetric() {
 	prometheus.MustRegister(HttpErrorsTotal)
 }
 
+func InitAuthMetric() {
+	prometheus.MustRegister(LoginFailuresTotal)
+	prometheus.MustRegister(LoginLockoutsTotal)
+	prometheus.MustRegister(LoginRejectedTotal)
+	prometheus.MustRegister(LoginUnlocksTotal)
+}
+
 func InitRepoMetric() {
 	prometheus.MustRegister(RepoRequestTotal)
 	prometheus.MustRegister(RepoRequestDuration)
@@ -110,3 +147,13 @@ func SanitizeAdIdPath(path string) string {
 	re := regexp.MustCompile(`[0-9a-fA-F-]{36}`)
 	return re.ReplaceAllString(path, "{adId}")
 }
+
+func SanitizeReviewIdPath(path string) string {
+	re := regexp.MustCompile(`/reviews/[0-9]+`)
+	return re.ReplaceAllString(path, "/reviews/{reviewId}")
+}
+
+func SanitizeSessionIdPath(path string) string {
+	re := regexp.MustCompile(`/sessions/[0-9a-fA-F]+`)
+	return re.ReplaceAllString(path, "/sessions/{sessionId}")
+}
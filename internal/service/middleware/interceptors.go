@@ -1,9 +1,11 @@
 package middleware
 
 import (
+	"2024_2_FIGHT-CLUB/domain"
 	"2024_2_FIGHT-CLUB/internal/service/metrics"
 	"context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"time"
 )
@@ -32,3 +34,106 @@ func UnaryMetricsInterceptor(
 
 	return resp, err
 }
+
+// StreamMetricsInterceptor считает поток одним запросом, длительность - время жизни потока
+func StreamMetricsInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	statusCode := "success"
+	if err != nil {
+		statusCode = "error"
+	}
+	metrics.GrpcRequestsTotal.WithLabelValues(info.FullMethod, statusCode).Inc()
+	metrics.GrpcRequestDuration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		grpcStatus, _ := status.FromError(err)
+		errorMsg := grpcStatus.Message()
+		metrics.GrpcErrorsTotal.WithLabelValues(info.FullMethod, grpcStatus.Code().String(), errorMsg).Inc()
+	}
+
+	return err
+}
+
+// SessionDataGetter - часть сервиса сессий, нужная для проверки ролей
+type SessionDataGetter interface {
+	GetSessionData(ctx context.Context, sessionID string) (*domain.SessionData, error)
+}
+
+// В proto поле сессии называется то sessionId, то sessionID
+type sessionIdRequest interface {
+	GetSessionId() string
+}
+
+type sessionIDRequest interface {
+	GetSessionID() string
+}
+
+func requestSessionID(req interface{}) string {
+	switch r := req.(type) {
+	case sessionIdRequest:
+		return r.GetSessionId()
+	case sessionIDRequest:
+		return r.GetSessionID()
+	}
+	return ""
+}
+
+// RoleInterceptor пускает к методам из policy (полное имя метода -> минимальная роль)
+// только пользователей с подходящей ролью в сессии, остальные методы не проверяет
+func RoleInterceptor(sessions SessionDataGetter, policy map[string]string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		required, ok := policy[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		sessionID := requestSessionID(req)
+		if sessionID == "" {
+			return nil, status.Error(codes.Unauthenticated, "session not found")
+		}
+		data, err := sessions.GetSessionData(ctx, sessionID)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "session not found")
+		}
+		if !domain.HasRole(data.Role, required) {
+			return nil, status.Error(codes.PermissionDenied, "access denied")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// EmailVerifiedInterceptor не пускает к методам из methods пользователей с неподтверждённой почтой
+func EmailVerifiedInterceptor(sessions SessionDataGetter, methods map[string]bool) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !methods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		sessionID := requestSessionID(req)
+		if sessionID == "" {
+			return nil, status.Error(codes.Unauthenticated, "session not found")
+		}
+		data, err := sessions.GetSessionData(ctx, sessionID)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "session not found")
+		}
+		if data.EmailUnverified {
+			return nil, status.Error(codes.PermissionDenied, "email not verified")
+		}
+		return handler(ctx, req)
+	}
+}
@@ -2,6 +2,8 @@ package middleware
 
 import (
 	"errors"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt"
@@ -11,16 +13,79 @@ type JwtTokenService interface {
 	Create(session_id string, tokenExpTime int64) (string, error)
 	Validate(tokenString string, expectedSessionId string) (*JwtCsrfClaims, error)
 	ParseSecretGetter(token *jwt.Token) (interface{}, error)
+	CreateEmailToken(userID string, email string, tokenExpTime int64) (string, error)
+	ValidateEmailToken(tokenString string) (*JwtEmailClaims, error)
+}
+
+// JwtKey - ключ подписи, ID попадает в заголовок kid токена
+type JwtKey struct {
+	ID     string
+	Secret []byte
 }
 
 type JwtToken struct {
+	// Secret подписывает новые токены
 	Secret []byte
+	KeyID  string
+	// предыдущие ключи только проверяют уже выданные токены
+	previous map[string][]byte
 }
 
+const defaultJwtKeyID = "default"
+
 func NewJwtToken(secret string) (JwtTokenService, error) {
-	return &JwtToken{
-		Secret: []byte(secret),
-	}, nil
+	return NewJwtTokenWithKeys(JwtKey{ID: defaultJwtKeyID, Secret: []byte(secret)})
+}
+
+func NewJwtTokenWithKeys(current JwtKey, previous ...JwtKey) (JwtTokenService, error) {
+	if current.ID == "" || len(current.Secret) == 0 {
+		return nil, errors.New("jwt key id and secret are required")
+	}
+	tk := &JwtToken{
+		Secret:   current.Secret,
+		KeyID:    current.ID,
+		previous: make(map[string][]byte, len(previous)),
+	}
+	for _, key := range previous {
+		if key.ID == "" || len(key.Secret) == 0 {
+			return nil, errors.New("jwt key id and secret are required")
+		}
+		if _, ok := tk.previous[key.ID]; ok || key.ID == current.ID {
+			return nil, errors.New("duplicate jwt key id " + key.ID)
+		}
+		tk.previous[key.ID] = key.Secret
+	}
+	return tk, nil
+}
+
+// NewJwtTokenFromEnv читает ключи из JWT_KEYS в формате "kid:secret,kid:secret".
+// Первый ключ текущий, остальные нужны до истечения выданных ими токенов.
+// Для ротации новый ключ дописывается в начало списка, а старый удаляется через сутки
+func NewJwtTokenFromEnv() (JwtTokenService, error) {
+	keys, err := ParseJwtKeys(os.Getenv("JWT_KEYS"))
+	if err != nil {
+		return nil, err
+	}
+	return NewJwtTokenWithKeys(keys[0], keys[1:]...)
+}
+
+func ParseJwtKeys(value string) ([]JwtKey, error) {
+	var keys []JwtKey
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, secret, ok := strings.Cut(entry, ":")
+		if !ok || strings.TrimSpace(id) == "" || secret == "" {
+			return nil, errors.New("invalid JWT_KEYS entry, expected kid:secret")
+		}
+		keys = append(keys, JwtKey{ID: strings.TrimSpace(id), Secret: []byte(secret)})
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("JWT_KEYS is not set")
+	}
+	return keys, nil
 }
 
 type JwtCsrfClaims struct {
@@ -28,6 +93,15 @@ type JwtCsrfClaims struct {
 	jwt.StandardClaims
 }
 
+// JwtEmailClaims - токен подтверждения почты. Purpose не даёт выдать за него CSRF-токен
+type JwtEmailClaims struct {
+	Email   string `json:"email"`
+	Purpose string `json:"purpose"`
+	jwt.StandardClaims
+}
+
+const emailTokenPurpose = "email_verification"
+
 func (tk *JwtToken) Create(session_id string, tokenExpTime int64) (string, error) {
 	data := JwtCsrfClaims{
 		SessionID: session_id,
@@ -36,8 +110,7 @@ func (tk *JwtToken) Create(session_id string, tokenExpTime int64) (string, error
 			IssuedAt:  time.Now().Unix(),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, data)
-	return token.SignedString(tk.Secret)
+	return tk.sign(data)
 }
 
 func (tk *JwtToken) Validate(tokenString string, expectedSessionId string) (*JwtCsrfClaims, error) {
@@ -56,17 +129,60 @@ func (tk *JwtToken) Validate(tokenString string, expectedSessionId string) (*Jwt
 		return nil, errors.New("token expired")
 	}
 
-	if claims.SessionID != expectedSessionId {
+	if claims.SessionID == "" || claims.SessionID != expectedSessionId {
 		return nil, errors.New("token invalid")
 	}
 
 	return claims, nil
 }
 
+func (tk *JwtToken) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = tk.KeyID
+	return token.SignedString(tk.Secret)
+}
+
+// ParseSecretGetter выбирает ключ по kid. Токены без kid выданы до ротации и проверяются текущим ключом
 func (tk *JwtToken) ParseSecretGetter(token *jwt.Token) (interface{}, error) {
 	method, ok := token.Method.(*jwt.SigningMethodHMAC)
 	if !ok || method.Alg() != "HS256" {
 		return nil, errors.New("bad sign method")
 	}
-	return tk.Secret, nil
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" || kid == tk.KeyID {
+		return tk.Secret, nil
+	}
+	if secret, ok := tk.previous[kid]; ok {
+		return secret, nil
+	}
+	return nil, errors.New("unknown key id")
+}
+
+func (tk *JwtToken) CreateEmailToken(userID string, email string, tokenExpTime int64) (string, error) {
+	data := JwtEmailClaims{
+		Email:   email,
+		Purpose: emailTokenPurpose,
+		StandardClaims: jwt.StandardClaims{
+			Subject:   userID,
+			ExpiresAt: tokenExpTime,
+			IssuedAt:  time.Now().Unix(),
+		},
+	}
+	return tk.sign(data)
+}
+
+func (tk *JwtToken) ValidateEmailToken(tokenString string) (*JwtEmailClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &JwtEmailClaims{}, tk.ParseSecretGetter)
+	if err != nil {
+		return nil, errors.New("token parse error")
+	}
+
+	claims, ok := token.Claims.(*JwtEmailClaims)
+	if !ok || !token.Valid || claims.Purpose != emailTokenPurpose || claims.Subject == "" {
+		return nil, errors.New("token invalid")
+	}
+	if claims.ExpiresAt < time.Now().Unix() {
+		return nil, errors.New("token expired")
+	}
+	return claims, nil
 }
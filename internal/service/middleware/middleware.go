@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"log"
@@ -22,6 +23,7 @@ import (
 	"net/http"
 	"os"
 	"runtime/debug"
+	"strings"
 	"sync"
 	"time"
 )
@@ -68,9 +70,82 @@ func getLimiter(ip string) *rate.Limiter {
 	return limiter.(*rate.Limiter)
 }
 
+var trustedProxies []*net.IPNet
+
+// SetTrustedProxies задаёт адреса или подсети (через запятую) обратных прокси,
+// от которых принимаются X-Real-IP и X-Forwarded-For
+func SetTrustedProxies(value string) error {
+	var proxies []*net.IPNet
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if !strings.Contains(item, "/") {
+			ip := net.ParseIP(item)
+			if ip == nil {
+				return fmt.Errorf("invalid trusted proxy %q", item)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(item)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy %q", item)
+		}
+		proxies = append(proxies, network)
+	}
+	trustedProxies = proxies
+	return nil
+}
+
+func isTrustedProxy(host string) bool {
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP возвращает адрес клиента без порта. Заголовкам прокси верим только если запрос пришёл от доверенного прокси,
+// из X-Forwarded-For берём последний адрес, добавленный не нашими прокси
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !isTrustedProxy(host) {
+		return host
+	}
+	if realIP := strings.TrimSpace(r.Header.Get("X-Real-IP")); net.ParseIP(realIP) != nil {
+		return realIP
+	}
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		ip := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(ip) == nil {
+			break
+		}
+		if !isTrustedProxy(ip) {
+			return ip
+		}
+	}
+	return host
+}
+
 func RateLimitMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ip := r.RemoteAddr
+		ip := ClientIP(r)
 
 		limiter := getLimiter(ip)
 
@@ -158,6 +233,54 @@ func ConvertGRPCToRooms(grpc []*gen.AdRooms) []domain.AdRoomsResponse {
 	return Rooms
 }
 
+func ConvertDateRangesToGRPC(ranges []domain.AvailableDateRange) []*gen.DateRange {
+	var grpcRanges []*gen.DateRange
+	for _, dateRange := range ranges {
+		grpcRanges = append(grpcRanges, &gen.DateRange{
+			DateFrom: timestamppb.New(dateRange.DateFrom),
+			DateTo:   timestamppb.New(dateRange.DateTo),
+		})
+	}
+	return grpcRanges
+}
+
+func ConvertGRPCToDateRanges(grpc []*gen.DateRange) []domain.AvailableDateRange {
+	var ranges []domain.AvailableDateRange
+	for _, dateRange := range grpc {
+		ranges = append(ranges, domain.AvailableDateRange{
+			DateFrom: dateRange.DateFrom.AsTime(),
+			DateTo:   dateRange.DateTo.AsTime(),
+		})
+	}
+	return ranges
+}
+
+func ConvertDatesToGRPC(dates []time.Time) []*timestamppb.Timestamp {
+	var grpcDates []*timestamppb.Timestamp
+	for _, date := range dates {
+		grpcDates = append(grpcDates, timestamppb.New(date))
+	}
+	return grpcDates
+}
+
+func ConvertGRPCToDates(grpc []*timestamppb.Timestamp) []time.Time {
+	var dates []time.Time
+	for _, date := range grpc {
+		dates = append(dates, date.AsTime())
+	}
+	return dates
+}
+
+func ConvertPositionToGRPC(position *domain.GeoPoint) *gen.GeoPoint {
+	if position == nil {
+		return nil
+	}
+	return &gen.GeoPoint{
+		Latitude:  position.Latitude,
+		Longitude: position.Longitude,
+	}
+}
+
 type responseWriterWrapper struct {
 	http.ResponseWriter
 	written bool
@@ -217,6 +340,22 @@ func RecoveryInterceptor(
 	return handler(ctx, req)
 }
 
+func StreamRecoveryInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("Panic occurred: %v\n", r)
+			debug.PrintStack()
+			err = status.Errorf(codes.Internal, "internal server error: %v", r)
+		}
+	}()
+	return handler(srv, ss)
+}
+
 func ChainUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
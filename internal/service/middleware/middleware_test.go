@@ -1,11 +1,18 @@
 package middleware_test
 
 import (
+	"2024_2_FIGHT-CLUB/domain"
 	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	authGen "2024_2_FIGHT-CLUB/microservices/auth_service/controller/gen"
 	"context"
+	"errors"
+	"fmt"
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -43,6 +50,58 @@ func TestGetRequestID(t *testing.T) {
 	assert.Empty(t, extractedRequestID, "Должен вернуть пустую строку, если Request ID не найден")
 }
 
+func TestClientIP(t *testing.T) {
+	assert.NoError(t, middleware.SetTrustedProxies("10.0.0.1, 172.16.0.0/12"))
+	t.Cleanup(func() {
+		_ = middleware.SetTrustedProxies("")
+	})
+
+	tests := map[string]struct {
+		remoteAddr string
+		headers    map[string]string
+		expected   string
+	}{
+		"Direct Connection":           {remoteAddr: "203.0.113.5:41000", expected: "203.0.113.5"},
+		"Headers From Untrusted Peer": {remoteAddr: "203.0.113.5:41000", headers: map[string]string{"X-Real-IP": "1.2.3.4", "X-Forwarded-For": "1.2.3.4"}, expected: "203.0.113.5"},
+		"Real IP From Proxy":          {remoteAddr: "10.0.0.1:41000", headers: map[string]string{"X-Real-IP": "198.51.100.7"}, expected: "198.51.100.7"},
+		"Forwarded Chain From Proxy":  {remoteAddr: "10.0.0.1:41000", headers: map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.7, 172.16.0.9"}, expected: "198.51.100.7"},
+		"Garbage Forwarded Header":    {remoteAddr: "10.0.0.1:41000", headers: map[string]string{"X-Forwarded-For": "not-an-ip"}, expected: "10.0.0.1"},
+		"IPv6 Peer":                   {remoteAddr: "[2001:db8::1]:41000", expected: "2001:db8::1"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/ads", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for header, value := range tt.headers {
+				req.Header.Set(header, value)
+			}
+			assert.Equal(t, tt.expected, middleware.ClientIP(req))
+		})
+	}
+
+	assert.Error(t, middleware.SetTrustedProxies("proxy.local"))
+}
+
+func TestRateLimitMiddleware_SharesLimitAcrossPorts(t *testing.T) {
+	handler := middleware.RateLimitMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	send := func(remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodGet, "/api/ads", nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// исчерпываем всплеск с одного порта, новый порт того же хоста не получает свежий лимит
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, http.StatusOK, send(fmt.Sprintf("192.0.2.77:%d", 40000+i)))
+	}
+	assert.Equal(t, http.StatusTooManyRequests, send("192.0.2.77:50000"))
+	assert.Equal(t, http.StatusOK, send("192.0.2.78:40000"))
+}
+
 func TestJwtToken_Create(t *testing.T) {
 	secret := "mysecretkey"
 	jwtService, err := middleware.NewJwtToken(secret)
@@ -149,3 +208,228 @@ func TestJwtToken_ParseSecretGetter(t *testing.T) {
 	assert.Nil(t, parsedSecret)
 	assert.Contains(t, err.Error(), "bad sign method")
 }
+
+func TestJwtToken_KeyRotation(t *testing.T) {
+	oldKey := middleware.JwtKey{ID: "2024-11", Secret: []byte("old-secret")}
+	newKey := middleware.JwtKey{ID: "2024-12", Secret: []byte("new-secret")}
+	exp := time.Now().Add(time.Hour).Unix()
+
+	before, err := middleware.NewJwtTokenWithKeys(oldKey)
+	assert.NoError(t, err)
+	oldToken, err := before.Create("session", exp)
+	assert.NoError(t, err)
+	parsed, _, err := new(jwt.Parser).ParseUnverified(oldToken, &middleware.JwtCsrfClaims{})
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-11", parsed.Header["kid"])
+
+	// после ротации старые токены ещё принимаются, новые подписываются новым ключом
+	after, err := middleware.NewJwtTokenWithKeys(newKey, oldKey)
+	assert.NoError(t, err)
+	_, err = after.Validate(oldToken, "session")
+	assert.NoError(t, err)
+	newToken, _ := after.Create("session", exp)
+	parsed, _, _ = new(jwt.Parser).ParseUnverified(newToken, &middleware.JwtCsrfClaims{})
+	assert.Equal(t, "2024-12", parsed.Header["kid"])
+	_, err = after.Validate(newToken, "session")
+	assert.NoError(t, err)
+
+	// старый ключ удалён из конфигурации
+	retired, _ := middleware.NewJwtTokenWithKeys(newKey)
+	_, err = retired.Validate(oldToken, "session")
+	assert.EqualError(t, err, "token parse error")
+	_, err = retired.Validate(newToken, "session")
+	assert.NoError(t, err)
+
+	// подмена kid не позволяет проверить токен другим ключом
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, &middleware.JwtCsrfClaims{
+		SessionID:      "session",
+		StandardClaims: jwt.StandardClaims{ExpiresAt: exp},
+	})
+	forged.Header["kid"] = "2024-11"
+	forgedToken, _ := forged.SignedString(newKey.Secret)
+	_, err = after.Validate(forgedToken, "session")
+	assert.EqualError(t, err, "token parse error")
+}
+
+func TestJwtToken_LegacyTokenWithoutKid(t *testing.T) {
+	jwtService, err := middleware.NewJwtTokenWithKeys(middleware.JwtKey{ID: "2024-12", Secret: []byte("secret")})
+	assert.NoError(t, err)
+
+	legacy := jwt.NewWithClaims(jwt.SigningMethodHS256, &middleware.JwtCsrfClaims{
+		SessionID:      "session",
+		StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(time.Hour).Unix()},
+	})
+	legacyToken, _ := legacy.SignedString([]byte("secret"))
+	_, err = jwtService.Validate(legacyToken, "session")
+	assert.NoError(t, err)
+}
+
+func TestJwtToken_Expiry(t *testing.T) {
+	jwtService, err := middleware.NewJwtTokenWithKeys(
+		middleware.JwtKey{ID: "new", Secret: []byte("new-secret")},
+		middleware.JwtKey{ID: "old", Secret: []byte("old-secret")},
+	)
+	assert.NoError(t, err)
+	oldService, _ := middleware.NewJwtTokenWithKeys(middleware.JwtKey{ID: "old", Secret: []byte("old-secret")})
+
+	valid, _ := jwtService.Create("session", time.Now().Add(time.Minute).Unix())
+	_, err = jwtService.Validate(valid, "session")
+	assert.NoError(t, err)
+
+	expired, _ := jwtService.Create("session", time.Now().Add(-time.Minute).Unix())
+	_, err = jwtService.Validate(expired, "session")
+	assert.Error(t, err)
+
+	// предыдущий ключ не продлевает срок действия токена
+	expiredOld, _ := oldService.Create("session", time.Now().Add(-time.Minute).Unix())
+	_, err = jwtService.Validate(expiredOld, "session")
+	assert.Error(t, err)
+
+	expiredEmail, _ := oldService.CreateEmailToken("user1", "test@example.com", time.Now().Add(-time.Minute).Unix())
+	_, err = jwtService.ValidateEmailToken(expiredEmail)
+	assert.Error(t, err)
+	email, _ := oldService.CreateEmailToken("user1", "test@example.com", time.Now().Add(time.Minute).Unix())
+	_, err = jwtService.ValidateEmailToken(email)
+	assert.NoError(t, err)
+}
+
+func TestNewJwtTokenFromEnv(t *testing.T) {
+	t.Setenv("JWT_KEYS", "2024-12:new:secret, 2024-11:old-secret")
+	jwtService, err := middleware.NewJwtTokenFromEnv()
+	assert.NoError(t, err)
+	tk := jwtService.(*middleware.JwtToken)
+	assert.Equal(t, "2024-12", tk.KeyID)
+	assert.Equal(t, []byte("new:secret"), tk.Secret)
+
+	for _, value := range []string{"", "no-separator", ":secret", "kid:", "a:1,a:2"} {
+		t.Setenv("JWT_KEYS", value)
+		_, err = middleware.NewJwtTokenFromEnv()
+		assert.Error(t, err, value)
+	}
+}
+
+func TestJwtToken_EmailToken(t *testing.T) {
+	jwtService, err := middleware.NewJwtToken("testsecret")
+	assert.NoError(t, err)
+
+	token, err := jwtService.CreateEmailToken("user1", "test@example.com", time.Now().Add(time.Hour).Unix())
+	assert.NoError(t, err)
+	claims, err := jwtService.ValidateEmailToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "user1", claims.Subject)
+	assert.Equal(t, "test@example.com", claims.Email)
+
+	// токен почты не подходит как CSRF-токен и наоборот
+	_, err = jwtService.Validate(token, "")
+	assert.Error(t, err)
+	csrfToken, _ := jwtService.Create("session", time.Now().Add(time.Hour).Unix())
+	_, err = jwtService.ValidateEmailToken(csrfToken)
+	assert.EqualError(t, err, "token invalid")
+
+	expired, _ := jwtService.CreateEmailToken("user1", "test@example.com", time.Now().Add(-time.Hour).Unix())
+	_, err = jwtService.ValidateEmailToken(expired)
+	assert.Error(t, err)
+
+	otherService, _ := middleware.NewJwtToken("wrongsecret")
+	_, err = otherService.ValidateEmailToken(token)
+	assert.EqualError(t, err, "token parse error")
+}
+
+type fakeSessions map[string]domain.SessionData
+
+func (f fakeSessions) GetSessionData(ctx context.Context, sessionID string) (*domain.SessionData, error) {
+	data, ok := f[sessionID]
+	if !ok {
+		return nil, errors.New("session not found")
+	}
+	return &data, nil
+}
+
+func TestRoleInterceptor(t *testing.T) {
+	sessions := fakeSessions{
+		"admin-session": {Id: "u1", Role: domain.RoleAdmin},
+		"guest-session": {Id: "u2", Role: domain.RoleGuest},
+		"old-session":   {Id: "u3"},
+	}
+	policy := map[string]string{
+		authGen.Auth_GetAllUsers_FullMethodName: domain.RoleAdmin,
+		authGen.Auth_PutUser_FullMethodName:     domain.RoleGuest,
+	}
+	interceptor := middleware.RoleInterceptor(sessions, policy)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(method string, req interface{}) (interface{}, error) {
+		return interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+
+	t.Run("Allowed", func(t *testing.T) {
+		resp, err := call(authGen.Auth_GetAllUsers_FullMethodName, &authGen.GetAllUsersRequest{SessionId: "admin-session"})
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("Insufficient Role", func(t *testing.T) {
+		_, err := call(authGen.Auth_GetAllUsers_FullMethodName, &authGen.GetAllUsersRequest{SessionId: "guest-session"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, "access denied", status.Convert(err).Message())
+	})
+
+	t.Run("Session Without Role Is Guest", func(t *testing.T) {
+		_, err := call(authGen.Auth_PutUser_FullMethodName, &authGen.PutUserRequest{SessionId: "old-session"})
+		assert.NoError(t, err)
+		_, err = call(authGen.Auth_GetAllUsers_FullMethodName, &authGen.GetAllUsersRequest{SessionId: "old-session"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Unknown Session", func(t *testing.T) {
+		_, err := call(authGen.Auth_GetAllUsers_FullMethodName, &authGen.GetAllUsersRequest{SessionId: "missing"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Method Without Policy", func(t *testing.T) {
+		resp, err := call(authGen.Auth_LoginUser_FullMethodName, &authGen.LoginUserRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+}
+
+func TestEmailVerifiedInterceptor(t *testing.T) {
+	sessions := fakeSessions{
+		"verified-session":   {Id: "u1"},
+		"unverified-session": {Id: "u2", EmailUnverified: true},
+	}
+	methods := map[string]bool{
+		authGen.Auth_PutUser_FullMethodName: true,
+	}
+	interceptor := middleware.EmailVerifiedInterceptor(sessions, methods)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(method string, req interface{}) (interface{}, error) {
+		return interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+
+	t.Run("Verified", func(t *testing.T) {
+		resp, err := call(authGen.Auth_PutUser_FullMethodName, &authGen.PutUserRequest{SessionId: "verified-session"})
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("Unverified", func(t *testing.T) {
+		_, err := call(authGen.Auth_PutUser_FullMethodName, &authGen.PutUserRequest{SessionId: "unverified-session"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, "email not verified", status.Convert(err).Message())
+	})
+
+	t.Run("Unknown Session", func(t *testing.T) {
+		_, err := call(authGen.Auth_PutUser_FullMethodName, &authGen.PutUserRequest{SessionId: "missing"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Unchecked Method", func(t *testing.T) {
+		resp, err := call(authGen.Auth_GetSessionData_FullMethodName, &authGen.GetSessionDataRequest{SessionId: "unverified-session"})
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+}
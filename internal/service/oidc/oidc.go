@@ -0,0 +1,314 @@
+package oidc
+
+import (
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"go.uber.org/zap"
+)
+
+// Config - параметры приложения, зарегистрированного у провайдера
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL - страница фронтенда, которая передаёт code и state в /api/auth/oidc/callback
+	RedirectURL string
+	Scopes      []string
+}
+
+// Claims - проверенные данные пользователя из ID-токена
+type Claims struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+type Provider interface {
+	// Issuer вместе с Subject однозначно определяет внешний аккаунт
+	Issuer() string
+	AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error)
+	// Exchange обменивает код авторизации на ID-токен и проверяет его подпись, получателя и nonce
+	Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*Claims, error)
+}
+
+var (
+	ErrDiscovery    = errors.New("failed to load oidc provider configuration")
+	ErrExchange     = errors.New("failed to exchange authorization code")
+	ErrInvalidToken = errors.New("invalid id token")
+)
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type tokenResponse struct {
+	IDToken string `json:"id_token"`
+	Error   string `json:"error"`
+}
+
+type client struct {
+	config     Config
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	discovery *discoveryDocument
+	keys      map[string]*rsa.PublicKey
+}
+
+func NewProvider(config Config, httpClient *http.Client) Provider {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"openid", "email", "profile"}
+	}
+	return &client{
+		config:     config,
+		httpClient: httpClient,
+	}
+}
+
+// FromEnv возвращает nil, если OIDC_ISSUER не задан и вход через провайдера выключен
+func FromEnv() Provider {
+	issuer := os.Getenv("OIDC_ISSUER")
+	if issuer == "" {
+		return nil
+	}
+	var scopes []string
+	if value := os.Getenv("OIDC_SCOPES"); value != "" {
+		scopes = strings.Fields(value)
+	}
+	return NewProvider(Config{
+		Issuer:       issuer,
+		ClientID:     os.Getenv("OIDC_CLIENT_ID"),
+		ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+		RedirectURL:  os.Getenv("OIDC_REDIRECT_URL"),
+		Scopes:       scopes,
+	}, nil)
+}
+
+// RandomString возвращает случайную строку для state, nonce и code_verifier
+func RandomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CodeChallenge - PKCE S256 из RFC 7636
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func (c *client) Issuer() string {
+	return c.config.Issuer
+}
+
+func (c *client) AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error) {
+	doc, err := c.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", c.config.ClientID)
+	params.Set("redirect_uri", c.config.RedirectURL)
+	params.Set("scope", strings.Join(c.config.Scopes, " "))
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", CodeChallenge(codeVerifier))
+	params.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(doc.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return doc.AuthorizationEndpoint + separator + params.Encode(), nil
+}
+
+func (c *client) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*Claims, error) {
+	requestID := middleware.GetRequestID(ctx)
+	doc, err := c.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.config.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, ErrExchange
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(c.config.ClientID), url.QueryEscape(c.config.ClientSecret))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to call oidc token endpoint", zap.String("request_id", requestID), zap.Error(err))
+		return nil, ErrExchange
+	}
+	defer resp.Body.Close()
+
+	var token tokenResponse
+	if err = json.NewDecoder(resp.Body).Decode(&token); err != nil || resp.StatusCode != http.StatusOK || token.IDToken == "" {
+		logger.AccessLogger.Warn("OIDC token endpoint rejected code",
+			zap.String("request_id", requestID),
+			zap.Int("status", resp.StatusCode),
+			zap.String("error", token.Error))
+		return nil, ErrExchange
+	}
+	return c.verifyIDToken(ctx, doc, token.IDToken, nonce)
+}
+
+func (c *client) verifyIDToken(ctx context.Context, doc *discoveryDocument, rawToken string, nonce string) (*Claims, error) {
+	requestID := middleware.GetRequestID(ctx)
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		kid, _ := token.Header["kid"].(string)
+		return c.getKey(ctx, doc, kid)
+	})
+	if err != nil {
+		logger.AccessLogger.Warn("Invalid oidc id token", zap.String("request_id", requestID), zap.Error(err))
+		return nil, ErrInvalidToken
+	}
+
+	if !claims.VerifyIssuer(doc.Issuer, true) || !claims.VerifyAudience(c.config.ClientID, true) || !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		logger.AccessLogger.Warn("OIDC id token issued for another client", zap.String("request_id", requestID))
+		return nil, ErrInvalidToken
+	}
+	if tokenNonce, _ := claims["nonce"].(string); tokenNonce == "" || tokenNonce != nonce {
+		logger.AccessLogger.Warn("OIDC nonce mismatch", zap.String("request_id", requestID))
+		return nil, ErrInvalidToken
+	}
+
+	result := &Claims{}
+	result.Subject, _ = claims["sub"].(string)
+	result.Email, _ = claims["email"].(string)
+	result.Name, _ = claims["name"].(string)
+	result.PreferredUsername, _ = claims["preferred_username"].(string)
+	// некоторые провайдеры отдают email_verified строкой
+	switch verified := claims["email_verified"].(type) {
+	case bool:
+		result.EmailVerified = verified
+	case string:
+		result.EmailVerified = verified == "true"
+	}
+	if result.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+	return result, nil
+}
+
+func (c *client) getDiscovery(ctx context.Context) (*discoveryDocument, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.discovery != nil {
+		return c.discovery, nil
+	}
+
+	var doc discoveryDocument
+	if err := c.getJSON(ctx, strings.TrimSuffix(c.config.Issuer, "/")+"/.well-known/openid-configuration", &doc); err != nil {
+		return nil, ErrDiscovery
+	}
+	// OpenID Connect Discovery 1.0, п. 4.3
+	if doc.Issuer != c.config.Issuer || doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JwksURI == "" {
+		logger.AccessLogger.Error("Invalid oidc discovery document", zap.String("request_id", middleware.GetRequestID(ctx)), zap.String("issuer", doc.Issuer))
+		return nil, ErrDiscovery
+	}
+	c.discovery = &doc
+	return c.discovery, nil
+}
+
+// getKey перечитывает JWKS, если kid незнаком: провайдер мог сменить ключи
+func (c *client) getKey(ctx context.Context, doc *discoveryDocument, kid string) (*rsa.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if key, ok := c.keys[kid]; ok {
+		return key, nil
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := c.getJSON(ctx, doc.JwksURI, &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, errN := base64.RawURLEncoding.DecodeString(k.N)
+		e, errE := base64.RawURLEncoding.DecodeString(k.E)
+		if errN != nil || errE != nil {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	c.keys = keys
+
+	key, ok := c.keys[kid]
+	if !ok {
+		return nil, errors.New("unknown key id")
+	}
+	return key, nil
+}
+
+func (c *client) getJSON(ctx context.Context, target string, v interface{}) error {
+	requestID := middleware.GetRequestID(ctx)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to call oidc provider", zap.String("request_id", requestID), zap.String("url", target), zap.Error(err))
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		logger.AccessLogger.Error("OIDC provider returned error", zap.String("request_id", requestID), zap.String("url", target), zap.Int("status", resp.StatusCode))
+		return errors.New("unexpected status " + resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
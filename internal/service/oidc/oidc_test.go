@@ -0,0 +1,141 @@
+package oidc
+
+import (
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/oidc/oidctest"
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestProvider(t *testing.T) (*oidctest.Provider, Provider) {
+	require.NoError(t, logger.InitLoggers())
+	t.Cleanup(func() {
+		_ = logger.SyncLoggers()
+	})
+	fake := oidctest.NewProvider(t, "fight-club", "secret")
+	return fake, NewProvider(Config{
+		Issuer:       fake.Issuer(),
+		ClientID:     "fight-club",
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost:3000/oidc/callback",
+	}, fake.Server.Client())
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	fake, provider := newTestProvider(t)
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "state1", "nonce1", "verifier1")
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, fake.Issuer()+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	query := parsed.Query()
+	assert.Equal(t, "code", query.Get("response_type"))
+	assert.Equal(t, "fight-club", query.Get("client_id"))
+	assert.Equal(t, "openid email profile", query.Get("scope"))
+	assert.Equal(t, "state1", query.Get("state"))
+	assert.Equal(t, "nonce1", query.Get("nonce"))
+	assert.Equal(t, CodeChallenge("verifier1"), query.Get("code_challenge"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+}
+
+func TestCodeChallenge_RFC7636(t *testing.T) {
+	// пример из приложения B RFC 7636
+	assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", CodeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+}
+
+func TestExchange(t *testing.T) {
+	fake, provider := newTestProvider(t)
+	ctx := context.Background()
+	identity := oidctest.Identity{
+		Subject:           "external-1",
+		Email:             "user@example.com",
+		EmailVerified:     true,
+		PreferredUsername: "user",
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		code := fake.Authorize(identity, "nonce1", CodeChallenge("verifier1"))
+		claims, err := provider.Exchange(ctx, code, "verifier1", "nonce1")
+		require.NoError(t, err)
+		assert.Equal(t, &Claims{
+			Subject:           "external-1",
+			Email:             "user@example.com",
+			EmailVerified:     true,
+			PreferredUsername: "user",
+		}, claims)
+	})
+
+	t.Run("Code Used Twice", func(t *testing.T) {
+		code := fake.Authorize(identity, "nonce1", CodeChallenge("verifier1"))
+		_, err := provider.Exchange(ctx, code, "verifier1", "nonce1")
+		require.NoError(t, err)
+		_, err = provider.Exchange(ctx, code, "verifier1", "nonce1")
+		assert.ErrorIs(t, err, ErrExchange)
+	})
+
+	t.Run("Wrong Verifier", func(t *testing.T) {
+		code := fake.Authorize(identity, "nonce1", CodeChallenge("verifier1"))
+		_, err := provider.Exchange(ctx, code, "another", "nonce1")
+		assert.ErrorIs(t, err, ErrExchange)
+	})
+
+	t.Run("Nonce Mismatch", func(t *testing.T) {
+		code := fake.Authorize(identity, "nonce1", CodeChallenge("verifier1"))
+		_, err := provider.Exchange(ctx, code, "verifier1", "nonce2")
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	fake, provider := newTestProvider(t)
+	ctx := context.Background()
+	c := provider.(*client)
+	doc, err := c.getDiscovery(ctx)
+	require.NoError(t, err)
+
+	valid := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":            fake.Issuer(),
+			"sub":            "external-1",
+			"aud":            "fight-club",
+			"exp":            time.Now().Add(time.Hour).Unix(),
+			"nonce":          "nonce1",
+			"email_verified": "true",
+		}
+	}
+
+	claims, err := c.verifyIDToken(ctx, doc, fake.SignIDToken(valid()), "nonce1")
+	require.NoError(t, err)
+	assert.True(t, claims.EmailVerified)
+
+	tests := map[string]func(jwt.MapClaims){
+		"Wrong Audience": func(claims jwt.MapClaims) { claims["aud"] = "another-client" },
+		"Wrong Issuer":   func(claims jwt.MapClaims) { claims["iss"] = "https://evil.example.com" },
+		"Expired":        func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-time.Minute).Unix() },
+		"No Subject":     func(claims jwt.MapClaims) { delete(claims, "sub") },
+		"No Nonce":       func(claims jwt.MapClaims) { delete(claims, "nonce") },
+	}
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			claims := valid()
+			modify(claims)
+			_, err := c.verifyIDToken(ctx, doc, fake.SignIDToken(claims), "nonce1")
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+
+	t.Run("Not Signed By Provider", func(t *testing.T) {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, valid()).SignedString([]byte("fight-club"))
+		require.NoError(t, err)
+		_, err = c.verifyIDToken(ctx, doc, token, "nonce1")
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
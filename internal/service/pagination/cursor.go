@@ -0,0 +1,30 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// EncodeCursor упаковывает ключ последней записи страницы в непрозрачную строку
+func EncodeCursor(key interface{}) string {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor распаковывает курсор, полученный от клиента, в key
+func DecodeCursor(cursor string, key interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	if err := json.Unmarshal(data, key); err != nil {
+		return ErrInvalidCursor
+	}
+	return nil
+}
@@ -1,35 +1,60 @@
 package router
 
 import (
+	"2024_2_FIGHT-CLUB/domain"
 	ads "2024_2_FIGHT-CLUB/internal/ads/controller"
 	auth "2024_2_FIGHT-CLUB/internal/auth/controller"
 	chat "2024_2_FIGHT-CLUB/internal/chat/controller"
 	city "2024_2_FIGHT-CLUB/internal/cities/controller"
+	moderation "2024_2_FIGHT-CLUB/internal/moderation/controller"
 	regions "2024_2_FIGHT-CLUB/internal/regions/controller"
 	review "2024_2_FIGHT-CLUB/internal/reviews/contoller"
+	"2024_2_FIGHT-CLUB/internal/service/session"
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
 )
 
-func SetUpRoutes(authHandler *auth.AuthHandler, adsHandler *ads.AdHandler, cityHandler *city.CityHandler, chatHandler *chat.ChatHandler, reviewHandler *review.ReviewHandler, regionsHandler *regions.RegionHandler) *mux.Router {
+func SetUpRoutes(authHandler *auth.AuthHandler, adsHandler *ads.AdHandler, cityHandler *city.CityHandler, chatHandler *chat.ChatHandler, reviewHandler *review.ReviewHandler, regionsHandler *regions.RegionHandler, moderationHandler *moderation.ModerationHandler, sessionService session.InterfaceSession) *mux.Router {
 	router := mux.NewRouter()
 	api := "/api"
+	requireRole := func(role string, handler http.HandlerFunc) http.Handler {
+		return session.RequireRole(sessionService, role)(handler)
+	}
 
 	// User Authentication Routes
 	router.HandleFunc(api+"/auth/register", authHandler.RegisterUser).Methods("POST") // Register a new user
 	router.HandleFunc(api+"/auth/login", authHandler.LoginUser).Methods("POST")       // Login user
 	router.HandleFunc(api+"/auth/logout", authHandler.LogoutUser).Methods("DELETE")   // Logout user
+	// Password Routes
+	router.HandleFunc(api+"/auth/password", authHandler.ChangePassword).Methods("PUT")         // Change password
+	router.HandleFunc(api+"/auth/password/forgot", authHandler.ForgotPassword).Methods("POST") // Send password reset link
+	router.HandleFunc(api+"/auth/password/reset", authHandler.ResetPassword).Methods("POST")   // Reset password by token
+	// Email Verification Routes
+	router.HandleFunc(api+"/auth/email/verify", authHandler.VerifyEmail).Methods("POST")             // Confirm email by token
+	router.HandleFunc(api+"/auth/email/resend", authHandler.ResendVerificationEmail).Methods("POST") // Resend confirmation email
+	// Two-Factor Authentication Routes
+	router.HandleFunc(api+"/auth/login/2fa", authHandler.VerifySecondFactor).Methods("POST")                    // Finish login with TOTP or recovery code
+	router.Handle(api+"/auth/2fa/setup", requireRole(domain.RoleHost, authHandler.SetupTOTP)).Methods("POST")   // Generate TOTP secret
+	router.Handle(api+"/auth/2fa/enable", requireRole(domain.RoleHost, authHandler.EnableTOTP)).Methods("POST") // Confirm TOTP and get recovery codes
+	router.HandleFunc(api+"/auth/2fa", authHandler.DisableTOTP).Methods("DELETE")                               // Disable TOTP
+	// OpenID Connect Routes
+	router.HandleFunc(api+"/auth/oidc/login", authHandler.StartOIDCLogin).Methods("GET")      // Get provider login URL
+	router.HandleFunc(api+"/auth/oidc/callback", authHandler.FinishOIDCLogin).Methods("POST") // Exchange provider code for session
 	// User Management Routes
 	router.HandleFunc(api+"/users", authHandler.PutUser).Methods("PUT")                            // Update user
 	router.HandleFunc(api+"/users/{userId}", authHandler.GetUserById).Methods("GET")               // Get user by ID
-	router.HandleFunc(api+"/users", authHandler.GetAllUsers).Methods("GET")                        // Get all users
 	router.HandleFunc(api+"/session", authHandler.GetSessionData).Methods("GET")                   // Get session data
+	router.HandleFunc(api+"/sessions", authHandler.GetSessions).Methods("GET")                     // List active sessions
+	router.HandleFunc(api+"/sessions", authHandler.RevokeSession).Methods("DELETE")                // Revoke all other sessions
+	router.HandleFunc(api+"/sessions/{sessionId}", authHandler.RevokeSession).Methods("DELETE")    // Revoke session by ID
 	router.HandleFunc(api+"/users/{userId}/housing", adsHandler.GetUserPlaces).Methods("GET")      // Get User Ads
 	router.HandleFunc(api+"/users/{userId}/favorites", adsHandler.GetUserFavorites).Methods("GET") // Get User Favorites
 	router.HandleFunc(api+"/users/regions", authHandler.UpdateUserRegion).Methods("POST")
 	router.HandleFunc(api+"/users/regions/{regionName}", authHandler.DeleteUserRegion).Methods("DELETE")
 	// Ad Management Routes
 	router.HandleFunc(api+"/housing", adsHandler.GetAllPlaces).Methods("GET")                             // Get all ads
+	router.HandleFunc(api+"/housing/map", adsHandler.GetPlacesInBounds).Methods("GET")                    // Get ads inside map bbox
 	router.HandleFunc(api+"/housing/{adId}", adsHandler.GetOnePlace).Methods("GET")                       // Get ad by ID
 	router.HandleFunc(api+"/housing", adsHandler.CreatePlace).Methods("POST")                             // Create a new ad
 	router.HandleFunc(api+"/housing/{adId}", adsHandler.UpdatePlace).Methods("PUT")                       // Update ad by ID
@@ -44,18 +69,39 @@ func SetUpRoutes(authHandler *auth.AuthHandler, adsHandler *ads.AdHandler, cityH
 	router.HandleFunc(api+"/cities", cityHandler.GetCities).Methods("GET")         // Get All Cities
 	router.HandleFunc(api+"/cities/{city}", cityHandler.GetOneCity).Methods("GET") // Get One City
 	// Chat Management Routes
-	router.HandleFunc(api+"/messages/chats", chatHandler.GetAllChats).Methods("GET") //Get All Chats
-	router.HandleFunc(api+"/messages/chat/{id}", chatHandler.GetChat).Methods("GET") //Get One Chats
-	router.HandleFunc(api+"/messages/setconn", chatHandler.SetConnection)            //Set connection
+	router.HandleFunc(api+"/messages/chats", chatHandler.GetAllChats).Methods("GET")            //Get All Chats
+	router.HandleFunc(api+"/messages/chat/{id}", chatHandler.GetChat).Methods("GET")            //Get One Chats
+	router.HandleFunc(api+"/messages/chat/{id}/read", chatHandler.MarkChatRead).Methods("POST") //Mark chat as read
+	router.HandleFunc(api+"/messages/setconn", chatHandler.SetConnection)                       //Set connection
 	// Reviews Management Routese
 	router.HandleFunc(api+"/reviews", reviewHandler.CreateReview).Methods("POST")
 	router.HandleFunc(api+"/reviews/{userId}", reviewHandler.GetUserReviews).Methods("GET")
+	router.HandleFunc(api+"/reviews/{reviewId:[0-9]+}", reviewHandler.UpdateReviewByID).Methods("PUT")    // Edit own review by id, including ad reviews
+	router.HandleFunc(api+"/reviews/{reviewId:[0-9]+}", reviewHandler.DeleteReviewByID).Methods("DELETE") // Delete own review by id
 	router.HandleFunc(api+"/reviews/{hostId}", reviewHandler.DeleteReview).Methods("DELETE")
 	router.HandleFunc(api+"/reviews/{hostId}", reviewHandler.UpdateReview).Methods("PUT")
+	router.HandleFunc(api+"/housing/{adId}/reviews", reviewHandler.CreateReview).Methods("POST") // Review the ad
+	router.HandleFunc(api+"/housing/{adId}/reviews", reviewHandler.GetAdReviews).Methods("GET")  // Get ad reviews
+	router.HandleFunc(api+"/reviews/{reviewId}/reply", reviewHandler.CreateReply).Methods("POST")
+	router.HandleFunc(api+"/reviews/{reviewId}/reply", reviewHandler.UpdateReply).Methods("PUT")
+	router.HandleFunc(api+"/reviews/{reviewId}/reply", reviewHandler.DeleteReply).Methods("DELETE")
 	// Payment Management Routes
 	router.HandleFunc(api+"/housing/{adId}/payment", adsHandler.UpdatePriorityWithPayment).Methods("PUT")
+	// Booking Management Routes
+	router.HandleFunc(api+"/housing/{adId}/bookings", adsHandler.CreateBooking).Methods("POST")   // Request booking of ad
+	router.HandleFunc(api+"/housing/{adId}/bookings", adsHandler.GetAdBookings).Methods("GET")    // Get ad bookings (host only)
+	router.HandleFunc(api+"/bookings", adsHandler.GetUserBookings).Methods("GET")                 // Get bookings of current user
+	router.HandleFunc(api+"/bookings/{bookingId}", adsHandler.UpdateBookingStatus).Methods("PUT") // Approve, decline, cancel or complete booking
 	// Regions Management Routes
 	router.HandleFunc(api+"/users/{userId}/regions", regionsHandler.GetVisitedRegions).Methods("GET")
+	// Moderation Routes
+	router.HandleFunc(api+"/reports", moderationHandler.CreateReport).Methods("POST") // Report a review, ad or message
+	router.Handle(api+"/admin/reports", requireRole(domain.RoleModerator, moderationHandler.GetReports)).Methods("GET")
+	router.Handle(api+"/admin/reports/{reportId}/{action:hide|dismiss}", requireRole(domain.RoleModerator, moderationHandler.ResolveReport)).Methods("POST")
+	router.Handle(api+"/admin/reports/{reportId}/{action:ban}", requireRole(domain.RoleAdmin, moderationHandler.ResolveReport)).Methods("POST")
+	// Admin Routes
+	router.Handle(api+"/users", requireRole(domain.RoleAdmin, authHandler.GetAllUsers)).Methods("GET")                       // Get all users
+	router.Handle(api+"/admin/users/{userId}/unlock", requireRole(domain.RoleAdmin, authHandler.UnlockUser)).Methods("POST") // Lift login lockout
 	router.Handle(api+"/metrics", promhttp.Handler())
 
 	return router
@@ -0,0 +1,36 @@
+// Package scores пересчитывает оценки объявлений и хозяев по отзывам, которые не скрыты модератором.
+// Используется сервисом отзывов и модерацией, чтобы формулы не разошлись
+package scores
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+
+	"gorm.io/gorm"
+)
+
+const adQuery = `UPDATE ads SET ("reviewsRating", "reviewsCount") = (
+	SELECT COALESCE(ROUND(AVG(rating)::numeric, 1), 0), COUNT(*)
+	FROM reviews WHERE "adId" = ? AND NOT hidden)
+WHERE uuid = ?`
+
+// Кроме среднего хранится байесовская оценка (см. domain.HostScorePriorMean),
+// у хозяина без отзывов обе равны нулю
+const hostQuery = `UPDATE users SET (score, "reviewsCount", "weightedScore") = (
+	SELECT COALESCE(ROUND(AVG(rating)::numeric, 1), 0),
+		COUNT(*),
+		CASE WHEN COUNT(*) = 0 THEN 0
+			ELSE ROUND((?::numeric * ?::numeric + SUM(rating)) / (?::numeric + COUNT(*)), 2) END
+	FROM reviews WHERE "hostId" = ? AND NOT hidden)
+WHERE uuid = ?`
+
+// UpdateAd пересчитывает средний рейтинг и число отзывов объявления
+func UpdateAd(tx *gorm.DB, adID string) error {
+	return tx.Exec(adQuery, adID, adID).Error
+}
+
+// UpdateHost пересчитывает средний рейтинг, число отзывов и байесовскую оценку хозяина
+func UpdateHost(tx *gorm.DB, hostID string) error {
+	return tx.Exec(hostQuery,
+		domain.HostScorePriorWeight, domain.HostScorePriorMean, domain.HostScorePriorWeight,
+		hostID, hostID).Error
+}
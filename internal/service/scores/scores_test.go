@@ -0,0 +1,40 @@
+package scores
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func setupDBMock(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+	require.NoError(t, err)
+	return gormDB, mock
+}
+
+func TestUpdateAd_SkipsHiddenReviews(t *testing.T) {
+	db, mock := setupDBMock(t)
+	mock.ExpectExec(`UPDATE ads SET \("reviewsRating", "reviewsCount"\) = \(\s*SELECT .* FROM reviews WHERE "adId" = \$1 AND NOT hidden\)\s*WHERE uuid = \$2`).
+		WithArgs("ad-uuid", "ad-uuid").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, UpdateAd(db, "ad-uuid"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateHost_SkipsHiddenReviews(t *testing.T) {
+	db, mock := setupDBMock(t)
+	mock.ExpectExec(`UPDATE users SET \(score, "reviewsCount", "weightedScore"\) = \(\s*SELECT .* FROM reviews WHERE "hostId" = \$4 AND NOT hidden\)\s*WHERE uuid = \$5`).
+		WithArgs(domain.HostScorePriorWeight, domain.HostScorePriorMean, domain.HostScorePriorWeight, "host", "host").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, UpdateHost(db, "host"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -13,7 +13,13 @@ import (
 type RedisInterface interface {
 	Get(ctx context.Context, sessionID string) (domain.SessionData, error)
 	Set(ctx context.Context, sessionID string, data domain.SessionData, ttl time.Duration) error
+	// Update перезаписывает данные существующей сессии, не меняя срок её жизни
+	Update(ctx context.Context, sessionID string, data domain.SessionData) error
 	Delete(ctx context.Context, sessionID string) error
+	// Индекс сессий пользователя, может содержать уже истёкшие сессии
+	AddUserSession(ctx context.Context, userID string, sessionID string, ttl time.Duration) error
+	GetUserSessions(ctx context.Context, userID string) ([]string, error)
+	RemoveUserSessions(ctx context.Context, userID string, sessionIDs ...string) error
 }
 
 type RedisSessionStore struct {
@@ -53,3 +59,42 @@ func (r *RedisSessionStore) Set(ctx context.Context, sessionID string, data doma
 func (r *RedisSessionStore) Delete(ctx context.Context, sessionID string) error {
 	return r.client.Del(ctx, sessionID).Err()
 }
+
+func (r *RedisSessionStore) Update(ctx context.Context, sessionID string, data domain.SessionData) error {
+	jsonData, err := easyjson.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	// XX: истёкшая за это время сессия не должна появиться снова
+	return r.client.SetXX(ctx, sessionID, jsonData, redis.KeepTTL).Err()
+}
+
+func userSessionsKey(userID string) string {
+	return "user_sessions:" + userID
+}
+
+func (r *RedisSessionStore) AddUserSession(ctx context.Context, userID string, sessionID string, ttl time.Duration) error {
+	key := userSessionsKey(userID)
+	pipe := r.client.TxPipeline()
+	pipe.SAdd(ctx, key, sessionID)
+	// индекс живёт не меньше самой новой сессии
+	pipe.Expire(ctx, key, ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *RedisSessionStore) GetUserSessions(ctx context.Context, userID string) ([]string, error) {
+	return r.client.SMembers(ctx, userSessionsKey(userID)).Result()
+}
+
+func (r *RedisSessionStore) RemoveUserSessions(ctx context.Context, userID string, sessionIDs ...string) error {
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+	members := make([]interface{}, len(sessionIDs))
+	for i, id := range sessionIDs {
+		members[i] = id
+	}
+	return r.client.SRem(ctx, userSessionsKey(userID), members...).Err()
+}
@@ -6,20 +6,42 @@ import (
 	"2024_2_FIGHT-CLUB/internal/service/middleware"
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"github.com/mailru/easyjson"
 	"go.uber.org/zap"
 	"net/http"
+	"sort"
 	"time"
 )
 
 type InterfaceSession interface {
 	GetUserID(ctx context.Context, sessionID string) (string, error)
 	LogoutSession(ctx context.Context, sessionID string) error
-	CreateSession(ctx context.Context, user *domain.User) (string, error)
+	CreateSession(ctx context.Context, user *domain.User, client domain.SessionClient) (string, error)
 	GetSessionData(ctx context.Context, sessionID string) (*domain.SessionData, error)
+	// GetUserSessions возвращает активные сессии владельца sessionID, текущая отмечена Current
+	GetUserSessions(ctx context.Context, sessionID string) ([]domain.ActiveSession, error)
+	// RevokeSession завершает сессию владельца sessionID по её публичному id из списка
+	RevokeSession(ctx context.Context, sessionID string, publicID string) error
+	RevokeOtherSessions(ctx context.Context, sessionID string) error
+	RevokeUserSessions(ctx context.Context, userID string) error
+	// MarkEmailVerified снимает ограничения неподтверждённой почты во всех сессиях пользователя
+	MarkEmailVerified(ctx context.Context, userID string) error
+	// MarkEmailUnverified возвращает ограничения во все сессии пользователя после смены почты
+	MarkEmailUnverified(ctx context.Context, userID string) error
+	// UpdateUserRole переносит новую роль пользователя во все его сессии
+	UpdateUserRole(ctx context.Context, userID string, role string) error
 }
 
+const (
+	sessionTTL = 24 * time.Hour
+	// lastSeen обновляется не чаще раза в минуту, чтобы не писать в Redis на каждый запрос
+	lastSeenPrecision = time.Minute
+)
+
 type ServiceSession struct {
 	store RedisInterface
 }
@@ -31,7 +53,7 @@ func NewSessionService(store RedisInterface) InterfaceSession {
 }
 
 // CreateSession Создание сессии
-func (s *ServiceSession) CreateSession(ctx context.Context, user *domain.User) (string, error) {
+func (s *ServiceSession) CreateSession(ctx context.Context, user *domain.User, client domain.SessionClient) (string, error) {
 	requestID := middleware.GetRequestID(ctx)
 	logger.AccessLogger.Info("CreateSession called", zap.String("request_id", requestID), zap.String("userID", user.UUID))
 
@@ -43,15 +65,29 @@ func (s *ServiceSession) CreateSession(ctx context.Context, user *domain.User) (
 	}
 
 	// Данные для сессии
+	now := time.Now().Unix()
 	sessionData := domain.SessionData{
-		Id:     user.UUID,
-		Avatar: user.Avatar,
+		Id:              user.UUID,
+		Avatar:          user.Avatar,
+		Role:            domain.RoleOf(user),
+		Device:          client.Device,
+		IP:              client.IP,
+		CreatedAt:       now,
+		LastSeen:        now,
+		EmailUnverified: !user.EmailVerified,
 	}
 	// Сохранение сессии в Redis
-	if err := s.store.Set(ctx, sessionID, sessionData, 24*time.Hour); err != nil {
+	if err := s.store.Set(ctx, sessionID, sessionData, sessionTTL); err != nil {
 		logger.AccessLogger.Error("Failed to save session", zap.String("request_id", requestID), zap.Error(err))
 		return "", errors.New("failed to save session")
 	}
+	if err := s.store.AddUserSession(ctx, user.UUID, sessionID, sessionTTL); err != nil {
+		logger.AccessLogger.Error("Failed to index session", zap.String("request_id", requestID), zap.Error(err))
+		if err = s.store.Delete(ctx, sessionID); err != nil {
+			logger.AccessLogger.Error("Failed to delete unindexed session", zap.String("request_id", requestID), zap.Error(err))
+		}
+		return "", errors.New("failed to save session")
+	}
 
 	logger.AccessLogger.Info("Successfully created session", zap.String("request_id", requestID), zap.String("session_id", sessionID), zap.String("userID", user.UUID))
 	return sessionID, nil
@@ -67,6 +103,7 @@ func (s *ServiceSession) GetUserID(ctx context.Context, sessionID string) (strin
 		logger.AccessLogger.Error("Failed to get session", zap.String("request_id", requestID), zap.Error(err))
 		return "", errors.New("session not found")
 	}
+	s.touch(ctx, sessionID, data)
 
 	userID := data.Id
 
@@ -84,6 +121,7 @@ func (s *ServiceSession) GetSessionData(ctx context.Context, sessionID string) (
 		logger.AccessLogger.Error("Failed to get session", zap.String("request_id", requestID), zap.Error(err))
 		return nil, errors.New("session not found")
 	}
+	s.touch(ctx, sessionID, data)
 
 	logger.AccessLogger.Info("Successfully retrieved session data", zap.String("request_id", requestID), zap.Any("session_data", data))
 	return &data, nil
@@ -94,15 +132,214 @@ func (s *ServiceSession) LogoutSession(ctx context.Context, sessionID string) er
 	requestID := middleware.GetRequestID(ctx)
 	logger.AccessLogger.Info("LogoutSession called", zap.String("request_id", requestID))
 
-	if err := s.store.Delete(ctx, sessionID); err != nil {
+	// у истёкшей сессии владелец неизвестен, индекс почистится при следующем просмотре списка
+	var userID string
+	if data, err := s.store.Get(ctx, sessionID); err == nil {
+		userID = data.Id
+	}
+	if err := s.revoke(ctx, userID, sessionID); err != nil {
 		logger.AccessLogger.Error("Failed to delete session", zap.String("request_id", requestID), zap.Error(err))
-		return errors.New("failed to delete session")
+		return err
 	}
 
 	logger.AccessLogger.Info("Successfully logged out session", zap.String("request_id", requestID))
 	return nil
 }
 
+// touch обновляет время последней активности сессии. Ошибка не мешает запросу, поэтому только логируется
+func (s *ServiceSession) touch(ctx context.Context, sessionID string, data domain.SessionData) {
+	now := time.Now()
+	if now.Sub(time.Unix(data.LastSeen, 0)) < lastSeenPrecision {
+		return
+	}
+	data.LastSeen = now.Unix()
+	if err := s.store.Update(ctx, sessionID, data); err != nil {
+		logger.AccessLogger.Warn("Failed to update session last seen", zap.String("request_id", middleware.GetRequestID(ctx)), zap.Error(err))
+	}
+}
+
+func (s *ServiceSession) revoke(ctx context.Context, userID string, sessionIDs ...string) error {
+	for _, id := range sessionIDs {
+		if err := s.store.Delete(ctx, id); err != nil {
+			return errors.New("failed to delete session")
+		}
+	}
+	if err := s.store.RemoveUserSessions(ctx, userID, sessionIDs...); err != nil {
+		return errors.New("failed to delete session")
+	}
+	return nil
+}
+
+// PublicSessionID - идентификатор сессии для списка активных сессий
+func PublicSessionID(sessionID string) string {
+	sum := sha256.Sum256([]byte(sessionID))
+	return hex.EncodeToString(sum[:8])
+}
+
+// userSessions возвращает живые сессии пользователя, истёкшие убирает из индекса
+func (s *ServiceSession) userSessions(ctx context.Context, userID string) (map[string]domain.SessionData, error) {
+	requestID := middleware.GetRequestID(ctx)
+	ids, err := s.store.GetUserSessions(ctx, userID)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get user sessions", zap.String("request_id", requestID), zap.Error(err))
+		return nil, errors.New("failed to get user sessions")
+	}
+
+	sessions := make(map[string]domain.SessionData, len(ids))
+	var expired []string
+	for _, id := range ids {
+		data, err := s.store.Get(ctx, id)
+		if err != nil {
+			expired = append(expired, id)
+			continue
+		}
+		sessions[id] = data
+	}
+	if err = s.store.RemoveUserSessions(ctx, userID, expired...); err != nil {
+		logger.AccessLogger.Warn("Failed to prune expired sessions", zap.String("request_id", requestID), zap.Error(err))
+	}
+	return sessions, nil
+}
+
+// GetUserSessions Список активных сессий пользователя
+func (s *ServiceSession) GetUserSessions(ctx context.Context, sessionID string) ([]domain.ActiveSession, error) {
+	current, err := s.GetSessionData(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.userSessions(ctx, current.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.ActiveSession, 0, len(sessions))
+	for id, data := range sessions {
+		result = append(result, domain.ActiveSession{
+			ID:        PublicSessionID(id),
+			Device:    data.Device,
+			IP:        data.IP,
+			CreatedAt: time.Unix(data.CreatedAt, 0),
+			LastSeen:  time.Unix(data.LastSeen, 0),
+			Current:   id == sessionID,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].LastSeen.After(result[j].LastSeen)
+	})
+	return result, nil
+}
+
+// RevokeSession Завершение одной из сессий пользователя
+func (s *ServiceSession) RevokeSession(ctx context.Context, sessionID string, publicID string) error {
+	requestID := middleware.GetRequestID(ctx)
+	current, err := s.GetSessionData(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	sessions, err := s.userSessions(ctx, current.Id)
+	if err != nil {
+		return err
+	}
+	for id := range sessions {
+		if PublicSessionID(id) == publicID {
+			if err = s.revoke(ctx, current.Id, id); err != nil {
+				logger.AccessLogger.Error("Failed to revoke session", zap.String("request_id", requestID), zap.Error(err))
+				return err
+			}
+			logger.AccessLogger.Info("Session revoked", zap.String("request_id", requestID), zap.String("userID", current.Id))
+			return nil
+		}
+	}
+	return errors.New("active session not found")
+}
+
+// RevokeOtherSessions Завершение всех сессий пользователя, кроме текущей
+func (s *ServiceSession) RevokeOtherSessions(ctx context.Context, sessionID string) error {
+	requestID := middleware.GetRequestID(ctx)
+	current, err := s.GetSessionData(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	sessions, err := s.userSessions(ctx, current.Id)
+	if err != nil {
+		return err
+	}
+	others := make([]string, 0, len(sessions))
+	for id := range sessions {
+		if id != sessionID {
+			others = append(others, id)
+		}
+	}
+	if err = s.revoke(ctx, current.Id, others...); err != nil {
+		logger.AccessLogger.Error("Failed to revoke sessions", zap.String("request_id", requestID), zap.Error(err))
+		return err
+	}
+	logger.AccessLogger.Info("Other sessions revoked", zap.String("request_id", requestID), zap.String("userID", current.Id), zap.Int("count", len(others)))
+	return nil
+}
+
+// RevokeUserSessions Завершение всех сессий пользователя, например после смены пароля
+func (s *ServiceSession) RevokeUserSessions(ctx context.Context, userID string) error {
+	requestID := middleware.GetRequestID(ctx)
+	ids, err := s.store.GetUserSessions(ctx, userID)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get user sessions", zap.String("request_id", requestID), zap.Error(err))
+		return errors.New("failed to get user sessions")
+	}
+	if err = s.revoke(ctx, userID, ids...); err != nil {
+		logger.AccessLogger.Error("Failed to revoke sessions", zap.String("request_id", requestID), zap.Error(err))
+		return err
+	}
+	logger.AccessLogger.Info("All user sessions revoked", zap.String("request_id", requestID), zap.String("userID", userID), zap.Int("count", len(ids)))
+	return nil
+}
+
+func (s *ServiceSession) MarkEmailVerified(ctx context.Context, userID string) error {
+	return s.setEmailUnverified(ctx, userID, false)
+}
+
+func (s *ServiceSession) MarkEmailUnverified(ctx context.Context, userID string) error {
+	return s.setEmailUnverified(ctx, userID, true)
+}
+
+func (s *ServiceSession) setEmailUnverified(ctx context.Context, userID string, unverified bool) error {
+	requestID := middleware.GetRequestID(ctx)
+	sessions, err := s.userSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for id, data := range sessions {
+		if data.EmailUnverified == unverified {
+			continue
+		}
+		data.EmailUnverified = unverified
+		if err = s.store.Update(ctx, id, data); err != nil {
+			logger.AccessLogger.Error("Failed to update session", zap.String("request_id", requestID), zap.Error(err))
+			return errors.New("failed to save session")
+		}
+	}
+	return nil
+}
+
+func (s *ServiceSession) UpdateUserRole(ctx context.Context, userID string, role string) error {
+	requestID := middleware.GetRequestID(ctx)
+	sessions, err := s.userSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for id, data := range sessions {
+		if data.Role == role {
+			continue
+		}
+		data.Role = role
+		if err = s.store.Update(ctx, id, data); err != nil {
+			logger.AccessLogger.Error("Failed to update session", zap.String("request_id", requestID), zap.Error(err))
+			return errors.New("failed to save session")
+		}
+	}
+	return nil
+}
+
 // GenerateSessionID Генерация уникального session_id
 func GenerateSessionID(ctx context.Context) (string, error) {
 	requestID := middleware.GetRequestID(ctx)
@@ -129,3 +366,36 @@ func GetSessionId(r *http.Request) (string, error) {
 	sessionID := cookie.Value
 	return sessionID, nil
 }
+
+// RequireRole пропускает запрос дальше, только если роль пользователя в сессии не ниже required
+func RequireRole(s InterfaceSession, required string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := middleware.GetRequestID(r.Context())
+			statusCode := http.StatusUnauthorized
+			sessionID, err := GetSessionId(r)
+			var data *domain.SessionData
+			if err == nil {
+				data, err = s.GetSessionData(r.Context(), sessionID)
+			}
+			if err == nil && !domain.HasRole(data.Role, required) {
+				logger.AccessLogger.Warn("Access denied",
+					zap.String("request_id", requestID),
+					zap.String("userID", data.Id),
+					zap.String("role", data.Role),
+					zap.String("required", required))
+				statusCode = http.StatusForbidden
+				err = errors.New("access denied")
+			}
+			if err != nil {
+				w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+				w.WriteHeader(statusCode)
+				if _, jsonErr := easyjson.MarshalToWriter(&domain.ErrorResponse{Error: err.Error()}, w); jsonErr != nil {
+					logger.AccessLogger.Error("Failed to encode error response", zap.String("request_id", requestID), zap.Error(jsonErr))
+				}
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package session
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSessionService(t *testing.T) (*miniredis.Miniredis, InterfaceSession) {
+	require.NoError(t, logger.InitLoggers())
+	t.Cleanup(func() {
+		_ = logger.SyncLoggers()
+	})
+	fakeRedis := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: fakeRedis.Addr()})
+	return fakeRedis, NewSessionService(NewRedisSessionStore(rdb))
+}
+
+func TestServiceSession_ActiveSessions(t *testing.T) {
+	_, service := newTestSessionService(t)
+	ctx := context.Background()
+	user := &domain.User{UUID: "user1"}
+
+	laptop, err := service.CreateSession(ctx, user, domain.SessionClient{Device: "Firefox", IP: "10.0.0.1"})
+	require.NoError(t, err)
+	phone, err := service.CreateSession(ctx, user, domain.SessionClient{Device: "Safari", IP: "10.0.0.2"})
+	require.NoError(t, err)
+	_, err = service.CreateSession(ctx, &domain.User{UUID: "user2"}, domain.SessionClient{})
+	require.NoError(t, err)
+
+	sessions, err := service.GetUserSessions(ctx, laptop)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	byID := map[string]domain.ActiveSession{}
+	for _, s := range sessions {
+		byID[s.ID] = s
+	}
+	assert.True(t, byID[PublicSessionID(laptop)].Current)
+	assert.Equal(t, "Firefox", byID[PublicSessionID(laptop)].Device)
+	assert.False(t, byID[PublicSessionID(phone)].Current)
+	assert.Equal(t, "10.0.0.2", byID[PublicSessionID(phone)].IP)
+
+	t.Run("Revoke Unknown Session", func(t *testing.T) {
+		err := service.RevokeSession(ctx, laptop, "deadbeef")
+		assert.EqualError(t, err, "active session not found")
+	})
+
+	t.Run("Revoke Other Sessions", func(t *testing.T) {
+		require.NoError(t, service.RevokeOtherSessions(ctx, laptop))
+
+		_, err := service.GetUserID(ctx, phone)
+		assert.EqualError(t, err, "session not found")
+		sessions, err := service.GetUserSessions(ctx, laptop)
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.True(t, sessions[0].Current)
+	})
+
+	t.Run("Revoke Session By ID", func(t *testing.T) {
+		tablet, err := service.CreateSession(ctx, user, domain.SessionClient{Device: "Chrome"})
+		require.NoError(t, err)
+
+		require.NoError(t, service.RevokeSession(ctx, laptop, PublicSessionID(tablet)))
+		_, err = service.GetUserID(ctx, tablet)
+		assert.EqualError(t, err, "session not found")
+		_, err = service.GetUserID(ctx, laptop)
+		assert.NoError(t, err)
+	})
+}
+
+func TestServiceSession_RevokeUserSessions(t *testing.T) {
+	fakeRedis, service := newTestSessionService(t)
+	ctx := context.Background()
+	user := &domain.User{UUID: "user1"}
+
+	first, err := service.CreateSession(ctx, user, domain.SessionClient{})
+	require.NoError(t, err)
+	second, err := service.CreateSession(ctx, user, domain.SessionClient{})
+	require.NoError(t, err)
+	other, err := service.CreateSession(ctx, &domain.User{UUID: "user2"}, domain.SessionClient{})
+	require.NoError(t, err)
+
+	require.NoError(t, service.RevokeUserSessions(ctx, user.UUID))
+
+	for _, id := range []string{first, second} {
+		_, err = service.GetUserID(ctx, id)
+		assert.EqualError(t, err, "session not found")
+	}
+	assert.False(t, fakeRedis.Exists("user_sessions:"+user.UUID))
+	_, err = service.GetUserID(ctx, other)
+	assert.NoError(t, err)
+}
+
+func TestServiceSession_LastSeen(t *testing.T) {
+	fakeRedis, service := newTestSessionService(t)
+	ctx := context.Background()
+
+	sessionID, err := service.CreateSession(ctx, &domain.User{UUID: "user1"}, domain.SessionClient{})
+	require.NoError(t, err)
+	created, err := service.GetSessionData(ctx, sessionID)
+	require.NoError(t, err)
+
+	// сессия создана два часа назад, обращение обновляет lastSeen, но не время создания
+	created.CreatedAt -= int64((2 * time.Hour).Seconds())
+	created.LastSeen = created.CreatedAt
+	stale, err := created.MarshalJSON()
+	require.NoError(t, err)
+	require.NoError(t, fakeRedis.Set(sessionID, string(stale)))
+
+	_, err = service.GetUserID(ctx, sessionID)
+	require.NoError(t, err)
+
+	sessions, err := service.GetUserSessions(ctx, sessionID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.WithinDuration(t, time.Now(), sessions[0].LastSeen, 5*time.Second)
+	assert.Equal(t, created.CreatedAt, sessions[0].CreatedAt.Unix())
+}
+
+func TestServiceSession_MarkEmailVerified(t *testing.T) {
+	_, service := newTestSessionService(t)
+	ctx := context.Background()
+	user := &domain.User{UUID: "user1"}
+
+	first, err := service.CreateSession(ctx, user, domain.SessionClient{})
+	require.NoError(t, err)
+	second, err := service.CreateSession(ctx, user, domain.SessionClient{})
+	require.NoError(t, err)
+	data, err := service.GetSessionData(ctx, first)
+	require.NoError(t, err)
+	assert.True(t, data.EmailUnverified)
+
+	require.NoError(t, service.MarkEmailVerified(ctx, user.UUID))
+
+	for _, id := range []string{first, second} {
+		data, err = service.GetSessionData(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, data.EmailUnverified)
+	}
+
+	// после смены почты ограничения возвращаются
+	require.NoError(t, service.MarkEmailUnverified(ctx, user.UUID))
+
+	for _, id := range []string{first, second} {
+		data, err = service.GetSessionData(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, data.EmailUnverified)
+	}
+}
+
+func TestServiceSession_UpdateUserRole(t *testing.T) {
+	_, service := newTestSessionService(t)
+	ctx := context.Background()
+	user := &domain.User{UUID: "user1"}
+
+	first, err := service.CreateSession(ctx, user, domain.SessionClient{})
+	require.NoError(t, err)
+	second, err := service.CreateSession(ctx, user, domain.SessionClient{})
+	require.NoError(t, err)
+	other, err := service.CreateSession(ctx, &domain.User{UUID: "user2"}, domain.SessionClient{})
+	require.NoError(t, err)
+
+	require.NoError(t, service.UpdateUserRole(ctx, user.UUID, domain.RoleHost))
+
+	for _, id := range []string{first, second} {
+		data, err := service.GetSessionData(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, domain.RoleHost, data.Role)
+	}
+	data, err := service.GetSessionData(ctx, other)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RoleGuest, data.Role)
+}
@@ -0,0 +1,79 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// секрет и ожидаемые значения для SHA1 из приложения B RFC 6238, коды обрезаны до 6 цифр
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCode_RFC6238Vectors(t *testing.T) {
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for unix, expected := range vectors {
+		code, err := Code(rfcSecret, time.Unix(unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, expected, code, "time %d", unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	code, err := Code(rfcSecret, now)
+	require.NoError(t, err)
+
+	assert.True(t, Validate(rfcSecret, code, now))
+	assert.True(t, Validate(rfcSecret, code, now.Add(Period*time.Second)))
+	assert.False(t, Validate(rfcSecret, code, now.Add(3*Period*time.Second)))
+	assert.False(t, Validate(rfcSecret, "000000", now))
+	assert.False(t, Validate(rfcSecret, code[:5], now))
+	assert.False(t, Validate("not base32!", code, now))
+}
+
+func TestValidateStep(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step := now.Unix() / Period
+	code, err := Code(rfcSecret, now)
+	require.NoError(t, err)
+
+	matched, ok := ValidateStep(rfcSecret, code, now)
+	assert.True(t, ok)
+	assert.Equal(t, step, matched)
+
+	// код из прошлого шага принимается с учётом расхождения часов, но шаг остаётся его собственным
+	matched, ok = ValidateStep(rfcSecret, code, now.Add(Period*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, step, matched)
+
+	_, ok = ValidateStep(rfcSecret, "000000", now)
+	assert.False(t, ok)
+}
+
+func TestGenerateSecretAndURI(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	_, err = Code(secret, time.Now())
+	require.NoError(t, err)
+
+	uri, err := url.Parse(URI("FightClub", "host user", secret))
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "totp", uri.Host)
+	assert.Equal(t, "/FightClub:host user", uri.Path)
+	assert.Equal(t, secret, uri.Query().Get("secret"))
+	assert.Equal(t, "FightClub", uri.Query().Get("issuer"))
+	assert.False(t, strings.Contains(secret, "="))
+}
@@ -4,7 +4,9 @@ import (
 	"2024_2_FIGHT-CLUB/domain"
 	adsGen "2024_2_FIGHT-CLUB/microservices/ads_service/controller/gen"
 	authGen "2024_2_FIGHT-CLUB/microservices/auth_service/controller/gen"
+	chatGen "2024_2_FIGHT-CLUB/microservices/chat_service/controller/gen"
 	cityGen "2024_2_FIGHT-CLUB/microservices/city_service/controller/gen"
+	reviewsGen "2024_2_FIGHT-CLUB/microservices/reviews_service/controller/gen"
 	"errors"
 	"fmt"
 	"log"
@@ -19,8 +21,18 @@ type UtilsInterface interface {
 	ConvertUserResponseProtoToGo(user *authGen.MetadataOneUser) (domain.UserDataResponse, error)
 	ConvertUsersProtoToGo(users *authGen.AllUsersResponse) ([]*domain.UserDataResponse, error)
 	ConvertSessionDataProtoToGo(sessionData *authGen.SessionDataResponse) (domain.SessionData, error)
+	ConvertSessionsProtoToGo(sessions *authGen.SessionsResponse) (domain.ActiveSessionsList, error)
 	ConvertAllCitiesProtoToGo(cities *cityGen.GetCitiesResponse) ([]*domain.City, error)
 	ConvertOneCityProtoToGo(city *cityGen.City) (domain.City, error)
+	ConvertBookingProtoToGo(booking *adsGen.Booking) (domain.Booking, error)
+	ConvertBookingsProtoToGo(bookings *adsGen.BookingList) (domain.BookingsResponse, error)
+	ConvertChatsProtoToGo(chats *chatGen.GetChatsResponse) (domain.AllChats, error)
+	ConvertMessagesProtoToGo(messages *chatGen.GetMessagesResponse) (domain.AllMessages, error)
+	ConvertMessageProtoToGo(message *chatGen.Message) (domain.Message, error)
+	ConvertReceiptProtoToGo(receipt *chatGen.Receipt) (domain.ChatReceipt, error)
+	ConvertChatEventProtoToGo(event *chatGen.ChatEvent) (*domain.ChatEvent, error)
+	ConvertReviewProtoToGo(review *reviewsGen.Review) (domain.Review, error)
+	ConvertUserReviewsProtoToGo(reviews *reviewsGen.GetUserReviewsResponse) (domain.UserReviewsList, error)
 }
 
 type Utils struct{}
@@ -54,7 +66,7 @@ func (u *Utils) ConvertGetAllAdsResponseProtoToGo(proto *adsGen.GetAllAdsRespons
 		ads = append(ads, adConverted)
 	}
 
-	return domain.GetAllAdsListResponse{Housing: ads}, nil
+	return domain.GetAllAdsListResponse{Housing: ads, NextCursor: proto.NextCursor}, nil
 }
 
 func (u *Utils) ConvertAdProtoToGo(ad *adsGen.GetAllAdsResponse) (domain.GetAllAdsResponse, error) {
@@ -91,29 +103,56 @@ func (u *Utils) ConvertAdProtoToGo(ad *adsGen.GetAllAdsResponse) (domain.GetAllA
 		return domain.GetAllAdsResponse{}, err
 	}
 
+	stayPrice, err := u.convertStayPriceProtoToGo(ad.StayPrice, ad.Id)
+	if err != nil {
+		return domain.GetAllAdsResponse{}, err
+	}
+
+	var latitude, longitude, distance, searchRank *float64
+	if ad.Position != nil {
+		latitude, longitude = &ad.Position.Latitude, &ad.Position.Longitude
+	}
+	if ad.Distance > 0 {
+		distance = &ad.Distance
+	}
+	if ad.SearchRank > 0 {
+		searchRank = &ad.SearchRank
+	}
+
 	// Преобразуем объявление
 	return domain.GetAllAdsResponse{
-		UUID:            ad.Id,
-		CityID:          int(ad.CityId),
-		AuthorUUID:      ad.AuthorUUID,
-		Address:         ad.Address,
-		PublicationDate: parsedPublicationDate,
-		Description:     ad.Description,
-		RoomsNumber:     int(ad.RoomsNumber),
-		ViewsCount:      int(ad.ViewsCount),
-		SquareMeters:    int(ad.SquareMeters),
-		Floor:           int(ad.Floor),
-		BuildingType:    ad.BuildingType,
-		HasBalcony:      ad.HasBalcony,
-		HasElevator:     ad.HasElevator,
-		HasGas:          ad.HasGas,
-		LikesCount:      int(ad.LikesCount),
-		Priority:        int(ad.Priority),
-		EndBoostDate:    parsedEndBoostDate,
-		CityName:        ad.CityName,
-		AdDateFrom:      parsedDateFrom,
-		AdDateTo:        parsedDateTo,
-		IsFavorite:      ad.IsFavorite,
+		UUID:                 ad.Id,
+		CityID:               int(ad.CityId),
+		AuthorUUID:           ad.AuthorUUID,
+		Address:              ad.Address,
+		PublicationDate:      parsedPublicationDate,
+		Description:          ad.Description,
+		RoomsNumber:          int(ad.RoomsNumber),
+		ViewsCount:           int(ad.ViewsCount),
+		SquareMeters:         int(ad.SquareMeters),
+		Floor:                int(ad.Floor),
+		BuildingType:         ad.BuildingType,
+		HasBalcony:           ad.HasBalcony,
+		HasElevator:          ad.HasElevator,
+		HasGas:               ad.HasGas,
+		LikesCount:           int(ad.LikesCount),
+		ReviewsRating:        ad.Rating,
+		ReviewsCount:         int(ad.ReviewsCount),
+		Priority:             int(ad.Priority),
+		EndBoostDate:         parsedEndBoostDate,
+		Price:                int(ad.Price),
+		WeekendPrice:         int(ad.WeekendPrice),
+		CleaningFee:          int(ad.CleaningFee),
+		Latitude:             latitude,
+		Longitude:            longitude,
+		Distance:             distance,
+		SearchRank:           searchRank,
+		DescriptionHighlight: ad.DescriptionHighlight,
+		AddressHighlight:     ad.AddressHighlight,
+		CityName:             ad.CityName,
+		AdDateFrom:           parsedDateFrom,
+		AdDateTo:             parsedDateTo,
+		IsFavorite:           ad.IsFavorite,
 		AdAuthor: domain.UserResponce{
 			Rating:     math.Round(float64(ad.AdAuthor.Rating)*10) / 10,
 			Avatar:     ad.AdAuthor.Avatar,
@@ -122,8 +161,10 @@ func (u *Utils) ConvertAdProtoToGo(ad *adsGen.GetAllAdsResponse) (domain.GetAllA
 			Birthdate:  parsedBirthDate,
 			GuestCount: int(ad.AdAuthor.GuestCount),
 		},
-		Images: u.convertImagesResponseProtoToGo(ad.Images),
-		Rooms:  u.convertAdRoomsResponseProtoToGo(ad.Rooms),
+		Images:    u.convertImagesResponseProtoToGo(ad.Images),
+		Rooms:     u.convertAdRoomsResponseProtoToGo(ad.Rooms),
+		Calendar:  u.convertAdCalendarProtoToGo(ad.Calendar),
+		StayPrice: stayPrice,
 	}, nil
 }
 
@@ -150,6 +191,48 @@ func (u *Utils) convertAdRoomsResponseProtoToGo(protoRooms []*adsGen.AdRooms) []
 	return rooms
 }
 
+func (u *Utils) convertStayPriceProtoToGo(protoPrice *adsGen.StayPrice, adID string) (*domain.StayPrice, error) {
+	if protoPrice == nil {
+		return nil, nil
+	}
+	dateFrom, err := parseDate(protoPrice.DateFrom, adID, "StayDateFrom")
+	if err != nil {
+		return nil, err
+	}
+	dateTo, err := parseDate(protoPrice.DateTo, adID, "StayDateTo")
+	if err != nil {
+		return nil, err
+	}
+	return &domain.StayPrice{
+		DateFrom:    dateFrom,
+		DateTo:      dateTo,
+		Nights:      int(protoPrice.Nights),
+		NightsPrice: int(protoPrice.NightsPrice),
+		CleaningFee: int(protoPrice.CleaningFee),
+		Total:       int(protoPrice.Total),
+	}, nil
+}
+
+func (u *Utils) convertAdCalendarProtoToGo(protoCalendar *adsGen.AdCalendar) *domain.AdCalendar {
+	if protoCalendar == nil {
+		return nil
+	}
+	calendar := &domain.AdCalendar{
+		AvailableDates: make([]domain.AvailableDateRange, len(protoCalendar.AvailableDates)),
+		BlockedDates:   make([]time.Time, len(protoCalendar.BlockedDates)),
+	}
+	for i, dateRange := range protoCalendar.AvailableDates {
+		calendar.AvailableDates[i] = domain.AvailableDateRange{
+			DateFrom: dateRange.DateFrom.AsTime(),
+			DateTo:   dateRange.DateTo.AsTime(),
+		}
+	}
+	for i, date := range protoCalendar.BlockedDates {
+		calendar.BlockedDates[i] = date.AsTime()
+	}
+	return calendar
+}
+
 func (u *Utils) ConvertAuthResponseProtoToGo(response *authGen.UserResponse, userSession string) (domain.AuthResponse, error) {
 	if response == nil || response.User == nil {
 		return domain.AuthResponse{}, errors.New("invalid response or user nil")
@@ -205,11 +288,32 @@ func (u *Utils) ConvertSessionDataProtoToGo(sessionData *authGen.SessionDataResp
 	}
 
 	return domain.SessionData{
-		Id:     sessionData.Id,
-		Avatar: sessionData.Avatar,
+		Id:              sessionData.Id,
+		Avatar:          sessionData.Avatar,
+		Role:            sessionData.Role,
+		EmailUnverified: sessionData.EmailUnverified,
 	}, nil
 }
 
+func (u *Utils) ConvertSessionsProtoToGo(sessions *authGen.SessionsResponse) (domain.ActiveSessionsList, error) {
+	if sessions == nil {
+		return domain.ActiveSessionsList{}, errors.New("sessions is nil")
+	}
+
+	list := domain.ActiveSessionsList{Sessions: make([]domain.ActiveSession, 0, len(sessions.Sessions))}
+	for _, activeSession := range sessions.Sessions {
+		list.Sessions = append(list.Sessions, domain.ActiveSession{
+			ID:        activeSession.Id,
+			Device:    activeSession.Device,
+			IP:        activeSession.Ip,
+			CreatedAt: activeSession.CreatedAt.AsTime(),
+			LastSeen:  activeSession.LastSeen.AsTime(),
+			Current:   activeSession.Current,
+		})
+	}
+	return list, nil
+}
+
 func (u *Utils) ConvertAllCitiesProtoToGo(cities *cityGen.GetCitiesResponse) ([]*domain.City, error) {
 	if cities == nil || cities.Cities == nil {
 		return []*domain.City{}, errors.New("cities is nil")
@@ -240,3 +344,280 @@ func (u *Utils) ConvertOneCityProtoToGo(city *cityGen.City) (domain.City, error)
 		Image:       city.Image,
 	}, nil
 }
+
+func (u *Utils) ConvertBookingProtoToGo(booking *adsGen.Booking) (domain.Booking, error) {
+	if booking == nil {
+		return domain.Booking{}, errors.New("booking is nil")
+	}
+
+	adID := booking.AdId
+	dateFrom, err := parseDate(booking.DateFrom, adID, "DateFrom")
+	if err != nil {
+		return domain.Booking{}, err
+	}
+	dateTo, err := parseDate(booking.DateTo, adID, "DateTo")
+	if err != nil {
+		return domain.Booking{}, err
+	}
+	createDate, err := time.Parse(time.RFC3339, booking.CreateDate)
+	if err != nil {
+		return domain.Booking{}, errors.New("error parsing date for booking")
+	}
+	updateDate, err := time.Parse(time.RFC3339, booking.UpdateDate)
+	if err != nil {
+		return domain.Booking{}, errors.New("error parsing date for booking")
+	}
+
+	result := domain.Booking{
+		ID:         int(booking.Id),
+		AdID:       booking.AdId,
+		UserID:     booking.UserId,
+		HostID:     booking.HostId,
+		Status:     booking.Status,
+		DateFrom:   dateFrom,
+		DateTo:     dateTo,
+		CreateDate: createDate,
+		UpdateDate: updateDate,
+	}
+	if booking.CloseDate != "" {
+		closeDate, err := time.Parse(time.RFC3339, booking.CloseDate)
+		if err != nil {
+			return domain.Booking{}, errors.New("error parsing date for booking")
+		}
+		result.CloseDate = &closeDate
+	}
+	return result, nil
+}
+
+func (u *Utils) ConvertBookingsProtoToGo(bookings *adsGen.BookingList) (domain.BookingsResponse, error) {
+	if bookings == nil {
+		return domain.BookingsResponse{}, errors.New("bookings is nil")
+	}
+	body := domain.BookingsResponse{Bookings: make([]domain.Booking, 0, len(bookings.Bookings))}
+	for _, booking := range bookings.Bookings {
+		converted, err := u.ConvertBookingProtoToGo(booking)
+		if err != nil {
+			return domain.BookingsResponse{}, err
+		}
+		body.Bookings = append(body.Bookings, converted)
+	}
+	return body, nil
+}
+
+// время в чате передаётся с наносекундами, иначе курсоры и lastTime теряют точность
+const chatTimeLayout = time.RFC3339Nano
+
+func parseChatTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(chatTimeLayout, value)
+	if err != nil {
+		return nil, errors.New("error parsing date for chat")
+	}
+	return &parsed, nil
+}
+
+func (u *Utils) ConvertChatsProtoToGo(chats *chatGen.GetChatsResponse) (domain.AllChats, error) {
+	if chats == nil {
+		return domain.AllChats{}, errors.New("chats is nil")
+	}
+	body := domain.AllChats{
+		Chats:      make([]*domain.Chat, 0, len(chats.Chats)),
+		NextCursor: chats.NextCursor,
+	}
+	for _, chat := range chats.Chats {
+		lastDate, err := parseChatTime(chat.LastDate)
+		if err != nil {
+			return domain.AllChats{}, err
+		}
+		converted := &domain.Chat{
+			LastMessage:  chat.LastMessage,
+			AuthorName:   chat.AuthorName,
+			AuthorAvatar: chat.AuthorAvatar,
+			AuthorUUID:   chat.AuthorUuid,
+			UnreadCount:  int(chat.UnreadCount),
+		}
+		if lastDate != nil {
+			converted.LastDate = *lastDate
+		}
+		body.Chats = append(body.Chats, converted)
+	}
+	return body, nil
+}
+
+func (u *Utils) ConvertMessagesProtoToGo(messages *chatGen.GetMessagesResponse) (domain.AllMessages, error) {
+	if messages == nil {
+		return domain.AllMessages{}, errors.New("messages is nil")
+	}
+	body := domain.AllMessages{Chat: make([]*domain.Message, 0, len(messages.Messages))}
+	for _, message := range messages.Messages {
+		converted, err := u.ConvertMessageProtoToGo(message)
+		if err != nil {
+			return domain.AllMessages{}, err
+		}
+		body.Chat = append(body.Chat, &converted)
+	}
+	return body, nil
+}
+
+func (u *Utils) ConvertMessageProtoToGo(message *chatGen.Message) (domain.Message, error) {
+	if message == nil {
+		return domain.Message{}, errors.New("message is nil")
+	}
+	createdAt, err := parseChatTime(message.CreatedAt)
+	if err != nil {
+		return domain.Message{}, err
+	}
+	deliveredAt, err := parseChatTime(message.DeliveredAt)
+	if err != nil {
+		return domain.Message{}, err
+	}
+	readAt, err := parseChatTime(message.ReadAt)
+	if err != nil {
+		return domain.Message{}, err
+	}
+
+	result := domain.Message{
+		ID:          int(message.Id),
+		SenderID:    message.SenderId,
+		ReceiverID:  message.ReceiverId,
+		Content:     message.Content,
+		DeliveredAt: deliveredAt,
+		ReadAt:      readAt,
+	}
+	if createdAt != nil {
+		result.CreatedAt = *createdAt
+	}
+	return result, nil
+}
+
+func (u *Utils) ConvertReceiptProtoToGo(receipt *chatGen.Receipt) (domain.ChatReceipt, error) {
+	if receipt == nil {
+		return domain.ChatReceipt{}, errors.New("receipt is nil")
+	}
+	at, err := parseChatTime(receipt.At)
+	if err != nil {
+		return domain.ChatReceipt{}, err
+	}
+	result := domain.ChatReceipt{
+		Type:      receipt.Type,
+		MessageID: int(receipt.MessageId),
+		UserID:    receipt.UserId,
+	}
+	if at != nil {
+		result.At = *at
+	}
+	return result, nil
+}
+
+// ConvertChatEventProtoToGo упаковывает событие из потока Subscribe в конверт веб-сокета
+func (u *Utils) ConvertChatEventProtoToGo(event *chatGen.ChatEvent) (*domain.ChatEvent, error) {
+	if event == nil {
+		return nil, errors.New("chat event is nil")
+	}
+	switch e := event.Event.(type) {
+	case *chatGen.ChatEvent_Message:
+		message, err := u.ConvertMessageProtoToGo(e.Message)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewChatEvent(domain.ChatEventMessageNew, "", &message)
+	case *chatGen.ChatEvent_Delivered:
+		receipt, err := u.ConvertReceiptProtoToGo(e.Delivered)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewChatEvent(domain.ChatEventMessageDelivered, "", &receipt)
+	case *chatGen.ChatEvent_Read:
+		receipt, err := u.ConvertReceiptProtoToGo(e.Read)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewChatEvent(domain.ChatEventRead, "", &receipt)
+	case *chatGen.ChatEvent_Typing:
+		return domain.NewChatEvent(domain.ChatEventTyping, "", &domain.TypingPayload{
+			UserID: e.Typing.UserId,
+			Typing: e.Typing.Typing,
+		})
+	default:
+		return nil, errors.New("unknown chat event")
+	}
+}
+
+func parseReviewTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, errors.New("error parsing date for review")
+	}
+	return parsed, nil
+}
+
+func (u *Utils) ConvertReviewProtoToGo(review *reviewsGen.Review) (domain.Review, error) {
+	if review == nil {
+		return domain.Review{}, errors.New("review is nil")
+	}
+	createdAt, err := parseReviewTime(review.CreatedAt)
+	if err != nil {
+		return domain.Review{}, err
+	}
+	return domain.Review{
+		ID:        int(review.Id),
+		UserID:    review.UserId,
+		HostID:    review.HostId,
+		AdID:      optionalString(review.AdId),
+		Title:     review.Title,
+		Text:      review.Text,
+		Rating:    int(review.Rating),
+		CreatedAt: createdAt,
+	}, nil
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func (u *Utils) ConvertUserReviewsProtoToGo(reviews *reviewsGen.GetUserReviewsResponse) (domain.UserReviewsList, error) {
+	if reviews == nil {
+		return domain.UserReviewsList{}, errors.New("reviews is nil")
+	}
+	body := domain.UserReviewsList{
+		Reviews:    make([]domain.UserReviews, 0, len(reviews.Reviews)),
+		NextCursor: reviews.NextCursor,
+	}
+	for _, review := range reviews.Reviews {
+		createdAt, err := parseReviewTime(review.CreatedAt)
+		if err != nil {
+			return domain.UserReviewsList{}, err
+		}
+		var replyUpdatedAt *time.Time
+		if review.ReplyUpdatedAt != "" {
+			parsed, err := parseReviewTime(review.ReplyUpdatedAt)
+			if err != nil {
+				return domain.UserReviewsList{}, err
+			}
+			replyUpdatedAt = &parsed
+		}
+		body.Reviews = append(body.Reviews, domain.UserReviews{
+			ID:             int(review.Id),
+			UserID:         review.UserId,
+			HostID:         review.HostId,
+			AdID:           optionalString(review.AdId),
+			Title:          review.Title,
+			Text:           review.Text,
+			Rating:         int(review.Rating),
+			CreatedAt:      createdAt,
+			Reply:          optionalString(review.Reply),
+			ReplyUpdatedAt: replyUpdatedAt,
+			UserAvatar:     review.UserAvatar,
+			UserName:       review.UserName,
+		})
+	}
+	return body, nil
+}
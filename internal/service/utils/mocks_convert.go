@@ -4,7 +4,9 @@ import (
 	"2024_2_FIGHT-CLUB/domain"
 	"2024_2_FIGHT-CLUB/microservices/ads_service/controller/gen"
 	authGen "2024_2_FIGHT-CLUB/microservices/auth_service/controller/gen"
+	chatGen "2024_2_FIGHT-CLUB/microservices/chat_service/controller/gen"
 	cityGen "2024_2_FIGHT-CLUB/microservices/city_service/controller/gen"
+	reviewsGen "2024_2_FIGHT-CLUB/microservices/reviews_service/controller/gen"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -60,6 +62,14 @@ func (m *MockUtils) ConvertSessionDataProtoToGo(sessionData *authGen.SessionData
 	return domain.SessionData{}, args.Error(1)
 }
 
+func (m *MockUtils) ConvertSessionsProtoToGo(sessions *authGen.SessionsResponse) (domain.ActiveSessionsList, error) {
+	args := m.Called(sessions)
+	if res, ok := args.Get(0).(domain.ActiveSessionsList); ok {
+		return res, args.Error(1)
+	}
+	return domain.ActiveSessionsList{}, args.Error(1)
+}
+
 func (m *MockUtils) ConvertAllCitiesProtoToGo(cities *cityGen.GetCitiesResponse) ([]*domain.City, error) {
 	args := m.Called(cities)
 	var trueRes []*domain.City
@@ -77,3 +87,75 @@ func (m *MockUtils) ConvertOneCityProtoToGo(city *cityGen.City) (domain.City, er
 	}
 	return domain.City{}, args.Error(1)
 }
+
+func (m *MockUtils) ConvertBookingProtoToGo(booking *gen.Booking) (domain.Booking, error) {
+	args := m.Called(booking)
+	if res, ok := args.Get(0).(domain.Booking); ok {
+		return res, args.Error(1)
+	}
+	return domain.Booking{}, args.Error(1)
+}
+
+func (m *MockUtils) ConvertBookingsProtoToGo(bookings *gen.BookingList) (domain.BookingsResponse, error) {
+	args := m.Called(bookings)
+	if res, ok := args.Get(0).(domain.BookingsResponse); ok {
+		return res, args.Error(1)
+	}
+	return domain.BookingsResponse{}, args.Error(1)
+}
+
+func (m *MockUtils) ConvertChatsProtoToGo(chats *chatGen.GetChatsResponse) (domain.AllChats, error) {
+	args := m.Called(chats)
+	if res, ok := args.Get(0).(domain.AllChats); ok {
+		return res, args.Error(1)
+	}
+	return domain.AllChats{}, args.Error(1)
+}
+
+func (m *MockUtils) ConvertMessagesProtoToGo(messages *chatGen.GetMessagesResponse) (domain.AllMessages, error) {
+	args := m.Called(messages)
+	if res, ok := args.Get(0).(domain.AllMessages); ok {
+		return res, args.Error(1)
+	}
+	return domain.AllMessages{}, args.Error(1)
+}
+
+func (m *MockUtils) ConvertMessageProtoToGo(message *chatGen.Message) (domain.Message, error) {
+	args := m.Called(message)
+	if res, ok := args.Get(0).(domain.Message); ok {
+		return res, args.Error(1)
+	}
+	return domain.Message{}, args.Error(1)
+}
+
+func (m *MockUtils) ConvertReceiptProtoToGo(receipt *chatGen.Receipt) (domain.ChatReceipt, error) {
+	args := m.Called(receipt)
+	if res, ok := args.Get(0).(domain.ChatReceipt); ok {
+		return res, args.Error(1)
+	}
+	return domain.ChatReceipt{}, args.Error(1)
+}
+
+func (m *MockUtils) ConvertChatEventProtoToGo(event *chatGen.ChatEvent) (*domain.ChatEvent, error) {
+	args := m.Called(event)
+	if res, ok := args.Get(0).(*domain.ChatEvent); ok {
+		return res, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockUtils) ConvertReviewProtoToGo(review *reviewsGen.Review) (domain.Review, error) {
+	args := m.Called(review)
+	if res, ok := args.Get(0).(domain.Review); ok {
+		return res, args.Error(1)
+	}
+	return domain.Review{}, args.Error(1)
+}
+
+func (m *MockUtils) ConvertUserReviewsProtoToGo(reviews *reviewsGen.GetUserReviewsResponse) (domain.UserReviewsList, error) {
+	args := m.Called(reviews)
+	if res, ok := args.Get(0).(domain.UserReviewsList); ok {
+		return res, args.Error(1)
+	}
+	return domain.UserReviewsList{}, args.Error(1)
+}
@@ -53,7 +53,7 @@ func main() {
 		}
 	}()
 
-	jwtToken, err := middleware.NewJwtToken("secret-key")
+	jwtToken, err := middleware.NewJwtTokenFromEnv()
 	if err != nil {
 		log.Fatalf("Failed to create JWT token: %v", err)
 	}
@@ -67,6 +67,10 @@ func main() {
 		grpc.UnaryInterceptor(middleware.ChainUnaryInterceptors(
 			middleware.RecoveryInterceptor,     // интерсептор для обработки паники
 			middleware.UnaryMetricsInterceptor, // интерсептор для метрик
+			// интерсептор для проверки ролей
+			middleware.RoleInterceptor(sessionService, grpcAd.MethodRoles),
+			// интерсептор для проверки подтверждения почты
+			middleware.EmailVerifiedInterceptor(sessionService, grpcAd.VerifiedMethods),
 		)),
 	)
 	generatedAds.RegisterAdsServer(grpcServer, adsServer)
@@ -4,6 +4,7 @@ import (
 	"2024_2_FIGHT-CLUB/domain"
 	"2024_2_FIGHT-CLUB/internal/service/logger"
 	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/pagination"
 	"2024_2_FIGHT-CLUB/internal/service/session"
 	"2024_2_FIGHT-CLUB/microservices/ads_service/controller/gen"
 	"2024_2_FIGHT-CLUB/microservices/ads_service/usecase"
@@ -12,9 +13,31 @@ import (
 	"github.com/microcosm-cc/bluemonday"
 	"go.uber.org/zap"
 	"strconv"
+	"strings"
 	"time"
 )
 
+// MethodRoles - минимальные роли для методов сервиса, проверяются middleware.RoleInterceptor.
+// Хозяина объявления и участников брони по-прежнему проверяет usecase
+var MethodRoles = map[string]string{
+	gen.Ads_CreatePlace_FullMethodName:         domain.RoleGuest,
+	gen.Ads_UpdatePlace_FullMethodName:         domain.RoleGuest,
+	gen.Ads_DeletePlace_FullMethodName:         domain.RoleGuest,
+	gen.Ads_DeleteAdImage_FullMethodName:       domain.RoleGuest,
+	gen.Ads_AddToFavorites_FullMethodName:      domain.RoleGuest,
+	gen.Ads_DeleteFromFavorites_FullMethodName: domain.RoleGuest,
+	gen.Ads_UpdatePriority_FullMethodName:      domain.RoleGuest,
+	gen.Ads_CreateBooking_FullMethodName:       domain.RoleGuest,
+	gen.Ads_GetAdBookings_FullMethodName:       domain.RoleGuest,
+	gen.Ads_GetUserBookings_FullMethodName:     domain.RoleGuest,
+	gen.Ads_UpdateBookingStatus_FullMethodName: domain.RoleGuest,
+}
+
+// VerifiedMethods - методы, недоступные до подтверждения почты, проверяются middleware.EmailVerifiedInterceptor
+var VerifiedMethods = map[string]bool{
+	gen.Ads_CreatePlace_FullMethodName: true,
+}
+
 type GrpcAdHandler struct {
 	gen.AdsServer
 	sessionService session.InterfaceSession
@@ -43,6 +66,7 @@ func (adh *GrpcAdHandler) GetAllPlaces(ctx context.Context, in *gen.AdFilterRequ
 
 	location := sanitizer.Sanitize(in.Location)
 	rating := sanitizer.Sanitize(in.Rating)
+	ratingMode := sanitizer.Sanitize(in.RatingMode)
 	newThisWeek := sanitizer.Sanitize(in.NewThisWeek)
 	hostGender := sanitizer.Sanitize(in.HostGender)
 	guestCounter := sanitizer.Sanitize(in.GuestCount)
@@ -60,7 +84,7 @@ func (adh *GrpcAdHandler) GetAllPlaces(ctx context.Context, in *gen.AdFilterRequ
 
 	limit := sanitizer.Sanitize(in.Limit)
 	var limitInt int
-	if offset != "" {
+	if limit != "" {
 		var err error
 		limitInt, err = strconv.Atoi(limit)
 		if err != nil {
@@ -94,9 +118,65 @@ func (adh *GrpcAdHandler) GetAllPlaces(ctx context.Context, in *gen.AdFilterRequ
 		}
 	}
 
+	var priceMin, priceMax int
+	if priceMinStr := sanitizer.Sanitize(in.PriceMin); priceMinStr != "" {
+		var err error
+		priceMin, err = strconv.Atoi(priceMinStr)
+		if err != nil || priceMin < 0 {
+			logger.AccessLogger.Error("Failed to parse priceMin as int", zap.String("request_id", requestID), zap.Error(err))
+			return nil, errors.New("query priceMin not int")
+		}
+	}
+	if priceMaxStr := sanitizer.Sanitize(in.PriceMax); priceMaxStr != "" {
+		var err error
+		priceMax, err = strconv.Atoi(priceMaxStr)
+		if err != nil || priceMax < 0 {
+			logger.AccessLogger.Error("Failed to parse priceMax as int", zap.String("request_id", requestID), zap.Error(err))
+			return nil, errors.New("query priceMax not int")
+		}
+	}
+
+	var near *domain.GeoPoint
+	var radius float64
+	latitudeStr := sanitizer.Sanitize(in.Latitude)
+	longitudeStr := sanitizer.Sanitize(in.Longitude)
+	if latitudeStr != "" || longitudeStr != "" {
+		latitude, errLat := strconv.ParseFloat(latitudeStr, 64)
+		longitude, errLng := strconv.ParseFloat(longitudeStr, 64)
+		if errLat != nil || errLng != nil {
+			logger.AccessLogger.Error("Failed to parse point", zap.String("request_id", requestID))
+			return nil, errors.New("query point invalid")
+		}
+		near = &domain.GeoPoint{Latitude: latitude, Longitude: longitude}
+	}
+	if radiusStr := sanitizer.Sanitize(in.Radius); radiusStr != "" {
+		var err error
+		radius, err = strconv.ParseFloat(radiusStr, 64)
+		if err != nil || radius <= 0 || near == nil {
+			logger.AccessLogger.Error("Failed to parse radius", zap.String("request_id", requestID), zap.Error(err))
+			return nil, errors.New("query radius invalid")
+		}
+	}
+
+	sort := sanitizer.Sanitize(in.Sort)
+	if sort != "" && sort != domain.AdSortPriceAsc && sort != domain.AdSortPriceDesc {
+		logger.AccessLogger.Error("Unknown sort value", zap.String("request_id", requestID), zap.String("sort", sort))
+		return nil, errors.New("query sort invalid")
+	}
+
+	var cursor *domain.AdCursor
+	if cursorStr := sanitizer.Sanitize(in.Cursor); cursorStr != "" {
+		cursor = &domain.AdCursor{}
+		if err := pagination.DecodeCursor(cursorStr, cursor); err != nil {
+			logger.AccessLogger.Error("Failed to parse cursor", zap.String("request_id", requestID), zap.Error(err))
+			return nil, errors.New("query cursor invalid")
+		}
+	}
+
 	filter := domain.AdFilter{
 		Location:    location,
 		Rating:      rating,
+		RatingMode:  ratingMode,
 		NewThisWeek: newThisWeek,
 		HostGender:  hostGender,
 		GuestCount:  guestCounter,
@@ -104,6 +184,13 @@ func (adh *GrpcAdHandler) GetAllPlaces(ctx context.Context, in *gen.AdFilterRequ
 		Offset:      offsetInt,
 		DateFrom:    dateFrom,
 		DateTo:      dateTo,
+		PriceMin:    priceMin,
+		PriceMax:    priceMax,
+		Sort:        sort,
+		Near:        near,
+		RadiusKm:    radius,
+		Cursor:      cursor,
+		Query:       strings.TrimSpace(sanitizer.Sanitize(in.Q)),
 	}
 
 	userID, err := adh.sessionService.GetUserID(ctx, in.SessionId)
@@ -111,14 +198,14 @@ func (adh *GrpcAdHandler) GetAllPlaces(ctx context.Context, in *gen.AdFilterRequ
 		logger.AccessLogger.Warn("No active session", zap.String("request_id", requestID))
 	}
 
-	places, err := adh.usecase.GetAllPlaces(ctx, filter, userID)
+	places, nextCursor, err := adh.usecase.GetAllPlaces(ctx, filter, userID)
 	if err != nil {
 		logger.AccessLogger.Error("Failed to get places",
 			zap.Error(err),
 			zap.String("request_id", requestID))
 		return nil, err
 	}
-	var responseList gen.GetAllAdsResponseList
+	responseList := gen.GetAllAdsResponseList{NextCursor: nextCursor}
 	for _, place := range places {
 		ad := &gen.GetAllAdsResponse{
 			Id:              place.UUID,
@@ -136,9 +223,15 @@ func (adh *GrpcAdHandler) GetAllPlaces(ctx context.Context, in *gen.AdFilterRequ
 			HasElevator:     place.HasElevator,
 			HasGas:          place.HasGas,
 			LikesCount:      int32(place.LikesCount),
+			Rating:          place.ReviewsRating,
+			ReviewsCount:    int32(place.ReviewsCount),
 			Priority:        int32(place.Priority),
 			EndBoostDate:    place.EndBoostDate.Format(layout),
 			CityName:        place.CityName,
+			Price:           int32(place.Price),
+			WeekendPrice:    int32(place.WeekendPrice),
+			CleaningFee:     int32(place.CleaningFee),
+			Position:        convertPositionToGRPC(place.Latitude, place.Longitude),
 			AdDateFrom:      place.AdDateFrom.Format(layout),
 			AdDateTo:        place.AdDateTo.Format(layout),
 			IsFavorite:      place.IsFavorite,
@@ -153,6 +246,14 @@ func (adh *GrpcAdHandler) GetAllPlaces(ctx context.Context, in *gen.AdFilterRequ
 			Images: convertImagesToGRPC(place.Images),
 			Rooms:  middleware.ConvertRoomsToGRPC(place.Rooms),
 		}
+		if place.Distance != nil {
+			ad.Distance = *place.Distance
+		}
+		if place.SearchRank != nil {
+			ad.SearchRank = *place.SearchRank
+			ad.DescriptionHighlight = place.DescriptionHighlight
+			ad.AddressHighlight = place.AddressHighlight
+		}
 		responseList.Housing = append(responseList.Housing, ad)
 	}
 
@@ -171,7 +272,37 @@ func (adh *GrpcAdHandler) GetOnePlace(ctx context.Context, in *gen.GetPlaceByIdR
 
 	in.AdId = sanitizer.Sanitize(in.AdId)
 
-	place, err := adh.usecase.GetOnePlace(ctx, in.AdId, in.IsAuthorized)
+	var dateFrom, dateTo time.Time
+	if dateFromStr := sanitizer.Sanitize(in.DateFrom); dateFromStr != "" {
+		var err error
+		dateFrom, err = time.Parse(layout, dateFromStr)
+		if err != nil {
+			logger.AccessLogger.Error("Failed to parse dateFrom", zap.Error(err), zap.String("request_id", requestID))
+			return nil, errors.New("query dateFrom not int")
+		}
+	}
+	if dateToStr := sanitizer.Sanitize(in.DateTo); dateToStr != "" {
+		var err error
+		dateTo, err = time.Parse(layout, dateToStr)
+		if err != nil {
+			logger.AccessLogger.Error("Failed to parse dateTo", zap.Error(err), zap.String("request_id", requestID))
+			return nil, errors.New("query dateTo not int")
+		}
+	}
+
+	var viewer *domain.SessionData
+	if in.SessionId != "" {
+		data, err := adh.sessionService.GetSessionData(ctx, in.SessionId)
+		if err != nil {
+			logger.AccessLogger.Warn("Failed to get session data, showing place anonymously",
+				zap.String("request_id", requestID),
+				zap.Error(err))
+		} else {
+			viewer = data
+		}
+	}
+
+	place, err := adh.usecase.GetOnePlace(ctx, in.AdId, viewer, dateFrom, dateTo)
 	if err != nil {
 		logger.AccessLogger.Error("Failed to get places",
 			zap.Error(err),
@@ -195,9 +326,15 @@ func (adh *GrpcAdHandler) GetOnePlace(ctx context.Context, in *gen.GetPlaceByIdR
 		HasElevator:     place.HasElevator,
 		HasGas:          place.HasGas,
 		LikesCount:      int32(place.LikesCount),
+		Rating:          place.ReviewsRating,
+		ReviewsCount:    int32(place.ReviewsCount),
 		Priority:        int32(place.Priority),
 		EndBoostDate:    place.EndBoostDate.Format(layout),
 		CityName:        place.CityName,
+		Price:           int32(place.Price),
+		WeekendPrice:    int32(place.WeekendPrice),
+		CleaningFee:     int32(place.CleaningFee),
+		Position:        convertPositionToGRPC(place.Latitude, place.Longitude),
 		AdDateFrom:      place.AdDateFrom.Format(layout),
 		AdDateTo:        place.AdDateTo.Format(layout),
 		IsFavorite:      place.IsFavorite,
@@ -209,8 +346,10 @@ func (adh *GrpcAdHandler) GetOnePlace(ctx context.Context, in *gen.GetPlaceByIdR
 			Sex:        place.AdAuthor.Sex,
 			BirthDate:  place.AdAuthor.Birthdate.Format(layout),
 		},
-		Images: convertImagesToGRPC(place.Images),
-		Rooms:  middleware.ConvertRoomsToGRPC(place.Rooms),
+		Images:    convertImagesToGRPC(place.Images),
+		Rooms:     middleware.ConvertRoomsToGRPC(place.Rooms),
+		Calendar:  convertCalendarToGRPC(place.Calendar),
+		StayPrice: convertStayPriceToGRPC(place.StayPrice),
 	}, nil
 }
 
@@ -250,19 +389,25 @@ func (adh *GrpcAdHandler) CreatePlace(ctx context.Context, in *gen.CreateAdReque
 
 	var place domain.Ad
 	newPlace := domain.CreateAdRequest{
-		CityName:     in.CityName,
-		Description:  in.Description,
-		Address:      in.Address,
-		RoomsNumber:  int(in.RoomsNumber),
-		DateFrom:     (in.DateFrom).AsTime(),
-		DateTo:       (in.DateTo).AsTime(),
-		Rooms:        middleware.ConvertGRPCToRooms(in.Rooms),
-		SquareMeters: int(in.SquareMeters),
-		Floor:        int(in.Floor),
-		BuildingType: in.BuildingType,
-		HasBalcony:   in.HasBalcony,
-		HasElevator:  in.HasElevator,
-		HasGas:       in.HasGas,
+		CityName:       in.CityName,
+		Description:    in.Description,
+		Address:        in.Address,
+		RoomsNumber:    int(in.RoomsNumber),
+		DateFrom:       (in.DateFrom).AsTime(),
+		DateTo:         (in.DateTo).AsTime(),
+		Rooms:          middleware.ConvertGRPCToRooms(in.Rooms),
+		AvailableDates: middleware.ConvertGRPCToDateRanges(in.AvailableDates),
+		BlockedDates:   middleware.ConvertGRPCToDates(in.BlockedDates),
+		Price:          int(in.Price),
+		WeekendPrice:   int(in.WeekendPrice),
+		CleaningFee:    int(in.CleaningFee),
+		Position:       convertGRPCToPosition(in.Position),
+		SquareMeters:   int(in.SquareMeters),
+		Floor:          int(in.Floor),
+		BuildingType:   in.BuildingType,
+		HasBalcony:     in.HasBalcony,
+		HasElevator:    in.HasElevator,
+		HasGas:         in.HasGas,
 	}
 	place.AuthorUUID = userID
 
@@ -318,19 +463,26 @@ func (adh *GrpcAdHandler) UpdatePlace(ctx context.Context, in *gen.UpdateAdReque
 		return nil, errors.New("no active session")
 	}
 	updatedPlace := domain.UpdateAdRequest{
-		CityName:     in.CityName,
-		Description:  in.Description,
-		Address:      in.Address,
-		RoomsNumber:  int(in.RoomsNumber),
-		DateFrom:     (in.DateFrom).AsTime(),
-		DateTo:       (in.DateTo).AsTime(),
-		Rooms:        middleware.ConvertGRPCToRooms(in.Rooms),
-		SquareMeters: int(in.SquareMeters),
-		Floor:        int(in.Floor),
-		BuildingType: in.BuildingType,
-		HasBalcony:   in.HasBalcony,
-		HasElevator:  in.HasElevator,
-		HasGas:       in.HasGas,
+		CityName:        in.CityName,
+		Description:     in.Description,
+		Address:         in.Address,
+		RoomsNumber:     int(in.RoomsNumber),
+		DateFrom:        (in.DateFrom).AsTime(),
+		DateTo:          (in.DateTo).AsTime(),
+		Rooms:           middleware.ConvertGRPCToRooms(in.Rooms),
+		AvailableDates:  middleware.ConvertGRPCToDateRanges(in.AvailableDates),
+		BlockedDates:    middleware.ConvertGRPCToDates(in.BlockedDates),
+		Price:           int(in.Price),
+		WeekendPrice:    int(in.WeekendPrice),
+		CleaningFee:     int(in.CleaningFee),
+		Position:        convertGRPCToPosition(in.Position),
+		SquareMeters:    int(in.SquareMeters),
+		Floor:           int(in.Floor),
+		BuildingType:    in.BuildingType,
+		HasBalcony:      in.HasBalcony,
+		HasElevator:     in.HasElevator,
+		HasGas:          in.HasGas,
+		ReplaceCalendar: in.ReplaceCalendar,
 	}
 	var place domain.Ad
 	err = adh.usecase.UpdatePlace(ctx, &place, in.AdId, userID, in.Images, updatedPlace)
@@ -410,9 +562,15 @@ func (adh *GrpcAdHandler) GetPlacesPerCity(ctx context.Context, in *gen.GetPlace
 			HasElevator:     place.HasElevator,
 			HasGas:          place.HasGas,
 			LikesCount:      int32(place.LikesCount),
+			Rating:          place.ReviewsRating,
+			ReviewsCount:    int32(place.ReviewsCount),
 			Priority:        int32(place.Priority),
 			EndBoostDate:    place.EndBoostDate.Format(layout),
 			CityName:        place.CityName,
+			Price:           int32(place.Price),
+			WeekendPrice:    int32(place.WeekendPrice),
+			CleaningFee:     int32(place.CleaningFee),
+			Position:        convertPositionToGRPC(place.Latitude, place.Longitude),
 			AdDateFrom:      place.AdDateFrom.Format(layout),
 			AdDateTo:        place.AdDateTo.Format(layout),
 			IsFavorite:      place.IsFavorite,
@@ -463,9 +621,15 @@ func (adh *GrpcAdHandler) GetUserPlaces(ctx context.Context, in *gen.GetUserPlac
 			HasElevator:     place.HasElevator,
 			HasGas:          place.HasGas,
 			LikesCount:      int32(place.LikesCount),
+			Rating:          place.ReviewsRating,
+			ReviewsCount:    int32(place.ReviewsCount),
 			Priority:        int32(place.Priority),
 			EndBoostDate:    place.EndBoostDate.Format(layout),
 			CityName:        place.CityName,
+			Price:           int32(place.Price),
+			WeekendPrice:    int32(place.WeekendPrice),
+			CleaningFee:     int32(place.CleaningFee),
+			Position:        convertPositionToGRPC(place.Latitude, place.Longitude),
 			AdDateFrom:      place.AdDateFrom.Format(layout),
 			AdDateTo:        place.AdDateTo.Format(layout),
 			AdAuthor: &gen.UserResponse{
@@ -640,9 +804,15 @@ func (adh *GrpcAdHandler) GetUserFavorites(ctx context.Context, in *gen.GetUserF
 			HasElevator:     place.HasElevator,
 			HasGas:          place.HasGas,
 			LikesCount:      int32(place.LikesCount),
+			Rating:          place.ReviewsRating,
+			ReviewsCount:    int32(place.ReviewsCount),
 			Priority:        int32(place.Priority),
 			EndBoostDate:    place.EndBoostDate.Format(layout),
 			CityName:        place.CityName,
+			Price:           int32(place.Price),
+			WeekendPrice:    int32(place.WeekendPrice),
+			CleaningFee:     int32(place.CleaningFee),
+			Position:        convertPositionToGRPC(place.Latitude, place.Longitude),
 			AdDateFrom:      place.AdDateFrom.Format(layout),
 			AdDateTo:        place.AdDateTo.Format(layout),
 			AdAuthor: &gen.UserResponse{
@@ -720,3 +890,272 @@ func convertImagesToGRPC(images []domain.ImageResponse) []*gen.ImageResponse {
 	}
 	return grpcImages
 }
+
+func convertPositionToGRPC(latitude *float64, longitude *float64) *gen.GeoPoint {
+	if latitude == nil || longitude == nil {
+		return nil
+	}
+	return &gen.GeoPoint{
+		Latitude:  *latitude,
+		Longitude: *longitude,
+	}
+}
+
+func convertGRPCToPosition(position *gen.GeoPoint) *domain.GeoPoint {
+	if position == nil {
+		return nil
+	}
+	return &domain.GeoPoint{
+		Latitude:  position.Latitude,
+		Longitude: position.Longitude,
+	}
+}
+
+func convertStayPriceToGRPC(stayPrice *domain.StayPrice) *gen.StayPrice {
+	if stayPrice == nil {
+		return nil
+	}
+	layout := "2006-01-02"
+	return &gen.StayPrice{
+		DateFrom:    stayPrice.DateFrom.Format(layout),
+		DateTo:      stayPrice.DateTo.Format(layout),
+		Nights:      int32(stayPrice.Nights),
+		NightsPrice: int32(stayPrice.NightsPrice),
+		CleaningFee: int32(stayPrice.CleaningFee),
+		Total:       int32(stayPrice.Total),
+	}
+}
+
+func convertCalendarToGRPC(calendar *domain.AdCalendar) *gen.AdCalendar {
+	if calendar == nil {
+		return nil
+	}
+	return &gen.AdCalendar{
+		AvailableDates: middleware.ConvertDateRangesToGRPC(calendar.AvailableDates),
+		BlockedDates:   middleware.ConvertDatesToGRPC(calendar.BlockedDates),
+	}
+}
+
+func (adh *GrpcAdHandler) CreateBooking(ctx context.Context, in *gen.CreateBookingRequest) (*gen.Booking, error) {
+	requestID := middleware.GetRequestID(ctx)
+	sanitizer := bluemonday.UGCPolicy()
+	logger.AccessLogger.Info("Received CreateBooking request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	in.AdId = sanitizer.Sanitize(in.AdId)
+
+	if in.AuthHeader == "" {
+		logger.AccessLogger.Warn("Missing X-CSRF-Token header",
+			zap.String("request_id", requestID),
+			zap.Error(errors.New("missing X-CSRF-Token header")),
+		)
+		return nil, errors.New("missing X-CSRF-Token header")
+	}
+
+	tokenString := in.AuthHeader[len("Bearer "):]
+	_, err := adh.jwtToken.Validate(tokenString, in.SessionID)
+	if err != nil {
+		logger.AccessLogger.Warn("Invalid JWT token", zap.String("request_id", requestID), zap.Error(err))
+		return nil, errors.New("invalid JWT token")
+	}
+
+	userID, err := adh.sessionService.GetUserID(ctx, in.SessionID)
+	if err != nil {
+		logger.AccessLogger.Warn("No active session", zap.String("request_id", requestID))
+		return nil, errors.New("no active session")
+	}
+
+	request := domain.CreateBookingRequest{
+		DateFrom: in.DateFrom.AsTime(),
+		DateTo:   in.DateTo.AsTime(),
+	}
+	booking, err := adh.usecase.CreateBooking(ctx, in.AdId, userID, request)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to create booking", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	return convertBookingToGRPC(booking), nil
+}
+
+func (adh *GrpcAdHandler) GetAdBookings(ctx context.Context, in *gen.GetAdBookingsRequest) (*gen.BookingList, error) {
+	requestID := middleware.GetRequestID(ctx)
+	sanitizer := bluemonday.UGCPolicy()
+	logger.AccessLogger.Info("Received GetAdBookings request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	in.AdId = sanitizer.Sanitize(in.AdId)
+
+	userID, err := adh.sessionService.GetUserID(ctx, in.SessionID)
+	if err != nil {
+		logger.AccessLogger.Warn("No active session", zap.String("request_id", requestID))
+		return nil, errors.New("no active session")
+	}
+
+	bookings, err := adh.usecase.GetAdBookings(ctx, in.AdId, userID)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to get ad bookings", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	return convertBookingsToGRPC(bookings), nil
+}
+
+func (adh *GrpcAdHandler) GetUserBookings(ctx context.Context, in *gen.GetUserBookingsRequest) (*gen.BookingList, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received GetUserBookings request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	userID, err := adh.sessionService.GetUserID(ctx, in.SessionID)
+	if err != nil {
+		logger.AccessLogger.Warn("No active session", zap.String("request_id", requestID))
+		return nil, errors.New("no active session")
+	}
+
+	bookings, err := adh.usecase.GetUserBookings(ctx, userID)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to get user bookings", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	return convertBookingsToGRPC(bookings), nil
+}
+
+func (adh *GrpcAdHandler) UpdateBookingStatus(ctx context.Context, in *gen.UpdateBookingStatusRequest) (*gen.Booking, error) {
+	requestID := middleware.GetRequestID(ctx)
+	sanitizer := bluemonday.UGCPolicy()
+	logger.AccessLogger.Info("Received UpdateBookingStatus request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	in.Status = sanitizer.Sanitize(in.Status)
+
+	if in.AuthHeader == "" {
+		logger.AccessLogger.Warn("Missing X-CSRF-Token header",
+			zap.String("request_id", requestID),
+			zap.Error(errors.New("missing X-CSRF-Token header")),
+		)
+		return nil, errors.New("missing X-CSRF-Token header")
+	}
+
+	tokenString := in.AuthHeader[len("Bearer "):]
+	_, err := adh.jwtToken.Validate(tokenString, in.SessionID)
+	if err != nil {
+		logger.AccessLogger.Warn("Invalid JWT token", zap.String("request_id", requestID), zap.Error(err))
+		return nil, errors.New("invalid JWT token")
+	}
+
+	userID, err := adh.sessionService.GetUserID(ctx, in.SessionID)
+	if err != nil {
+		logger.AccessLogger.Warn("No active session", zap.String("request_id", requestID))
+		return nil, errors.New("no active session")
+	}
+
+	booking, err := adh.usecase.UpdateBookingStatus(ctx, int(in.BookingId), userID, in.Status)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to update booking status", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	return convertBookingToGRPC(booking), nil
+}
+
+func convertBookingToGRPC(booking domain.Booking) *gen.Booking {
+	layout := "2006-01-02"
+	grpcBooking := &gen.Booking{
+		Id:         int32(booking.ID),
+		AdId:       booking.AdID,
+		UserId:     booking.UserID,
+		HostId:     booking.HostID,
+		Status:     booking.Status,
+		DateFrom:   booking.DateFrom.Format(layout),
+		DateTo:     booking.DateTo.Format(layout),
+		CreateDate: booking.CreateDate.Format(time.RFC3339),
+		UpdateDate: booking.UpdateDate.Format(time.RFC3339),
+	}
+	if booking.CloseDate != nil {
+		grpcBooking.CloseDate = booking.CloseDate.Format(time.RFC3339)
+	}
+	return grpcBooking
+}
+
+func convertBookingsToGRPC(bookings []domain.Booking) *gen.BookingList {
+	var bookingList gen.BookingList
+	for _, booking := range bookings {
+		bookingList.Bookings = append(bookingList.Bookings, convertBookingToGRPC(booking))
+	}
+	return &bookingList
+}
+
+func (adh *GrpcAdHandler) GetPlacesInBounds(ctx context.Context, in *gen.GetPlacesInBoundsRequest) (*gen.GetAllAdsResponseList, error) {
+	requestID := middleware.GetRequestID(ctx)
+	sanitizer := bluemonday.UGCPolicy()
+	logger.AccessLogger.Info("Received GetPlacesInBounds request in microservice",
+		zap.String("request_id", requestID),
+	)
+	layout := "2006-01-02"
+
+	// bbox передаётся как minLongitude,minLatitude,maxLongitude,maxLatitude
+	parts := strings.Split(sanitizer.Sanitize(in.Bbox), ",")
+	if len(parts) != 4 {
+		logger.AccessLogger.Warn("Invalid bbox", zap.String("request_id", requestID))
+		return nil, errors.New("invalid bbox")
+	}
+	var coords [4]float64
+	for i, part := range parts {
+		value, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			logger.AccessLogger.Warn("Invalid bbox", zap.String("request_id", requestID), zap.Error(err))
+			return nil, errors.New("invalid bbox")
+		}
+		coords[i] = value
+	}
+	bounds := domain.GeoBounds{
+		MinLongitude: coords[0],
+		MinLatitude:  coords[1],
+		MaxLongitude: coords[2],
+		MaxLatitude:  coords[3],
+	}
+
+	places, err := adh.usecase.GetPlacesInBounds(ctx, bounds)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get places in bounds", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+
+	var responseList gen.GetAllAdsResponseList
+	for _, place := range places {
+		responseList.Housing = append(responseList.Housing, &gen.GetAllAdsResponse{
+			Id:              place.UUID,
+			CityId:          int32(place.CityID),
+			AuthorUUID:      place.AuthorUUID,
+			Address:         place.Address,
+			PublicationDate: place.PublicationDate.Format(layout),
+			Description:     place.Description,
+			RoomsNumber:     int32(place.RoomsNumber),
+			ViewsCount:      int32(place.ViewsCount),
+			SquareMeters:    int32(place.SquareMeters),
+			Floor:           int32(place.Floor),
+			BuildingType:    place.BuildingType,
+			HasBalcony:      place.HasBalcony,
+			HasElevator:     place.HasElevator,
+			HasGas:          place.HasGas,
+			LikesCount:      int32(place.LikesCount),
+			Rating:          place.ReviewsRating,
+			ReviewsCount:    int32(place.ReviewsCount),
+			Priority:        int32(place.Priority),
+			EndBoostDate:    place.EndBoostDate.Format(layout),
+			CityName:        place.CityName,
+			Price:           int32(place.Price),
+			WeekendPrice:    int32(place.WeekendPrice),
+			CleaningFee:     int32(place.CleaningFee),
+			Position:        convertPositionToGRPC(place.Latitude, place.Longitude),
+			AdAuthor: &gen.UserResponse{
+				BirthDate: place.AdAuthor.Birthdate.Format(layout),
+			},
+			Images: convertImagesToGRPC(place.Images),
+		})
+	}
+
+	logger.AccessLogger.Info("Successfully fetched places in bounds", zap.String("request_id", requestID), zap.Int("count", len(places)))
+	return &responseList, nil
+}
@@ -127,23 +127,29 @@ type CreateAdRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CityName     string                 `protobuf:"bytes,1,opt,name=cityName,proto3" json:"cityName,omitempty"`
-	Address      string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Description  string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	RoomsNumber  int32                  `protobuf:"varint,4,opt,name=roomsNumber,proto3" json:"roomsNumber,omitempty"`
-	DateFrom     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dateFrom,proto3" json:"dateFrom,omitempty"`
-	DateTo       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=dateTo,proto3" json:"dateTo,omitempty"`
-	Images       [][]byte               `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
-	SquareMeters int32                  `protobuf:"varint,8,opt,name=squareMeters,proto3" json:"squareMeters,omitempty"`
-	Floor        int32                  `protobuf:"varint,9,opt,name=floor,proto3" json:"floor,omitempty"`
-	BuildingType string                 `protobuf:"bytes,10,opt,name=buildingType,proto3" json:"buildingType,omitempty"`
-	HasBalcony   bool                   `protobuf:"varint,11,opt,name=hasBalcony,proto3" json:"hasBalcony,omitempty"`
-	HasElevator  bool                   `protobuf:"varint,12,opt,name=hasElevator,proto3" json:"hasElevator,omitempty"`
-	HasGas       bool                   `protobuf:"varint,13,opt,name=hasGas,proto3" json:"hasGas,omitempty"`
-	AuthHeader   string                 `protobuf:"bytes,14,opt,name=authHeader,proto3" json:"authHeader,omitempty"`
-	SessionID    string                 `protobuf:"bytes,15,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	AuthorID     string                 `protobuf:"bytes,16,opt,name=authorID,proto3" json:"authorID,omitempty"`
-	Rooms        []*AdRooms             `protobuf:"bytes,17,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	CityName       string                   `protobuf:"bytes,1,opt,name=cityName,proto3" json:"cityName,omitempty"`
+	Address        string                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Description    string                   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	RoomsNumber    int32                    `protobuf:"varint,4,opt,name=roomsNumber,proto3" json:"roomsNumber,omitempty"`
+	DateFrom       *timestamppb.Timestamp   `protobuf:"bytes,5,opt,name=dateFrom,proto3" json:"dateFrom,omitempty"`
+	DateTo         *timestamppb.Timestamp   `protobuf:"bytes,6,opt,name=dateTo,proto3" json:"dateTo,omitempty"`
+	Images         [][]byte                 `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
+	SquareMeters   int32                    `protobuf:"varint,8,opt,name=squareMeters,proto3" json:"squareMeters,omitempty"`
+	Floor          int32                    `protobuf:"varint,9,opt,name=floor,proto3" json:"floor,omitempty"`
+	BuildingType   string                   `protobuf:"bytes,10,opt,name=buildingType,proto3" json:"buildingType,omitempty"`
+	HasBalcony     bool                     `protobuf:"varint,11,opt,name=hasBalcony,proto3" json:"hasBalcony,omitempty"`
+	HasElevator    bool                     `protobuf:"varint,12,opt,name=hasElevator,proto3" json:"hasElevator,omitempty"`
+	HasGas         bool                     `protobuf:"varint,13,opt,name=hasGas,proto3" json:"hasGas,omitempty"`
+	AuthHeader     string                   `protobuf:"bytes,14,opt,name=authHeader,proto3" json:"authHeader,omitempty"`
+	SessionID      string                   `protobuf:"bytes,15,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	AuthorID       string                   `protobuf:"bytes,16,opt,name=authorID,proto3" json:"authorID,omitempty"`
+	Rooms          []*AdRooms               `protobuf:"bytes,17,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	AvailableDates []*DateRange             `protobuf:"bytes,18,rep,name=availableDates,proto3" json:"availableDates,omitempty"`
+	BlockedDates   []*timestamppb.Timestamp `protobuf:"bytes,19,rep,name=blockedDates,proto3" json:"blockedDates,omitempty"`
+	Price          int32                    `protobuf:"varint,20,opt,name=price,proto3" json:"price,omitempty"`
+	WeekendPrice   int32                    `protobuf:"varint,21,opt,name=weekendPrice,proto3" json:"weekendPrice,omitempty"`
+	CleaningFee    int32                    `protobuf:"varint,22,opt,name=cleaningFee,proto3" json:"cleaningFee,omitempty"`
+	Position       *GeoPoint                `protobuf:"bytes,23,opt,name=position,proto3" json:"position,omitempty"`
 }
 
 func (x *CreateAdRequest) Reset() {
@@ -295,6 +301,48 @@ func (x *CreateAdRequest) GetRooms() []*AdRooms {
 	return nil
 }
 
+func (x *CreateAdRequest) GetAvailableDates() []*DateRange {
+	if x != nil {
+		return x.AvailableDates
+	}
+	return nil
+}
+
+func (x *CreateAdRequest) GetBlockedDates() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockedDates
+	}
+	return nil
+}
+
+func (x *CreateAdRequest) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateAdRequest) GetWeekendPrice() int32 {
+	if x != nil {
+		return x.WeekendPrice
+	}
+	return 0
+}
+
+func (x *CreateAdRequest) GetCleaningFee() int32 {
+	if x != nil {
+		return x.CleaningFee
+	}
+	return 0
+}
+
+func (x *CreateAdRequest) GetPosition() *GeoPoint {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
 type AdRooms struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -353,23 +401,30 @@ type UpdateAdRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AdId         string                 `protobuf:"bytes,1,opt,name=adId,proto3" json:"adId,omitempty"`
-	CityName     string                 `protobuf:"bytes,2,opt,name=cityName,proto3" json:"cityName,omitempty"`
-	Address      string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Description  string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	RoomsNumber  int32                  `protobuf:"varint,5,opt,name=roomsNumber,proto3" json:"roomsNumber,omitempty"`
-	DateFrom     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=dateFrom,proto3" json:"dateFrom,omitempty"`
-	DateTo       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=dateTo,proto3" json:"dateTo,omitempty"`
-	SquareMeters int32                  `protobuf:"varint,8,opt,name=squareMeters,proto3" json:"squareMeters,omitempty"`
-	Floor        int32                  `protobuf:"varint,9,opt,name=floor,proto3" json:"floor,omitempty"`
-	BuildingType string                 `protobuf:"bytes,10,opt,name=buildingType,proto3" json:"buildingType,omitempty"`
-	HasBalcony   bool                   `protobuf:"varint,11,opt,name=hasBalcony,proto3" json:"hasBalcony,omitempty"`
-	HasElevator  bool                   `protobuf:"varint,12,opt,name=hasElevator,proto3" json:"hasElevator,omitempty"`
-	HasGas       bool                   `protobuf:"varint,13,opt,name=hasGas,proto3" json:"hasGas,omitempty"`
-	Images       [][]byte               `protobuf:"bytes,14,rep,name=images,proto3" json:"images,omitempty"`
-	AuthHeader   string                 `protobuf:"bytes,15,opt,name=authHeader,proto3" json:"authHeader,omitempty"`
-	SessionID    string                 `protobuf:"bytes,16,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	Rooms        []*AdRooms             `protobuf:"bytes,17,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	AdId            string                   `protobuf:"bytes,1,opt,name=adId,proto3" json:"adId,omitempty"`
+	CityName        string                   `protobuf:"bytes,2,opt,name=cityName,proto3" json:"cityName,omitempty"`
+	Address         string                   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Description     string                   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	RoomsNumber     int32                    `protobuf:"varint,5,opt,name=roomsNumber,proto3" json:"roomsNumber,omitempty"`
+	DateFrom        *timestamppb.Timestamp   `protobuf:"bytes,6,opt,name=dateFrom,proto3" json:"dateFrom,omitempty"`
+	DateTo          *timestamppb.Timestamp   `protobuf:"bytes,7,opt,name=dateTo,proto3" json:"dateTo,omitempty"`
+	SquareMeters    int32                    `protobuf:"varint,8,opt,name=squareMeters,proto3" json:"squareMeters,omitempty"`
+	Floor           int32                    `protobuf:"varint,9,opt,name=floor,proto3" json:"floor,omitempty"`
+	BuildingType    string                   `protobuf:"bytes,10,opt,name=buildingType,proto3" json:"buildingType,omitempty"`
+	HasBalcony      bool                     `protobuf:"varint,11,opt,name=hasBalcony,proto3" json:"hasBalcony,omitempty"`
+	HasElevator     bool                     `protobuf:"varint,12,opt,name=hasElevator,proto3" json:"hasElevator,omitempty"`
+	HasGas          bool                     `protobuf:"varint,13,opt,name=hasGas,proto3" json:"hasGas,omitempty"`
+	Images          [][]byte                 `protobuf:"bytes,14,rep,name=images,proto3" json:"images,omitempty"`
+	AuthHeader      string                   `protobuf:"bytes,15,opt,name=authHeader,proto3" json:"authHeader,omitempty"`
+	SessionID       string                   `protobuf:"bytes,16,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Rooms           []*AdRooms               `protobuf:"bytes,17,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	AvailableDates  []*DateRange             `protobuf:"bytes,18,rep,name=availableDates,proto3" json:"availableDates,omitempty"`
+	BlockedDates    []*timestamppb.Timestamp `protobuf:"bytes,19,rep,name=blockedDates,proto3" json:"blockedDates,omitempty"`
+	Price           int32                    `protobuf:"varint,20,opt,name=price,proto3" json:"price,omitempty"`
+	WeekendPrice    int32                    `protobuf:"varint,21,opt,name=weekendPrice,proto3" json:"weekendPrice,omitempty"`
+	CleaningFee     int32                    `protobuf:"varint,22,opt,name=cleaningFee,proto3" json:"cleaningFee,omitempty"`
+	Position        *GeoPoint                `protobuf:"bytes,23,opt,name=position,proto3" json:"position,omitempty"`
+	ReplaceCalendar bool                     `protobuf:"varint,24,opt,name=replaceCalendar,proto3" json:"replaceCalendar,omitempty"`
 }
 
 func (x *UpdateAdRequest) Reset() {
@@ -521,6 +576,55 @@ func (x *UpdateAdRequest) GetRooms() []*AdRooms {
 	return nil
 }
 
+func (x *UpdateAdRequest) GetAvailableDates() []*DateRange {
+	if x != nil {
+		return x.AvailableDates
+	}
+	return nil
+}
+
+func (x *UpdateAdRequest) GetBlockedDates() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockedDates
+	}
+	return nil
+}
+
+func (x *UpdateAdRequest) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UpdateAdRequest) GetWeekendPrice() int32 {
+	if x != nil {
+		return x.WeekendPrice
+	}
+	return 0
+}
+
+func (x *UpdateAdRequest) GetCleaningFee() int32 {
+	if x != nil {
+		return x.CleaningFee
+	}
+	return 0
+}
+
+func (x *UpdateAdRequest) GetPosition() *GeoPoint {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *UpdateAdRequest) GetReplaceCalendar() bool {
+	if x != nil {
+		return x.ReplaceCalendar
+	}
+	return false
+}
+
 type DeletePlaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -931,6 +1035,15 @@ type AdFilterRequest struct {
 	DateFrom    string `protobuf:"bytes,8,opt,name=dateFrom,proto3" json:"dateFrom,omitempty"`
 	DateTo      string `protobuf:"bytes,9,opt,name=dateTo,proto3" json:"dateTo,omitempty"`
 	SessionId   string `protobuf:"bytes,10,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	PriceMin    string `protobuf:"bytes,11,opt,name=priceMin,proto3" json:"priceMin,omitempty"`
+	PriceMax    string `protobuf:"bytes,12,opt,name=priceMax,proto3" json:"priceMax,omitempty"`
+	Sort        string `protobuf:"bytes,13,opt,name=sort,proto3" json:"sort,omitempty"`
+	Latitude    string `protobuf:"bytes,14,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude   string `protobuf:"bytes,15,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Radius      string `protobuf:"bytes,16,opt,name=radius,proto3" json:"radius,omitempty"`
+	Cursor      string `protobuf:"bytes,17,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Q           string `protobuf:"bytes,18,opt,name=q,proto3" json:"q,omitempty"`
+	RatingMode  string `protobuf:"bytes,19,opt,name=ratingMode,proto3" json:"ratingMode,omitempty"` // average (по умолчанию) или weighted
 }
 
 func (x *AdFilterRequest) Reset() {
@@ -1033,35 +1146,110 @@ func (x *AdFilterRequest) GetSessionId() string {
 	return ""
 }
 
+func (x *AdFilterRequest) GetPriceMin() string {
+	if x != nil {
+		return x.PriceMin
+	}
+	return ""
+}
+
+func (x *AdFilterRequest) GetPriceMax() string {
+	if x != nil {
+		return x.PriceMax
+	}
+	return ""
+}
+
+func (x *AdFilterRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *AdFilterRequest) GetLatitude() string {
+	if x != nil {
+		return x.Latitude
+	}
+	return ""
+}
+
+func (x *AdFilterRequest) GetLongitude() string {
+	if x != nil {
+		return x.Longitude
+	}
+	return ""
+}
+
+func (x *AdFilterRequest) GetRadius() string {
+	if x != nil {
+		return x.Radius
+	}
+	return ""
+}
+
+func (x *AdFilterRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AdFilterRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *AdFilterRequest) GetRatingMode() string {
+	if x != nil {
+		return x.RatingMode
+	}
+	return ""
+}
+
 type GetAllAdsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CityId          int32            `protobuf:"varint,2,opt,name=cityId,proto3" json:"cityId,omitempty"`
-	AuthorUUID      string           `protobuf:"bytes,3,opt,name=authorUUID,proto3" json:"authorUUID,omitempty"`
-	Address         string           `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	PublicationDate string           `protobuf:"bytes,5,opt,name=publicationDate,proto3" json:"publicationDate,omitempty"`
-	Description     string           `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	RoomsNumber     int32            `protobuf:"varint,7,opt,name=roomsNumber,proto3" json:"roomsNumber,omitempty"`
-	ViewsCount      int32            `protobuf:"varint,8,opt,name=viewsCount,proto3" json:"viewsCount,omitempty"`
-	SquareMeters    int32            `protobuf:"varint,9,opt,name=squareMeters,proto3" json:"squareMeters,omitempty"`
-	Floor           int32            `protobuf:"varint,10,opt,name=floor,proto3" json:"floor,omitempty"`
-	BuildingType    string           `protobuf:"bytes,11,opt,name=buildingType,proto3" json:"buildingType,omitempty"`
-	HasBalcony      bool             `protobuf:"varint,12,opt,name=hasBalcony,proto3" json:"hasBalcony,omitempty"`
-	HasElevator     bool             `protobuf:"varint,13,opt,name=hasElevator,proto3" json:"hasElevator,omitempty"`
-	HasGas          bool             `protobuf:"varint,14,opt,name=hasGas,proto3" json:"hasGas,omitempty"`
-	LikesCount      int32            `protobuf:"varint,15,opt,name=LikesCount,proto3" json:"LikesCount,omitempty"`
-	Priority        int32            `protobuf:"varint,16,opt,name=priority,proto3" json:"priority,omitempty"`
-	EndBoostDate    string           `protobuf:"bytes,17,opt,name=endBoostDate,proto3" json:"endBoostDate,omitempty"`
-	CityName        string           `protobuf:"bytes,18,opt,name=cityName,proto3" json:"cityName,omitempty"`
-	AdDateFrom      string           `protobuf:"bytes,19,opt,name=adDateFrom,proto3" json:"adDateFrom,omitempty"`
-	AdDateTo        string           `protobuf:"bytes,20,opt,name=adDateTo,proto3" json:"adDateTo,omitempty"`
-	IsFavorite      bool             `protobuf:"varint,21,opt,name=isFavorite,proto3" json:"isFavorite,omitempty"`
-	AdAuthor        *UserResponse    `protobuf:"bytes,22,opt,name=adAuthor,proto3" json:"adAuthor,omitempty"`
-	Images          []*ImageResponse `protobuf:"bytes,23,rep,name=images,proto3" json:"images,omitempty"`
-	Rooms           []*AdRooms       `protobuf:"bytes,24,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	Id                   string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CityId               int32            `protobuf:"varint,2,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	AuthorUUID           string           `protobuf:"bytes,3,opt,name=authorUUID,proto3" json:"authorUUID,omitempty"`
+	Address              string           `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	PublicationDate      string           `protobuf:"bytes,5,opt,name=publicationDate,proto3" json:"publicationDate,omitempty"`
+	Description          string           `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	RoomsNumber          int32            `protobuf:"varint,7,opt,name=roomsNumber,proto3" json:"roomsNumber,omitempty"`
+	ViewsCount           int32            `protobuf:"varint,8,opt,name=viewsCount,proto3" json:"viewsCount,omitempty"`
+	SquareMeters         int32            `protobuf:"varint,9,opt,name=squareMeters,proto3" json:"squareMeters,omitempty"`
+	Floor                int32            `protobuf:"varint,10,opt,name=floor,proto3" json:"floor,omitempty"`
+	BuildingType         string           `protobuf:"bytes,11,opt,name=buildingType,proto3" json:"buildingType,omitempty"`
+	HasBalcony           bool             `protobuf:"varint,12,opt,name=hasBalcony,proto3" json:"hasBalcony,omitempty"`
+	HasElevator          bool             `protobuf:"varint,13,opt,name=hasElevator,proto3" json:"hasElevator,omitempty"`
+	HasGas               bool             `protobuf:"varint,14,opt,name=hasGas,proto3" json:"hasGas,omitempty"`
+	LikesCount           int32            `protobuf:"varint,15,opt,name=LikesCount,proto3" json:"LikesCount,omitempty"`
+	Priority             int32            `protobuf:"varint,16,opt,name=priority,proto3" json:"priority,omitempty"`
+	EndBoostDate         string           `protobuf:"bytes,17,opt,name=endBoostDate,proto3" json:"endBoostDate,omitempty"`
+	CityName             string           `protobuf:"bytes,18,opt,name=cityName,proto3" json:"cityName,omitempty"`
+	AdDateFrom           string           `protobuf:"bytes,19,opt,name=adDateFrom,proto3" json:"adDateFrom,omitempty"`
+	AdDateTo             string           `protobuf:"bytes,20,opt,name=adDateTo,proto3" json:"adDateTo,omitempty"`
+	IsFavorite           bool             `protobuf:"varint,21,opt,name=isFavorite,proto3" json:"isFavorite,omitempty"`
+	AdAuthor             *UserResponse    `protobuf:"bytes,22,opt,name=adAuthor,proto3" json:"adAuthor,omitempty"`
+	Images               []*ImageResponse `protobuf:"bytes,23,rep,name=images,proto3" json:"images,omitempty"`
+	Rooms                []*AdRooms       `protobuf:"bytes,24,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	Calendar             *AdCalendar      `protobuf:"bytes,25,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	Price                int32            `protobuf:"varint,26,opt,name=price,proto3" json:"price,omitempty"`
+	WeekendPrice         int32            `protobuf:"varint,27,opt,name=weekendPrice,proto3" json:"weekendPrice,omitempty"`
+	CleaningFee          int32            `protobuf:"varint,28,opt,name=cleaningFee,proto3" json:"cleaningFee,omitempty"`
+	StayPrice            *StayPrice       `protobuf:"bytes,29,opt,name=stayPrice,proto3" json:"stayPrice,omitempty"`
+	Position             *GeoPoint        `protobuf:"bytes,30,opt,name=position,proto3" json:"position,omitempty"`
+	Distance             float64          `protobuf:"fixed64,31,opt,name=distance,proto3" json:"distance,omitempty"`
+	SearchRank           float64          `protobuf:"fixed64,32,opt,name=searchRank,proto3" json:"searchRank,omitempty"`
+	DescriptionHighlight string           `protobuf:"bytes,33,opt,name=descriptionHighlight,proto3" json:"descriptionHighlight,omitempty"`
+	AddressHighlight     string           `protobuf:"bytes,34,opt,name=addressHighlight,proto3" json:"addressHighlight,omitempty"`
+	Rating               float64          `protobuf:"fixed64,35,opt,name=rating,proto3" json:"rating,omitempty"`
+	ReviewsCount         int32            `protobuf:"varint,36,opt,name=reviewsCount,proto3" json:"reviewsCount,omitempty"`
 }
 
 func (x *GetAllAdsResponse) Reset() {
@@ -1262,28 +1450,113 @@ func (x *GetAllAdsResponse) GetRooms() []*AdRooms {
 	return nil
 }
 
-type GetAllAdsResponseList struct {
+func (x *GetAllAdsResponse) GetCalendar() *AdCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+func (x *GetAllAdsResponse) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *GetAllAdsResponse) GetWeekendPrice() int32 {
+	if x != nil {
+		return x.WeekendPrice
+	}
+	return 0
+}
+
+func (x *GetAllAdsResponse) GetCleaningFee() int32 {
+	if x != nil {
+		return x.CleaningFee
+	}
+	return 0
+}
+
+func (x *GetAllAdsResponse) GetStayPrice() *StayPrice {
+	if x != nil {
+		return x.StayPrice
+	}
+	return nil
+}
+
+func (x *GetAllAdsResponse) GetPosition() *GeoPoint {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *GetAllAdsResponse) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *GetAllAdsResponse) GetSearchRank() float64 {
+	if x != nil {
+		return x.SearchRank
+	}
+	return 0
+}
+
+func (x *GetAllAdsResponse) GetDescriptionHighlight() string {
+	if x != nil {
+		return x.DescriptionHighlight
+	}
+	return ""
+}
+
+func (x *GetAllAdsResponse) GetAddressHighlight() string {
+	if x != nil {
+		return x.AddressHighlight
+	}
+	return ""
+}
+
+func (x *GetAllAdsResponse) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *GetAllAdsResponse) GetReviewsCount() int32 {
+	if x != nil {
+		return x.ReviewsCount
+	}
+	return 0
+}
+
+type GeoPoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Housing []*GetAllAdsResponse `protobuf:"bytes,1,rep,name=housing,proto3" json:"housing,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
 }
 
-func (x *GetAllAdsResponseList) Reset() {
-	*x = GetAllAdsResponseList{}
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
 	mi := &file_ads_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllAdsResponseList) String() string {
+func (x *GeoPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllAdsResponseList) ProtoMessage() {}
+func (*GeoPoint) ProtoMessage() {}
 
-func (x *GetAllAdsResponseList) ProtoReflect() protoreflect.Message {
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
 	mi := &file_ads_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1295,41 +1568,47 @@ func (x *GetAllAdsResponseList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllAdsResponseList.ProtoReflect.Descriptor instead.
-func (*GetAllAdsResponseList) Descriptor() ([]byte, []int) {
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
 	return file_ads_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetAllAdsResponseList) GetHousing() []*GetAllAdsResponse {
+func (x *GeoPoint) GetLatitude() float64 {
 	if x != nil {
-		return x.Housing
+		return x.Latitude
 	}
-	return nil
+	return 0
 }
 
-type GetPlaceByIdRequest struct {
+func (x *GeoPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type GetPlacesInBoundsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AdId         string `protobuf:"bytes,1,opt,name=adId,proto3" json:"adId,omitempty"`
-	IsAuthorized bool   `protobuf:"varint,2,opt,name=isAuthorized,proto3" json:"isAuthorized,omitempty"`
+	Bbox string `protobuf:"bytes,1,opt,name=bbox,proto3" json:"bbox,omitempty"`
 }
 
-func (x *GetPlaceByIdRequest) Reset() {
-	*x = GetPlaceByIdRequest{}
+func (x *GetPlacesInBoundsRequest) Reset() {
+	*x = GetPlacesInBoundsRequest{}
 	mi := &file_ads_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlaceByIdRequest) String() string {
+func (x *GetPlacesInBoundsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlaceByIdRequest) ProtoMessage() {}
+func (*GetPlacesInBoundsRequest) ProtoMessage() {}
 
-func (x *GetPlaceByIdRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPlacesInBoundsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ads_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1341,47 +1620,45 @@ func (x *GetPlaceByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlaceByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetPlaceByIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPlacesInBoundsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlacesInBoundsRequest) Descriptor() ([]byte, []int) {
 	return file_ads_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetPlaceByIdRequest) GetAdId() string {
+func (x *GetPlacesInBoundsRequest) GetBbox() string {
 	if x != nil {
-		return x.AdId
+		return x.Bbox
 	}
 	return ""
 }
 
-func (x *GetPlaceByIdRequest) GetIsAuthorized() bool {
-	if x != nil {
-		return x.IsAuthorized
-	}
-	return false
-}
-
-type AdResponse struct {
+type StayPrice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	DateFrom    string `protobuf:"bytes,1,opt,name=dateFrom,proto3" json:"dateFrom,omitempty"`
+	DateTo      string `protobuf:"bytes,2,opt,name=dateTo,proto3" json:"dateTo,omitempty"`
+	Nights      int32  `protobuf:"varint,3,opt,name=nights,proto3" json:"nights,omitempty"`
+	NightsPrice int32  `protobuf:"varint,4,opt,name=nightsPrice,proto3" json:"nightsPrice,omitempty"`
+	CleaningFee int32  `protobuf:"varint,5,opt,name=cleaningFee,proto3" json:"cleaningFee,omitempty"`
+	Total       int32  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *AdResponse) Reset() {
-	*x = AdResponse{}
+func (x *StayPrice) Reset() {
+	*x = StayPrice{}
 	mi := &file_ads_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdResponse) String() string {
+func (x *StayPrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdResponse) ProtoMessage() {}
+func (*StayPrice) ProtoMessage() {}
 
-func (x *AdResponse) ProtoReflect() protoreflect.Message {
+func (x *StayPrice) ProtoReflect() protoreflect.Message {
 	mi := &file_ads_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1393,40 +1670,76 @@ func (x *AdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdResponse.ProtoReflect.Descriptor instead.
-func (*AdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StayPrice.ProtoReflect.Descriptor instead.
+func (*StayPrice) Descriptor() ([]byte, []int) {
 	return file_ads_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AdResponse) GetResponse() string {
+func (x *StayPrice) GetDateFrom() string {
 	if x != nil {
-		return x.Response
+		return x.DateFrom
 	}
 	return ""
 }
 
-type DeleteResponse struct {
+func (x *StayPrice) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *StayPrice) GetNights() int32 {
+	if x != nil {
+		return x.Nights
+	}
+	return 0
+}
+
+func (x *StayPrice) GetNightsPrice() int32 {
+	if x != nil {
+		return x.NightsPrice
+	}
+	return 0
+}
+
+func (x *StayPrice) GetCleaningFee() int32 {
+	if x != nil {
+		return x.CleaningFee
+	}
+	return 0
+}
+
+func (x *StayPrice) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type DateRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	DateFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=dateFrom,proto3" json:"dateFrom,omitempty"`
+	DateTo   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=dateTo,proto3" json:"dateTo,omitempty"`
 }
 
-func (x *DeleteResponse) Reset() {
-	*x = DeleteResponse{}
+func (x *DateRange) Reset() {
+	*x = DateRange{}
 	mi := &file_ads_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResponse) String() string {
+func (x *DateRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResponse) ProtoMessage() {}
+func (*DateRange) ProtoMessage() {}
 
-func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+func (x *DateRange) ProtoReflect() protoreflect.Message {
 	mi := &file_ads_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1438,42 +1751,530 @@ func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DateRange.ProtoReflect.Descriptor instead.
+func (*DateRange) Descriptor() ([]byte, []int) {
 	return file_ads_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DeleteResponse) GetResponse() string {
+func (x *DateRange) GetDateFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Response
+		return x.DateFrom
 	}
-	return ""
+	return nil
 }
 
-type ImageResponse struct {
+func (x *DateRange) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+type AdCalendar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AvailableDates []*DateRange             `protobuf:"bytes,1,rep,name=availableDates,proto3" json:"availableDates,omitempty"`
+	BlockedDates   []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=blockedDates,proto3" json:"blockedDates,omitempty"`
+}
+
+func (x *AdCalendar) Reset() {
+	*x = AdCalendar{}
+	mi := &file_ads_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdCalendar) ProtoMessage() {}
+
+func (x *AdCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_ads_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdCalendar.ProtoReflect.Descriptor instead.
+func (*AdCalendar) Descriptor() ([]byte, []int) {
+	return file_ads_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AdCalendar) GetAvailableDates() []*DateRange {
+	if x != nil {
+		return x.AvailableDates
+	}
+	return nil
+}
+
+func (x *AdCalendar) GetBlockedDates() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockedDates
+	}
+	return nil
+}
+
+type GetAllAdsResponseList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Housing    []*GetAllAdsResponse `protobuf:"bytes,1,rep,name=housing,proto3" json:"housing,omitempty"`
+	NextCursor string               `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+}
+
+func (x *GetAllAdsResponseList) Reset() {
+	*x = GetAllAdsResponseList{}
+	mi := &file_ads_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllAdsResponseList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAdsResponseList) ProtoMessage() {}
+
+func (x *GetAllAdsResponseList) ProtoReflect() protoreflect.Message {
+	mi := &file_ads_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAdsResponseList.ProtoReflect.Descriptor instead.
+func (*GetAllAdsResponseList) Descriptor() ([]byte, []int) {
+	return file_ads_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAllAdsResponseList) GetHousing() []*GetAllAdsResponse {
+	if x != nil {
+		return x.Housing
+	}
+	return nil
+}
+
+func (x *GetAllAdsResponseList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetPlaceByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdId     string `protobuf:"bytes,1,opt,name=adId,proto3" json:"adId,omitempty"`
+	DateFrom string `protobuf:"bytes,3,opt,name=dateFrom,proto3" json:"dateFrom,omitempty"`
+	DateTo   string `protobuf:"bytes,4,opt,name=dateTo,proto3" json:"dateTo,omitempty"`
+	// пустой для анонимного просмотра
+	SessionId string `protobuf:"bytes,5,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+}
+
+func (x *GetPlaceByIdRequest) Reset() {
+	*x = GetPlaceByIdRequest{}
+	mi := &file_ads_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlaceByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaceByIdRequest) ProtoMessage() {}
+
+func (x *GetPlaceByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ads_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaceByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetPlaceByIdRequest) Descriptor() ([]byte, []int) {
+	return file_ads_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetPlaceByIdRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *GetPlaceByIdRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetPlaceByIdRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetPlaceByIdRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type AdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *AdResponse) Reset() {
+	*x = AdResponse{}
+	mi := &file_ads_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdResponse) ProtoMessage() {}
+
+func (x *AdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ads_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdResponse.ProtoReflect.Descriptor instead.
+func (*AdResponse) Descriptor() ([]byte, []int) {
+	return file_ads_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AdResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type DeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *DeleteResponse) Reset() {
+	*x = DeleteResponse{}
+	mi := &file_ads_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResponse) ProtoMessage() {}
+
+func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ads_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_ads_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type ImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *ImageResponse) Reset() {
+	*x = ImageResponse{}
+	mi := &file_ads_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageResponse) ProtoMessage() {}
+
+func (x *ImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ads_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageResponse.ProtoReflect.Descriptor instead.
+func (*ImageResponse) Descriptor() ([]byte, []int) {
+	return file_ads_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImageResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImageResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type UserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rating     float32 `protobuf:"fixed32,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	Avatar     string  `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Name       string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Sex        string  `protobuf:"bytes,4,opt,name=sex,proto3" json:"sex,omitempty"`
+	BirthDate  string  `protobuf:"bytes,5,opt,name=birthDate,proto3" json:"birthDate,omitempty"`
+	GuestCount int32   `protobuf:"varint,6,opt,name=guestCount,proto3" json:"guestCount,omitempty"`
+}
+
+func (x *UserResponse) Reset() {
+	*x = UserResponse{}
+	mi := &file_ads_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserResponse) ProtoMessage() {}
+
+func (x *UserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ads_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
+func (*UserResponse) Descriptor() ([]byte, []int) {
+	return file_ads_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UserResponse) GetRating() float32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *UserResponse) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *UserResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserResponse) GetSex() string {
+	if x != nil {
+		return x.Sex
+	}
+	return ""
+}
+
+func (x *UserResponse) GetBirthDate() string {
+	if x != nil {
+		return x.BirthDate
+	}
+	return ""
+}
+
+func (x *UserResponse) GetGuestCount() int32 {
+	if x != nil {
+		return x.GuestCount
+	}
+	return 0
+}
+
+type UpdatePriorityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdId       string `protobuf:"bytes,1,opt,name=adId,proto3" json:"adId,omitempty"`
+	AuthHeader string `protobuf:"bytes,2,opt,name=authHeader,proto3" json:"authHeader,omitempty"`
+	SessionID  string `protobuf:"bytes,3,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Amount     string `protobuf:"bytes,4,opt,name=Amount,proto3" json:"Amount,omitempty"`
+}
+
+func (x *UpdatePriorityRequest) Reset() {
+	*x = UpdatePriorityRequest{}
+	mi := &file_ads_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePriorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriorityRequest) ProtoMessage() {}
+
+func (x *UpdatePriorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ads_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriorityRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePriorityRequest) Descriptor() ([]byte, []int) {
+	return file_ads_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdatePriorityRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *UpdatePriorityRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
+	}
+	return ""
+}
+
+func (x *UpdatePriorityRequest) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *UpdatePriorityRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type Booking struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-}
-
-func (x *ImageResponse) Reset() {
-	*x = ImageResponse{}
-	mi := &file_ads_proto_msgTypes[17]
+	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdId       string `protobuf:"bytes,2,opt,name=adId,proto3" json:"adId,omitempty"`
+	UserId     string `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	HostId     string `protobuf:"bytes,4,opt,name=hostId,proto3" json:"hostId,omitempty"`
+	Status     string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	DateFrom   string `protobuf:"bytes,6,opt,name=dateFrom,proto3" json:"dateFrom,omitempty"`
+	DateTo     string `protobuf:"bytes,7,opt,name=dateTo,proto3" json:"dateTo,omitempty"`
+	CreateDate string `protobuf:"bytes,8,opt,name=createDate,proto3" json:"createDate,omitempty"`
+	UpdateDate string `protobuf:"bytes,9,opt,name=updateDate,proto3" json:"updateDate,omitempty"`
+	CloseDate  string `protobuf:"bytes,10,opt,name=closeDate,proto3" json:"closeDate,omitempty"`
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	mi := &file_ads_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImageResponse) String() string {
+func (x *Booking) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImageResponse) ProtoMessage() {}
+func (*Booking) ProtoMessage() {}
 
-func (x *ImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ads_proto_msgTypes[17]
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_ads_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1484,53 +2285,104 @@ func (x *ImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImageResponse.ProtoReflect.Descriptor instead.
-func (*ImageResponse) Descriptor() ([]byte, []int) {
-	return file_ads_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_ads_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ImageResponse) GetId() int32 {
+func (x *Booking) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ImageResponse) GetPath() string {
+func (x *Booking) GetAdId() string {
 	if x != nil {
-		return x.Path
+		return x.AdId
 	}
 	return ""
 }
 
-type UserResponse struct {
+func (x *Booking) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Booking) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *Booking) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Booking) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *Booking) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *Booking) GetCreateDate() string {
+	if x != nil {
+		return x.CreateDate
+	}
+	return ""
+}
+
+func (x *Booking) GetUpdateDate() string {
+	if x != nil {
+		return x.UpdateDate
+	}
+	return ""
+}
+
+func (x *Booking) GetCloseDate() string {
+	if x != nil {
+		return x.CloseDate
+	}
+	return ""
+}
+
+type BookingList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rating     float32 `protobuf:"fixed32,1,opt,name=rating,proto3" json:"rating,omitempty"`
-	Avatar     string  `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Name       string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Sex        string  `protobuf:"bytes,4,opt,name=sex,proto3" json:"sex,omitempty"`
-	BirthDate  string  `protobuf:"bytes,5,opt,name=birthDate,proto3" json:"birthDate,omitempty"`
-	GuestCount int32   `protobuf:"varint,6,opt,name=guestCount,proto3" json:"guestCount,omitempty"`
+	Bookings []*Booking `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
 }
 
-func (x *UserResponse) Reset() {
-	*x = UserResponse{}
-	mi := &file_ads_proto_msgTypes[18]
+func (x *BookingList) Reset() {
+	*x = BookingList{}
+	mi := &file_ads_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserResponse) String() string {
+func (x *BookingList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserResponse) ProtoMessage() {}
+func (*BookingList) ProtoMessage() {}
 
-func (x *UserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ads_proto_msgTypes[18]
+func (x *BookingList) ProtoReflect() protoreflect.Message {
+	mi := &file_ads_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1541,79 +2393,119 @@ func (x *UserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
-func (*UserResponse) Descriptor() ([]byte, []int) {
-	return file_ads_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use BookingList.ProtoReflect.Descriptor instead.
+func (*BookingList) Descriptor() ([]byte, []int) {
+	return file_ads_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UserResponse) GetRating() float32 {
+func (x *BookingList) GetBookings() []*Booking {
 	if x != nil {
-		return x.Rating
+		return x.Bookings
 	}
-	return 0
+	return nil
 }
 
-func (x *UserResponse) GetAvatar() string {
+type CreateBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdId       string                 `protobuf:"bytes,1,opt,name=adId,proto3" json:"adId,omitempty"`
+	DateFrom   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=dateFrom,proto3" json:"dateFrom,omitempty"`
+	DateTo     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=dateTo,proto3" json:"dateTo,omitempty"`
+	AuthHeader string                 `protobuf:"bytes,4,opt,name=authHeader,proto3" json:"authHeader,omitempty"`
+	SessionID  string                 `protobuf:"bytes,5,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *CreateBookingRequest) Reset() {
+	*x = CreateBookingRequest{}
+	mi := &file_ads_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingRequest) ProtoMessage() {}
+
+func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ads_proto_msgTypes[27]
 	if x != nil {
-		return x.Avatar
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserResponse) GetName() string {
+// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_ads_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateBookingRequest) GetAdId() string {
 	if x != nil {
-		return x.Name
+		return x.AdId
 	}
 	return ""
 }
 
-func (x *UserResponse) GetSex() string {
+func (x *CreateBookingRequest) GetDateFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Sex
+		return x.DateFrom
 	}
-	return ""
+	return nil
 }
 
-func (x *UserResponse) GetBirthDate() string {
+func (x *CreateBookingRequest) GetDateTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BirthDate
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
 	}
 	return ""
 }
 
-func (x *UserResponse) GetGuestCount() int32 {
+func (x *CreateBookingRequest) GetSessionID() string {
 	if x != nil {
-		return x.GuestCount
+		return x.SessionID
 	}
-	return 0
+	return ""
 }
 
-type UpdatePriorityRequest struct {
+type GetAdBookingsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AdId       string `protobuf:"bytes,1,opt,name=adId,proto3" json:"adId,omitempty"`
-	AuthHeader string `protobuf:"bytes,2,opt,name=authHeader,proto3" json:"authHeader,omitempty"`
-	SessionID  string `protobuf:"bytes,3,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	Amount     string `protobuf:"bytes,4,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	AdId      string `protobuf:"bytes,1,opt,name=adId,proto3" json:"adId,omitempty"`
+	SessionID string `protobuf:"bytes,2,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
 }
 
-func (x *UpdatePriorityRequest) Reset() {
-	*x = UpdatePriorityRequest{}
-	mi := &file_ads_proto_msgTypes[19]
+func (x *GetAdBookingsRequest) Reset() {
+	*x = GetAdBookingsRequest{}
+	mi := &file_ads_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePriorityRequest) String() string {
+func (x *GetAdBookingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePriorityRequest) ProtoMessage() {}
+func (*GetAdBookingsRequest) ProtoMessage() {}
 
-func (x *UpdatePriorityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ads_proto_msgTypes[19]
+func (x *GetAdBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ads_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1624,35 +2516,135 @@ func (x *UpdatePriorityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePriorityRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePriorityRequest) Descriptor() ([]byte, []int) {
-	return file_ads_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetAdBookingsRequest.ProtoReflect.Descriptor instead.
+func (*GetAdBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_ads_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *UpdatePriorityRequest) GetAdId() string {
+func (x *GetAdBookingsRequest) GetAdId() string {
 	if x != nil {
 		return x.AdId
 	}
 	return ""
 }
 
-func (x *UpdatePriorityRequest) GetAuthHeader() string {
+func (x *GetAdBookingsRequest) GetSessionID() string {
 	if x != nil {
-		return x.AuthHeader
+		return x.SessionID
 	}
 	return ""
 }
 
-func (x *UpdatePriorityRequest) GetSessionID() string {
+type GetUserBookingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *GetUserBookingsRequest) Reset() {
+	*x = GetUserBookingsRequest{}
+	mi := &file_ads_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBookingsRequest) ProtoMessage() {}
+
+func (x *GetUserBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ads_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBookingsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_ads_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetUserBookingsRequest) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *UpdatePriorityRequest) GetAmount() string {
+type UpdateBookingStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId  int32  `protobuf:"varint,1,opt,name=bookingId,proto3" json:"bookingId,omitempty"`
+	Status     string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	AuthHeader string `protobuf:"bytes,3,opt,name=authHeader,proto3" json:"authHeader,omitempty"`
+	SessionID  string `protobuf:"bytes,4,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *UpdateBookingStatusRequest) Reset() {
+	*x = UpdateBookingStatusRequest{}
+	mi := &file_ads_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingStatusRequest) ProtoMessage() {}
+
+func (x *UpdateBookingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ads_proto_msgTypes[30]
 	if x != nil {
-		return x.Amount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBookingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_ads_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateBookingStatusRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *UpdateBookingStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateBookingStatusRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
+	}
+	return ""
+}
+
+func (x *UpdateBookingStatusRequest) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
 	}
 	return ""
 }
@@ -1678,7 +2670,7 @@ var file_ads_proto_rawDesc = []byte{
 	0x6f, 0x6d, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
 	0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
 	0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc5, 0x04, 0x0a,
+	0x52, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc4, 0x06, 0x0a,
 	0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x08, 0x63, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
@@ -1715,245 +2707,433 @@ var file_ads_proto_rawDesc = []byte{
 	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44,
 	0x12, 0x22, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x0c, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x05, 0x72,
-	0x6f, 0x6f, 0x6d, 0x73, 0x22, 0x41, 0x0a, 0x07, 0x41, 0x64, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x71, 0x75, 0x61, 0x72,
-	0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xbd, 0x04, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x41, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x73,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f,
-	0x6f, 0x6d, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x61, 0x74,
-	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f,
-	0x6d, 0x12, 0x32, 0x0a, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x4d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x71, 0x75,
-	0x61, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f,
-	0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12,
-	0x22, 0x0a, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x42, 0x61, 0x6c, 0x63, 0x6f, 0x6e,
-	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x42, 0x61, 0x6c, 0x63,
-	0x6f, 0x6e, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x45, 0x6c, 0x65,
-	0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x47, 0x61, 0x73, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x73, 0x47, 0x61, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x11, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x52, 0x6f, 0x6f, 0x6d, 0x73,
-	0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22, 0x66, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49,
-	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22,
-	0x69, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
-	0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x6e, 0x0a, 0x1a, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
-	0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x4f, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x82, 0x01, 0x0a, 0x14,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x50, 0x65, 0x72,
-	0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa7, 0x02, 0x0a, 0x0f, 0x41, 0x64, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x54, 0x68, 0x69, 0x73, 0x57, 0x65, 0x65, 0x6b, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x54, 0x68, 0x69, 0x73, 0x57, 0x65, 0x65,
-	0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x22, 0x92, 0x06, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x64, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12,
-	0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x6f, 0x6d,
-	0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x73,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69, 0x65,
-	0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x71, 0x75, 0x61, 0x72,
-	0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73,
-	0x71, 0x75, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66,
-	0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f,
-	0x72, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e,
-	0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x42, 0x61, 0x6c, 0x63,
-	0x6f, 0x6e, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x42, 0x61,
-	0x6c, 0x63, 0x6f, 0x6e, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x45, 0x6c, 0x65, 0x76,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x45,
-	0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x47, 0x61,
-	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x73, 0x47, 0x61, 0x73, 0x12,
-	0x1e, 0x0a, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x65,
-	0x6e, 0x64, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
-	0x64, 0x44, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x61, 0x64, 0x44, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x64, 0x44, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
-	0x64, 0x44, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x46, 0x61, 0x76,
-	0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x46,
-	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x61, 0x64, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x64, 0x73, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x61, 0x64,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73,
-	0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28,
+	0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x64, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0e, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x65, 0x65, 0x6b, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x65, 0x65, 0x6b, 0x65, 0x6e,
+	0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x69,
+	0x6e, 0x67, 0x46, 0x65, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x64, 0x73,
+	0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x41, 0x0a, 0x07, 0x41, 0x64, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65,
+	0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xe6, 0x06, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x6f,
+	0x6d, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x32, 0x0a, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x4d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x71, 0x75, 0x61,
+	0x72, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x22,
+	0x0a, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x42, 0x61, 0x6c, 0x63, 0x6f, 0x6e, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x42, 0x61, 0x6c, 0x63, 0x6f,
+	0x6e, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x45, 0x6c, 0x65, 0x76,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x47, 0x61, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x73, 0x47, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52,
-	0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0e,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3e,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x18, 0x13,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x65, 0x65, 0x6b, 0x65, 0x6e, 0x64, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x65, 0x65, 0x6b,
+	0x65, 0x6e, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63,
+	0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61,
+	0x64, 0x73, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x22,
+	0x66, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74,
+	0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x69, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x54, 0x6f,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x22, 0x6e, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x22, 0x4f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x22, 0x82, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75,
+	0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x73, 0x50, 0x65, 0x72, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x2e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x8b, 0x04, 0x0a, 0x0f, 0x41, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x54, 0x68,
+	0x69, 0x73, 0x57, 0x65, 0x65, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65,
+	0x77, 0x54, 0x68, 0x69, 0x73, 0x57, 0x65, 0x65, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x73,
+	0x74, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68,
+	0x6f, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x75, 0x65,
+	0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67,
+	0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x4d, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x61,
+	0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x61,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x0c, 0x0a, 0x01, 0x71, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x71, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xcc, 0x09,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x71, 0x75, 0x61, 0x72,
+	0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x22, 0x0a,
+	0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x42, 0x61, 0x6c, 0x63, 0x6f, 0x6e, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x42, 0x61, 0x6c, 0x63, 0x6f, 0x6e,
+	0x79, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x45, 0x6c, 0x65, 0x76, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x47, 0x61, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x73, 0x47, 0x61, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4c,
+	0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x6f, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x61, 0x64, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x61, 0x64, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x17, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x22,
+	0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x05, 0x72, 0x6f, 0x6f,
+	0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x65, 0x65, 0x6b, 0x65, 0x6e, 0x64,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x65, 0x65,
+	0x6b, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x63, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x61, 0x64, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x64,
+	0x73, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x20,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x61, 0x6e, 0x6b,
+	0x12, 0x32, 0x0a, 0x14, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48,
+	0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x23, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x24, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x44, 0x0a, 0x08,
+	0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x22, 0x2e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49,
+	0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x62, 0x6f, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x62,
+	0x6f, 0x78, 0x22, 0xb1, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x6e, 0x69, 0x67, 0x68, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x77, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x32, 0x0a, 0x06, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x22,
+	0x84, 0x01, 0x0a, 0x0a, 0x41, 0x64, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x36,
+	0x0a, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x61, 0x74,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x22, 0x69, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
 	0x41, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
 	0x30, 0x0a, 0x07, 0x68, 0x6f, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x16, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x64, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x68, 0x6f, 0x75, 0x73, 0x69, 0x6e,
-	0x67, 0x22, 0x4d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x79, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
-	0x69, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64,
-	0x22, 0x28, 0x0a, 0x0a, 0x41, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x0e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x0d, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xa2, 0x01,
-	0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06,
-	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x73, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x74,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64,
-	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16,
-	0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x95, 0x06, 0x0a, 0x03, 0x41, 0x64, 0x73, 0x12, 0x40,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x14,
-	0x2e, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x41, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x3f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12,
-	0x18, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x79,
-	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x64, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2c, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x12, 0x14, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x12,
-	0x34, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x14,
-	0x2e, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x52, 0x65, 0x71,
+	0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x22, 0x81, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x4a,
+	0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x28, 0x0a, 0x0a, 0x41, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2c, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a,
+	0x0d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x22, 0xa2, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x78, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x69, 0x72,
+	0x74, 0x68, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69,
+	0x72, 0x74, 0x68, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x75, 0x65,
+	0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x87, 0x02, 0x0a, 0x07,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0x37, 0x0a, 0x0b, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xd4,
+	0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x32, 0x0a, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74,
+	0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x48, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x64, 0x42, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22,
+	0x36, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x32, 0xe5, 0x08, 0x0a, 0x03, 0x41,
+	0x64, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x14, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x61, 0x64, 0x73,
+	0x2e, 0x41, 0x64, 0x12, 0x34, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x41,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x17, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x73, 0x50, 0x65, 0x72, 0x43, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x2e, 0x61, 0x64, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x50, 0x65, 0x72, 0x43, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x19, 0x2e,
+	0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a,
+	0x0e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12,
+	0x1a, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x64,
+	0x73, 0x2e, 0x41, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x6c, 0x61, 0x63, 0x65, 0x12, 0x17, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x50,
-	0x65, 0x72, 0x43, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x50, 0x65, 0x72, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x41, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x73, 0x12, 0x19, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61,
-	0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x41, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x19, 0x2e, 0x61, 0x64, 0x73, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x41, 0x64, 0x64,
-	0x54, 0x6f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x64,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12,
-	0x1f, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d,
-	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0f, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f,
-	0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x41, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x3d, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x12, 0x1a, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
-	0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x32,
-	0x5a, 0x30, 0x2e, 0x2e, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2f, 0x61, 0x64, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x3b, 0x67,
-	0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x64, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x41, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x19, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c,
+	0x2e, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x41, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x19, 0x2e,
+	0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1b, 0x2e,
+	0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x64, 0x73,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x13,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x12, 0x4e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49,
+	0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x41, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x32, 0x5a, 0x30, 0x2e, 0x2e, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x64, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x3b, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1968,7 +3148,7 @@ func file_ads_proto_rawDescGZIP() []byte {
 	return file_ads_proto_rawDescData
 }
 
-var file_ads_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_ads_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_ads_proto_goTypes = []any{
 	(*Ad)(nil),                         // 0: ads.Ad
 	(*CreateAdRequest)(nil),            // 1: ads.CreateAdRequest
@@ -1983,55 +3163,92 @@ var file_ads_proto_goTypes = []any{
 	(*GetUserPlacesRequest)(nil),       // 10: ads.GetUserPlacesRequest
 	(*AdFilterRequest)(nil),            // 11: ads.AdFilterRequest
 	(*GetAllAdsResponse)(nil),          // 12: ads.GetAllAdsResponse
-	(*GetAllAdsResponseList)(nil),      // 13: ads.GetAllAdsResponseList
-	(*GetPlaceByIdRequest)(nil),        // 14: ads.GetPlaceByIdRequest
-	(*AdResponse)(nil),                 // 15: ads.AdResponse
-	(*DeleteResponse)(nil),             // 16: ads.DeleteResponse
-	(*ImageResponse)(nil),              // 17: ads.ImageResponse
-	(*UserResponse)(nil),               // 18: ads.UserResponse
-	(*UpdatePriorityRequest)(nil),      // 19: ads.UpdatePriorityRequest
-	(*timestamppb.Timestamp)(nil),      // 20: google.protobuf.Timestamp
+	(*GeoPoint)(nil),                   // 13: ads.GeoPoint
+	(*GetPlacesInBoundsRequest)(nil),   // 14: ads.GetPlacesInBoundsRequest
+	(*StayPrice)(nil),                  // 15: ads.StayPrice
+	(*DateRange)(nil),                  // 16: ads.DateRange
+	(*AdCalendar)(nil),                 // 17: ads.AdCalendar
+	(*GetAllAdsResponseList)(nil),      // 18: ads.GetAllAdsResponseList
+	(*GetPlaceByIdRequest)(nil),        // 19: ads.GetPlaceByIdRequest
+	(*AdResponse)(nil),                 // 20: ads.AdResponse
+	(*DeleteResponse)(nil),             // 21: ads.DeleteResponse
+	(*ImageResponse)(nil),              // 22: ads.ImageResponse
+	(*UserResponse)(nil),               // 23: ads.UserResponse
+	(*UpdatePriorityRequest)(nil),      // 24: ads.UpdatePriorityRequest
+	(*Booking)(nil),                    // 25: ads.Booking
+	(*BookingList)(nil),                // 26: ads.BookingList
+	(*CreateBookingRequest)(nil),       // 27: ads.CreateBookingRequest
+	(*GetAdBookingsRequest)(nil),       // 28: ads.GetAdBookingsRequest
+	(*GetUserBookingsRequest)(nil),     // 29: ads.GetUserBookingsRequest
+	(*UpdateBookingStatusRequest)(nil), // 30: ads.UpdateBookingStatusRequest
+	(*timestamppb.Timestamp)(nil),      // 31: google.protobuf.Timestamp
 }
 var file_ads_proto_depIdxs = []int32{
-	20, // 0: ads.CreateAdRequest.dateFrom:type_name -> google.protobuf.Timestamp
-	20, // 1: ads.CreateAdRequest.dateTo:type_name -> google.protobuf.Timestamp
+	31, // 0: ads.CreateAdRequest.dateFrom:type_name -> google.protobuf.Timestamp
+	31, // 1: ads.CreateAdRequest.dateTo:type_name -> google.protobuf.Timestamp
 	2,  // 2: ads.CreateAdRequest.rooms:type_name -> ads.AdRooms
-	20, // 3: ads.UpdateAdRequest.dateFrom:type_name -> google.protobuf.Timestamp
-	20, // 4: ads.UpdateAdRequest.dateTo:type_name -> google.protobuf.Timestamp
-	2,  // 5: ads.UpdateAdRequest.rooms:type_name -> ads.AdRooms
-	18, // 6: ads.GetAllAdsResponse.adAuthor:type_name -> ads.UserResponse
-	17, // 7: ads.GetAllAdsResponse.images:type_name -> ads.ImageResponse
-	2,  // 8: ads.GetAllAdsResponse.rooms:type_name -> ads.AdRooms
-	12, // 9: ads.GetAllAdsResponseList.housing:type_name -> ads.GetAllAdsResponse
-	11, // 10: ads.Ads.GetAllPlaces:input_type -> ads.AdFilterRequest
-	14, // 11: ads.Ads.GetOnePlace:input_type -> ads.GetPlaceByIdRequest
-	1,  // 12: ads.Ads.CreatePlace:input_type -> ads.CreateAdRequest
-	3,  // 13: ads.Ads.UpdatePlace:input_type -> ads.UpdateAdRequest
-	4,  // 14: ads.Ads.DeletePlace:input_type -> ads.DeletePlaceRequest
-	9,  // 15: ads.Ads.GetPlacesPerCity:input_type -> ads.GetPlacesPerCityRequest
-	10, // 16: ads.Ads.GetUserPlaces:input_type -> ads.GetUserPlacesRequest
-	8,  // 17: ads.Ads.DeleteAdImage:input_type -> ads.DeleteAdImageRequest
-	5,  // 18: ads.Ads.AddToFavorites:input_type -> ads.AddToFavoritesRequest
-	6,  // 19: ads.Ads.DeleteFromFavorites:input_type -> ads.DeleteFromFavoritesRequest
-	7,  // 20: ads.Ads.GetUserFavorites:input_type -> ads.GetUserFavoritesRequest
-	19, // 21: ads.Ads.UpdatePriority:input_type -> ads.UpdatePriorityRequest
-	13, // 22: ads.Ads.GetAllPlaces:output_type -> ads.GetAllAdsResponseList
-	12, // 23: ads.Ads.GetOnePlace:output_type -> ads.GetAllAdsResponse
-	0,  // 24: ads.Ads.CreatePlace:output_type -> ads.Ad
-	15, // 25: ads.Ads.UpdatePlace:output_type -> ads.AdResponse
-	16, // 26: ads.Ads.DeletePlace:output_type -> ads.DeleteResponse
-	13, // 27: ads.Ads.GetPlacesPerCity:output_type -> ads.GetAllAdsResponseList
-	13, // 28: ads.Ads.GetUserPlaces:output_type -> ads.GetAllAdsResponseList
-	16, // 29: ads.Ads.DeleteAdImage:output_type -> ads.DeleteResponse
-	15, // 30: ads.Ads.AddToFavorites:output_type -> ads.AdResponse
-	15, // 31: ads.Ads.DeleteFromFavorites:output_type -> ads.AdResponse
-	13, // 32: ads.Ads.GetUserFavorites:output_type -> ads.GetAllAdsResponseList
-	15, // 33: ads.Ads.UpdatePriority:output_type -> ads.AdResponse
-	22, // [22:34] is the sub-list for method output_type
-	10, // [10:22] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	16, // 3: ads.CreateAdRequest.availableDates:type_name -> ads.DateRange
+	31, // 4: ads.CreateAdRequest.blockedDates:type_name -> google.protobuf.Timestamp
+	13, // 5: ads.CreateAdRequest.position:type_name -> ads.GeoPoint
+	31, // 6: ads.UpdateAdRequest.dateFrom:type_name -> google.protobuf.Timestamp
+	31, // 7: ads.UpdateAdRequest.dateTo:type_name -> google.protobuf.Timestamp
+	2,  // 8: ads.UpdateAdRequest.rooms:type_name -> ads.AdRooms
+	16, // 9: ads.UpdateAdRequest.availableDates:type_name -> ads.DateRange
+	31, // 10: ads.UpdateAdRequest.blockedDates:type_name -> google.protobuf.Timestamp
+	13, // 11: ads.UpdateAdRequest.position:type_name -> ads.GeoPoint
+	23, // 12: ads.GetAllAdsResponse.adAuthor:type_name -> ads.UserResponse
+	22, // 13: ads.GetAllAdsResponse.images:type_name -> ads.ImageResponse
+	2,  // 14: ads.GetAllAdsResponse.rooms:type_name -> ads.AdRooms
+	17, // 15: ads.GetAllAdsResponse.calendar:type_name -> ads.AdCalendar
+	15, // 16: ads.GetAllAdsResponse.stayPrice:type_name -> ads.StayPrice
+	13, // 17: ads.GetAllAdsResponse.position:type_name -> ads.GeoPoint
+	31, // 18: ads.DateRange.dateFrom:type_name -> google.protobuf.Timestamp
+	31, // 19: ads.DateRange.dateTo:type_name -> google.protobuf.Timestamp
+	16, // 20: ads.AdCalendar.availableDates:type_name -> ads.DateRange
+	31, // 21: ads.AdCalendar.blockedDates:type_name -> google.protobuf.Timestamp
+	12, // 22: ads.GetAllAdsResponseList.housing:type_name -> ads.GetAllAdsResponse
+	25, // 23: ads.BookingList.bookings:type_name -> ads.Booking
+	31, // 24: ads.CreateBookingRequest.dateFrom:type_name -> google.protobuf.Timestamp
+	31, // 25: ads.CreateBookingRequest.dateTo:type_name -> google.protobuf.Timestamp
+	11, // 26: ads.Ads.GetAllPlaces:input_type -> ads.AdFilterRequest
+	19, // 27: ads.Ads.GetOnePlace:input_type -> ads.GetPlaceByIdRequest
+	1,  // 28: ads.Ads.CreatePlace:input_type -> ads.CreateAdRequest
+	3,  // 29: ads.Ads.UpdatePlace:input_type -> ads.UpdateAdRequest
+	4,  // 30: ads.Ads.DeletePlace:input_type -> ads.DeletePlaceRequest
+	9,  // 31: ads.Ads.GetPlacesPerCity:input_type -> ads.GetPlacesPerCityRequest
+	10, // 32: ads.Ads.GetUserPlaces:input_type -> ads.GetUserPlacesRequest
+	8,  // 33: ads.Ads.DeleteAdImage:input_type -> ads.DeleteAdImageRequest
+	5,  // 34: ads.Ads.AddToFavorites:input_type -> ads.AddToFavoritesRequest
+	6,  // 35: ads.Ads.DeleteFromFavorites:input_type -> ads.DeleteFromFavoritesRequest
+	7,  // 36: ads.Ads.GetUserFavorites:input_type -> ads.GetUserFavoritesRequest
+	24, // 37: ads.Ads.UpdatePriority:input_type -> ads.UpdatePriorityRequest
+	27, // 38: ads.Ads.CreateBooking:input_type -> ads.CreateBookingRequest
+	28, // 39: ads.Ads.GetAdBookings:input_type -> ads.GetAdBookingsRequest
+	29, // 40: ads.Ads.GetUserBookings:input_type -> ads.GetUserBookingsRequest
+	30, // 41: ads.Ads.UpdateBookingStatus:input_type -> ads.UpdateBookingStatusRequest
+	14, // 42: ads.Ads.GetPlacesInBounds:input_type -> ads.GetPlacesInBoundsRequest
+	18, // 43: ads.Ads.GetAllPlaces:output_type -> ads.GetAllAdsResponseList
+	12, // 44: ads.Ads.GetOnePlace:output_type -> ads.GetAllAdsResponse
+	0,  // 45: ads.Ads.CreatePlace:output_type -> ads.Ad
+	20, // 46: ads.Ads.UpdatePlace:output_type -> ads.AdResponse
+	21, // 47: ads.Ads.DeletePlace:output_type -> ads.DeleteResponse
+	18, // 48: ads.Ads.GetPlacesPerCity:output_type -> ads.GetAllAdsResponseList
+	18, // 49: ads.Ads.GetUserPlaces:output_type -> ads.GetAllAdsResponseList
+	21, // 50: ads.Ads.DeleteAdImage:output_type -> ads.DeleteResponse
+	20, // 51: ads.Ads.AddToFavorites:output_type -> ads.AdResponse
+	20, // 52: ads.Ads.DeleteFromFavorites:output_type -> ads.AdResponse
+	18, // 53: ads.Ads.GetUserFavorites:output_type -> ads.GetAllAdsResponseList
+	20, // 54: ads.Ads.UpdatePriority:output_type -> ads.AdResponse
+	25, // 55: ads.Ads.CreateBooking:output_type -> ads.Booking
+	26, // 56: ads.Ads.GetAdBookings:output_type -> ads.BookingList
+	26, // 57: ads.Ads.GetUserBookings:output_type -> ads.BookingList
+	25, // 58: ads.Ads.UpdateBookingStatus:output_type -> ads.Booking
+	18, // 59: ads.Ads.GetPlacesInBounds:output_type -> ads.GetAllAdsResponseList
+	43, // [43:60] is the sub-list for method output_type
+	26, // [26:43] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_ads_proto_init() }
@@ -2045,7 +3262,7 @@ func file_ads_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ads_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
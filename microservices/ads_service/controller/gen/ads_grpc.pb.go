@@ -31,6 +31,11 @@ const (
 	Ads_DeleteFromFavorites_FullMethodName = "/ads.Ads/DeleteFromFavorites"
 	Ads_GetUserFavorites_FullMethodName    = "/ads.Ads/GetUserFavorites"
 	Ads_UpdatePriority_FullMethodName      = "/ads.Ads/UpdatePriority"
+	Ads_CreateBooking_FullMethodName       = "/ads.Ads/CreateBooking"
+	Ads_GetAdBookings_FullMethodName       = "/ads.Ads/GetAdBookings"
+	Ads_GetUserBookings_FullMethodName     = "/ads.Ads/GetUserBookings"
+	Ads_UpdateBookingStatus_FullMethodName = "/ads.Ads/UpdateBookingStatus"
+	Ads_GetPlacesInBounds_FullMethodName   = "/ads.Ads/GetPlacesInBounds"
 )
 
 // AdsClient is the client API for Ads service.
@@ -49,6 +54,11 @@ type AdsClient interface {
 	DeleteFromFavorites(ctx context.Context, in *DeleteFromFavoritesRequest, opts ...grpc.CallOption) (*AdResponse, error)
 	GetUserFavorites(ctx context.Context, in *GetUserFavoritesRequest, opts ...grpc.CallOption) (*GetAllAdsResponseList, error)
 	UpdatePriority(ctx context.Context, in *UpdatePriorityRequest, opts ...grpc.CallOption) (*AdResponse, error)
+	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	GetAdBookings(ctx context.Context, in *GetAdBookingsRequest, opts ...grpc.CallOption) (*BookingList, error)
+	GetUserBookings(ctx context.Context, in *GetUserBookingsRequest, opts ...grpc.CallOption) (*BookingList, error)
+	UpdateBookingStatus(ctx context.Context, in *UpdateBookingStatusRequest, opts ...grpc.CallOption) (*Booking, error)
+	GetPlacesInBounds(ctx context.Context, in *GetPlacesInBoundsRequest, opts ...grpc.CallOption) (*GetAllAdsResponseList, error)
 }
 
 type adsClient struct {
@@ -179,6 +189,56 @@ func (c *adsClient) UpdatePriority(ctx context.Context, in *UpdatePriorityReques
 	return out, nil
 }
 
+func (c *adsClient) CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, Ads_CreateBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adsClient) GetAdBookings(ctx context.Context, in *GetAdBookingsRequest, opts ...grpc.CallOption) (*BookingList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingList)
+	err := c.cc.Invoke(ctx, Ads_GetAdBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adsClient) GetUserBookings(ctx context.Context, in *GetUserBookingsRequest, opts ...grpc.CallOption) (*BookingList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingList)
+	err := c.cc.Invoke(ctx, Ads_GetUserBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adsClient) UpdateBookingStatus(ctx context.Context, in *UpdateBookingStatusRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, Ads_UpdateBookingStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adsClient) GetPlacesInBounds(ctx context.Context, in *GetPlacesInBoundsRequest, opts ...grpc.CallOption) (*GetAllAdsResponseList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllAdsResponseList)
+	err := c.cc.Invoke(ctx, Ads_GetPlacesInBounds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdsServer is the server API for Ads service.
 // All implementations must embed UnimplementedAdsServer
 // for forward compatibility.
@@ -195,6 +255,11 @@ type AdsServer interface {
 	DeleteFromFavorites(context.Context, *DeleteFromFavoritesRequest) (*AdResponse, error)
 	GetUserFavorites(context.Context, *GetUserFavoritesRequest) (*GetAllAdsResponseList, error)
 	UpdatePriority(context.Context, *UpdatePriorityRequest) (*AdResponse, error)
+	CreateBooking(context.Context, *CreateBookingRequest) (*Booking, error)
+	GetAdBookings(context.Context, *GetAdBookingsRequest) (*BookingList, error)
+	GetUserBookings(context.Context, *GetUserBookingsRequest) (*BookingList, error)
+	UpdateBookingStatus(context.Context, *UpdateBookingStatusRequest) (*Booking, error)
+	GetPlacesInBounds(context.Context, *GetPlacesInBoundsRequest) (*GetAllAdsResponseList, error)
 	mustEmbedUnimplementedAdsServer()
 }
 
@@ -241,6 +306,21 @@ func (UnimplementedAdsServer) GetUserFavorites(context.Context, *GetUserFavorite
 func (UnimplementedAdsServer) UpdatePriority(context.Context, *UpdatePriorityRequest) (*AdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriority not implemented")
 }
+func (UnimplementedAdsServer) CreateBooking(context.Context, *CreateBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBooking not implemented")
+}
+func (UnimplementedAdsServer) GetAdBookings(context.Context, *GetAdBookingsRequest) (*BookingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdBookings not implemented")
+}
+func (UnimplementedAdsServer) GetUserBookings(context.Context, *GetUserBookingsRequest) (*BookingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserBookings not implemented")
+}
+func (UnimplementedAdsServer) UpdateBookingStatus(context.Context, *UpdateBookingStatusRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBookingStatus not implemented")
+}
+func (UnimplementedAdsServer) GetPlacesInBounds(context.Context, *GetPlacesInBoundsRequest) (*GetAllAdsResponseList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlacesInBounds not implemented")
+}
 func (UnimplementedAdsServer) mustEmbedUnimplementedAdsServer() {}
 func (UnimplementedAdsServer) testEmbeddedByValue()             {}
 
@@ -478,6 +558,96 @@ func _Ads_UpdatePriority_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Ads_CreateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdsServer).CreateBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ads_CreateBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdsServer).CreateBooking(ctx, req.(*CreateBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ads_GetAdBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdsServer).GetAdBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ads_GetAdBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdsServer).GetAdBookings(ctx, req.(*GetAdBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ads_GetUserBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdsServer).GetUserBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ads_GetUserBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdsServer).GetUserBookings(ctx, req.(*GetUserBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ads_UpdateBookingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdsServer).UpdateBookingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ads_UpdateBookingStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdsServer).UpdateBookingStatus(ctx, req.(*UpdateBookingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ads_GetPlacesInBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlacesInBoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdsServer).GetPlacesInBounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ads_GetPlacesInBounds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdsServer).GetPlacesInBounds(ctx, req.(*GetPlacesInBoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Ads_ServiceDesc is the grpc.ServiceDesc for Ads service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -533,6 +703,26 @@ var Ads_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePriority",
 			Handler:    _Ads_UpdatePriority_Handler,
 		},
+		{
+			MethodName: "CreateBooking",
+			Handler:    _Ads_CreateBooking_Handler,
+		},
+		{
+			MethodName: "GetAdBookings",
+			Handler:    _Ads_GetAdBookings_Handler,
+		},
+		{
+			MethodName: "GetUserBookings",
+			Handler:    _Ads_GetUserBookings_Handler,
+		},
+		{
+			MethodName: "UpdateBookingStatus",
+			Handler:    _Ads_UpdateBookingStatus_Handler,
+		},
+		{
+			MethodName: "GetPlacesInBounds",
+			Handler:    _Ads_GetPlacesInBounds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ads.proto",
@@ -12,9 +12,11 @@ import (
 )
 
 type MockJwtTokenService struct {
-	MockCreate            func(session_id string, tokenExpTime int64) (string, error)
-	MockValidate          func(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error)
-	MockParseSecretGetter func(token *jwt.Token) (interface{}, error)
+	MockCreate             func(session_id string, tokenExpTime int64) (string, error)
+	MockValidate           func(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error)
+	MockParseSecretGetter  func(token *jwt.Token) (interface{}, error)
+	MockCreateEmailToken   func(userID string, email string, tokenExpTime int64) (string, error)
+	MockValidateEmailToken func(tokenString string) (*middleware.JwtEmailClaims, error)
 }
 
 func (m *MockJwtTokenService) Create(session_id string, tokenExpTime int64) (string, error) {
@@ -29,11 +31,26 @@ func (m *MockJwtTokenService) ParseSecretGetter(token *jwt.Token) (interface{},
 	return m.MockParseSecretGetter(token)
 }
 
+func (m *MockJwtTokenService) CreateEmailToken(userID string, email string, tokenExpTime int64) (string, error) {
+	return m.MockCreateEmailToken(userID, email, tokenExpTime)
+}
+
+func (m *MockJwtTokenService) ValidateEmailToken(tokenString string) (*middleware.JwtEmailClaims, error) {
+	return m.MockValidateEmailToken(tokenString)
+}
+
 type MockServiceSession struct {
-	MockGetUserID      func(ctx context.Context, sessionID string) (string, error)
-	MockLogoutSession  func(ctx context.Context, sessionID string) error
-	MockCreateSession  func(ctx context.Context, user *domain.User) (string, error)
-	MockGetSessionData func(ctx context.Context, sessionID string) (*domain.SessionData, error)
+	MockGetUserID           func(ctx context.Context, sessionID string) (string, error)
+	MockLogoutSession       func(ctx context.Context, sessionID string) error
+	MockCreateSession       func(ctx context.Context, user *domain.User, client domain.SessionClient) (string, error)
+	MockGetSessionData      func(ctx context.Context, sessionID string) (*domain.SessionData, error)
+	MockGetUserSessions     func(ctx context.Context, sessionID string) ([]domain.ActiveSession, error)
+	MockRevokeSession       func(ctx context.Context, sessionID string, publicID string) error
+	MockRevokeOtherSessions func(ctx context.Context, sessionID string) error
+	MockRevokeUserSessions  func(ctx context.Context, userID string) error
+	MockMarkEmailVerified   func(ctx context.Context, userID string) error
+	MockMarkEmailUnverified func(ctx context.Context, userID string) error
+	MockUpdateUserRole      func(ctx context.Context, userID string, role string) error
 }
 
 func (m *MockServiceSession) GetUserID(ctx context.Context, sessionID string) (string, error) {
@@ -44,17 +61,45 @@ func (m *MockServiceSession) LogoutSession(ctx context.Context, sessionID string
 	return m.MockLogoutSession(ctx, sessionID)
 }
 
-func (m *MockServiceSession) CreateSession(ctx context.Context, user *domain.User) (string, error) {
-	return m.MockCreateSession(ctx, user)
+func (m *MockServiceSession) CreateSession(ctx context.Context, user *domain.User, client domain.SessionClient) (string, error) {
+	return m.MockCreateSession(ctx, user, client)
 }
 
 func (m *MockServiceSession) GetSessionData(ctx context.Context, sessionID string) (*domain.SessionData, error) {
 	return m.MockGetSessionData(ctx, sessionID)
 }
 
+func (m *MockServiceSession) GetUserSessions(ctx context.Context, sessionID string) ([]domain.ActiveSession, error) {
+	return m.MockGetUserSessions(ctx, sessionID)
+}
+
+func (m *MockServiceSession) RevokeSession(ctx context.Context, sessionID string, publicID string) error {
+	return m.MockRevokeSession(ctx, sessionID, publicID)
+}
+
+func (m *MockServiceSession) RevokeOtherSessions(ctx context.Context, sessionID string) error {
+	return m.MockRevokeOtherSessions(ctx, sessionID)
+}
+
+func (m *MockServiceSession) RevokeUserSessions(ctx context.Context, userID string) error {
+	return m.MockRevokeUserSessions(ctx, userID)
+}
+
+func (m *MockServiceSession) MarkEmailVerified(ctx context.Context, userID string) error {
+	return m.MockMarkEmailVerified(ctx, userID)
+}
+
+func (m *MockServiceSession) MarkEmailUnverified(ctx context.Context, userID string) error {
+	return m.MockMarkEmailUnverified(ctx, userID)
+}
+
+func (m *MockServiceSession) UpdateUserRole(ctx context.Context, userID string, role string) error {
+	return m.MockUpdateUserRole(ctx, userID, role)
+}
+
 type MockAdUseCase struct {
-	MockGetAllPlaces             func(ctx context.Context, filter domain.AdFilter, userId string) ([]domain.GetAllAdsResponse, error)
-	MockGetOnePlace              func(ctx context.Context, adId string, isAuthorized bool) (domain.GetAllAdsResponse, error)
+	MockGetAllPlaces             func(ctx context.Context, filter domain.AdFilter, userId string) ([]domain.GetAllAdsResponse, string, error)
+	MockGetOnePlace              func(ctx context.Context, adId string, viewer *domain.SessionData, dateFrom time.Time, dateTo time.Time) (domain.GetAllAdsResponse, error)
 	MockCreatePlace              func(ctx context.Context, place *domain.Ad, fileHeader [][]byte, newPlace domain.CreateAdRequest, userId string) error
 	MockUpdatePlace              func(ctx context.Context, place *domain.Ad, adId string, userId string, fileHeader [][]byte, updatedPlace domain.UpdateAdRequest) error
 	MockDeletePlace              func(ctx context.Context, adId string, userId string) error
@@ -65,6 +110,11 @@ type MockAdUseCase struct {
 	MockDeleteFromFavorites      func(ctx context.Context, adId string, userId string) error
 	MockGetUserFavorites         func(ctx context.Context, userId string) ([]domain.GetAllAdsResponse, error)
 	MockUpdatePriority           func(ctx context.Context, adId string, userId string, amount int) error
+	MockCreateBooking            func(ctx context.Context, adId string, userId string, request domain.CreateBookingRequest) (domain.Booking, error)
+	MockGetAdBookings            func(ctx context.Context, adId string, userId string) ([]domain.Booking, error)
+	MockGetUserBookings          func(ctx context.Context, userId string) ([]domain.Booking, error)
+	MockUpdateBookingStatus      func(ctx context.Context, bookingId int, userId string, status string) (domain.Booking, error)
+	MockGetPlacesInBounds        func(ctx context.Context, bounds domain.GeoBounds) ([]domain.GetAllAdsResponse, error)
 	MockStartPriorityResetWorker func(ctx context.Context, tickerInterval time.Duration)
 }
 
@@ -72,12 +122,12 @@ func (m *MockAdUseCase) DeleteAdImage(ctx context.Context, adId string, imageId
 	return m.MockDeleteAdImage(ctx, adId, imageId, userId)
 }
 
-func (m *MockAdUseCase) GetAllPlaces(ctx context.Context, filter domain.AdFilter, userId string) ([]domain.GetAllAdsResponse, error) {
+func (m *MockAdUseCase) GetAllPlaces(ctx context.Context, filter domain.AdFilter, userId string) ([]domain.GetAllAdsResponse, string, error) {
 	return m.MockGetAllPlaces(ctx, filter, userId)
 }
 
-func (m *MockAdUseCase) GetOnePlace(ctx context.Context, adId string, isAuthorized bool) (domain.GetAllAdsResponse, error) {
-	return m.MockGetOnePlace(ctx, adId, isAuthorized)
+func (m *MockAdUseCase) GetOnePlace(ctx context.Context, adId string, viewer *domain.SessionData, dateFrom time.Time, dateTo time.Time) (domain.GetAllAdsResponse, error) {
+	return m.MockGetOnePlace(ctx, adId, viewer, dateFrom, dateTo)
 }
 
 func (m *MockAdUseCase) CreatePlace(ctx context.Context, place *domain.Ad, fileHeader [][]byte, newPlace domain.CreateAdRequest, userId string) error {
@@ -120,25 +170,53 @@ func (m *MockAdUseCase) StartPriorityResetWorker(ctx context.Context, tickerInte
 	m.MockStartPriorityResetWorker(ctx, tickerInterval)
 }
 
+func (m *MockAdUseCase) CreateBooking(ctx context.Context, adId string, userId string, request domain.CreateBookingRequest) (domain.Booking, error) {
+	return m.MockCreateBooking(ctx, adId, userId, request)
+}
+
+func (m *MockAdUseCase) GetAdBookings(ctx context.Context, adId string, userId string) ([]domain.Booking, error) {
+	return m.MockGetAdBookings(ctx, adId, userId)
+}
+
+func (m *MockAdUseCase) GetUserBookings(ctx context.Context, userId string) ([]domain.Booking, error) {
+	return m.MockGetUserBookings(ctx, userId)
+}
+
+func (m *MockAdUseCase) UpdateBookingStatus(ctx context.Context, bookingId int, userId string, status string) (domain.Booking, error) {
+	return m.MockUpdateBookingStatus(ctx, bookingId, userId, status)
+}
+
+func (m *MockAdUseCase) GetPlacesInBounds(ctx context.Context, bounds domain.GeoBounds) ([]domain.GetAllAdsResponse, error) {
+	return m.MockGetPlacesInBounds(ctx, bounds)
+}
+
 type MockAdRepository struct {
-	MockGetAllPlaces           func(ctx context.Context, filter domain.AdFilter, userId string) ([]domain.GetAllAdsResponse, error)
-	MockGetPlaceById           func(ctx context.Context, adId string) (domain.GetAllAdsResponse, error)
-	MockUpdateViewsCount       func(ctx context.Context, ad domain.GetAllAdsResponse) (domain.GetAllAdsResponse, error)
-	MockCreatePlace            func(ctx context.Context, ad *domain.Ad, newAd domain.CreateAdRequest, userId string) error
-	MockSavePlace              func(ctx context.Context, ad *domain.Ad) error
-	MockUpdatePlace            func(ctx context.Context, ad *domain.Ad, adId string, userId string, updatedAd domain.UpdateAdRequest) error
-	MockDeletePlace            func(ctx context.Context, adId string, userId string) error
-	MockGetPlacesPerCity       func(ctx context.Context, city string) ([]domain.GetAllAdsResponse, error)
-	MockSaveImages             func(ctx context.Context, adUUID string, imagePaths []string) error
-	MockGetAdImages            func(ctx context.Context, adId string) ([]string, error)
-	MockGetUserPlaces          func(ctx context.Context, userId string) ([]domain.GetAllAdsResponse, error)
-	MockDeleteAdImage          func(ctx context.Context, adId string, imageId int, userId string) (string, error)
-	MockAddToFavorites         func(ctx context.Context, adId string, userId string) error
-	MockDeleteFromFavorites    func(ctx context.Context, adId string, userId string) error
-	MockGetUserFavorites       func(ctx context.Context, userId string) ([]domain.GetAllAdsResponse, error)
-	MockUpdateFavoritesCount   func(ctx context.Context, adId string) error
-	MockUpdatePriority         func(ctx context.Context, adId string, userId string, amount int) error
-	MockResetExpiredPriorities func(ctx context.Context) error
+	MockGetAllPlaces              func(ctx context.Context, filter domain.AdFilter, userId string) ([]domain.GetAllAdsResponse, error)
+	MockGetPlaceById              func(ctx context.Context, adId string) (domain.GetAllAdsResponse, error)
+	MockUpdateViewsCount          func(ctx context.Context, ad domain.GetAllAdsResponse) (domain.GetAllAdsResponse, error)
+	MockCreatePlace               func(ctx context.Context, ad *domain.Ad, newAd domain.CreateAdRequest, userId string) error
+	MockSavePlace                 func(ctx context.Context, ad *domain.Ad) error
+	MockUpdatePlace               func(ctx context.Context, ad *domain.Ad, adId string, userId string, updatedAd domain.UpdateAdRequest) error
+	MockDeletePlace               func(ctx context.Context, adId string, userId string) error
+	MockGetPlacesPerCity          func(ctx context.Context, city string) ([]domain.GetAllAdsResponse, error)
+	MockSaveImages                func(ctx context.Context, adUUID string, imagePaths []string) error
+	MockGetAdImages               func(ctx context.Context, adId string) ([]string, error)
+	MockGetUserPlaces             func(ctx context.Context, userId string) ([]domain.GetAllAdsResponse, error)
+	MockDeleteAdImage             func(ctx context.Context, adId string, imageId int, userId string) (string, error)
+	MockAddToFavorites            func(ctx context.Context, adId string, userId string) error
+	MockDeleteFromFavorites       func(ctx context.Context, adId string, userId string) error
+	MockGetUserFavorites          func(ctx context.Context, userId string) ([]domain.GetAllAdsResponse, error)
+	MockUpdateFavoritesCount      func(ctx context.Context, adId string) error
+	MockUpdatePriority            func(ctx context.Context, adId string, userId string, amount int) error
+	MockResetExpiredPriorities    func(ctx context.Context) error
+	MockCreateBooking             func(ctx context.Context, booking *domain.Booking) error
+	MockGetBookingById            func(ctx context.Context, bookingId int) (domain.Booking, error)
+	MockGetAdBookings             func(ctx context.Context, adId string) ([]domain.Booking, error)
+	MockGetUserBookings           func(ctx context.Context, userId string) ([]domain.Booking, error)
+	MockHasApprovedBookingOverlap func(ctx context.Context, adId string, dateFrom time.Time, dateTo time.Time, excludeId int) (bool, error)
+	MockUpdateBookingStatus       func(ctx context.Context, booking *domain.Booking, fromStatus string) error
+	MockIsAdAvailable             func(ctx context.Context, adId string, dateFrom time.Time, dateTo time.Time) (bool, error)
+	MockGetPlacesInBounds         func(ctx context.Context, bounds domain.GeoBounds, limit int) ([]domain.GetAllAdsResponse, error)
 }
 
 func (m *MockAdRepository) DeleteAdImage(ctx context.Context, adId string, imageId int, userId string) (string, error) {
@@ -213,6 +291,38 @@ func (m *MockAdRepository) ResetExpiredPriorities(ctx context.Context) error {
 	return m.MockResetExpiredPriorities(ctx)
 }
 
+func (m *MockAdRepository) CreateBooking(ctx context.Context, booking *domain.Booking) error {
+	return m.MockCreateBooking(ctx, booking)
+}
+
+func (m *MockAdRepository) GetBookingById(ctx context.Context, bookingId int) (domain.Booking, error) {
+	return m.MockGetBookingById(ctx, bookingId)
+}
+
+func (m *MockAdRepository) GetAdBookings(ctx context.Context, adId string) ([]domain.Booking, error) {
+	return m.MockGetAdBookings(ctx, adId)
+}
+
+func (m *MockAdRepository) GetUserBookings(ctx context.Context, userId string) ([]domain.Booking, error) {
+	return m.MockGetUserBookings(ctx, userId)
+}
+
+func (m *MockAdRepository) HasApprovedBookingOverlap(ctx context.Context, adId string, dateFrom time.Time, dateTo time.Time, excludeId int) (bool, error) {
+	return m.MockHasApprovedBookingOverlap(ctx, adId, dateFrom, dateTo, excludeId)
+}
+
+func (m *MockAdRepository) UpdateBookingStatus(ctx context.Context, booking *domain.Booking, fromStatus string) error {
+	return m.MockUpdateBookingStatus(ctx, booking, fromStatus)
+}
+
+func (m *MockAdRepository) IsAdAvailable(ctx context.Context, adId string, dateFrom time.Time, dateTo time.Time) (bool, error) {
+	return m.MockIsAdAvailable(ctx, adId, dateFrom, dateTo)
+}
+
+func (m *MockAdRepository) GetPlacesInBounds(ctx context.Context, bounds domain.GeoBounds, limit int) ([]domain.GetAllAdsResponse, error) {
+	return m.MockGetPlacesInBounds(ctx, bounds, limit)
+}
+
 type MockMinioService struct {
 	UploadFileFunc func(file []byte, contentType, id string) (string, error)
 	DeleteFileFunc func(filePath string) error
@@ -289,3 +399,28 @@ func (m *MockGrpcClient) UpdatePriority(ctx context.Context, in *gen.UpdatePrior
 	args := m.Called(ctx, in, opts)
 	return args.Get(0).(*gen.AdResponse), args.Error(1)
 }
+
+func (m *MockGrpcClient) CreateBooking(ctx context.Context, in *gen.CreateBookingRequest, opts ...grpc.CallOption) (*gen.Booking, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.Booking), args.Error(1)
+}
+
+func (m *MockGrpcClient) GetAdBookings(ctx context.Context, in *gen.GetAdBookingsRequest, opts ...grpc.CallOption) (*gen.BookingList, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.BookingList), args.Error(1)
+}
+
+func (m *MockGrpcClient) GetUserBookings(ctx context.Context, in *gen.GetUserBookingsRequest, opts ...grpc.CallOption) (*gen.BookingList, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.BookingList), args.Error(1)
+}
+
+func (m *MockGrpcClient) GetPlacesInBounds(ctx context.Context, in *gen.GetPlacesInBoundsRequest, opts ...grpc.CallOption) (*gen.GetAllAdsResponseList, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.GetAllAdsResponseList), args.Error(1)
+}
+
+func (m *MockGrpcClient) UpdateBookingStatus(ctx context.Context, in *gen.UpdateBookingStatusRequest, opts ...grpc.CallOption) (*gen.Booking, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.Booking), args.Error(1)
+}
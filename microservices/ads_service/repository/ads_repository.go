@@ -9,6 +9,7 @@ import (
 	"errors"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"strconv"
 	"time"
 )
@@ -23,6 +24,211 @@ func NewAdRepository(db *gorm.DB) domain.AdRepository {
 	}
 }
 
+// Даты доступности выбираются подзапросами: у объявления может быть несколько диапазонов,
+// и JOIN с ad_available_dates размножал бы строки выдачи
+const adDatesSelect = `(SELECT MIN(ad_available_dates."availableDateFrom") FROM ad_available_dates WHERE ad_available_dates."adId" = ads.uuid) as "AdDateFrom", ` +
+	`(SELECT MAX(ad_available_dates."availableDateTo") FROM ad_available_dates WHERE ad_available_dates."adId" = ads.uuid) as "AdDateTo"`
+
+// Скрытые модератором объявления не попадают в выдачу
+const adVisible = "NOT ads.hidden"
+
+// Координаты объявления: у объявления не больше одной строки в ad_positions
+const (
+	adPositionJoin   = `LEFT JOIN ad_positions ON ad_positions."adId" = ads.uuid`
+	adPositionSelect = `ad_positions.latitude as "Latitude", ad_positions.longitude as "Longitude"`
+)
+
+// Расстояние по формуле гаверсинусов в километрах, параметры: широта, долгота, широта точки
+const distanceExpr = `6371 * acos(LEAST(1, cos(radians(?)) * cos(radians(ad_positions.latitude)) * cos(radians(ad_positions.longitude) - radians(?)) + sin(radians(?)) * sin(radians(ad_positions.latitude))))`
+
+// Полнотекстовый поиск: к вектору объявления (адрес и описание, см. 010_ad_search.sql) добавляются
+// названия города на обоих языках, запрос разбирается русской и английской конфигурациями.
+// Параметры searchQuery: строка поиска дважды
+const (
+	searchDocument = `(ads."searchVector" || setweight(to_tsvector('russian', coalesce(cities.title, '')) || to_tsvector('english', coalesce(cities."enTitle", '')), 'A'))`
+	searchQuery    = `(websearch_to_tsquery('russian', ?) || websearch_to_tsquery('english', ?))`
+	// в конфигурации russian латиница обрабатывается английским стеммером, поэтому её хватает для подсветки
+	searchHighlightOptions = `'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5'`
+	searchSelect           = `ts_rank(` + searchDocument + `, ` + searchQuery + `) as "SearchRank", ` +
+		`ts_headline('russian', ads.description, ` + searchQuery + `, ` + searchHighlightOptions + `) as "DescriptionHighlight", ` +
+		`ts_headline('russian', ads.address, ` + searchQuery + `, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true') as "AddressHighlight"`
+)
+
+// whereAvailable оставляет объявления, у которых есть диапазон, целиком покрывающий проживание,
+// и нет заблокированных дат или подтверждённых бронирований внутри него
+func whereAvailable(query *gorm.DB, dateFrom time.Time, dateTo time.Time) *gorm.DB {
+	switch {
+	case !dateFrom.IsZero() && !dateTo.IsZero():
+	case !dateFrom.IsZero():
+		dateTo = dateFrom.AddDate(0, 0, 1)
+	case !dateTo.IsZero():
+		dateFrom = dateTo.AddDate(0, 0, -1)
+	default:
+		return query
+	}
+	return query.
+		Where("EXISTS (SELECT 1 FROM ad_available_dates WHERE ad_available_dates.\"adId\" = ads.uuid AND ad_available_dates.\"availableDateFrom\" <= ? AND ad_available_dates.\"availableDateTo\" >= ?)", dateFrom, dateTo).
+		Where("NOT EXISTS (SELECT 1 FROM ad_blocked_dates WHERE ad_blocked_dates.\"adId\" = ads.uuid AND ad_blocked_dates.\"blockedDate\" >= ? AND ad_blocked_dates.\"blockedDate\" < ?)", dateFrom, dateTo).
+		Where("NOT EXISTS (SELECT 1 FROM requests WHERE requests.\"adId\" = ads.uuid AND requests.status = ? AND requests.\"dateFrom\" < ? AND requests.\"dateTo\" > ?)", domain.BookingStatusApproved, dateTo, dateFrom)
+}
+
+func saveAdCalendar(db *gorm.DB, adId string, ranges []domain.AvailableDateRange, blockedDates []time.Time) error {
+	dates := make([]domain.AdAvailableDate, 0, len(ranges))
+	for _, dateRange := range ranges {
+		dates = append(dates, domain.AdAvailableDate{
+			AdID:              adId,
+			AvailableDateFrom: dateRange.DateFrom,
+			AvailableDateTo:   dateRange.DateTo,
+		})
+	}
+	if len(dates) > 0 {
+		if err := db.Create(&dates).Error; err != nil {
+			return err
+		}
+	}
+	blocked := make([]domain.AdBlockedDate, 0, len(blockedDates))
+	for _, blockedDate := range blockedDates {
+		blocked = append(blocked, domain.AdBlockedDate{
+			AdID:        adId,
+			BlockedDate: blockedDate,
+		})
+	}
+	if len(blocked) > 0 {
+		if err := db.Create(&blocked).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *adRepository) saveAdPosition(adId string, point domain.GeoPoint) error {
+	if err := r.db.Where("\"adId\" = ?", adId).Delete(&domain.AdPosition{}).Error; err != nil {
+		return err
+	}
+	position := domain.AdPosition{
+		AdID:      adId,
+		Latitude:  point.Latitude,
+		Longitude: point.Longitude,
+	}
+	return r.db.Create(&position).Error
+}
+
+func (r *adRepository) getAdCalendar(adId string) (domain.AdCalendar, error) {
+	calendar := domain.AdCalendar{
+		AvailableDates: []domain.AvailableDateRange{},
+		BlockedDates:   []time.Time{},
+	}
+
+	var dates []domain.AdAvailableDate
+	if err := r.db.Model(&domain.AdAvailableDate{}).Where("\"adId\" = ?", adId).Order("\"availableDateFrom\" ASC").Find(&dates).Error; err != nil {
+		return calendar, err
+	}
+	for _, date := range dates {
+		calendar.AvailableDates = append(calendar.AvailableDates, domain.AvailableDateRange{
+			DateFrom: date.AvailableDateFrom,
+			DateTo:   date.AvailableDateTo,
+		})
+	}
+
+	var blocked []domain.AdBlockedDate
+	if err := r.db.Model(&domain.AdBlockedDate{}).Where("\"adId\" = ?", adId).Order("\"blockedDate\" ASC").Find(&blocked).Error; err != nil {
+		return calendar, err
+	}
+	for _, date := range blocked {
+		calendar.BlockedDates = append(calendar.BlockedDates, date.BlockedDate)
+	}
+	return calendar, nil
+}
+
+// loadAdsImages подгружает картинки для всей страницы объявлений одним запросом
+func (r *adRepository) loadAdsImages(requestID string, ads []domain.GetAllAdsResponse) error {
+	if len(ads) == 0 {
+		return nil
+	}
+	adIndex := make(map[string][]int, len(ads))
+	adIds := make([]string, 0, len(ads))
+	for i, ad := range ads {
+		if _, ok := adIndex[ad.UUID]; !ok {
+			adIds = append(adIds, ad.UUID)
+		}
+		adIndex[ad.UUID] = append(adIndex[ad.UUID], i)
+	}
+
+	var images []domain.Image
+	if err := r.db.Model(&domain.Image{}).Where("\"adId\" IN ?", adIds).Find(&images).Error; err != nil {
+		logger.DBLogger.Error("Error fetching images for ad", zap.String("request_id", requestID), zap.Error(err))
+		return errors.New("error fetching images for ad")
+	}
+	for _, img := range images {
+		for _, i := range adIndex[img.AdID] {
+			ads[i].Images = append(ads[i].Images, domain.ImageResponse{
+				ID:        img.ID,
+				ImagePath: img.ImageUrl,
+			})
+		}
+	}
+	return nil
+}
+
+// loadAdsDetails заполняет картинки, комнаты и, если нужно, автора объявлений
+// фиксированным числом запросов, независимо от размера страницы
+func (r *adRepository) loadAdsDetails(requestID string, ads []domain.GetAllAdsResponse, withAuthor bool) error {
+	if len(ads) == 0 {
+		return nil
+	}
+	if err := r.loadAdsImages(requestID, ads); err != nil {
+		return err
+	}
+
+	adIndex := make(map[string][]int, len(ads))
+	authorIndex := make(map[string][]int, len(ads))
+	adIds := make([]string, 0, len(ads))
+	authorIds := make([]string, 0, len(ads))
+	for i, ad := range ads {
+		if _, ok := adIndex[ad.UUID]; !ok {
+			adIds = append(adIds, ad.UUID)
+		}
+		adIndex[ad.UUID] = append(adIndex[ad.UUID], i)
+		if _, ok := authorIndex[ad.AuthorUUID]; !ok {
+			authorIds = append(authorIds, ad.AuthorUUID)
+		}
+		authorIndex[ad.AuthorUUID] = append(authorIndex[ad.AuthorUUID], i)
+	}
+
+	if withAuthor {
+		var users []domain.User
+		if err := r.db.Model(&domain.User{}).Where("uuid IN ?", authorIds).Find(&users).Error; err != nil {
+			logger.DBLogger.Error("Error fetching user", zap.String("request_id", requestID), zap.Error(err))
+			return errors.New("error fetching user")
+		}
+		for _, user := range users {
+			for _, i := range authorIndex[user.UUID] {
+				ads[i].AdAuthor.Name = user.Name
+				ads[i].AdAuthor.Avatar = user.Avatar
+				ads[i].AdAuthor.Rating = user.Score
+				ads[i].AdAuthor.GuestCount = user.GuestCount
+				ads[i].AdAuthor.Sex = user.Sex
+				ads[i].AdAuthor.Birthdate = user.Birthdate
+			}
+		}
+	}
+
+	var rooms []domain.AdRooms
+	if err := r.db.Model(&domain.AdRooms{}).Where("\"adId\" IN ?", adIds).Find(&rooms).Error; err != nil {
+		logger.DBLogger.Error("Error fetching rooms for ad", zap.String("request_id", requestID), zap.Error(err))
+		return errors.New("error fetching rooms for ad")
+	}
+	for _, room := range rooms {
+		for _, i := range adIndex[room.AdID] {
+			ads[i].Rooms = append(ads[i].Rooms, domain.AdRoomsResponse{
+				Type:         room.Type,
+				SquareMeters: room.SquareMeters,
+			})
+		}
+	}
+	return nil
+}
+
 func (r *adRepository) GetAllPlaces(ctx context.Context, filter domain.AdFilter, userId string) ([]domain.GetAllAdsResponse, error) {
 	start := time.Now()
 	requestID := middleware.GetRequestID(ctx)
@@ -39,10 +245,12 @@ func (r *adRepository) GetAllPlaces(ctx context.Context, filter domain.AdFilter,
 	}()
 	var ads []domain.GetAllAdsResponse
 
+	selectExpr := "ads.*, cities.title as \"CityName\", " + adDatesSelect + ", " + adPositionSelect
+	var selectArgs []interface{}
 	query := r.db.Model(&domain.Ad{}).Joins("JOIN cities ON  ads.\"cityId\" = cities.id").
 		Joins("JOIN users ON ads.\"authorUUID\" = users.uuid").
-		Joins("JOIN ad_available_dates ON ad_available_dates.\"adId\" = ads.uuid").
-		Select("ads.*, cities.title as \"CityName\", ad_available_dates.\"availableDateFrom\" as \"AdDateFrom\", ad_available_dates.\"availableDateTo\" as \"AdDateTo\"")
+		Joins(adPositionJoin).
+		Where(adVisible)
 
 	if filter.Location != "" {
 		query = query.Where("cities.\"enTitle\" = ?", filter.Location)
@@ -54,7 +262,15 @@ func (r *adRepository) GetAllPlaces(ctx context.Context, filter domain.AdFilter,
 			logger.DBLogger.Error("Invalid rating value", zap.String("request_id", requestID))
 			return nil, errors.New("invalid rating value")
 		}
-		query = query.Where("users.score >= ?", rating)
+		switch filter.RatingMode {
+		case "", domain.RatingModeAverage:
+			query = query.Where("users.score >= ?", rating)
+		case domain.RatingModeWeighted:
+			query = query.Where("users.\"weightedScore\" >= ?", rating)
+		default:
+			logger.DBLogger.Error("Invalid rating mode", zap.String("request_id", requestID))
+			return nil, errors.New("invalid rating mode")
+		}
 	}
 
 	if filter.NewThisWeek == "true" {
@@ -83,13 +299,52 @@ func (r *adRepository) GetAllPlaces(ctx context.Context, filter domain.AdFilter,
 		}
 	}
 
-	switch {
-	case !filter.DateFrom.IsZero() && !filter.DateTo.IsZero():
-		query = query.Where("ad_available_dates.\"availableDateFrom\" <= ? AND ad_available_dates.\"availableDateTo\" >= ?", filter.DateTo, filter.DateFrom)
-	case !filter.DateFrom.IsZero():
-		query = query.Where("ad_available_dates.\"availableDateTo\" >= ?", filter.DateFrom)
-	case !filter.DateTo.IsZero():
-		query = query.Where("ad_available_dates.\"availableDateFrom\" <= ?", filter.DateTo)
+	query = whereAvailable(query, filter.DateFrom, filter.DateTo)
+
+	if filter.PriceMin > 0 {
+		query = query.Where("ads.price >= ?", filter.PriceMin)
+	}
+
+	if filter.PriceMax > 0 {
+		query = query.Where("ads.price <= ?", filter.PriceMax)
+	}
+
+	if filter.Near != nil {
+		lat, lng := filter.Near.Latitude, filter.Near.Longitude
+		selectExpr += ", " + distanceExpr + " as \"Distance\""
+		selectArgs = append(selectArgs, lat, lng, lat)
+		query = query.Where("ad_positions.id IS NOT NULL")
+		if filter.RadiusKm > 0 {
+			query = query.Where(distanceExpr+" <= ?", lat, lng, lat, filter.RadiusKm)
+		}
+	}
+
+	if filter.Query != "" {
+		q := filter.Query
+		selectExpr += ", " + searchSelect
+		selectArgs = append(selectArgs, q, q, q, q, q, q)
+		query = query.Where(searchDocument+" @@ "+searchQuery, q, q)
+	}
+
+	query = query.Select(selectExpr, selectArgs...)
+
+	switch filter.Sort {
+	case domain.AdSortPriceAsc:
+		query = query.Order("ads.price ASC")
+	case domain.AdSortPriceDesc:
+		query = query.Order("ads.price DESC")
+	default:
+		if filter.Query != "" {
+			query = query.Order("\"SearchRank\" DESC")
+		}
+		if filter.Near != nil {
+			query = query.Order("\"Distance\" ASC")
+		}
+	}
+
+	if filter.Cursor != nil {
+		query = query.Where("(ads.priority, ads.\"publicationDate\", ads.uuid) < (?, ?, ?)",
+			filter.Cursor.Priority, filter.Cursor.PublicationDate, filter.Cursor.UUID)
 	}
 
 	if filter.Offset != 0 {
@@ -100,7 +355,7 @@ func (r *adRepository) GetAllPlaces(ctx context.Context, filter domain.AdFilter,
 		query = query.Limit(filter.Limit)
 	}
 
-	if err := query.Order("priority DESC").Find(&ads).Error; err != nil {
+	if err := query.Order("priority DESC").Order("ads.\"publicationDate\" DESC").Order("ads.uuid DESC").Find(&ads).Error; err != nil {
 		logger.DBLogger.Error("Error fetching all places", zap.String("request_id", requestID), zap.Error(err))
 		return nil, errors.New("erro0r fetching all places")
 	}
@@ -122,47 +377,10 @@ func (r *adRepository) GetAllPlaces(ctx context.Context, filter domain.AdFilter,
 		if _, ok := favoritesMap[ad.UUID]; ok {
 			ads[i].IsFavorite = true
 		}
+	}
 
-		var images []domain.Image
-		var user domain.User
-		var rooms []domain.AdRooms
-		err := r.db.Model(&domain.Image{}).Where("\"adId\" = ?", ad.UUID).Find(&images).Error
-		if err != nil {
-			logger.DBLogger.Error("Error fetching images for ad", zap.String("request_id", requestID), zap.Error(err))
-			return nil, errors.New("error fetching images for ad")
-		}
-
-		err = r.db.Model(&domain.User{}).Where("uuid = ?", ad.AuthorUUID).Find(&user).Error
-		if err != nil {
-			logger.DBLogger.Error("Error fetching user", zap.String("request_id", requestID), zap.Error(err))
-			return nil, errors.New("error fetching user")
-		}
-
-		err = r.db.Model(&domain.AdRooms{}).Where("\"adId\" = ?", ad.UUID).Find(&rooms).Error
-		if err != nil {
-			logger.DBLogger.Error("Error fetching rooms for ad", zap.String("request_id", requestID), zap.Error(err))
-			return nil, errors.New("error fetching rooms for ad")
-		}
-
-		ads[i].AdAuthor.Name = user.Name
-		ads[i].AdAuthor.Avatar = user.Avatar
-		ads[i].AdAuthor.Rating = user.Score
-		ads[i].AdAuthor.GuestCount = user.GuestCount
-		ads[i].AdAuthor.Sex = user.Sex
-		ads[i].AdAuthor.Birthdate = user.Birthdate
-		for _, img := range images {
-			ads[i].Images = append(ads[i].Images, domain.ImageResponse{
-				ID:        img.ID,
-				ImagePath: img.ImageUrl,
-			})
-		}
-
-		for _, room := range rooms {
-			ads[i].Rooms = append(ads[i].Rooms, domain.AdRoomsResponse{
-				Type:         room.Type,
-				SquareMeters: room.SquareMeters,
-			})
-		}
+	if err := r.loadAdsDetails(requestID, ads, true); err != nil {
+		return nil, err
 	}
 
 	logger.DBLogger.Info("Successfully fetched all places", zap.String("request_id", requestID), zap.Int("count", len(ads)))
@@ -187,9 +405,9 @@ func (r *adRepository) GetPlaceById(ctx context.Context, adId string) (domain.Ge
 
 	query := r.db.Model(&domain.Ad{}).Joins("JOIN users ON ads.\"authorUUID\" = users.uuid").
 		Joins("JOIN cities ON ads.\"cityId\" = cities.id").
-		Joins("JOIN ad_available_dates ON ad_available_dates.\"adId\" = ads.uuid").
-		Select("ads.*, cities.title as \"CityName\", ad_available_dates.\"availableDateFrom\" as \"AdDateFrom\", ad_available_dates.\"availableDateTo\" as \"AdDateTo\"").
-		Where("\"adId\" = ?", adId)
+		Joins(adPositionJoin).
+		Select("ads.*, cities.title as \"CityName\", "+adDatesSelect+", "+adPositionSelect).
+		Where("ads.uuid = ?", adId)
 
 	if err := query.Find(&ad).Error; err != nil {
 		logger.DBLogger.Error("Error fetching place", zap.String("request_id", requestID), zap.Error(err))
@@ -216,6 +434,14 @@ func (r *adRepository) GetPlaceById(ctx context.Context, adId string) (domain.Ge
 		logger.DBLogger.Error("Error fetching rooms for ad", zap.String("request_id", requestID), zap.Error(err))
 		return ad, errors.New("error fetching rooms for ad")
 	}
+
+	calendar, err := r.getAdCalendar(ad.UUID)
+	if err != nil {
+		logger.DBLogger.Error("Error fetching calendar for ad", zap.String("request_id", requestID), zap.Error(err))
+		return ad, errors.New("error fetching calendar for ad")
+	}
+	ad.Calendar = &calendar
+
 	ad.AdAuthor.Name = user.Name
 	ad.AdAuthor.Avatar = user.Avatar
 	ad.AdAuthor.Rating = user.Score
@@ -317,7 +543,6 @@ func (r *adRepository) CreatePlace(ctx context.Context, ad *domain.Ad, newAd dom
 	}()
 	var city domain.City
 	var user domain.User
-	var date domain.AdAvailableDate
 	if err := r.db.Where("uuid = ?", userId).First(&user).Error; err != nil {
 		logger.DBLogger.Error("Error finding user", zap.String("userId", userId), zap.String("request_id", requestID), zap.Error(err))
 		return errors.New("error finding user")
@@ -344,20 +569,26 @@ func (r *adRepository) CreatePlace(ctx context.Context, ad *domain.Ad, newAd dom
 	ad.HasBalcony = newAd.HasBalcony
 	ad.HasElevator = newAd.HasElevator
 	ad.HasGas = newAd.HasGas
+	ad.Price = newAd.Price
+	ad.WeekendPrice = newAd.WeekendPrice
+	ad.CleaningFee = newAd.CleaningFee
 	if err := r.db.Create(ad).Error; err != nil {
 		logger.DBLogger.Error("Error creating place", zap.String("adId", ad.UUID), zap.String("request_id", requestID), zap.Error(err))
 		return errors.New("error creating place")
 	}
 
-	date.AdID = ad.UUID
-	date.AvailableDateFrom = newAd.DateFrom
-	date.AvailableDateTo = newAd.DateTo
-
-	if err := r.db.Create(&date).Error; err != nil {
+	if err := saveAdCalendar(r.db, ad.UUID, newAd.AvailableDates, newAd.BlockedDates); err != nil {
 		logger.DBLogger.Error("Error creating date", zap.String("adId", ad.UUID), zap.String("request_id", requestID), zap.Error(err))
 		return errors.New("error creating date")
 	}
 
+	if newAd.Position != nil {
+		if err := r.saveAdPosition(ad.UUID, *newAd.Position); err != nil {
+			logger.DBLogger.Error("Error creating position", zap.String("adId", ad.UUID), zap.String("request_id", requestID), zap.Error(err))
+			return errors.New("error saving position")
+		}
+	}
+
 	for _, room := range newAd.Rooms {
 		var oneRoom domain.AdRooms
 		oneRoom.AdID = ad.UUID
@@ -387,17 +618,11 @@ func (r *adRepository) UpdatePlace(ctx context.Context, ad *domain.Ad, adId stri
 		metrics.RepoRequestDuration.WithLabelValues("UpdatePlace").Observe(duration)
 	}()
 	var oldAd domain.Ad
-	var oldDate domain.AdAvailableDate
 	if err := r.db.Where("uuid = ?", adId).First(&oldAd).Error; err != nil {
 		logger.DBLogger.Error("Ad not found", zap.String("adId", adId), zap.String("request_id", requestID))
 		return errors.New("ad not found")
 	}
 
-	if err := r.db.Where("\"adId\" = ?", adId).First(&oldDate).Error; err != nil {
-		logger.DBLogger.Error("Ad date not found", zap.String("adId", adId), zap.String("request_id", requestID))
-		return errors.New("ad date not found")
-	}
-
 	if oldAd.AuthorUUID != userId {
 		logger.DBLogger.Warn("User is not the owner of the ad", zap.String("adId", adId), zap.String("userId", userId), zap.String("request_id", requestID))
 		return errors.New("not owner of ad")
@@ -417,6 +642,9 @@ func (r *adRepository) UpdatePlace(ctx context.Context, ad *domain.Ad, adId stri
 	ad.HasBalcony = updatedPlace.HasBalcony
 	ad.HasElevator = updatedPlace.HasElevator
 	ad.HasGas = updatedPlace.HasGas
+	ad.Price = updatedPlace.Price
+	ad.WeekendPrice = updatedPlace.WeekendPrice
+	ad.CleaningFee = updatedPlace.CleaningFee
 	if err := r.db.Model(&oldAd).Updates(ad).Error; err != nil {
 		logger.DBLogger.Error("Error updating place", zap.String("adId", adId), zap.String("request_id", requestID), zap.Error(err))
 		return errors.New("error updating place")
@@ -433,12 +661,33 @@ func (r *adRepository) UpdatePlace(ctx context.Context, ad *domain.Ad, adId stri
 		logger.DBLogger.Error("Error updating place", zap.String("adId", adId), zap.String("request_id", requestID), zap.Error(err))
 		return errors.New("error updating place")
 	}
-	oldDate.AvailableDateFrom = updatedPlace.DateFrom
-	oldDate.AvailableDateTo = updatedPlace.DateTo
+	// Цена выходного дня и уборка могут быть сброшены в ноль, Updates со структурой их пропустит
+	if err := r.db.Model(&oldAd).Updates(map[string]interface{}{"weekendPrice": ad.WeekendPrice, "cleaningFee": ad.CleaningFee}).Error; err != nil {
+		logger.DBLogger.Error("Error updating place", zap.String("adId", adId), zap.String("request_id", requestID), zap.Error(err))
+		return errors.New("error updating place")
+	}
+	// Календарь заменяется целиком, пустые списки его очищают
+	if updatedPlace.ReplaceCalendar {
+		err := r.db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Where("\"adId\" = ?", adId).Delete(&domain.AdAvailableDate{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("\"adId\" = ?", adId).Delete(&domain.AdBlockedDate{}).Error; err != nil {
+				return err
+			}
+			return saveAdCalendar(tx, adId, updatedPlace.AvailableDates, updatedPlace.BlockedDates)
+		})
+		if err != nil {
+			logger.DBLogger.Error("Error updating date", zap.String("adId", adId), zap.String("request_id", requestID), zap.Error(err))
+			return errors.New("error updating date")
+		}
+	}
 
-	if err := r.db.Model(&oldDate).Updates(oldDate).Error; err != nil {
-		logger.DBLogger.Error("Error updating date", zap.String("adId", adId), zap.String("request_id", requestID), zap.Error(err))
-		return errors.New("error updating date")
+	if updatedPlace.Position != nil {
+		if err := r.saveAdPosition(adId, *updatedPlace.Position); err != nil {
+			logger.DBLogger.Error("Error updating position", zap.String("adId", adId), zap.String("request_id", requestID), zap.Error(err))
+			return errors.New("error saving position")
+		}
 	}
 
 	if err := r.db.Model(&domain.AdRooms{}).Where("\"adId\" = ?", adId).Delete(&domain.AdRooms{}).Error; err != nil {
@@ -501,6 +750,11 @@ func (r *adRepository) DeletePlace(ctx context.Context, adId string, userId stri
 		return errors.New("error deleting place")
 	}
 
+	if err := r.db.Where("\"adId\" = ?", adId).Delete(&domain.AdBlockedDate{}).Error; err != nil {
+		logger.DBLogger.Error("Error deleting blocked dates", zap.String("adId", adId), zap.String("request_id", requestID), zap.Error(err))
+		return errors.New("error deleting place")
+	}
+
 	if err := r.db.Where("\"adId\" = ?", adId).Delete(&domain.AdRooms{}).Error; err != nil {
 		logger.DBLogger.Error("Error deleting rooms", zap.String("adId", adId), zap.String("request_id", requestID), zap.Error(err))
 		return errors.New("error deleting place")
@@ -531,53 +785,15 @@ func (r *adRepository) GetPlacesPerCity(ctx context.Context, city string) ([]dom
 	}()
 	var ads []domain.GetAllAdsResponse
 	query := r.db.Model(&domain.Ad{}).Joins("JOIN users ON ads.\"authorUUID\" = users.uuid").Joins("JOIN cities ON  ads.\"cityId\" = cities.id").
-		Select("ads.*, cities.title as \"CityName\"").Where("cities.\"enTitle\" = ?", city)
+		Joins(adPositionJoin).
+		Select("ads.*, cities.title as \"CityName\", "+adPositionSelect).Where("cities.\"enTitle\" = ?", city).Where(adVisible)
 	if err := query.Order("priority DESC").Find(&ads).Error; err != nil {
 		logger.DBLogger.Error("Error fetching places per city", zap.String("city", city), zap.String("request_id", requestID), zap.Error(err))
 		return nil, errors.New("error fetching places per city")
 	}
 
-	for i, ad := range ads {
-		var images []domain.Image
-		var user domain.User
-		var rooms []domain.AdRooms
-		err := r.db.Model(&domain.Image{}).Where("\"adId\" = ?", ad.UUID).Find(&images).Error
-		if err != nil {
-			logger.DBLogger.Error("Error fetching images for ad", zap.String("request_id", requestID), zap.Error(err))
-			return nil, errors.New("error fetching images for ad")
-		}
-
-		err = r.db.Model(&domain.User{}).Where("uuid = ?", ad.AuthorUUID).Find(&user).Error
-		if err != nil {
-			logger.DBLogger.Error("Error fetching user", zap.String("request_id", requestID), zap.Error(err))
-			return nil, errors.New("error fetching user")
-		}
-
-		err = r.db.Model(&domain.AdRooms{}).Where("\"adId\" = ?", ad.UUID).Find(&rooms).Error
-		if err != nil {
-			logger.DBLogger.Error("Error fetching rooms for ad", zap.String("request_id", requestID), zap.Error(err))
-			return nil, errors.New("error fetching rooms for ad")
-		}
-
-		ads[i].AdAuthor.Name = user.Name
-		ads[i].AdAuthor.Avatar = user.Avatar
-		ads[i].AdAuthor.Rating = user.Score
-		ads[i].AdAuthor.GuestCount = user.GuestCount
-		ads[i].AdAuthor.Sex = user.Sex
-		ads[i].AdAuthor.Birthdate = user.Birthdate
-		for _, img := range images {
-			ads[i].Images = append(ads[i].Images, domain.ImageResponse{
-				ID:        img.ID,
-				ImagePath: img.ImageUrl,
-			})
-		}
-
-		for _, room := range rooms {
-			ads[i].Rooms = append(ads[i].Rooms, domain.AdRoomsResponse{
-				Type:         room.Type,
-				SquareMeters: room.SquareMeters,
-			})
-		}
+	if err := r.loadAdsDetails(requestID, ads, true); err != nil {
+		return nil, err
 	}
 
 	logger.DBLogger.Info("Successfully fetched places per city", zap.String("city", city), zap.Int("count", len(ads)), zap.String("request_id", requestID))
@@ -652,40 +868,15 @@ func (r *adRepository) GetUserPlaces(ctx context.Context, userId string) ([]doma
 	}()
 	var ads []domain.GetAllAdsResponse
 	query := r.db.Model(&domain.Ad{}).Joins("JOIN users ON ads.\"authorUUID\" = users.uuid").Joins("JOIN cities ON  ads.\"cityId\" = cities.id").
-		Select("ads.*, users.avatar, users.name, users.score as rating, cities.title as \"CityName\"").Where("users.uuid = ?", userId)
+		Joins(adPositionJoin).
+		Select("ads.*, users.avatar, users.name, users.score as rating, cities.title as \"CityName\", "+adPositionSelect).Where("users.uuid = ?", userId).Where(adVisible)
 	if err := query.Order("priority DESC").Find(&ads).Error; err != nil {
 		logger.DBLogger.Error("Error fetching user places", zap.String("city", userId), zap.String("request_id", requestID), zap.Error(err))
 		return nil, errors.New("error fetching user places")
 	}
 
-	for i, ad := range ads {
-		var images []domain.Image
-		var rooms []domain.AdRooms
-		err := r.db.Model(&domain.Image{}).Where("\"adId\" = ?", ad.UUID).Find(&images).Error
-		if err != nil {
-			logger.DBLogger.Error("Error fetching images for ad", zap.String("request_id", requestID), zap.Error(err))
-			return nil, errors.New("error fetching images for ad")
-		}
-
-		err = r.db.Model(&domain.AdRooms{}).Where("\"adId\" = ?", ad.UUID).Find(&rooms).Error
-		if err != nil {
-			logger.DBLogger.Error("Error fetching rooms for ad", zap.String("request_id", requestID), zap.Error(err))
-			return nil, errors.New("error fetching rooms for ad")
-		}
-
-		for _, img := range images {
-			ads[i].Images = append(ads[i].Images, domain.ImageResponse{
-				ID:        img.ID,
-				ImagePath: img.ImageUrl,
-			})
-		}
-
-		for _, room := range rooms {
-			ads[i].Rooms = append(ads[i].Rooms, domain.AdRoomsResponse{
-				Type:         room.Type,
-				SquareMeters: room.SquareMeters,
-			})
-		}
+	if err := r.loadAdsDetails(requestID, ads, false); err != nil {
+		return nil, err
 	}
 
 	logger.DBLogger.Info("Successfully fetched user places", zap.String("city", userId), zap.Int("count", len(ads)), zap.String("request_id", requestID))
@@ -819,56 +1010,18 @@ func (r *adRepository) GetUserFavorites(ctx context.Context, userId string) ([]d
 	query := r.db.Model(&domain.Ad{}).
 		Joins("JOIN favorites ON favorites.\"adId\" = ads.uuid").
 		Joins("JOIN cities ON  ads.\"cityId\" = cities.id").
-		Joins("JOIN ad_available_dates ON ad_available_dates.\"adId\" = ads.uuid").
+		Joins(adPositionJoin).
 		Where("favorites.\"userId\" = ?", userId).
-		Select("ads.*, favorites.\"userId\" AS \"FavoriteUserId\", cities.title as \"CityName\", ad_available_dates.\"availableDateFrom\" as \"AdDateFrom\", ad_available_dates.\"availableDateTo\" as \"AdDateTo\"")
+		Where(adVisible).
+		Select("ads.*, favorites.\"userId\" AS \"FavoriteUserId\", cities.title as \"CityName\", " + adDatesSelect + ", " + adPositionSelect)
 
 	if err := query.Find(&ads).Error; err != nil {
 		logger.DBLogger.Error("Error fetching user favorites", zap.String("request_id", requestID), zap.Error(err))
 		return nil, errors.New("error fetching user favorites")
 	}
 
-	for i, ad := range ads {
-		var images []domain.Image
-		var user domain.User
-		var rooms []domain.AdRooms
-		err := r.db.Model(&domain.Image{}).Where("\"adId\" = ?", ad.UUID).Find(&images).Error
-		if err != nil {
-			logger.DBLogger.Error("Error fetching images for ad", zap.String("request_id", requestID), zap.Error(err))
-			return nil, errors.New("error fetching images for ad")
-		}
-
-		err = r.db.Model(&domain.User{}).Where("uuid = ?", ad.AuthorUUID).Find(&user).Error
-		if err != nil {
-			logger.DBLogger.Error("Error fetching user", zap.String("request_id", requestID), zap.Error(err))
-			return nil, errors.New("error fetching user")
-		}
-
-		err = r.db.Model(&domain.AdRooms{}).Where("\"adId\" = ?", ad.UUID).Find(&rooms).Error
-		if err != nil {
-			logger.DBLogger.Error("Error fetching rooms for ad", zap.String("request_id", requestID), zap.Error(err))
-			return nil, errors.New("error fetching rooms for ad")
-		}
-
-		ads[i].AdAuthor.Name = user.Name
-		ads[i].AdAuthor.Avatar = user.Avatar
-		ads[i].AdAuthor.Rating = user.Score
-		ads[i].AdAuthor.GuestCount = user.GuestCount
-		ads[i].AdAuthor.Sex = user.Sex
-		ads[i].AdAuthor.Birthdate = user.Birthdate
-		for _, img := range images {
-			ads[i].Images = append(ads[i].Images, domain.ImageResponse{
-				ID:        img.ID,
-				ImagePath: img.ImageUrl,
-			})
-		}
-
-		for _, room := range rooms {
-			ads[i].Rooms = append(ads[i].Rooms, domain.AdRoomsResponse{
-				Type:         room.Type,
-				SquareMeters: room.SquareMeters,
-			})
-		}
+	if err := r.loadAdsDetails(requestID, ads, true); err != nil {
+		return nil, err
 	}
 
 	logger.DBLogger.Info("Successfully fetched user favorites", zap.String("request_id", requestID), zap.Int("count", len(ads)))
@@ -928,3 +1081,288 @@ func (r *adRepository) ResetExpiredPriorities(ctx context.Context) error {
 	logger.DBLogger.Info("Expired priorities reset successfully", zap.String("request_id", requestID), zap.Int64("rows_affected", result.RowsAffected))
 	return nil
 }
+
+func (r *adRepository) CreateBooking(ctx context.Context, booking *domain.Booking) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("CreateBooking called", zap.String("ad", booking.AdID), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("CreateBooking", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("CreateBooking", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("CreateBooking").Observe(duration)
+	}()
+
+	var ad domain.Ad
+	if err = r.db.Where("uuid = ?", booking.AdID).First(&ad).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.DBLogger.Warn("Ad not found", zap.String("request_id", requestID), zap.String("adId", booking.AdID))
+			return errors.New("ad not found")
+		}
+		logger.DBLogger.Error("Error fetching ad", zap.String("request_id", requestID), zap.Error(err))
+		return errors.New("error fetching ad")
+	}
+	if ad.AuthorUUID == booking.UserID {
+		err = errors.New("host cannot book own ad")
+		logger.DBLogger.Warn("Host tried to book own ad", zap.String("request_id", requestID), zap.String("adId", booking.AdID))
+		return err
+	}
+
+	booking.HostID = ad.AuthorUUID
+	booking.CreateDate = time.Now()
+	booking.UpdateDate = booking.CreateDate
+	if err = r.db.Create(booking).Error; err != nil {
+		logger.DBLogger.Error("Error creating booking", zap.String("request_id", requestID), zap.Error(err))
+		return errors.New("error creating booking")
+	}
+
+	logger.DBLogger.Info("Successfully created booking", zap.String("request_id", requestID), zap.Int("bookingId", booking.ID))
+	return nil
+}
+
+func (r *adRepository) GetBookingById(ctx context.Context, bookingId int) (domain.Booking, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("GetBookingById called", zap.Int("bookingId", bookingId), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("GetBookingById", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("GetBookingById", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("GetBookingById").Observe(duration)
+	}()
+
+	var booking domain.Booking
+	if err = r.db.Where("id = ?", bookingId).First(&booking).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.DBLogger.Warn("Booking not found", zap.String("request_id", requestID), zap.Int("bookingId", bookingId))
+			return booking, errors.New("booking not found")
+		}
+		logger.DBLogger.Error("Error fetching booking", zap.String("request_id", requestID), zap.Error(err))
+		return booking, errors.New("error fetching booking")
+	}
+
+	return booking, nil
+}
+
+func (r *adRepository) GetAdBookings(ctx context.Context, adId string) ([]domain.Booking, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("GetAdBookings called", zap.String("ad", adId), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("GetAdBookings", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("GetAdBookings", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("GetAdBookings").Observe(duration)
+	}()
+
+	var bookings []domain.Booking
+	if err = r.db.Where("\"adId\" = ?", adId).Order("\"dateFrom\" ASC").Find(&bookings).Error; err != nil {
+		logger.DBLogger.Error("Error fetching ad bookings", zap.String("request_id", requestID), zap.Error(err))
+		return nil, errors.New("error fetching bookings")
+	}
+
+	logger.DBLogger.Info("Successfully fetched ad bookings", zap.String("request_id", requestID), zap.Int("count", len(bookings)))
+	return bookings, nil
+}
+
+func (r *adRepository) GetUserBookings(ctx context.Context, userId string) ([]domain.Booking, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("GetUserBookings called", zap.String("user", userId), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("GetUserBookings", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("GetUserBookings", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("GetUserBookings").Observe(duration)
+	}()
+
+	// Возвращаем как заявки пользователя-гостя, так и входящие заявки на его объявления
+	var bookings []domain.Booking
+	if err = r.db.Where("\"userId\" = ? OR \"hostId\" = ?", userId, userId).Order("\"createDate\" DESC").Find(&bookings).Error; err != nil {
+		logger.DBLogger.Error("Error fetching user bookings", zap.String("request_id", requestID), zap.Error(err))
+		return nil, errors.New("error fetching bookings")
+	}
+
+	logger.DBLogger.Info("Successfully fetched user bookings", zap.String("request_id", requestID), zap.Int("count", len(bookings)))
+	return bookings, nil
+}
+
+func (r *adRepository) HasApprovedBookingOverlap(ctx context.Context, adId string, dateFrom time.Time, dateTo time.Time, excludeId int) (bool, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("HasApprovedBookingOverlap called", zap.String("ad", adId), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("HasApprovedBookingOverlap", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("HasApprovedBookingOverlap", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("HasApprovedBookingOverlap").Observe(duration)
+	}()
+
+	var count int64
+	err = r.db.Model(&domain.Booking{}).
+		Where("\"adId\" = ? AND status = ? AND id <> ?", adId, domain.BookingStatusApproved, excludeId).
+		Where("\"dateFrom\" < ? AND \"dateTo\" > ?", dateTo, dateFrom).
+		Count(&count).Error
+	if err != nil {
+		logger.DBLogger.Error("Error checking booking overlap", zap.String("request_id", requestID), zap.Error(err))
+		return false, errors.New("error checking booking overlap")
+	}
+
+	return count > 0, nil
+}
+
+func (r *adRepository) UpdateBookingStatus(ctx context.Context, booking *domain.Booking, fromStatus string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("UpdateBookingStatus called", zap.Int("bookingId", booking.ID), zap.String("status", booking.Status), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("UpdateBookingStatus", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("UpdateBookingStatus", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("UpdateBookingStatus").Observe(duration)
+	}()
+
+	booking.UpdateDate = time.Now()
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if booking.Status == domain.BookingStatusApproved {
+			// блокировка объявления выстраивает одобрения его заявок в очередь,
+			// иначе две пересекающиеся заявки одобряются одновременно
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("uuid").Where("uuid = ?", booking.AdID).First(&domain.Ad{}).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return errors.New("ad not found")
+				}
+				logger.DBLogger.Error("Error locking ad", zap.String("request_id", requestID), zap.Error(err))
+				return errors.New("error updating booking status")
+			}
+			var count int64
+			if err := tx.Model(&domain.Booking{}).
+				Where("\"adId\" = ? AND status = ? AND id <> ?", booking.AdID, domain.BookingStatusApproved, booking.ID).
+				Where("\"dateFrom\" < ? AND \"dateTo\" > ?", booking.DateTo, booking.DateFrom).
+				Count(&count).Error; err != nil {
+				logger.DBLogger.Error("Error checking booking overlap", zap.String("request_id", requestID), zap.Error(err))
+				return errors.New("error checking booking overlap")
+			}
+			if count > 0 {
+				return errors.New("dates already booked")
+			}
+		}
+
+		// статус могли поменять параллельно, например гость отменил заявку, пока хозяин её одобрял
+		result := tx.Model(&domain.Booking{}).Where("id = ? AND status = ?", booking.ID, fromStatus).Updates(map[string]interface{}{
+			"status":     booking.Status,
+			"updateDate": booking.UpdateDate,
+			"closeDate":  booking.CloseDate,
+		})
+		if result.Error != nil {
+			logger.DBLogger.Error("Error updating booking status", zap.String("request_id", requestID), zap.Error(result.Error))
+			return errors.New("error updating booking status")
+		}
+		if result.RowsAffected == 0 {
+			logger.DBLogger.Warn("Booking status changed concurrently", zap.String("request_id", requestID), zap.Int("bookingId", booking.ID))
+			return errors.New("booking status changed")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.DBLogger.Info("Successfully updated booking status", zap.String("request_id", requestID), zap.Int("bookingId", booking.ID))
+	return nil
+}
+
+func (r *adRepository) IsAdAvailable(ctx context.Context, adId string, dateFrom time.Time, dateTo time.Time) (bool, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("IsAdAvailable called", zap.String("ad", adId), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("IsAdAvailable", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("IsAdAvailable", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("IsAdAvailable").Observe(duration)
+	}()
+
+	var count int64
+	query := r.db.Model(&domain.Ad{}).Where("ads.uuid = ?", adId)
+	if err = whereAvailable(query, dateFrom, dateTo).Count(&count).Error; err != nil {
+		logger.DBLogger.Error("Error checking ad availability", zap.String("request_id", requestID), zap.Error(err))
+		return false, errors.New("error checking ad availability")
+	}
+
+	return count > 0, nil
+}
+
+func (r *adRepository) GetPlacesInBounds(ctx context.Context, bounds domain.GeoBounds, limit int) ([]domain.GetAllAdsResponse, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("GetPlacesInBounds called", zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("GetPlacesInBounds", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("GetPlacesInBounds", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("GetPlacesInBounds").Observe(duration)
+	}()
+
+	var ads []domain.GetAllAdsResponse
+	query := r.db.Model(&domain.Ad{}).
+		Joins("JOIN cities ON ads.\"cityId\" = cities.id").
+		Joins("JOIN ad_positions ON ad_positions.\"adId\" = ads.uuid").
+		Select("ads.*, cities.title as \"CityName\", "+adPositionSelect).
+		Where("ad_positions.latitude BETWEEN ? AND ?", bounds.MinLatitude, bounds.MaxLatitude).
+		Where(adVisible)
+
+	// Область может пересекать 180-й меридиан
+	if bounds.MinLongitude <= bounds.MaxLongitude {
+		query = query.Where("ad_positions.longitude BETWEEN ? AND ?", bounds.MinLongitude, bounds.MaxLongitude)
+	} else {
+		query = query.Where("(ad_positions.longitude >= ? OR ad_positions.longitude <= ?)", bounds.MinLongitude, bounds.MaxLongitude)
+	}
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err = query.Order("priority DESC").Find(&ads).Error; err != nil {
+		logger.DBLogger.Error("Error fetching places in bounds", zap.String("request_id", requestID), zap.Error(err))
+		return nil, errors.New("error fetching places in bounds")
+	}
+
+	if err = r.loadAdsImages(requestID, ads); err != nil {
+		return nil, err
+	}
+
+	logger.DBLogger.Info("Successfully fetched places in bounds", zap.String("request_id", requestID), zap.Int("count", len(ads)))
+	return ads, nil
+}
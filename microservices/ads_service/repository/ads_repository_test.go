@@ -7,6 +7,7 @@ import (
 	ntype "2024_2_FIGHT-CLUB/internal/service/type"
 	"context"
 	"errors"
+	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -58,11 +59,7 @@ func TestGetAllPlaces(t *testing.T) {
 	fixedDate := time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
 
 	query := `
-		SELECT ads.*, cities.title as "CityName", ad_available_dates."availableDateFrom" as "AdDateFrom", ad_available_dates."availableDateTo" as "AdDateTo"
-		FROM "ads"
-		JOIN cities ON ads."cityId" = cities.id
-		JOIN users ON ads."authorUUID" = users.uuid
-		JOIN ad_available_dates ON ad_available_dates."adId" = ads.uuid
+		SELECT ads.*, cities.title as "CityName", (SELECT MIN(ad_available_dates."availableDateFrom") FROM ad_available_dates WHERE ad_available_dates."adId" = ads.uuid) as "AdDateFrom", (SELECT MAX(ad_available_dates."availableDateTo") FROM ad_available_dates WHERE ad_available_dates."adId" = ads.uuid) as "AdDateTo", ad_positions.latitude as "Latitude", ad_positions.longitude as "Longitude" FROM "ads"
 	`
 
 	adRows := sqlmock.NewRows([]string{
@@ -75,19 +72,19 @@ func TestGetAllPlaces(t *testing.T) {
 	favoritesRows := sqlmock.NewRows([]string{"adId"}).AddRow("id1").AddRow("id2")
 	mock.ExpectQuery(regexp.QuoteMeta(favoritesQuery)).WillReturnRows(favoritesRows)
 
-	imagesQuery := `SELECT * FROM "images" WHERE "adId" = $1`
+	imagesQuery := `SELECT * FROM "images" WHERE "adId" IN ($1)`
 	imageRows := sqlmock.NewRows([]string{"id", "adId", "imageUrl"}).
 		AddRow(1, "some-uuid", "images/image1.jpg").
 		AddRow(2, "some-uuid", "images/image2.jpg")
 	mock.ExpectQuery(regexp.QuoteMeta(imagesQuery)).WithArgs("some-uuid").WillReturnRows(imageRows)
 
-	userQuery := `SELECT * FROM "users" WHERE uuid = $1`
+	userQuery := `SELECT * FROM "users" WHERE uuid IN ($1)`
 	userRows := sqlmock.NewRows([]string{
 		"uuid", "username", "password", "email", "name", "score", "avatar", "sex", "guestCount", "birthdate",
 	}).AddRow("author-uuid", "test_username", "some_password", "test@example.com", "Test User", 4.5, "avatar_url", "M", 2, fixedDate)
 	mock.ExpectQuery(regexp.QuoteMeta(userQuery)).WithArgs("author-uuid").WillReturnRows(userRows)
 
-	adQuery := `SELECT * FROM "ad_rooms" WHERE "adId" = $1`
+	adQuery := `SELECT * FROM "ad_rooms" WHERE "adId" IN ($1)`
 	addRows := sqlmock.NewRows([]string{"id", "adId", "type", "squaremeters"}).AddRow(1, "id1", "some-type", 12)
 	mock.ExpectQuery(regexp.QuoteMeta(adQuery)).WithArgs("some-uuid").WillReturnRows(addRows)
 
@@ -143,11 +140,7 @@ func TestGetAllPlaces_Failure(t *testing.T) {
 	repo := NewAdRepository(db)
 	filter := domain.AdFilter{}
 	query := `
-		SELECT ads.*, cities.title as "CityName", ad_available_dates."availableDateFrom" as "AdDateFrom", ad_available_dates."availableDateTo" as "AdDateTo"
-		FROM "ads"
-		JOIN cities ON ads."cityId" = cities.id
-		JOIN users ON ads."authorUUID" = users.uuid
-		JOIN ad_available_dates ON ad_available_dates."adId" = ads.uuid
+		SELECT ads.*, cities.title as "CityName", (SELECT MIN(ad_available_dates."availableDateFrom") FROM ad_available_dates WHERE ad_available_dates."adId" = ads.uuid) as "AdDateFrom", (SELECT MAX(ad_available_dates."availableDateTo") FROM ad_available_dates WHERE ad_available_dates."adId" = ads.uuid) as "AdDateTo", ad_positions.latitude as "Latitude", ad_positions.longitude as "Longitude" FROM "ads"
 	`
 	mock.ExpectQuery(query).
 		WillReturnError(errors.New("db error"))
@@ -157,6 +150,96 @@ func TestGetAllPlaces_Failure(t *testing.T) {
 	assert.Nil(t, ads)
 }
 
+func TestGetAllPlaces_PriceFilter(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+
+	repo := NewAdRepository(db)
+	filter := domain.AdFilter{PriceMin: 1000, PriceMax: 5000, Sort: domain.AdSortPriceDesc}
+
+	query := `WHERE NOT ads.hidden AND ads.price >= $1 AND ads.price <= $2 ORDER BY ads.price DESC,priority DESC,ads."publicationDate" DESC,ads.uuid DESC`
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(1000, 5000).
+		WillReturnRows(sqlmock.NewRows([]string{"uuid", "price"}))
+
+	favoritesQuery := `SELECT "adId" FROM "favorites" WHERE "userId" = $1`
+	mock.ExpectQuery(regexp.QuoteMeta(favoritesQuery)).WillReturnRows(sqlmock.NewRows([]string{"adId"}))
+
+	ads, err := repo.GetAllPlaces(context.Background(), filter, "12345")
+	require.NoError(t, err)
+	assert.Empty(t, ads)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAllPlaces_WeightedRating(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+
+	repo := NewAdRepository(db)
+	filter := domain.AdFilter{Rating: "4.5", RatingMode: domain.RatingModeWeighted}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE NOT ads.hidden AND users."weightedScore" >= $1`)).
+		WithArgs(4.5).
+		WillReturnRows(sqlmock.NewRows([]string{"uuid"}))
+
+	favoritesQuery := `SELECT "adId" FROM "favorites" WHERE "userId" = $1`
+	mock.ExpectQuery(regexp.QuoteMeta(favoritesQuery)).WillReturnRows(sqlmock.NewRows([]string{"adId"}))
+
+	ads, err := repo.GetAllPlaces(context.Background(), filter, "12345")
+	require.NoError(t, err)
+	assert.Empty(t, ads)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	_, err = repo.GetAllPlaces(context.Background(), domain.AdFilter{Rating: "4.5", RatingMode: "best"}, "12345")
+	assert.EqualError(t, err, "invalid rating mode")
+}
+
+func TestGetAllPlaces_Cursor(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+
+	repo := NewAdRepository(db)
+	date := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	filter := domain.AdFilter{Limit: 11, Cursor: &domain.AdCursor{Priority: 2, PublicationDate: date, UUID: "ad-uuid"}}
+
+	query := `WHERE NOT ads.hidden AND (ads.priority, ads."publicationDate", ads.uuid) < ($1, $2, $3) ORDER BY priority DESC,ads."publicationDate" DESC,ads.uuid DESC LIMIT $4`
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(2, date, "ad-uuid", 11).
+		WillReturnRows(sqlmock.NewRows([]string{"uuid"}))
+
+	ads, err := repo.GetAllPlaces(context.Background(), filter, "")
+	require.NoError(t, err)
+	assert.Empty(t, ads)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGetPlaceById(t *testing.T) {
 	if err := logger.InitLoggers(); err != nil {
 		log.Fatalf("Failed to initialize loggers: %v", err)
@@ -182,7 +265,7 @@ func TestGetPlaceById(t *testing.T) {
 	// Step 2: Define Mock Database Expectations
 	fixedDate := time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
 
-	query := "SELECT ads.*, cities.title as \"CityName\", ad_available_dates.\"availableDateFrom\" as \"AdDateFrom\", ad_available_dates.\"availableDateTo\" as \"AdDateTo\" FROM \"ads\" JOIN users ON ads.\"authorUUID\" = users.uuid JOIN cities ON ads.\"cityId\" = cities.id JOIN ad_available_dates ON ad_available_dates.\"adId\" = ads.uuid WHERE \"adId\" = $1"
+	query := "SELECT ads.*, cities.title as \"CityName\", (SELECT MIN(ad_available_dates.\"availableDateFrom\") FROM ad_available_dates WHERE ad_available_dates.\"adId\" = ads.uuid) as \"AdDateFrom\", (SELECT MAX(ad_available_dates.\"availableDateTo\") FROM ad_available_dates WHERE ad_available_dates.\"adId\" = ads.uuid) as \"AdDateTo\", ad_positions.latitude as \"Latitude\", ad_positions.longitude as \"Longitude\" FROM \"ads\" JOIN users ON ads.\"authorUUID\" = users.uuid JOIN cities ON ads.\"cityId\" = cities.id LEFT JOIN ad_positions ON ad_positions.\"adId\" = ads.uuid WHERE ads.uuid = $1"
 
 	adRows := sqlmock.NewRows([]string{
 		"uuid", "cityId", "authorUUID", "address", "publicationDate", "description", "roomsNumber", "viewsCount",
@@ -206,6 +289,18 @@ func TestGetPlaceById(t *testing.T) {
 	addRows := sqlmock.NewRows([]string{"id", "adId", "type", "squaremeters"}).AddRow(1, "id1", "some-type", 12)
 	mock.ExpectQuery(regexp.QuoteMeta(adQuery)).WithArgs("some-uuid").WillReturnRows(addRows)
 
+	datesQuery := `SELECT * FROM "ad_available_dates" WHERE "adId" = $1 ORDER BY "availableDateFrom" ASC`
+	dateFrom := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	dateTo := time.Date(2024, time.June, 10, 0, 0, 0, 0, time.UTC)
+	dateRows := sqlmock.NewRows([]string{"id", "adId", "availableDateFrom", "availableDateTo"}).
+		AddRow(1, "some-uuid", dateFrom, dateTo).
+		AddRow(2, "some-uuid", dateFrom.AddDate(0, 1, 0), dateTo.AddDate(0, 1, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(datesQuery)).WithArgs("some-uuid").WillReturnRows(dateRows)
+
+	blockedQuery := `SELECT * FROM "ad_blocked_dates" WHERE "adId" = $1 ORDER BY "blockedDate" ASC`
+	blockedRows := sqlmock.NewRows([]string{"id", "adId", "blockedDate"}).AddRow(1, "some-uuid", dateFrom.AddDate(0, 0, 3))
+	mock.ExpectQuery(regexp.QuoteMeta(blockedQuery)).WithArgs("some-uuid").WillReturnRows(blockedRows)
+
 	ad, err := repo.GetPlaceById(context.Background(), "some-uuid")
 
 	require.NoError(t, err)
@@ -233,6 +328,13 @@ func TestGetPlaceById(t *testing.T) {
 		Birthdate:  fixedDate,
 	}, ad.AdAuthor)
 
+	require.NotNil(t, ad.Calendar)
+	assert.Equal(t, []domain.AvailableDateRange{
+		{DateFrom: dateFrom, DateTo: dateTo},
+		{DateFrom: dateFrom.AddDate(0, 1, 0), DateTo: dateTo.AddDate(0, 1, 0)},
+	}, ad.Calendar.AvailableDates)
+	assert.Equal(t, []time.Time{dateFrom.AddDate(0, 0, 3)}, ad.Calendar.BlockedDates)
+
 	err = mock.ExpectationsWereMet()
 	require.NoError(t, err)
 }
@@ -252,7 +354,7 @@ func TestGetPlaceById_Failure(t *testing.T) {
 
 	repo := NewAdRepository(db)
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT ads.*, cities.title as \"CityName\", ad_available_dates.\"availableDateFrom\" as \"AdDateFrom\", ad_available_dates.\"availableDateTo\" as \"AdDateTo\" FROM \"ads\" JOIN users ON ads.\"authorUUID\" = users.uuid JOIN cities ON ads.\"cityId\" = cities.id JOIN ad_available_dates ON ad_available_dates.\"adId\" = ads.uuid WHERE \"adId\" = $1")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT ads.*, cities.title as \"CityName\", (SELECT MIN(ad_available_dates.\"availableDateFrom\") FROM ad_available_dates WHERE ad_available_dates.\"adId\" = ads.uuid) as \"AdDateFrom\", (SELECT MAX(ad_available_dates.\"availableDateTo\") FROM ad_available_dates WHERE ad_available_dates.\"adId\" = ads.uuid) as \"AdDateTo\", ad_positions.latitude as \"Latitude\", ad_positions.longitude as \"Longitude\" FROM \"ads\" JOIN users ON ads.\"authorUUID\" = users.uuid JOIN cities ON ads.\"cityId\" = cities.id LEFT JOIN ad_positions ON ad_positions.\"adId\" = ads.uuid WHERE ads.uuid = $1")).
 		WithArgs("ad1").
 		WillReturnError(errors.New("db error"))
 
@@ -480,25 +582,24 @@ func TestUpdatePlace(t *testing.T) {
 		HasBalcony:  true,
 		HasGas:      true,
 		HasElevator: true,
+		Price:       5000,
+		CleaningFee: 500,
 	}
+	updatedRequest.AvailableDates = []domain.AvailableDateRange{{DateFrom: updatedRequest.DateFrom, DateTo: updatedRequest.DateTo}}
+	updatedRequest.ReplaceCalendar = true
 
 	adRows := sqlmock.NewRows([]string{"uuid", "authorUUID", "cityId", "address", "roomsNumber"}).
 		AddRow("existing-ad-id", "author-id", 1, "Old Address", 2)
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ads" WHERE uuid = $1 ORDER BY "ads"."uuid" LIMIT $2`)).
 		WithArgs(adId, 1).WillReturnRows(adRows)
 
-	dateRows := sqlmock.NewRows([]string{"id", "adId", "availableDateFrom", "availableDateTo"}).
-		AddRow(1, "existing-ad-id", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC))
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ad_available_dates" WHERE "adId" = $1 ORDER BY "ad_available_dates"."id" LIMIT $2`)).
-		WithArgs(adId, 1).WillReturnRows(dateRows)
-
 	cityRows := sqlmock.NewRows([]string{"id", "title", "enTitle"}).AddRow(1, "Город", "City").AddRow(2, "Новый Город", "New city")
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "cities" WHERE title = $1 ORDER BY "cities"."id" LIMIT $2`)).
 		WithArgs("Новый город", 1).WillReturnRows(cityRows)
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "ads" SET "cityId"=$1,"address"=$2,"description"=$3,"roomsNumber"=$4,"hasBalcony"=$5,"hasElevator"=$6,"hasGas"=$7 WHERE "uuid" = $8`)).
-		WithArgs(1, "New Address", "Updated Description", 3, true, true, true, adId).
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "ads" SET "cityId"=$1,"address"=$2,"description"=$3,"roomsNumber"=$4,"hasBalcony"=$5,"hasElevator"=$6,"hasGas"=$7,"price"=$8,"cleaningFee"=$9 WHERE "uuid" = $10`)).
+		WithArgs(1, "New Address", "Updated Description", 3, true, true, true, 5000, 500, adId).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -521,9 +622,21 @@ func TestUpdatePlace(t *testing.T) {
 	mock.ExpectCommit()
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "ad_available_dates" SET "id"=$1,"adId"=$2,"availableDateFrom"=$3,"availableDateTo"=$4 WHERE "id" = $5`)).
-		WithArgs(1, adId, updatedRequest.DateFrom, updatedRequest.DateTo, 1).
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "ads" SET "cleaningFee"=$1,"weekendPrice"=$2 WHERE "uuid" = $3`)).
+		WithArgs(500, 0, adId).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "ad_available_dates" WHERE "adId" = $1`)).
+		WithArgs(adId).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "ad_blocked_dates" WHERE "adId" = $1`)).
+		WithArgs(adId).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "ad_available_dates" ("adId","availableDateFrom","availableDateTo") VALUES ($1,$2,$3) RETURNING "id"`)).
+		WithArgs(adId, updatedRequest.DateFrom, updatedRequest.DateTo).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
 	mock.ExpectCommit()
 
 	mock.ExpectBegin()
@@ -539,10 +652,22 @@ func TestUpdatePlace(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestUpdatePlace_AdNotFound(t *testing.T) {
-	if err := logger.InitLoggers(); err != nil {
-		log.Fatalf("Failed to initialize loggers: %v", err)
+func expectPlaceFieldsUpdated(mock sqlmock.Sqlmock, adId string) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ads" WHERE uuid = $1 ORDER BY "ads"."uuid" LIMIT $2`)).
+		WithArgs(adId, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"uuid", "authorUUID", "cityId"}).AddRow(adId, "author-id", 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "cities" WHERE title = $1 ORDER BY "cities"."id" LIMIT $2`)).
+		WithArgs("Город", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title"}).AddRow(1, "Город"))
+	for i := 0; i < 5; i++ {
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE "ads" SET`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
 	}
+}
+
+func TestUpdatePlace_Calendar(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
 	defer func() {
 		err := logger.SyncLoggers()
 		if err != nil {
@@ -550,32 +675,99 @@ func TestUpdatePlace_AdNotFound(t *testing.T) {
 		}
 	}()
 
-	db, mock, err := setupDBMock()
-	assert.Nil(t, err)
-
-	repo := NewAdRepository(db)
-
-	adId := "non-existing-ad-id"
-	userId := "author-id"
-	updatedRequest := domain.UpdateAdRequest{
-		CityName:    "New City",
-		Address:     "New Address",
-		Description: "Updated Description",
-		RoomsNumber: 3,
+	adId := "existing-ad-id"
+	firstFrom := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	secondFrom := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	blocked := []time.Time{firstFrom.AddDate(0, 0, 5), firstFrom.AddDate(0, 0, 6)}
+	deleteCalendar := func(mock sqlmock.Sqlmock) {
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "ad_available_dates" WHERE "adId" = $1`)).
+			WithArgs(adId).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "ad_blocked_dates" WHERE "adId" = $1`)).
+			WithArgs(adId).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	tests := map[string]struct {
+		request     domain.UpdateAdRequest
+		expect      func(mock sqlmock.Sqlmock)
+		expectedErr string
+	}{
+		"Calendar Not Sent": {
+			request: domain.UpdateAdRequest{CityName: "Город"},
+			expect:  func(mock sqlmock.Sqlmock) {},
+		},
+		"Calendar Cleared": {
+			request: domain.UpdateAdRequest{CityName: "Город", ReplaceCalendar: true},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				deleteCalendar(mock)
+				mock.ExpectCommit()
+			},
+		},
+		"Calendar Replaced In One Insert Per Table": {
+			request: domain.UpdateAdRequest{
+				CityName: "Город",
+				AvailableDates: []domain.AvailableDateRange{
+					{DateFrom: firstFrom, DateTo: firstFrom.AddDate(0, 0, 10)},
+					{DateFrom: secondFrom, DateTo: secondFrom.AddDate(0, 0, 10)},
+				},
+				BlockedDates:    blocked,
+				ReplaceCalendar: true,
+			},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				deleteCalendar(mock)
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "ad_available_dates" ("adId","availableDateFrom","availableDateTo") VALUES ($1,$2,$3),($4,$5,$6) RETURNING "id"`)).
+					WithArgs(adId, firstFrom, firstFrom.AddDate(0, 0, 10), adId, secondFrom, secondFrom.AddDate(0, 0, 10)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "ad_blocked_dates" ("adId","blockedDate") VALUES ($1,$2),($3,$4) RETURNING "id"`)).
+					WithArgs(adId, blocked[0], adId, blocked[1]).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+				mock.ExpectCommit()
+			},
+		},
+		"Insert Error Rolls Back": {
+			request: domain.UpdateAdRequest{
+				CityName:        "Город",
+				AvailableDates:  []domain.AvailableDateRange{{DateFrom: firstFrom, DateTo: firstFrom.AddDate(0, 0, 10)}},
+				ReplaceCalendar: true,
+			},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				deleteCalendar(mock)
+				mock.ExpectQuery(`INSERT INTO "ad_available_dates"`).WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			expectedErr: "error updating date",
+		},
 	}
 
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ads" WHERE uuid = $1 ORDER BY "ads"."uuid" LIMIT $2`)).
-		WithArgs(adId, 1).WillReturnRows(sqlmock.NewRows([]string{"uuid", "authorUUID", "cityId", "address", "roomsNumber"}))
-
-	err = repo.UpdatePlace(context.Background(), &domain.Ad{}, adId, userId, updatedRequest)
-
-	assert.Error(t, err)
-	assert.Equal(t, errors.New("ad not found"), err)
-	err = mock.ExpectationsWereMet()
-	assert.NoError(t, err)
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := setupDBMock()
+			require.NoError(t, err)
+			repo := NewAdRepository(db)
+
+			expectPlaceFieldsUpdated(mock, adId)
+			tt.expect(mock)
+			if tt.expectedErr == "" {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "ad_rooms" WHERE "adId" = $1`)).
+					WithArgs(adId).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			}
+
+			err = repo.UpdatePlace(context.Background(), &domain.Ad{}, adId, "author-id", tt.request)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
 }
 
-func TestUpdatePlace_AdDateNotFound(t *testing.T) {
+func TestUpdatePlace_AdNotFound(t *testing.T) {
 	if err := logger.InitLoggers(); err != nil {
 		log.Fatalf("Failed to initialize loggers: %v", err)
 	}
@@ -591,8 +783,8 @@ func TestUpdatePlace_AdDateNotFound(t *testing.T) {
 
 	repo := NewAdRepository(db)
 
-	adId := "existing-ad-id"
-	userId := "unauthorized-user-id"
+	adId := "non-existing-ad-id"
+	userId := "author-id"
 	updatedRequest := domain.UpdateAdRequest{
 		CityName:    "New City",
 		Address:     "New Address",
@@ -600,15 +792,13 @@ func TestUpdatePlace_AdDateNotFound(t *testing.T) {
 		RoomsNumber: 3,
 	}
 
-	adRows := sqlmock.NewRows([]string{"uuid", "authorUUID", "cityId", "address", "roomsNumber"}).
-		AddRow("existing-ad-id", "different-author-id", 1, "Old Address", 2)
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ads" WHERE uuid = $1 ORDER BY "ads"."uuid" LIMIT $2`)).
-		WithArgs(adId, 1).WillReturnRows(adRows)
+		WithArgs(adId, 1).WillReturnRows(sqlmock.NewRows([]string{"uuid", "authorUUID", "cityId", "address", "roomsNumber"}))
 
 	err = repo.UpdatePlace(context.Background(), &domain.Ad{}, adId, userId, updatedRequest)
 
 	assert.Error(t, err)
-	assert.Equal(t, errors.New("ad date not found"), err)
+	assert.Equal(t, errors.New("ad not found"), err)
 	err = mock.ExpectationsWereMet()
 	assert.NoError(t, err)
 }
@@ -643,11 +833,6 @@ func TestUpdatePlace_UserNotAuthorized(t *testing.T) {
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ads" WHERE uuid = $1 ORDER BY "ads"."uuid" LIMIT $2`)).
 		WithArgs(adId, 1).WillReturnRows(adRows)
 
-	dateRows := sqlmock.NewRows([]string{"id", "adId", "availableDateFrom", "availableDateTo"}).
-		AddRow(1, "existing-ad-id", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ad_available_dates" WHERE "adId" = $1 ORDER BY "ad_available_dates"."id" LIMIT $2`)).
-		WithArgs(adId, 1).WillReturnRows(dateRows)
-
 	err = repo.UpdatePlace(context.Background(), &domain.Ad{}, adId, userId, updatedRequest)
 	assert.Error(t, err)
 	assert.Equal(t, errors.New("not owner of ad"), err)
@@ -737,7 +922,12 @@ func TestCreatePlace(t *testing.T) {
 		HasElevator:  true,
 		HasGas:       true,
 		HasBalcony:   true,
+		Price:        4000,
+		WeekendPrice: 5500,
+		CleaningFee:  700,
 	}
+	newAd.AvailableDates = []domain.AvailableDateRange{{DateFrom: newAd.DateFrom, DateTo: newAd.DateTo}}
+	newAd.BlockedDates = []time.Time{newAd.DateFrom.AddDate(0, 0, 2)}
 
 	user := domain.User{
 		UUID:   "user-uuid",
@@ -774,7 +964,7 @@ func TestCreatePlace(t *testing.T) {
 			AddRow(city.ID, city.Title))
 
 	mock.ExpectBegin()
-	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "ads" ("cityId","authorUUID","address","publicationDate","description","roomsNumber","viewsCount","squareMeters","floor","buildingType","hasBalcony","hasElevator","hasGas","likesCount","priority","endBoostDate","uuid") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17) RETURNING "uuid"`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "ads" ("cityId","authorUUID","address","publicationDate","description","roomsNumber","viewsCount","squareMeters","floor","buildingType","hasBalcony","hasElevator","hasGas","likesCount","reviewsRating","reviewsCount","priority","endBoostDate","price","weekendPrice","cleaningFee","uuid") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22) RETURNING "uuid"`)).
 		WithArgs(
 			city.ID,          // cityId
 			user.UUID,        // authorUUID
@@ -790,8 +980,13 @@ func TestCreatePlace(t *testing.T) {
 			ad.HasElevator,
 			ad.HasGas,
 			ad.LikesCount,
+			0.0, // reviewsRating
+			0,   // reviewsCount
 			ad.Priority,
 			sqlmock.AnyArg(),
+			newAd.Price,
+			newAd.WeekendPrice,
+			newAd.CleaningFee,
 			ad.UUID, // uuid
 		).
 		WillReturnRows(sqlmock.NewRows([]string{"uuid"}).AddRow(ad.UUID))
@@ -808,6 +1003,12 @@ func TestCreatePlace(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(date.ID))
 	mock.ExpectCommit()
 
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "ad_blocked_dates" ("adId","blockedDate") VALUES ($1,$2) RETURNING "id"`)).
+		WithArgs(date.AdID, newAd.BlockedDates[0]).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
 	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "ad_rooms" ("adId","type","squareMeters") VALUES ($1,$2,$3) RETURNING "id"`)).
 		WithArgs(
@@ -963,20 +1164,20 @@ func TestGetPlacesPerCity_Success(t *testing.T) {
 			time.Now(), "A lovely place", 3, "avatar.png", "John Doe", 4.5, "New York")
 
 	// Mock select ads
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT ads.*, cities.title as \"CityName\" FROM \"ads\" JOIN users ON ads.\"authorUUID\" = users.uuid JOIN cities ON ads.\"cityId\" = cities.id WHERE cities.\"enTitle\" = $1")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT ads.*, cities.title as \"CityName\", ad_positions.latitude as \"Latitude\", ad_positions.longitude as \"Longitude\" FROM \"ads\" JOIN users ON ads.\"authorUUID\" = users.uuid JOIN cities ON ads.\"cityId\" = cities.id LEFT JOIN ad_positions ON ad_positions.\"adId\" = ads.uuid WHERE cities.\"enTitle\" = $1")).
 		WithArgs(city).
 		WillReturnRows(rows)
 
-	imagesQuery := "SELECT * FROM \"images\" WHERE \"adId\" = $1"
+	imagesQuery := "SELECT * FROM \"images\" WHERE \"adId\" IN ($1)"
 	imageRows := sqlmock.NewRows(ntype.StringArray{"imageUrl"}).AddRow("images/image1.jpg").AddRow("images/image2.jpg")
 	mock.ExpectQuery(regexp.QuoteMeta(imagesQuery)).WithArgs("ad-uuid-123").WillReturnRows(imageRows)
 
-	query2 := "SELECT * FROM \"users\" WHERE uuid = $1"
+	query2 := "SELECT * FROM \"users\" WHERE uuid IN ($1)"
 	rows2 := sqlmock.NewRows([]string{"uuid", "username", "password", "email", "username"}).
 		AddRow("some-uuid", "test_username", "some_password", "test@example.com", "test_username")
 	mock.ExpectQuery(regexp.QuoteMeta(query2)).WillReturnRows(rows2)
 
-	adQuery := "SELECT * FROM \"ad_rooms\" WHERE \"adId\" = $1"
+	adQuery := "SELECT * FROM \"ad_rooms\" WHERE \"adId\" IN ($1)"
 	addRows := sqlmock.NewRows([]string{"id", "adId", "type", "squaremeters"}).AddRow(1, "id1", "some-type", 12)
 	mock.ExpectQuery(regexp.QuoteMeta(adQuery)).WithArgs("ad-uuid-123").WillReturnRows(addRows)
 
@@ -1015,7 +1216,7 @@ func TestGetPlacesPerCity_Failure(t *testing.T) {
 	city := "Unknown City"
 
 	// Mock select ads with error
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT ads.*, cities.title as \"CityName\" FROM \"ads\" JOIN users ON ads.\"authorUUID\" = users.uuid JOIN cities ON ads.\"cityId\" = cities.id WHERE cities.\"enTitle\" = $1")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT ads.*, cities.title as \"CityName\", ad_positions.latitude as \"Latitude\", ad_positions.longitude as \"Longitude\" FROM \"ads\" JOIN users ON ads.\"authorUUID\" = users.uuid JOIN cities ON ads.\"cityId\" = cities.id LEFT JOIN ad_positions ON ad_positions.\"adId\" = ads.uuid WHERE cities.\"enTitle\" = $1")).
 		WithArgs(city).
 		WillReturnError(gorm.ErrInvalidData)
 
@@ -1227,7 +1428,7 @@ func TestGetUserPlaces_Success(t *testing.T) {
 			time.Now(), "A lovely place", 3, "avatar.png", "John Doe", 4.5, "New York")
 
 	// Mock select ads
-	mock.ExpectQuery(`SELECT ads\.\*, users\.avatar, users\.name, users\.score as rating, cities\.title as "CityName" FROM "ads" JOIN users ON ads\."authorUUID" = users\.uuid JOIN cities ON ads\."cityId" = cities\.id WHERE users\.uuid = \$1`).
+	mock.ExpectQuery(`SELECT ads\.\*, users\.avatar, users\.name, users\.score as rating, cities\.title as "CityName", ad_positions\.latitude as "Latitude", ad_positions\.longitude as "Longitude" FROM "ads" JOIN users ON ads\."authorUUID" = users\.uuid JOIN cities ON ads\."cityId" = cities\.id LEFT JOIN ad_positions ON ad_positions\."adId" = ads\.uuid WHERE users\.uuid = \$1`).
 		WithArgs(userId).
 		WillReturnRows(rows)
 
@@ -1236,11 +1437,11 @@ func TestGetUserPlaces_Success(t *testing.T) {
 		AddRow("img-uuid-1", "ad-uuid-123", "img1.png").
 		AddRow("img-uuid-2", "ad-uuid-123", "img2.png")
 
-	mock.ExpectQuery(`SELECT \* FROM "images" WHERE "adId" = \$1`).
+	mock.ExpectQuery(`SELECT \* FROM "images" WHERE "adId" IN \(\$1\)`).
 		WithArgs("ad-uuid-123").
 		WillReturnRows(imageRows)
 
-	adQuery := "SELECT * FROM \"ad_rooms\" WHERE \"adId\" = $1"
+	adQuery := "SELECT * FROM \"ad_rooms\" WHERE \"adId\" IN ($1)"
 	addRows := sqlmock.NewRows([]string{"id", "adId", "type", "squaremeters"}).AddRow(1, "id1", "some-type", 12)
 	mock.ExpectQuery(regexp.QuoteMeta(adQuery)).WithArgs("ad-uuid-123").WillReturnRows(addRows)
 
@@ -1291,12 +1492,12 @@ func TestGetUserPlaces_Failure(t *testing.T) {
 			time.Now(), "A lovely place", 3, "avatar.png", "John Doe", 4.5, "New York")
 
 	// Mock select ads
-	mock.ExpectQuery(`SELECT ads\.\*, users\.avatar, users\.name, users\.score as rating, cities\.title as "CityName" FROM "ads" JOIN users ON ads\."authorUUID" = users\.uuid JOIN cities ON ads\."cityId" = cities\.id WHERE users\.uuid = \$1`).
+	mock.ExpectQuery(`SELECT ads\.\*, users\.avatar, users\.name, users\.score as rating, cities\.title as "CityName", ad_positions\.latitude as "Latitude", ad_positions\.longitude as "Longitude" FROM "ads" JOIN users ON ads\."authorUUID" = users\.uuid JOIN cities ON ads\."cityId" = cities\.id LEFT JOIN ad_positions ON ad_positions\."adId" = ads\.uuid WHERE users\.uuid = \$1`).
 		WithArgs(userId).
 		WillReturnRows(rows)
 
 	// Mock select images with error
-	mock.ExpectQuery(`SELECT \* FROM "images" WHERE "adId" = \$1`).
+	mock.ExpectQuery(`SELECT \* FROM "images" WHERE "adId" IN \(\$1\)`).
 		WithArgs("ad-uuid-123").
 		WillReturnError(gorm.ErrInvalidData)
 
@@ -1545,11 +1746,11 @@ func TestGetUserFavorites(t *testing.T) {
 
 	// Основной запрос на выборку избранных объявлений
 	query := `
-		SELECT ads.*, favorites."userId" AS "FavoriteUserId", cities.title as "CityName", ad_available_dates."availableDateFrom" as "AdDateFrom", ad_available_dates."availableDateTo" as "AdDateTo"
+		SELECT ads.*, favorites."userId" AS "FavoriteUserId", cities.title as "CityName", (SELECT MIN(ad_available_dates."availableDateFrom") FROM ad_available_dates WHERE ad_available_dates."adId" = ads.uuid) as "AdDateFrom", (SELECT MAX(ad_available_dates."availableDateTo") FROM ad_available_dates WHERE ad_available_dates."adId" = ads.uuid) as "AdDateTo", ad_positions.latitude as "Latitude", ad_positions.longitude as "Longitude"
 		FROM "ads"
 		JOIN favorites ON favorites."adId" = ads.uuid
 		JOIN cities ON ads."cityId" = cities.id
-		JOIN ad_available_dates ON ad_available_dates."adId" = ads.uuid
+		LEFT JOIN ad_positions ON ad_positions."adId" = ads.uuid
 		WHERE favorites."userId" = $1
 	`
 
@@ -1565,7 +1766,7 @@ func TestGetUserFavorites(t *testing.T) {
 		WillReturnRows(adRows)
 
 	// Запрос на получение картинок
-	imagesQuery := `SELECT * FROM "images" WHERE "adId" = $1`
+	imagesQuery := `SELECT * FROM "images" WHERE "adId" IN ($1)`
 	imageRows := sqlmock.NewRows([]string{"id", "adId", "imageUrl"}).
 		AddRow(1, "ad-uuid-123", "images/image1.jpg").
 		AddRow(2, "ad-uuid-123", "images/image2.jpg")
@@ -1574,7 +1775,7 @@ func TestGetUserFavorites(t *testing.T) {
 		WillReturnRows(imageRows)
 
 	// Запрос на получение данных пользователя
-	userQuery := `SELECT * FROM "users" WHERE uuid = $1`
+	userQuery := `SELECT * FROM "users" WHERE uuid IN ($1)`
 	userRows := sqlmock.NewRows([]string{
 		"uuid", "name", "avatar", "score", "sex", "guestCount", "birthdate",
 	}).
@@ -1584,7 +1785,7 @@ func TestGetUserFavorites(t *testing.T) {
 		WillReturnRows(userRows)
 
 	// Запрос на получение комнат
-	roomsQuery := `SELECT * FROM "ad_rooms" WHERE "adId" = $1`
+	roomsQuery := `SELECT * FROM "ad_rooms" WHERE "adId" IN ($1)`
 	roomRows := sqlmock.NewRows([]string{"id", "adId", "type", "squareMeters"}).
 		AddRow(1, "ad-uuid-123", "Bedroom", 25).
 		AddRow(2, "ad-uuid-123", "Living Room", 40)
@@ -1645,11 +1846,11 @@ func TestGetUserFavoritesFail(t *testing.T) {
 
 	repo := NewAdRepository(db)
 	query := `
-		SELECT ads.*, favorites."userId" AS "FavoriteUserId", cities.title as "CityName", ad_available_dates."availableDateFrom" as "AdDateFrom", ad_available_dates."availableDateTo" as "AdDateTo"
+		SELECT ads.*, favorites."userId" AS "FavoriteUserId", cities.title as "CityName", (SELECT MIN(ad_available_dates."availableDateFrom") FROM ad_available_dates WHERE ad_available_dates."adId" = ads.uuid) as "AdDateFrom", (SELECT MAX(ad_available_dates."availableDateTo") FROM ad_available_dates WHERE ad_available_dates."adId" = ads.uuid) as "AdDateTo", ad_positions.latitude as "Latitude", ad_positions.longitude as "Longitude"
 		FROM "ads"
 		JOIN favorites ON favorites."adId" = ads.uuid
 		JOIN cities ON ads."cityId" = cities.id
-		JOIN ad_available_dates ON ad_available_dates."adId" = ads.uuid
+		LEFT JOIN ad_positions ON ad_positions."adId" = ads.uuid
 		WHERE favorites."userId" = $1
 	`
 	mock.ExpectQuery(regexp.QuoteMeta(query)).
@@ -1894,3 +2095,396 @@ func TestDeleteAdImage(t *testing.T) {
 		assert.Equal(t, "error deleting image from database", err.Error())
 	})
 }
+
+func TestCreateBooking(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+
+	repo := NewAdRepository(db)
+	ctx := context.WithValue(context.Background(), middleware.RequestIDKey, "test-request-id")
+
+	booking := &domain.Booking{
+		AdID:     "ad-uuid",
+		UserID:   "guest-uuid",
+		Status:   domain.BookingStatusPending,
+		DateFrom: time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC),
+		DateTo:   time.Date(2030, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ads" WHERE uuid = $1 ORDER BY "ads"."uuid" LIMIT $2`)).
+		WithArgs("ad-uuid", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"uuid", "authorUUID"}).AddRow("ad-uuid", "host-uuid"))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "requests"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	err = repo.CreateBooking(ctx, booking)
+	require.NoError(t, err)
+	assert.Equal(t, 1, booking.ID)
+	assert.Equal(t, "host-uuid", booking.HostID)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Хозяин не может забронировать собственное объявление
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ads" WHERE uuid = $1 ORDER BY "ads"."uuid" LIMIT $2`)).
+		WithArgs("ad-uuid", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"uuid", "authorUUID"}).AddRow("ad-uuid", "guest-uuid"))
+
+	err = repo.CreateBooking(ctx, booking)
+	assert.EqualError(t, err, "host cannot book own ad")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHasApprovedBookingOverlap(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+
+	repo := NewAdRepository(db)
+	ctx := context.WithValue(context.Background(), middleware.RequestIDKey, "test-request-id")
+	dateFrom := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+	dateTo := time.Date(2030, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "requests" WHERE ("adId" = $1 AND status = $2 AND id <> $3) AND ("dateFrom" < $4 AND "dateTo" > $5)`)).
+		WithArgs("ad-uuid", domain.BookingStatusApproved, 3, dateTo, dateFrom).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	overlap, err := repo.HasApprovedBookingOverlap(ctx, "ad-uuid", dateFrom, dateTo, 3)
+	require.NoError(t, err)
+	assert.True(t, overlap)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateBookingStatus(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+
+	repo := NewAdRepository(db)
+	ctx := context.WithValue(context.Background(), middleware.RequestIDKey, "test-request-id")
+	closeDate := time.Now()
+	updateQuery := regexp.QuoteMeta(`UPDATE "requests" SET "closeDate"=$1,"status"=$2,"updateDate"=$3 WHERE id = $4 AND status = $5`)
+	lockQuery := regexp.QuoteMeta(`SELECT "uuid" FROM "ads" WHERE uuid = $1 ORDER BY "ads"."uuid" LIMIT $2 FOR UPDATE`)
+	overlapQuery := regexp.QuoteMeta(`SELECT count(*) FROM "requests" WHERE ("adId" = $1 AND status = $2 AND id <> $3) AND ("dateFrom" < $4 AND "dateTo" > $5)`)
+	dateFrom := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+	dateTo := time.Date(2030, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Decline", func(t *testing.T) {
+		booking := &domain.Booking{ID: 5, Status: domain.BookingStatusDeclined, CloseDate: &closeDate}
+		mock.ExpectBegin()
+		mock.ExpectExec(updateQuery).
+			WithArgs(sqlmock.AnyArg(), domain.BookingStatusDeclined, sqlmock.AnyArg(), 5, domain.BookingStatusPending).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.UpdateBookingStatus(ctx, booking, domain.BookingStatusPending)
+		require.NoError(t, err)
+	})
+
+	t.Run("Approve", func(t *testing.T) {
+		booking := &domain.Booking{ID: 5, AdID: "ad-uuid", Status: domain.BookingStatusApproved, DateFrom: dateFrom, DateTo: dateTo}
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockQuery).WithArgs("ad-uuid", 1).WillReturnRows(sqlmock.NewRows([]string{"uuid"}).AddRow("ad-uuid"))
+		mock.ExpectQuery(overlapQuery).
+			WithArgs("ad-uuid", domain.BookingStatusApproved, 5, dateTo, dateFrom).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec(updateQuery).
+			WithArgs(nil, domain.BookingStatusApproved, sqlmock.AnyArg(), 5, domain.BookingStatusPending).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.UpdateBookingStatus(ctx, booking, domain.BookingStatusPending)
+		require.NoError(t, err)
+	})
+
+	t.Run("Approve Overlapping", func(t *testing.T) {
+		booking := &domain.Booking{ID: 6, AdID: "ad-uuid", Status: domain.BookingStatusApproved, DateFrom: dateFrom, DateTo: dateTo}
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockQuery).WithArgs("ad-uuid", 1).WillReturnRows(sqlmock.NewRows([]string{"uuid"}).AddRow("ad-uuid"))
+		mock.ExpectQuery(overlapQuery).
+			WithArgs("ad-uuid", domain.BookingStatusApproved, 6, dateTo, dateFrom).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectRollback()
+
+		err := repo.UpdateBookingStatus(ctx, booking, domain.BookingStatusPending)
+		assert.EqualError(t, err, "dates already booked")
+	})
+
+	t.Run("Status Changed Concurrently", func(t *testing.T) {
+		booking := &domain.Booking{ID: 5, Status: domain.BookingStatusCancelled, CloseDate: &closeDate}
+		mock.ExpectBegin()
+		mock.ExpectExec(updateQuery).
+			WithArgs(sqlmock.AnyArg(), domain.BookingStatusCancelled, sqlmock.AnyArg(), 5, domain.BookingStatusApproved).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := repo.UpdateBookingStatus(ctx, booking, domain.BookingStatusApproved)
+		assert.EqualError(t, err, "booking status changed")
+	})
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIsAdAvailable(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+
+	repo := NewAdRepository(db)
+
+	dateFrom := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	dateTo := time.Date(2024, time.June, 5, 0, 0, 0, 0, time.UTC)
+
+	query := `SELECT count(*) FROM "ads" WHERE ads.uuid = $1 AND (EXISTS (SELECT 1 FROM ad_available_dates WHERE ad_available_dates."adId" = ads.uuid AND ad_available_dates."availableDateFrom" <= $2 AND ad_available_dates."availableDateTo" >= $3)) AND (NOT EXISTS (SELECT 1 FROM ad_blocked_dates WHERE ad_blocked_dates."adId" = ads.uuid AND ad_blocked_dates."blockedDate" >= $4 AND ad_blocked_dates."blockedDate" < $5)) AND (NOT EXISTS (SELECT 1 FROM requests WHERE requests."adId" = ads.uuid AND requests.status = $6 AND requests."dateFrom" < $7 AND requests."dateTo" > $8))`
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs("ad-1", dateFrom, dateTo, dateFrom, dateTo, domain.BookingStatusApproved, dateTo, dateFrom).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	available, err := repo.IsAdAvailable(context.Background(), "ad-1", dateFrom, dateTo)
+	require.NoError(t, err)
+	assert.True(t, available)
+
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs("ad-1", dateFrom, dateTo, dateFrom, dateTo, domain.BookingStatusApproved, dateTo, dateFrom).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	available, err = repo.IsAdAvailable(context.Background(), "ad-1", dateFrom, dateTo)
+	require.NoError(t, err)
+	assert.False(t, available)
+
+	mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(errors.New("db error"))
+	_, err = repo.IsAdAvailable(context.Background(), "ad-1", dateFrom, dateTo)
+	assert.EqualError(t, err, "error checking ad availability")
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAllPlaces_Search(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+
+	repo := NewAdRepository(db)
+	q := "квартира у метро"
+	filter := domain.AdFilter{Query: q}
+
+	query := `ts_rank((ads."searchVector" || setweight(to_tsvector('russian', coalesce(cities.title, '')) || to_tsvector('english', coalesce(cities."enTitle", '')), 'A')), (websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $2))) as "SearchRank"`
+	where := `WHERE NOT ads.hidden AND (ads."searchVector" || setweight(to_tsvector('russian', coalesce(cities.title, '')) || to_tsvector('english', coalesce(cities."enTitle", '')), 'A')) @@ (websearch_to_tsquery('russian', $7) || websearch_to_tsquery('english', $8)) ORDER BY "SearchRank" DESC,priority DESC`
+	mock.ExpectQuery(regexp.QuoteMeta(query)+".*"+regexp.QuoteMeta(where)).
+		WithArgs(q, q, q, q, q, q, q, q).
+		WillReturnRows(sqlmock.NewRows([]string{"uuid", "SearchRank", "DescriptionHighlight", "AddressHighlight"}).
+			AddRow("ad-1", 0.6, "Светлая <mark>квартира</mark>", "<mark>метро</mark> Динамо"))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "images" WHERE "adId" IN ($1)`)).
+		WithArgs("ad-1").WillReturnRows(sqlmock.NewRows([]string{"id", "adId", "imageUrl"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE uuid IN ($1)`)).
+		WithArgs("").WillReturnRows(sqlmock.NewRows([]string{"uuid"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ad_rooms" WHERE "adId" IN ($1)`)).
+		WithArgs("ad-1").WillReturnRows(sqlmock.NewRows([]string{"id", "adId"}))
+
+	ads, err := repo.GetAllPlaces(context.Background(), filter, "")
+	require.NoError(t, err)
+	require.Len(t, ads, 1)
+	require.NotNil(t, ads[0].SearchRank)
+	assert.Equal(t, 0.6, *ads[0].SearchRank)
+	assert.Equal(t, "Светлая <mark>квартира</mark>", ads[0].DescriptionHighlight)
+	assert.Equal(t, "<mark>метро</mark> Динамо", ads[0].AddressHighlight)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAllPlaces_NearFilter(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+
+	repo := NewAdRepository(db)
+	filter := domain.AdFilter{Near: &domain.GeoPoint{Latitude: 55.75, Longitude: 37.61}, RadiusKm: 5}
+
+	query := `as "Distance" FROM "ads" JOIN cities ON ads."cityId" = cities.id JOIN users ON ads."authorUUID" = users.uuid LEFT JOIN ad_positions ON ad_positions."adId" = ads.uuid WHERE NOT ads.hidden AND ad_positions.id IS NOT NULL AND 6371 * acos(`
+	distance := 1.25
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(55.75, 37.61, 55.75, 55.75, 37.61, 55.75, float64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"uuid", "Latitude", "Longitude", "Distance"}).AddRow("ad-1", 55.76, 37.62, distance))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "adId" FROM "favorites" WHERE "userId" = $1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"adId"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "images" WHERE "adId" IN ($1)`)).
+		WithArgs("ad-1").WillReturnRows(sqlmock.NewRows([]string{"id", "adId", "imageUrl"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE uuid IN ($1)`)).
+		WithArgs("").WillReturnRows(sqlmock.NewRows([]string{"uuid"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ad_rooms" WHERE "adId" IN ($1)`)).
+		WithArgs("ad-1").WillReturnRows(sqlmock.NewRows([]string{"id", "adId"}))
+
+	ads, err := repo.GetAllPlaces(context.Background(), filter, "12345")
+	require.NoError(t, err)
+	require.Len(t, ads, 1)
+	require.NotNil(t, ads[0].Distance)
+	assert.Equal(t, distance, *ads[0].Distance)
+	require.NotNil(t, ads[0].Latitude)
+	assert.Equal(t, 55.76, *ads[0].Latitude)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPlacesInBounds(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+
+	repo := NewAdRepository(db)
+	bounds := domain.GeoBounds{MinLatitude: 55.7, MinLongitude: 37.5, MaxLatitude: 55.8, MaxLongitude: 37.7}
+
+	query := `SELECT ads.*, cities.title as "CityName", ad_positions.latitude as "Latitude", ad_positions.longitude as "Longitude" FROM "ads" JOIN cities ON ads."cityId" = cities.id JOIN ad_positions ON ad_positions."adId" = ads.uuid WHERE (ad_positions.latitude BETWEEN $1 AND $2) AND NOT ads.hidden AND (ad_positions.longitude BETWEEN $3 AND $4) ORDER BY priority DESC LIMIT $5`
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(55.7, 55.8, 37.5, 37.7, 100).
+		WillReturnRows(sqlmock.NewRows([]string{"uuid", "CityName", "Latitude", "Longitude"}).AddRow("ad-1", "Москва", 55.75, 37.61))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "images" WHERE "adId" IN ($1)`)).
+		WithArgs("ad-1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "adId", "imageUrl"}).AddRow(1, "ad-1", "images/1.jpg"))
+
+	ads, err := repo.GetPlacesInBounds(context.Background(), bounds, 100)
+	require.NoError(t, err)
+	require.Len(t, ads, 1)
+	assert.Equal(t, "Москва", ads[0].CityName)
+	assert.Equal(t, []domain.ImageResponse{{ID: 1, ImagePath: "images/1.jpg"}}, ads[0].Images)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM "ads"`)).WillReturnError(errors.New("db error"))
+	_, err = repo.GetPlacesInBounds(context.Background(), bounds, 100)
+	assert.EqualError(t, err, "error fetching places in bounds")
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// Число запросов на страницу объявлений не должно зависеть от её размера
+func BenchmarkGetAllPlaces_QueryCount(b *testing.B) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	const expectedQueries = 5 // объявления, избранное, картинки, авторы, комнаты
+
+	for _, pageSize := range []int{10, 50, 200} {
+		b.Run(fmt.Sprintf("page_%d", pageSize), func(b *testing.B) {
+			db, mock, err := setupDBMock()
+			require.NoError(b, err)
+			mock.MatchExpectationsInOrder(false)
+
+			queries := 0
+			err = db.Callback().Query().After("gorm:query").Register("bench:count_queries", func(*gorm.DB) {
+				queries++
+			})
+			require.NoError(b, err)
+			err = db.Callback().Row().After("gorm:row").Register("bench:count_rows", func(*gorm.DB) {
+				queries++
+			})
+			require.NoError(b, err)
+
+			repo := NewAdRepository(db)
+			fixedDate := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				adRows := sqlmock.NewRows([]string{"uuid", "cityId", "authorUUID", "address", "publicationDate", "CityName"})
+				imageRows := sqlmock.NewRows([]string{"id", "adId", "imageUrl"})
+				userRows := sqlmock.NewRows([]string{"uuid", "name", "avatar", "score"})
+				roomRows := sqlmock.NewRows([]string{"id", "adId", "type", "squareMeters"})
+				for j := 0; j < pageSize; j++ {
+					adId := fmt.Sprintf("ad-%d", j)
+					authorId := fmt.Sprintf("author-%d", j%7)
+					adRows.AddRow(adId, 1, authorId, "Some Address", fixedDate, "City Name")
+					imageRows.AddRow(j, adId, "images/image.jpg")
+					roomRows.AddRow(j, adId, "bedroom", 12)
+				}
+				for j := 0; j < 7; j++ {
+					userRows.AddRow(fmt.Sprintf("author-%d", j), "Test User", "avatar_url", 4.5)
+				}
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT ads.*, cities.title as "CityName"`)).WillReturnRows(adRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT "adId" FROM "favorites" WHERE "userId" = $1`)).
+					WillReturnRows(sqlmock.NewRows([]string{"adId"}).AddRow("ad-0"))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "images" WHERE "adId" IN (`)).WillReturnRows(imageRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE uuid IN (`)).WillReturnRows(userRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ad_rooms" WHERE "adId" IN (`)).WillReturnRows(roomRows)
+				queries = 0
+				b.StartTimer()
+
+				ads, err := repo.GetAllPlaces(context.Background(), domain.AdFilter{}, "user-id")
+
+				b.StopTimer()
+				require.NoError(b, err)
+				require.Len(b, ads, pageSize)
+				if queries != expectedQueries {
+					b.Fatalf("page size %d: expected %d queries, got %d", pageSize, expectedQueries, queries)
+				}
+				b.StartTimer()
+			}
+			b.ReportMetric(float64(queries), "queries/op")
+			require.NoError(b, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -5,22 +5,26 @@ import (
 	"2024_2_FIGHT-CLUB/internal/service/images"
 	"2024_2_FIGHT-CLUB/internal/service/logger"
 	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/pagination"
 	ntype "2024_2_FIGHT-CLUB/internal/service/type"
 	"2024_2_FIGHT-CLUB/internal/service/validation"
 	"go.uber.org/zap"
 	"log"
 	"net/http"
 	"regexp"
+	"sort"
 	"strconv"
 	"time"
+	"unicode/utf8"
 
 	"context"
 	"errors"
 )
 
 type AdUseCase interface {
-	GetAllPlaces(ctx context.Context, filter domain.AdFilter, userId string) ([]domain.GetAllAdsResponse, error)
-	GetOnePlace(ctx context.Context, adId string, isAuthorized bool) (domain.GetAllAdsResponse, error)
+	GetAllPlaces(ctx context.Context, filter domain.AdFilter, userId string) ([]domain.GetAllAdsResponse, string, error)
+	// GetOnePlace возвращает объявление, viewer равен nil для анонимного просмотра
+	GetOnePlace(ctx context.Context, adId string, viewer *domain.SessionData, dateFrom time.Time, dateTo time.Time) (domain.GetAllAdsResponse, error)
 	CreatePlace(ctx context.Context, place *domain.Ad, fileHeader [][]byte, newPlace domain.CreateAdRequest, userId string) error
 	UpdatePlace(ctx context.Context, place *domain.Ad, adId string, userId string, fileHeader [][]byte, updatedPlace domain.UpdateAdRequest) error
 	DeletePlace(ctx context.Context, adId string, userId string) error
@@ -32,6 +36,11 @@ type AdUseCase interface {
 	GetUserFavorites(ctx context.Context, userId string) ([]domain.GetAllAdsResponse, error)
 	UpdatePriority(ctx context.Context, adId string, userId string, amount int) error
 	StartPriorityResetWorker(ctx context.Context, tickerInterval time.Duration)
+	CreateBooking(ctx context.Context, adId string, userId string, request domain.CreateBookingRequest) (domain.Booking, error)
+	GetAdBookings(ctx context.Context, adId string, userId string) ([]domain.Booking, error)
+	GetUserBookings(ctx context.Context, userId string) ([]domain.Booking, error)
+	UpdateBookingStatus(ctx context.Context, bookingId int, userId string, status string) (domain.Booking, error)
+	GetPlacesInBounds(ctx context.Context, bounds domain.GeoBounds) ([]domain.GetAllAdsResponse, error)
 }
 
 type adUseCase struct {
@@ -46,15 +55,45 @@ func NewAdUseCase(adRepository domain.AdRepository, minioService images.MinioSer
 	}
 }
 
-func (uc *adUseCase) GetAllPlaces(ctx context.Context, filter domain.AdFilter, userId string) ([]domain.GetAllAdsResponse, error) {
+func (uc *adUseCase) GetAllPlaces(ctx context.Context, filter domain.AdFilter, userId string) ([]domain.GetAllAdsResponse, string, error) {
+	const maxQueryLen = 255
+	if utf8.RuneCountInString(filter.Query) > maxQueryLen {
+		return nil, "", errors.New("input exceeds character limit")
+	}
+
+	// курсор задаёт позицию только в порядке по умолчанию
+	keyset := filter.Sort == "" && filter.Near == nil && filter.Query == ""
+	if filter.Cursor != nil && (!keyset || filter.Offset != 0) {
+		return nil, "", errors.New("cursor not supported with sort")
+	}
+
+	limit := filter.Limit
+	if limit > 0 {
+		// берём одну лишнюю запись, чтобы понять, есть ли следующая страница
+		filter.Limit = limit + 1
+	}
+
 	ads, err := uc.adRepository.GetAllPlaces(ctx, filter, userId)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	var nextCursor string
+	if limit > 0 && len(ads) > limit {
+		ads = ads[:limit]
+		if keyset {
+			last := ads[limit-1]
+			nextCursor = pagination.EncodeCursor(domain.AdCursor{
+				Priority:        last.Priority,
+				PublicationDate: last.PublicationDate,
+				UUID:            last.UUID,
+			})
+		}
 	}
-	return ads, nil
+	return ads, nextCursor, nil
 }
 
-func (uc *adUseCase) GetOnePlace(ctx context.Context, adId string, isAuthorized bool) (domain.GetAllAdsResponse, error) {
+func (uc *adUseCase) GetOnePlace(ctx context.Context, adId string, viewer *domain.SessionData, dateFrom time.Time, dateTo time.Time) (domain.GetAllAdsResponse, error) {
 	const maxLen = 255
 	requestID := middleware.GetRequestID(ctx)
 	validCharPattern := regexp.MustCompile(`^[a-zA-Zа-яА-ЯёЁ0-9\s\-_]*$`)
@@ -72,14 +111,28 @@ func (uc *adUseCase) GetOnePlace(ctx context.Context, adId string, isAuthorized
 	if err != nil {
 		return ad, err
 	}
+	// скрытое модератором объявление видят только владелец и модераторы
+	if ad.Hidden && (viewer == nil || (viewer.Id != ad.AuthorUUID && !domain.HasRole(viewer.Role, domain.RoleModerator))) {
+		logger.AccessLogger.Warn("Hidden ad requested", zap.String("request_id", requestID), zap.String("adId", adId))
+		return domain.GetAllAdsResponse{}, errors.New("ad not found")
+	}
 
-	if isAuthorized {
+	if viewer != nil {
 		ad, err = uc.adRepository.UpdateViewsCount(ctx, ad)
 		if err != nil {
 			return ad, err
 		}
 	}
 
+	if !dateFrom.IsZero() || !dateTo.IsZero() {
+		stayPrice, err := calculateStayPrice(ad, dateFrom, dateTo)
+		if err != nil {
+			logger.AccessLogger.Warn("Invalid stay dates", zap.String("request_id", requestID), zap.Error(err))
+			return ad, err
+		}
+		ad.StayPrice = &stayPrice
+	}
+
 	return ad, nil
 }
 
@@ -106,12 +159,30 @@ func (uc *adUseCase) CreatePlace(ctx context.Context, place *domain.Ad, files []
 		return errors.New("roomsNumber out of range")
 	}
 
+	if err := validatePrices(newPlace.Price, newPlace.WeekendPrice, newPlace.CleaningFee); err != nil {
+		logger.AccessLogger.Warn("Invalid price", zap.String("request_id", requestID), zap.Error(err))
+		return err
+	}
+
+	if newPlace.Position != nil && !validCoordinates(*newPlace.Position) {
+		logger.AccessLogger.Warn("Invalid coordinates", zap.String("request_id", requestID))
+		return errors.New("invalid coordinates")
+	}
+
 	if err := validation.ValidateImages(files, 5<<20, []string{"image/jpeg", "image/png", "image/jpg"}, 2000, 2000); err != nil {
 		logger.AccessLogger.Warn("Invalid image", zap.String("request_id", requestID), zap.Error(err))
 		return err
 	}
 
-	err := uc.adRepository.CreatePlace(ctx, place, newPlace, userId)
+	availableDates, blockedDates, err := normalizeCalendar(newPlace.DateFrom, newPlace.DateTo, newPlace.AvailableDates, newPlace.BlockedDates)
+	if err != nil {
+		logger.AccessLogger.Warn("Invalid calendar", zap.String("request_id", requestID), zap.Error(err))
+		return err
+	}
+	newPlace.AvailableDates = availableDates
+	newPlace.BlockedDates = blockedDates
+
+	err = uc.adRepository.CreatePlace(ctx, place, newPlace, userId)
 	if err != nil {
 		return err
 	}
@@ -181,7 +252,25 @@ func (uc *adUseCase) UpdatePlace(ctx context.Context, place *domain.Ad, adId str
 		return errors.New("roomsNumber out of range")
 	}
 
-	_, err := uc.adRepository.GetPlaceById(ctx, adId)
+	if err := validatePrices(updatedPlace.Price, updatedPlace.WeekendPrice, updatedPlace.CleaningFee); err != nil {
+		logger.AccessLogger.Warn("Invalid price", zap.String("request_id", requestID), zap.Error(err))
+		return err
+	}
+
+	if updatedPlace.Position != nil && !validCoordinates(*updatedPlace.Position) {
+		logger.AccessLogger.Warn("Invalid coordinates", zap.String("request_id", requestID))
+		return errors.New("invalid coordinates")
+	}
+
+	availableDates, blockedDates, err := normalizeCalendar(updatedPlace.DateFrom, updatedPlace.DateTo, updatedPlace.AvailableDates, updatedPlace.BlockedDates)
+	if err != nil {
+		logger.AccessLogger.Warn("Invalid calendar", zap.String("request_id", requestID), zap.Error(err))
+		return err
+	}
+	updatedPlace.AvailableDates = availableDates
+	updatedPlace.BlockedDates = blockedDates
+
+	_, err = uc.adRepository.GetPlaceById(ctx, adId)
 	if err != nil {
 		return err
 	}
@@ -438,3 +527,271 @@ func (uc *adUseCase) StartPriorityResetWorker(ctx context.Context, tickerInterva
 		}
 	}()
 }
+
+// normalizeCalendar приводит календарь объявления к набору непересекающихся диапазонов,
+// отсортированных по дате начала. Одиночные dateFrom/dateTo считаются одним диапазоном
+func normalizeCalendar(dateFrom time.Time, dateTo time.Time, ranges []domain.AvailableDateRange, blocked []time.Time) ([]domain.AvailableDateRange, []time.Time, error) {
+	if len(ranges) == 0 && !dateFrom.IsZero() && !dateTo.IsZero() {
+		ranges = []domain.AvailableDateRange{{DateFrom: dateFrom, DateTo: dateTo}}
+	}
+
+	normalized := make([]domain.AvailableDateRange, 0, len(ranges))
+	for _, dateRange := range ranges {
+		from := dateRange.DateFrom.Truncate(24 * time.Hour)
+		to := dateRange.DateTo.Truncate(24 * time.Hour)
+		if from.IsZero() || to.IsZero() || to.Before(from) {
+			return nil, nil, errors.New("invalid available date range")
+		}
+		normalized = append(normalized, domain.AvailableDateRange{DateFrom: from, DateTo: to})
+	}
+	sort.Slice(normalized, func(i, j int) bool {
+		return normalized[i].DateFrom.Before(normalized[j].DateFrom)
+	})
+	for i := 1; i < len(normalized); i++ {
+		if !normalized[i].DateFrom.After(normalized[i-1].DateTo) {
+			return nil, nil, errors.New("available date ranges overlap")
+		}
+	}
+
+	seen := make(map[time.Time]bool)
+	blockedDates := make([]time.Time, 0, len(blocked))
+	for _, date := range blocked {
+		day := date.Truncate(24 * time.Hour)
+		if day.IsZero() || seen[day] {
+			continue
+		}
+		seen[day] = true
+		blockedDates = append(blockedDates, day)
+	}
+	sort.Slice(blockedDates, func(i, j int) bool {
+		return blockedDates[i].Before(blockedDates[j])
+	})
+	// Без диапазонов доступности объявление и так недоступно, блокировать нечего
+	if len(normalized) == 0 && len(blockedDates) > 0 {
+		return nil, nil, errors.New("blocked dates without available dates")
+	}
+
+	return normalized, blockedDates, nil
+}
+
+func (uc *adUseCase) GetPlacesInBounds(ctx context.Context, bounds domain.GeoBounds) ([]domain.GetAllAdsResponse, error) {
+	// Ограничение на количество меток, которые отдаются карте за один запрос
+	const maxMapPlaces = 500
+	requestID := middleware.GetRequestID(ctx)
+
+	if !validCoordinates(domain.GeoPoint{Latitude: bounds.MinLatitude, Longitude: bounds.MinLongitude}) ||
+		!validCoordinates(domain.GeoPoint{Latitude: bounds.MaxLatitude, Longitude: bounds.MaxLongitude}) ||
+		bounds.MinLatitude > bounds.MaxLatitude {
+		logger.AccessLogger.Warn("Invalid bbox", zap.String("request_id", requestID))
+		return nil, errors.New("invalid bbox")
+	}
+
+	ads, err := uc.adRepository.GetPlacesInBounds(ctx, bounds, maxMapPlaces)
+	if err != nil {
+		return nil, err
+	}
+	return ads, nil
+}
+
+func validatePrices(price int, weekendPrice int, cleaningFee int) error {
+	const maxPrice = 10000000
+	if price <= 0 || price > maxPrice {
+		return errors.New("price out of range")
+	}
+	if weekendPrice < 0 || weekendPrice > maxPrice || cleaningFee < 0 || cleaningFee > maxPrice {
+		return errors.New("price out of range")
+	}
+	return nil
+}
+
+func validCoordinates(point domain.GeoPoint) bool {
+	return point.Latitude >= -90 && point.Latitude <= 90 && point.Longitude >= -180 && point.Longitude <= 180
+}
+
+// calculateStayPrice считает стоимость проживания: ночи с пятницы и субботы идут
+// по цене выходного дня, если она задана, к сумме добавляется плата за уборку
+func calculateStayPrice(ad domain.GetAllAdsResponse, dateFrom time.Time, dateTo time.Time) (domain.StayPrice, error) {
+	// Ограничение длины проживания, ночи перебираются по одной
+	const maxStayNights = 365
+	dateFrom = dateFrom.Truncate(24 * time.Hour)
+	dateTo = dateTo.Truncate(24 * time.Hour)
+	if dateFrom.IsZero() || dateTo.IsZero() || !dateTo.After(dateFrom) {
+		return domain.StayPrice{}, errors.New("invalid stay dates")
+	}
+	if dateTo.After(dateFrom.AddDate(0, 0, maxStayNights)) {
+		return domain.StayPrice{}, errors.New("stay too long")
+	}
+
+	stayPrice := domain.StayPrice{
+		DateFrom:    dateFrom,
+		DateTo:      dateTo,
+		CleaningFee: ad.CleaningFee,
+	}
+	for night := dateFrom; night.Before(dateTo); night = night.AddDate(0, 0, 1) {
+		price := ad.Price
+		weekday := night.Weekday()
+		if ad.WeekendPrice > 0 && (weekday == time.Friday || weekday == time.Saturday) {
+			price = ad.WeekendPrice
+		}
+		stayPrice.Nights++
+		stayPrice.NightsPrice += price
+	}
+	stayPrice.Total = stayPrice.NightsPrice + stayPrice.CleaningFee
+	return stayPrice, nil
+}
+
+// Допустимые переходы статусов заявки на бронирование
+var bookingTransitions = map[string][]string{
+	domain.BookingStatusPending:  {domain.BookingStatusApproved, domain.BookingStatusDeclined, domain.BookingStatusCancelled},
+	domain.BookingStatusApproved: {domain.BookingStatusCancelled, domain.BookingStatusCompleted},
+}
+
+func canTransitBooking(from string, to string) bool {
+	for _, status := range bookingTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (uc *adUseCase) CreateBooking(ctx context.Context, adId string, userId string, request domain.CreateBookingRequest) (domain.Booking, error) {
+	requestID := middleware.GetRequestID(ctx)
+	const maxLen = 255
+
+	if len(adId) > maxLen {
+		logger.AccessLogger.Warn("Input exceeds character limit", zap.String("request_id", requestID))
+		return domain.Booking{}, errors.New("input exceeds character limit")
+	}
+
+	validCharPattern := regexp.MustCompile(`^[a-zA-Zа-яА-ЯёЁ0-9\s\-_]*$`)
+	if !validCharPattern.MatchString(adId) {
+		logger.AccessLogger.Warn("Input contains invalid characters", zap.String("request_id", requestID))
+		return domain.Booking{}, errors.New("input contains invalid characters")
+	}
+
+	today := time.Now().Truncate(24 * time.Hour)
+	if request.DateFrom.IsZero() || request.DateTo.IsZero() || !request.DateFrom.Before(request.DateTo) || request.DateFrom.Before(today) {
+		logger.AccessLogger.Warn("Invalid booking dates", zap.String("request_id", requestID))
+		return domain.Booking{}, errors.New("invalid booking dates")
+	}
+
+	overlap, err := uc.adRepository.HasApprovedBookingOverlap(ctx, adId, request.DateFrom, request.DateTo, 0)
+	if err != nil {
+		return domain.Booking{}, err
+	}
+	if overlap {
+		return domain.Booking{}, errors.New("dates already booked")
+	}
+
+	available, err := uc.adRepository.IsAdAvailable(ctx, adId, request.DateFrom, request.DateTo)
+	if err != nil {
+		return domain.Booking{}, err
+	}
+	if !available {
+		return domain.Booking{}, errors.New("ad is not available for these dates")
+	}
+
+	booking := domain.Booking{
+		AdID:     adId,
+		UserID:   userId,
+		Status:   domain.BookingStatusPending,
+		DateFrom: request.DateFrom,
+		DateTo:   request.DateTo,
+	}
+	err = uc.adRepository.CreateBooking(ctx, &booking)
+	if err != nil {
+		return domain.Booking{}, err
+	}
+	return booking, nil
+}
+
+func (uc *adUseCase) GetAdBookings(ctx context.Context, adId string, userId string) ([]domain.Booking, error) {
+	requestID := middleware.GetRequestID(ctx)
+	const maxLen = 255
+
+	if len(adId) > maxLen {
+		logger.AccessLogger.Warn("Input exceeds character limit", zap.String("request_id", requestID))
+		return nil, errors.New("input exceeds character limit")
+	}
+
+	validCharPattern := regexp.MustCompile(`^[a-zA-Zа-яА-ЯёЁ0-9\s\-_]*$`)
+	if !validCharPattern.MatchString(adId) {
+		logger.AccessLogger.Warn("Input contains invalid characters", zap.String("request_id", requestID))
+		return nil, errors.New("input contains invalid characters")
+	}
+
+	place, err := uc.adRepository.GetPlaceById(ctx, adId)
+	if err != nil {
+		return nil, err
+	}
+	if place.AuthorUUID != userId {
+		return nil, errors.New("not owner of ad")
+	}
+
+	bookings, err := uc.adRepository.GetAdBookings(ctx, adId)
+	if err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
+
+func (uc *adUseCase) GetUserBookings(ctx context.Context, userId string) ([]domain.Booking, error) {
+	bookings, err := uc.adRepository.GetUserBookings(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
+
+func (uc *adUseCase) UpdateBookingStatus(ctx context.Context, bookingId int, userId string, status string) (domain.Booking, error) {
+	requestID := middleware.GetRequestID(ctx)
+
+	booking, err := uc.adRepository.GetBookingById(ctx, bookingId)
+	if err != nil {
+		return domain.Booking{}, err
+	}
+
+	isHost := booking.HostID == userId
+	isGuest := booking.UserID == userId
+	if !isHost && !isGuest {
+		logger.AccessLogger.Warn("User is not a participant of booking", zap.String("request_id", requestID))
+		return domain.Booking{}, errors.New("not participant of booking")
+	}
+
+	if !canTransitBooking(booking.Status, status) {
+		logger.AccessLogger.Warn("Invalid booking status transition",
+			zap.String("request_id", requestID),
+			zap.String("from", booking.Status),
+			zap.String("to", status))
+		return domain.Booking{}, errors.New("invalid status transition")
+	}
+
+	switch status {
+	case domain.BookingStatusApproved, domain.BookingStatusDeclined:
+		if !isHost {
+			return domain.Booking{}, errors.New("only host can approve or decline booking")
+		}
+	case domain.BookingStatusCompleted:
+		if !isHost {
+			return domain.Booking{}, errors.New("only host can complete booking")
+		}
+		if time.Now().Before(booking.DateTo) {
+			return domain.Booking{}, errors.New("stay is not finished yet")
+		}
+	}
+
+	fromStatus := booking.Status
+	booking.Status = status
+	if status != domain.BookingStatusApproved {
+		closeDate := time.Now()
+		booking.CloseDate = &closeDate
+	}
+
+	// пересечение с одобренными заявками проверяется в той же транзакции, что и запись
+	err = uc.adRepository.UpdateBookingStatus(ctx, &booking, fromStatus)
+	if err != nil {
+		return domain.Booking{}, err
+	}
+	return booking, nil
+}
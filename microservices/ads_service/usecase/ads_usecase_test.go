@@ -3,6 +3,7 @@ package usecase
 import (
 	"2024_2_FIGHT-CLUB/domain"
 	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/pagination"
 	"2024_2_FIGHT-CLUB/microservices/ads_service/mocks"
 	"bytes"
 	"context"
@@ -13,6 +14,7 @@ import (
 	"image/color"
 	"image/jpeg"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 )
@@ -32,10 +34,72 @@ func TestAdUseCase_GetAllPlaces(t *testing.T) {
 	ctx := context.Background()
 	filter := domain.AdFilter{Location: "New York"}
 	userId := "user123"
-	ads, err := useCase.GetAllPlaces(ctx, filter, userId)
+	ads, nextCursor, err := useCase.GetAllPlaces(ctx, filter, userId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedAds, ads)
+	assert.Empty(t, nextCursor)
+}
+
+func TestAdUseCase_GetAllPlaces_Cursor(t *testing.T) {
+	mockRepo := &mocks.MockAdRepository{}
+	mockMinioService := &mocks.MockMinioService{}
+	useCase := NewAdUseCase(mockRepo, mockMinioService)
+
+	date := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	var gotFilter domain.AdFilter
+	mockRepo.MockGetAllPlaces = func(ctx context.Context, filter domain.AdFilter, userId string) ([]domain.GetAllAdsResponse, error) {
+		gotFilter = filter
+		return []domain.GetAllAdsResponse{
+			{UUID: "ad-3", Priority: 5, PublicationDate: date},
+			{UUID: "ad-2", Priority: 0, PublicationDate: date},
+			{UUID: "ad-1", Priority: 0, PublicationDate: date},
+		}, nil
+	}
+
+	ctx := context.Background()
+	ads, nextCursor, err := useCase.GetAllPlaces(ctx, domain.AdFilter{Limit: 2}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, gotFilter.Limit)
+	assert.Len(t, ads, 2)
+	assert.NotEmpty(t, nextCursor)
+
+	var cursor domain.AdCursor
+	assert.NoError(t, pagination.DecodeCursor(nextCursor, &cursor))
+	assert.Equal(t, domain.AdCursor{Priority: 0, PublicationDate: date, UUID: "ad-2"}, cursor)
+
+	// последняя страница курсора не возвращает
+	mockRepo.MockGetAllPlaces = func(ctx context.Context, filter domain.AdFilter, userId string) ([]domain.GetAllAdsResponse, error) {
+		return []domain.GetAllAdsResponse{{UUID: "ad-1", PublicationDate: date}}, nil
+	}
+	ads, nextCursor, err = useCase.GetAllPlaces(ctx, domain.AdFilter{Limit: 2, Cursor: &cursor}, "")
+	assert.NoError(t, err)
+	assert.Len(t, ads, 1)
+	assert.Empty(t, nextCursor)
+
+	_, _, err = useCase.GetAllPlaces(ctx, domain.AdFilter{Limit: 2, Cursor: &cursor, Sort: domain.AdSortPriceAsc}, "")
+	assert.EqualError(t, err, "cursor not supported with sort")
+}
+
+func TestAdUseCase_GetAllPlaces_Search(t *testing.T) {
+	mockRepo := &mocks.MockAdRepository{}
+	mockMinioService := &mocks.MockMinioService{}
+	useCase := NewAdUseCase(mockRepo, mockMinioService)
+
+	mockRepo.MockGetAllPlaces = func(ctx context.Context, filter domain.AdFilter, userId string) ([]domain.GetAllAdsResponse, error) {
+		assert.Equal(t, "studio near park", filter.Query)
+		return []domain.GetAllAdsResponse{{UUID: "ad-2"}, {UUID: "ad-1"}}, nil
+	}
+
+	ctx := context.Background()
+	ads, nextCursor, err := useCase.GetAllPlaces(ctx, domain.AdFilter{Query: "studio near park", Limit: 1}, "")
+	assert.NoError(t, err)
+	assert.Len(t, ads, 1)
+	// выдача по релевантности не продолжается курсором
+	assert.Empty(t, nextCursor)
+
+	_, _, err = useCase.GetAllPlaces(ctx, domain.AdFilter{Query: strings.Repeat("a", 256)}, "")
+	assert.EqualError(t, err, "input exceeds character limit")
 }
 
 func TestAdUseCase_GetOnePlace(t *testing.T) {
@@ -44,7 +108,7 @@ func TestAdUseCase_GetOnePlace(t *testing.T) {
 	useCase := NewAdUseCase(mockRepo, mockMinioService)
 
 	adID := "ad123"
-	isAutrhorized := true
+	viewer := &domain.SessionData{Id: "viewer1"}
 	expectedAd := domain.GetAllAdsResponse{UUID: adID, CityID: 2, AuthorUUID: "user567"}
 	mockRepo.MockGetPlaceById = func(ctx context.Context, id string) (domain.GetAllAdsResponse, error) {
 		return expectedAd, nil
@@ -53,12 +117,92 @@ func TestAdUseCase_GetOnePlace(t *testing.T) {
 		return expectedAd, nil
 	}
 	ctx := context.Background()
-	ad, err := useCase.GetOnePlace(ctx, adID, isAutrhorized)
+	ad, err := useCase.GetOnePlace(ctx, adID, viewer, time.Time{}, time.Time{})
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedAd, ad)
 }
 
+func TestAdUseCase_GetOnePlace_StayPrice(t *testing.T) {
+	logger.AccessLogger = zap.NewNop()
+	mockRepo := &mocks.MockAdRepository{}
+	mockMinioService := &mocks.MockMinioService{}
+	useCase := NewAdUseCase(mockRepo, mockMinioService)
+
+	mockRepo.MockGetPlaceById = func(ctx context.Context, id string) (domain.GetAllAdsResponse, error) {
+		return domain.GetAllAdsResponse{UUID: id, Price: 1000, WeekendPrice: 1500, CleaningFee: 300}, nil
+	}
+
+	// Четверг 4 июля - воскресенье 7 июля 2024: ночи чт, пт, сб
+	dateFrom := time.Date(2024, time.July, 4, 0, 0, 0, 0, time.UTC)
+	dateTo := time.Date(2024, time.July, 7, 0, 0, 0, 0, time.UTC)
+	ad, err := useCase.GetOnePlace(context.Background(), "ad123", nil, dateFrom, dateTo)
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.StayPrice{
+		DateFrom:    dateFrom,
+		DateTo:      dateTo,
+		Nights:      3,
+		NightsPrice: 4000,
+		CleaningFee: 300,
+		Total:       4300,
+	}, ad.StayPrice)
+
+	ad, err = useCase.GetOnePlace(context.Background(), "ad123", nil, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Nil(t, ad.StayPrice)
+
+	_, err = useCase.GetOnePlace(context.Background(), "ad123", nil, dateTo, dateFrom)
+	assert.EqualError(t, err, "invalid stay dates")
+
+	ad, err = useCase.GetOnePlace(context.Background(), "ad123", nil, dateFrom, dateFrom.AddDate(0, 0, 365))
+	assert.NoError(t, err)
+	assert.Equal(t, 365, ad.StayPrice.Nights)
+
+	_, err = useCase.GetOnePlace(context.Background(), "ad123", nil, dateFrom, dateFrom.AddDate(0, 0, 366))
+	assert.EqualError(t, err, "stay too long")
+
+	_, err = useCase.GetOnePlace(context.Background(), "ad123", nil,
+		time.Date(1, time.January, 2, 0, 0, 0, 0, time.UTC), time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC))
+	assert.EqualError(t, err, "stay too long")
+}
+
+func TestAdUseCase_GetOnePlace_Hidden(t *testing.T) {
+	logger.AccessLogger = zap.NewNop()
+	mockRepo := &mocks.MockAdRepository{}
+	mockMinioService := &mocks.MockMinioService{}
+	useCase := NewAdUseCase(mockRepo, mockMinioService)
+
+	mockRepo.MockGetPlaceById = func(ctx context.Context, id string) (domain.GetAllAdsResponse, error) {
+		return domain.GetAllAdsResponse{UUID: id, AuthorUUID: "owner", Hidden: true}, nil
+	}
+	mockRepo.MockUpdateViewsCount = func(ctx context.Context, ad domain.GetAllAdsResponse) (domain.GetAllAdsResponse, error) {
+		return ad, nil
+	}
+
+	tests := map[string]struct {
+		viewer  *domain.SessionData
+		visible bool
+	}{
+		"Anonymous":  {viewer: nil},
+		"Other User": {viewer: &domain.SessionData{Id: "guest", Role: domain.RoleHost}},
+		"Owner":      {viewer: &domain.SessionData{Id: "owner", Role: domain.RoleHost}, visible: true},
+		"Moderator":  {viewer: &domain.SessionData{Id: "moderator", Role: domain.RoleModerator}, visible: true},
+		"Admin":      {viewer: &domain.SessionData{Id: "admin", Role: domain.RoleAdmin}, visible: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ad, err := useCase.GetOnePlace(context.Background(), "ad123", tt.viewer, time.Time{}, time.Time{})
+			if tt.visible {
+				assert.NoError(t, err)
+				assert.Equal(t, "ad123", ad.UUID)
+			} else {
+				assert.EqualError(t, err, "ad not found")
+				assert.Empty(t, ad.UUID)
+			}
+		})
+	}
+}
+
 func TestAdUseCase_CreatePlace(t *testing.T) {
 	mockRepo := &mocks.MockAdRepository{}
 	mockMinioService := &mocks.MockMinioService{}
@@ -68,7 +212,7 @@ func TestAdUseCase_CreatePlace(t *testing.T) {
 	fileHeaders := [][]byte{}
 	userId := "user123"
 	createRequest := domain.CreateAdRequest{
-		CityName: "Los Angeles", Address: "123 Main St", Description: "Nice place", RoomsNumber: 2,
+		CityName: "Los Angeles", Address: "123 Main St", Description: "Nice place", RoomsNumber: 2, Price: 3000,
 	}
 
 	mockRepo.MockCreatePlace = func(ctx context.Context, ad *domain.Ad, newAd domain.CreateAdRequest, userId string) error {
@@ -89,6 +233,72 @@ func TestAdUseCase_CreatePlace(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAdUseCase_CreatePlace_Calendar(t *testing.T) {
+	logger.AccessLogger = zap.NewNop()
+	mockRepo := &mocks.MockAdRepository{}
+	mockMinioService := &mocks.MockMinioService{}
+	useCase := NewAdUseCase(mockRepo, mockMinioService)
+
+	day := func(d int) time.Time {
+		return time.Date(2024, time.July, d, 0, 0, 0, 0, time.UTC)
+	}
+	createRequest := domain.CreateAdRequest{
+		CityName: "Los Angeles", Address: "123 Main St", Description: "Nice place", RoomsNumber: 2, Price: 3000,
+		AvailableDates: []domain.AvailableDateRange{
+			{DateFrom: day(20), DateTo: day(25)},
+			{DateFrom: day(1).Add(15 * time.Hour), DateTo: day(10)},
+		},
+		BlockedDates: []time.Time{day(5), day(3), day(5).Add(time.Hour)},
+	}
+
+	var saved domain.CreateAdRequest
+	mockRepo.MockCreatePlace = func(ctx context.Context, ad *domain.Ad, newAd domain.CreateAdRequest, userId string) error {
+		saved = newAd
+		return nil
+	}
+	mockRepo.MockSaveImages = func(ctx context.Context, adUUID string, imagePaths []string) error {
+		return nil
+	}
+
+	err := useCase.CreatePlace(context.Background(), &domain.Ad{}, [][]byte{}, createRequest, "user123")
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.AvailableDateRange{
+		{DateFrom: day(1), DateTo: day(10)},
+		{DateFrom: day(20), DateTo: day(25)},
+	}, saved.AvailableDates)
+	assert.Equal(t, []time.Time{day(3), day(5)}, saved.BlockedDates)
+
+	createRequest.AvailableDates = append(createRequest.AvailableDates, domain.AvailableDateRange{DateFrom: day(8), DateTo: day(12)})
+	err = useCase.CreatePlace(context.Background(), &domain.Ad{}, [][]byte{}, createRequest, "user123")
+	assert.EqualError(t, err, "available date ranges overlap")
+
+	createRequest.AvailableDates = []domain.AvailableDateRange{{DateFrom: day(10), DateTo: day(1)}}
+	err = useCase.CreatePlace(context.Background(), &domain.Ad{}, [][]byte{}, createRequest, "user123")
+	assert.EqualError(t, err, "invalid available date range")
+
+	createRequest.AvailableDates = nil
+	err = useCase.CreatePlace(context.Background(), &domain.Ad{}, [][]byte{}, createRequest, "user123")
+	assert.EqualError(t, err, "blocked dates without available dates")
+}
+
+func TestAdUseCase_CreatePlace_InvalidPrice(t *testing.T) {
+	logger.AccessLogger = zap.NewNop()
+	mockRepo := &mocks.MockAdRepository{}
+	mockMinioService := &mocks.MockMinioService{}
+	useCase := NewAdUseCase(mockRepo, mockMinioService)
+
+	createRequest := domain.CreateAdRequest{
+		CityName: "Los Angeles", Address: "123 Main St", Description: "Nice place", RoomsNumber: 2,
+	}
+	err := useCase.CreatePlace(context.Background(), &domain.Ad{}, [][]byte{}, createRequest, "user123")
+	assert.EqualError(t, err, "price out of range")
+
+	createRequest.Price = 3000
+	createRequest.CleaningFee = -1
+	err = useCase.CreatePlace(context.Background(), &domain.Ad{}, [][]byte{}, createRequest, "user123")
+	assert.EqualError(t, err, "price out of range")
+}
+
 func TestAdUseCase_UpdatePlace(t *testing.T) {
 	mockRepo := &mocks.MockAdRepository{}
 	mockMinioService := &mocks.MockMinioService{}
@@ -98,7 +308,7 @@ func TestAdUseCase_UpdatePlace(t *testing.T) {
 	userID := "user456"
 	existingAd := domain.Ad{}
 	updateRequest := domain.UpdateAdRequest{
-		CityName: "New City", Address: "456 New St", Description: "Updated description", RoomsNumber: 3,
+		CityName: "New City", Address: "456 New St", Description: "Updated description", RoomsNumber: 3, Price: 3000,
 	}
 	fileHeaders := [][]byte{}
 
@@ -253,7 +463,7 @@ func TestAdUseCase_GetAllPlaces_Error(t *testing.T) {
 	ctx := context.Background()
 	filter := domain.AdFilter{Location: "New York"}
 	userId := "user123"
-	ads, err := useCase.GetAllPlaces(ctx, filter, userId)
+	ads, _, err := useCase.GetAllPlaces(ctx, filter, userId)
 
 	assert.Error(t, err)
 	assert.Nil(t, ads)
@@ -271,8 +481,7 @@ func TestAdUseCase_GetOnePlace_Error(t *testing.T) {
 
 	ctx := context.Background()
 	adID := "invalid_ad_id"
-	isAuthorized := true
-	ad, err := useCase.GetOnePlace(ctx, adID, isAuthorized)
+	ad, err := useCase.GetOnePlace(ctx, adID, &domain.SessionData{Id: "viewer1"}, time.Time{}, time.Time{})
 
 	assert.Error(t, err)
 	assert.Equal(t, "ad not found", err.Error())
@@ -287,7 +496,7 @@ func TestAdUseCase_CreatePlace_ErrorOnCreate(t *testing.T) {
 	newAd := domain.Ad{}
 	userId := "user123"
 	createRequest := domain.CreateAdRequest{
-		CityName: "Los Angeles", Address: "123 Main St", Description: "Nice place", RoomsNumber: 2,
+		CityName: "Los Angeles", Address: "123 Main St", Description: "Nice place", RoomsNumber: 2, Price: 3000,
 	}
 
 	mockRepo.MockCreatePlace = func(ctx context.Context, ad *domain.Ad, newAd domain.CreateAdRequest, userId string) error {
@@ -314,7 +523,7 @@ func TestAdUseCase_CreatePlace_ErrorOnUploadImage(t *testing.T) {
 	}
 
 	createRequest := domain.CreateAdRequest{
-		CityName: "Los Angeles", Address: "123 Main St", Description: "Nice place", RoomsNumber: 2,
+		CityName: "Los Angeles", Address: "123 Main St", Description: "Nice place", RoomsNumber: 2, Price: 3000,
 	}
 
 	mockRepo.MockCreatePlace = func(ctx context.Context, ad *domain.Ad, newAd domain.CreateAdRequest, userId string) error {
@@ -348,7 +557,7 @@ func TestAdUseCase_CreatePlace_ErrorOnSaveImage(t *testing.T) {
 	}
 
 	createRequest := domain.CreateAdRequest{
-		CityName: "Los Angeles", Address: "123 Main St", Description: "Nice place", RoomsNumber: 2,
+		CityName: "Los Angeles", Address: "123 Main St", Description: "Nice place", RoomsNumber: 2, Price: 3000,
 	}
 
 	mockRepo.MockCreatePlace = func(ctx context.Context, ad *domain.Ad, newAd domain.CreateAdRequest, userId string) error {
@@ -381,7 +590,7 @@ func TestAdUseCase_UpdatePlace_ErrorOnUploadImage(t *testing.T) {
 	userID := "user456"
 	newAd := domain.Ad{}
 	updateRequest := domain.UpdateAdRequest{
-		CityName: "New City", Address: "456 New St", Description: "Updated description", RoomsNumber: 3,
+		CityName: "New City", Address: "456 New St", Description: "Updated description", RoomsNumber: 3, Price: 3000,
 	}
 
 	mockRepo.MockGetPlaceById = func(ctx context.Context, id string) (domain.GetAllAdsResponse, error) {
@@ -405,7 +614,7 @@ func TestAdUseCase_UpdatePlace_ErrorOnGet(t *testing.T) {
 	adID := "invalid_ad_id"
 	userID := "user456"
 	updateRequest := domain.UpdateAdRequest{
-		CityName: "New City", Address: "456 New St", Description: "Updated description", RoomsNumber: 3,
+		CityName: "New City", Address: "456 New St", Description: "Updated description", RoomsNumber: 3, Price: 3000,
 	}
 
 	mockRepo.MockGetPlaceById = func(ctx context.Context, id string) (domain.GetAllAdsResponse, error) {
@@ -770,3 +979,141 @@ func createValidFileHeaders(numFiles int) ([][]byte, error) {
 func setupLogger() {
 	logger.AccessLogger = zap.NewNop()
 }
+
+func TestAdUseCase_CreateBooking(t *testing.T) {
+	mockRepo := &mocks.MockAdRepository{}
+	mockMinioService := &mocks.MockMinioService{}
+	useCase := NewAdUseCase(mockRepo, mockMinioService)
+
+	dateFrom := time.Now().Add(48 * time.Hour)
+	dateTo := dateFrom.Add(72 * time.Hour)
+
+	mockRepo.MockHasApprovedBookingOverlap = func(ctx context.Context, adId string, from time.Time, to time.Time, excludeId int) (bool, error) {
+		return false, nil
+	}
+	mockRepo.MockIsAdAvailable = func(ctx context.Context, adId string, from time.Time, to time.Time) (bool, error) {
+		return true, nil
+	}
+	mockRepo.MockCreateBooking = func(ctx context.Context, booking *domain.Booking) error {
+		booking.ID = 1
+		booking.HostID = "host-1"
+		return nil
+	}
+
+	booking, err := useCase.CreateBooking(context.Background(), "ad-1", "guest-1", domain.CreateBookingRequest{DateFrom: dateFrom, DateTo: dateTo})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, booking.ID)
+	assert.Equal(t, domain.BookingStatusPending, booking.Status)
+	assert.Equal(t, "guest-1", booking.UserID)
+
+	_, err = useCase.CreateBooking(context.Background(), "ad-1", "guest-1", domain.CreateBookingRequest{DateFrom: dateTo, DateTo: dateFrom})
+	assert.EqualError(t, err, "invalid booking dates")
+
+	mockRepo.MockIsAdAvailable = func(ctx context.Context, adId string, from time.Time, to time.Time) (bool, error) {
+		return false, nil
+	}
+	_, err = useCase.CreateBooking(context.Background(), "ad-1", "guest-1", domain.CreateBookingRequest{DateFrom: dateFrom, DateTo: dateTo})
+	assert.EqualError(t, err, "ad is not available for these dates")
+
+	mockRepo.MockHasApprovedBookingOverlap = func(ctx context.Context, adId string, from time.Time, to time.Time, excludeId int) (bool, error) {
+		return true, nil
+	}
+	_, err = useCase.CreateBooking(context.Background(), "ad-1", "guest-1", domain.CreateBookingRequest{DateFrom: dateFrom, DateTo: dateTo})
+	assert.EqualError(t, err, "dates already booked")
+}
+
+func TestAdUseCase_UpdateBookingStatus(t *testing.T) {
+	mockRepo := &mocks.MockAdRepository{}
+	mockMinioService := &mocks.MockMinioService{}
+	useCase := NewAdUseCase(mockRepo, mockMinioService)
+
+	stored := domain.Booking{ID: 1, AdID: "ad-1", UserID: "guest-1", HostID: "host-1", Status: domain.BookingStatusPending,
+		DateFrom: time.Now().Add(24 * time.Hour), DateTo: time.Now().Add(72 * time.Hour)}
+	mockRepo.MockGetBookingById = func(ctx context.Context, bookingId int) (domain.Booking, error) {
+		return stored, nil
+	}
+	mockRepo.MockUpdateBookingStatus = func(ctx context.Context, booking *domain.Booking, fromStatus string) error {
+		if fromStatus != stored.Status {
+			return errors.New("booking status changed")
+		}
+		stored = *booking
+		return nil
+	}
+
+	_, err := useCase.UpdateBookingStatus(context.Background(), 1, "guest-1", domain.BookingStatusApproved)
+	assert.EqualError(t, err, "only host can approve or decline booking")
+
+	_, err = useCase.UpdateBookingStatus(context.Background(), 1, "stranger", domain.BookingStatusCancelled)
+	assert.EqualError(t, err, "not participant of booking")
+
+	booking, err := useCase.UpdateBookingStatus(context.Background(), 1, "host-1", domain.BookingStatusApproved)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.BookingStatusApproved, booking.Status)
+	assert.Nil(t, booking.CloseDate)
+
+	_, err = useCase.UpdateBookingStatus(context.Background(), 1, "host-1", domain.BookingStatusDeclined)
+	assert.EqualError(t, err, "invalid status transition")
+
+	_, err = useCase.UpdateBookingStatus(context.Background(), 1, "host-1", domain.BookingStatusCompleted)
+	assert.EqualError(t, err, "stay is not finished yet")
+
+	booking, err = useCase.UpdateBookingStatus(context.Background(), 1, "guest-1", domain.BookingStatusCancelled)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.BookingStatusCancelled, booking.Status)
+	assert.NotNil(t, booking.CloseDate)
+
+	_, err = useCase.UpdateBookingStatus(context.Background(), 1, "guest-1", domain.BookingStatusApproved)
+	assert.EqualError(t, err, "invalid status transition")
+
+	t.Run("Changed Concurrently", func(t *testing.T) {
+		stored.Status = domain.BookingStatusPending
+		mockRepo.MockGetBookingById = func(ctx context.Context, bookingId int) (domain.Booking, error) {
+			loaded := stored
+			// гость отменил заявку сразу после того, как хозяин её прочитал
+			stored.Status = domain.BookingStatusCancelled
+			return loaded, nil
+		}
+
+		_, err := useCase.UpdateBookingStatus(context.Background(), 1, "host-1", domain.BookingStatusApproved)
+		assert.EqualError(t, err, "booking status changed")
+		assert.Equal(t, domain.BookingStatusCancelled, stored.Status)
+	})
+}
+
+func TestAdUseCase_GetPlacesInBounds(t *testing.T) {
+	logger.AccessLogger = zap.NewNop()
+	mockRepo := &mocks.MockAdRepository{}
+	mockMinioService := &mocks.MockMinioService{}
+	useCase := NewAdUseCase(mockRepo, mockMinioService)
+
+	bounds := domain.GeoBounds{MinLatitude: 55.7, MinLongitude: 37.5, MaxLatitude: 55.8, MaxLongitude: 37.7}
+	mockRepo.MockGetPlacesInBounds = func(ctx context.Context, b domain.GeoBounds, limit int) ([]domain.GetAllAdsResponse, error) {
+		assert.Equal(t, bounds, b)
+		assert.Equal(t, 500, limit)
+		return []domain.GetAllAdsResponse{{UUID: "ad-1"}}, nil
+	}
+
+	ads, err := useCase.GetPlacesInBounds(context.Background(), bounds)
+	assert.NoError(t, err)
+	assert.Len(t, ads, 1)
+
+	_, err = useCase.GetPlacesInBounds(context.Background(), domain.GeoBounds{MinLatitude: 56, MaxLatitude: 55})
+	assert.EqualError(t, err, "invalid bbox")
+
+	_, err = useCase.GetPlacesInBounds(context.Background(), domain.GeoBounds{MinLatitude: -95, MaxLatitude: 55})
+	assert.EqualError(t, err, "invalid bbox")
+}
+
+func TestAdUseCase_CreatePlace_InvalidCoordinates(t *testing.T) {
+	logger.AccessLogger = zap.NewNop()
+	mockRepo := &mocks.MockAdRepository{}
+	mockMinioService := &mocks.MockMinioService{}
+	useCase := NewAdUseCase(mockRepo, mockMinioService)
+
+	createRequest := domain.CreateAdRequest{
+		CityName: "Los Angeles", Address: "123 Main St", Description: "Nice place", RoomsNumber: 2, Price: 3000,
+		Position: &domain.GeoPoint{Latitude: 91, Longitude: 10},
+	}
+	err := useCase.CreatePlace(context.Background(), &domain.Ad{}, [][]byte{}, createRequest, "user123")
+	assert.EqualError(t, err, "invalid coordinates")
+}
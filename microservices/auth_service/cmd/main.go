@@ -2,8 +2,10 @@ package main
 
 import (
 	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/mailer"
 	"2024_2_FIGHT-CLUB/internal/service/metrics"
 	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/oidc"
 	"2024_2_FIGHT-CLUB/internal/service/session"
 	grpcAuth "2024_2_FIGHT-CLUB/microservices/auth_service/controller"
 	generatedAuth "2024_2_FIGHT-CLUB/microservices/auth_service/controller/gen"
@@ -33,6 +35,7 @@ func main() {
 	// Инициализация метрик
 	metrics.InitMetrics()
 	metrics.InitRepoMetric()
+	metrics.InitAuthMetric()
 	// Экспозиция метрик на порту 9092
 	go func() {
 		http.Handle("/api/metrics", promhttp.Handler())
@@ -43,7 +46,7 @@ func main() {
 	}()
 
 	// Создание JWT сервиса
-	jwtToken, err := middleware.NewJwtToken("secret-key")
+	jwtToken, err := middleware.NewJwtTokenFromEnv()
 	if err != nil {
 		log.Fatalf("Failed to create JWT token: %v", err)
 	}
@@ -60,13 +63,18 @@ func main() {
 
 	sessionService := session.NewSessionService(redisStore)
 	auRepository := authRepository.NewAuthRepository(db)
-	auUseCase := authUseCase.NewAuthUseCase(auRepository, minioService)
+	tokenStore := authRepository.NewRedisTokenStore(middleware.RedisClient)
+	loginLimiter := authRepository.NewRedisLoginLimiter(middleware.RedisClient)
+	oidcStates := authRepository.NewRedisOIDCStateStore(middleware.RedisClient)
+	auUseCase := authUseCase.NewAuthUseCase(auRepository, minioService, tokenStore, loginLimiter, mailer.FromEnv(), jwtToken, os.Getenv("FRONTEND_URL"), oidc.FromEnv(), oidcStates)
 	authServer := grpcAuth.NewGrpcAuthHandler(auUseCase, sessionService, jwtToken)
 
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(middleware.ChainUnaryInterceptors(
 			middleware.RecoveryInterceptor,     // интерсептор для обработки паники
 			middleware.UnaryMetricsInterceptor, // интерсептор для метрик
+			// интерсептор для проверки ролей
+			middleware.RoleInterceptor(sessionService, grpcAuth.MethodRoles),
 		)),
 	)
 	generatedAuth.RegisterAuthServer(grpcServer, authServer)
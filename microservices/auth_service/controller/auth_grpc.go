@@ -12,9 +12,24 @@ import (
 	"github.com/microcosm-cc/bluemonday"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
 	"time"
 )
 
+// MethodRoles - минимальные роли для методов сервиса, проверяются middleware.RoleInterceptor
+var MethodRoles = map[string]string{
+	gen.Auth_GetAllUsers_FullMethodName:             domain.RoleAdmin,
+	gen.Auth_GetSessions_FullMethodName:             domain.RoleGuest,
+	gen.Auth_RevokeSession_FullMethodName:           domain.RoleGuest,
+	gen.Auth_RevokeOtherSessions_FullMethodName:     domain.RoleGuest,
+	gen.Auth_ChangePassword_FullMethodName:          domain.RoleGuest,
+	gen.Auth_ResendVerificationEmail_FullMethodName: domain.RoleGuest,
+	gen.Auth_SetupTOTP_FullMethodName:               domain.RoleHost,
+	gen.Auth_EnableTOTP_FullMethodName:              domain.RoleHost,
+	gen.Auth_DisableTOTP_FullMethodName:             domain.RoleGuest,
+	gen.Auth_UnlockUser_FullMethodName:              domain.RoleAdmin,
+}
+
 type GrpcAuthHandler struct {
 	gen.AuthServer
 	usecase        usecase.AuthUseCase
@@ -58,7 +73,7 @@ func (h *GrpcAuthHandler) RegisterUser(ctx context.Context, in *gen.RegisterUser
 		return nil, err
 	}
 
-	userSession, err := h.sessionService.CreateSession(ctx, payload)
+	userSession, err := h.sessionService.CreateSession(ctx, payload, domain.SessionClient{Device: in.UserAgent, IP: in.Ip})
 	if err != nil {
 		logger.AccessLogger.Error("Failed create session",
 			zap.String("request_id", requestID),
@@ -102,7 +117,7 @@ func (h *GrpcAuthHandler) LoginUser(ctx context.Context, in *gen.LoginUserReques
 		Password: in.Password,
 	}
 
-	response, err := h.usecase.LoginUser(ctx, payload)
+	response, err := h.usecase.LoginUser(ctx, payload, in.Ip)
 	if err != nil {
 		logger.AccessLogger.Error("Failed to login user",
 			zap.String("request_id", requestID),
@@ -111,8 +126,86 @@ func (h *GrpcAuthHandler) LoginUser(ctx context.Context, in *gen.LoginUserReques
 		return nil, err
 	}
 
-	userSession, err := h.sessionService.CreateSession(ctx, response)
+	return h.completeLogin(ctx, response, domain.SessionClient{Device: in.UserAgent, IP: in.Ip})
+}
+
+func (h *GrpcAuthHandler) VerifySecondFactor(ctx context.Context, in *gen.VerifySecondFactorRequest) (*gen.UserResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received VerifySecondFactor request in microservice",
+		zap.String("request_id", requestID))
+
+	user, err := h.usecase.VerifySecondFactor(ctx, in.Challenge, in.Code, in.Ip)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to verify second factor",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return h.issueSession(ctx, user, domain.SessionClient{Device: in.UserAgent, IP: in.Ip})
+}
+
+func (h *GrpcAuthHandler) StartOIDCLogin(ctx context.Context, in *gen.StartOIDCLoginRequest) (*gen.StartOIDCLoginResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received StartOIDCLogin request in microservice",
+		zap.String("request_id", requestID))
+
+	authURL, state, err := h.usecase.StartOIDCLogin(ctx)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to start oidc login",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &gen.StartOIDCLoginResponse{
+		AuthUrl: authURL,
+		State:   state,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) FinishOIDCLogin(ctx context.Context, in *gen.FinishOIDCLoginRequest) (*gen.UserResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received FinishOIDCLogin request in microservice",
+		zap.String("request_id", requestID))
 
+	user, err := h.usecase.FinishOIDCLogin(ctx, in.Code, in.State)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to finish oidc login",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return h.completeLogin(ctx, user, domain.SessionClient{Device: in.UserAgent, IP: in.Ip})
+}
+
+// completeLogin выдаёт сессию или, если включён второй фактор, челлендж для VerifySecondFactor
+func (h *GrpcAuthHandler) completeLogin(ctx context.Context, user *domain.User, client domain.SessionClient) (*gen.UserResponse, error) {
+	if user.TOTPEnabled {
+		challenge, err := h.usecase.CreateLoginChallenge(ctx, user.UUID)
+		if err != nil {
+			logger.AccessLogger.Error("Failed to create login challenge",
+				zap.String("request_id", middleware.GetRequestID(ctx)),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		return &gen.UserResponse{
+			Challenge: challenge,
+		}, nil
+	}
+
+	return h.issueSession(ctx, user, client)
+}
+
+// issueSession создаёт сессию и CSRF-токен для пользователя, прошедшего проверку
+func (h *GrpcAuthHandler) issueSession(ctx context.Context, user *domain.User, client domain.SessionClient) (*gen.UserResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	userSession, err := h.sessionService.CreateSession(ctx, user, client)
 	if err != nil {
 		logger.AccessLogger.Error("Failed create session",
 			zap.String("request_id", requestID),
@@ -135,9 +228,9 @@ func (h *GrpcAuthHandler) LoginUser(ctx context.Context, in *gen.LoginUserReques
 		SessionId: userSession,
 		Jwttoken:  jwtToken,
 		User: &gen.User{
-			Id:       response.UUID,
-			Username: response.Username,
-			Email:    response.Email,
+			Id:       user.UUID,
+			Username: user.Username,
+			Email:    user.Email,
 		},
 	}, nil
 }
@@ -192,7 +285,6 @@ func (h *GrpcAuthHandler) PutUser(ctx context.Context, in *gen.PutUserRequest) (
 
 	in.Creds.Uuid = sanitizer.Sanitize(in.Creds.Uuid)
 	in.Creds.Username = sanitizer.Sanitize(in.Creds.Username)
-	in.Creds.Password = sanitizer.Sanitize(in.Creds.Password)
 	in.Creds.Email = sanitizer.Sanitize(in.Creds.Email)
 	in.Creds.Name = sanitizer.Sanitize(in.Creds.Name)
 	in.Creds.Avatar = sanitizer.Sanitize(in.Creds.Avatar)
@@ -209,7 +301,6 @@ func (h *GrpcAuthHandler) PutUser(ctx context.Context, in *gen.PutUserRequest) (
 	Payload := &domain.User{
 		UUID:       in.Creds.Uuid,
 		Username:   in.Creds.Username,
-		Password:   in.Creds.Password,
 		Email:      in.Creds.Email,
 		Name:       in.Creds.Name,
 		Score:      float64(in.Creds.Score),
@@ -226,6 +317,28 @@ func (h *GrpcAuthHandler) PutUser(ctx context.Context, in *gen.PutUserRequest) (
 			zap.Error(err))
 		return nil, err
 	}
+	// роль зависит от isHost, а новая почта ещё не подтверждена, поэтому открытые сессии обновляются
+	user, err := h.usecase.GetUserById(ctx, userID)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to get updated user",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	if err = h.sessionService.UpdateUserRole(ctx, userID, domain.RoleOf(user)); err != nil {
+		logger.AccessLogger.Warn("Failed to update role in sessions",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	if !user.EmailVerified {
+		if err = h.sessionService.MarkEmailUnverified(ctx, userID); err != nil {
+			logger.AccessLogger.Warn("Failed to mark sessions unverified",
+				zap.String("request_id", requestID),
+				zap.Error(err))
+			return nil, err
+		}
+	}
 	return &gen.UpdateResponse{
 		Response: "Success",
 	}, nil
@@ -264,7 +377,7 @@ func (h *GrpcAuthHandler) GetUserById(ctx context.Context, in *gen.GetUserByIdRe
 	}, nil
 }
 
-func (h *GrpcAuthHandler) GetAllUsers(ctx context.Context, in *gen.Empty) (*gen.AllUsersResponse, error) {
+func (h *GrpcAuthHandler) GetAllUsers(ctx context.Context, in *gen.GetAllUsersRequest) (*gen.AllUsersResponse, error) {
 	requestID := middleware.GetRequestID(ctx)
 	logger.AccessLogger.Info("Received GetAllUsers request in microservice",
 		zap.String("request_id", requestID))
@@ -314,8 +427,10 @@ func (h *GrpcAuthHandler) GetSessionData(ctx context.Context, in *gen.GetSession
 	id := data.Id
 	avatar := data.Avatar
 	return &gen.SessionDataResponse{
-		Id:     id,
-		Avatar: avatar,
+		Id:              id,
+		Avatar:          avatar,
+		Role:            data.Role,
+		EmailUnverified: data.EmailUnverified,
 	}, nil
 }
 
@@ -437,3 +552,307 @@ func (h *GrpcAuthHandler) RefreshCsrfToken(ctx context.Context, in *gen.RefreshC
 		CsrfToken: newCsrfToken,
 	}, nil
 }
+
+func (h *GrpcAuthHandler) GetSessions(ctx context.Context, in *gen.GetSessionsRequest) (*gen.SessionsResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received GetSessions request in microservice",
+		zap.String("request_id", requestID))
+
+	sessions, err := h.sessionService.GetUserSessions(ctx, in.SessionId)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to get user sessions",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	response := &gen.SessionsResponse{}
+	for _, activeSession := range sessions {
+		response.Sessions = append(response.Sessions, &gen.ActiveSession{
+			Id:        activeSession.ID,
+			Device:    activeSession.Device,
+			Ip:        activeSession.IP,
+			CreatedAt: timestamppb.New(activeSession.CreatedAt),
+			LastSeen:  timestamppb.New(activeSession.LastSeen),
+			Current:   activeSession.Current,
+		})
+	}
+	return response, nil
+}
+
+func (h *GrpcAuthHandler) RevokeSession(ctx context.Context, in *gen.RevokeSessionRequest) (*gen.UpdateResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received RevokeSession request in microservice",
+		zap.String("request_id", requestID))
+
+	if err := h.validateCsrf(in.AuthHeader, in.SessionId); err != nil {
+		logger.AccessLogger.Warn("Failed to validate CSRF token", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	if err := h.sessionService.RevokeSession(ctx, in.SessionId, in.Id); err != nil {
+		logger.AccessLogger.Warn("Failed to revoke session",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	return &gen.UpdateResponse{
+		Response: "Session revoked",
+	}, nil
+}
+
+func (h *GrpcAuthHandler) RevokeOtherSessions(ctx context.Context, in *gen.RevokeOtherSessionsRequest) (*gen.UpdateResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received RevokeOtherSessions request in microservice",
+		zap.String("request_id", requestID))
+
+	if err := h.validateCsrf(in.AuthHeader, in.SessionId); err != nil {
+		logger.AccessLogger.Warn("Failed to validate CSRF token", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	if err := h.sessionService.RevokeOtherSessions(ctx, in.SessionId); err != nil {
+		logger.AccessLogger.Warn("Failed to revoke other sessions",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	return &gen.UpdateResponse{
+		Response: "Other sessions revoked",
+	}, nil
+}
+
+func (h *GrpcAuthHandler) ChangePassword(ctx context.Context, in *gen.ChangePasswordRequest) (*gen.UpdateResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received ChangePassword request in microservice",
+		zap.String("request_id", requestID))
+
+	if err := h.validateCsrf(in.AuthHeader, in.SessionId); err != nil {
+		logger.AccessLogger.Warn("Failed to validate CSRF token", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	userID, err := h.sessionService.GetUserID(ctx, in.SessionId)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to get user ID from session",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		return nil, errors.New("failed to get user ID")
+	}
+
+	if err = h.usecase.ChangePassword(ctx, userID, in.OldPassword, in.NewPassword); err != nil {
+		logger.AccessLogger.Warn("Failed to change password",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	if err = h.sessionService.RevokeUserSessions(ctx, userID); err != nil {
+		logger.AccessLogger.Warn("Failed to revoke sessions after password change",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	return &gen.UpdateResponse{
+		Response: "Password changed",
+	}, nil
+}
+
+func (h *GrpcAuthHandler) ForgotPassword(ctx context.Context, in *gen.ForgotPasswordRequest) (*gen.UpdateResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	sanitizer := bluemonday.UGCPolicy()
+	logger.AccessLogger.Info("Received ForgotPassword request in microservice",
+		zap.String("request_id", requestID))
+
+	if err := h.usecase.RequestPasswordReset(ctx, sanitizer.Sanitize(in.Email)); err != nil {
+		logger.AccessLogger.Warn("Failed to request password reset",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	return &gen.UpdateResponse{
+		Response: "If the email is registered, a reset link has been sent",
+	}, nil
+}
+
+func (h *GrpcAuthHandler) ResetPassword(ctx context.Context, in *gen.ResetPasswordRequest) (*gen.UpdateResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received ResetPassword request in microservice",
+		zap.String("request_id", requestID))
+
+	userID, err := h.usecase.ResetPassword(ctx, in.Token, in.NewPassword)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to reset password",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	if err = h.sessionService.RevokeUserSessions(ctx, userID); err != nil {
+		logger.AccessLogger.Warn("Failed to revoke sessions after password reset",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	return &gen.UpdateResponse{
+		Response: "Password reset",
+	}, nil
+}
+
+func (h *GrpcAuthHandler) VerifyEmail(ctx context.Context, in *gen.VerifyEmailRequest) (*gen.UpdateResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received VerifyEmail request in microservice",
+		zap.String("request_id", requestID))
+
+	userID, err := h.usecase.VerifyEmail(ctx, in.Token)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to verify email",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	// почта уже подтверждена в БД, сессии подхватят это при следующем входе
+	if err = h.sessionService.MarkEmailVerified(ctx, userID); err != nil {
+		logger.AccessLogger.Warn("Failed to update sessions after email verification",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+	}
+	return &gen.UpdateResponse{
+		Response: "Email verified",
+	}, nil
+}
+
+func (h *GrpcAuthHandler) ResendVerificationEmail(ctx context.Context, in *gen.ResendVerificationEmailRequest) (*gen.UpdateResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received ResendVerificationEmail request in microservice",
+		zap.String("request_id", requestID))
+
+	if err := h.validateCsrf(in.AuthHeader, in.SessionId); err != nil {
+		logger.AccessLogger.Warn("Failed to validate CSRF token", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	userID, err := h.sessionService.GetUserID(ctx, in.SessionId)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to get user ID from session",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		return nil, errors.New("failed to get user ID")
+	}
+	if err = h.usecase.ResendVerificationEmail(ctx, userID); err != nil {
+		logger.AccessLogger.Warn("Failed to resend verification email",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	return &gen.UpdateResponse{
+		Response: "Verification email sent",
+	}, nil
+}
+
+func (h *GrpcAuthHandler) SetupTOTP(ctx context.Context, in *gen.SetupTOTPRequest) (*gen.SetupTOTPResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received SetupTOTP request in microservice",
+		zap.String("request_id", requestID))
+
+	userID, err := h.sessionUserID(ctx, in.AuthHeader, in.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	setup, err := h.usecase.SetupTOTP(ctx, userID)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to set up TOTP",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	return &gen.SetupTOTPResponse{
+		Secret: setup.Secret,
+		Uri:    setup.URI,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) EnableTOTP(ctx context.Context, in *gen.TOTPCodeRequest) (*gen.RecoveryCodesResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received EnableTOTP request in microservice",
+		zap.String("request_id", requestID))
+
+	userID, err := h.sessionUserID(ctx, in.AuthHeader, in.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := h.usecase.EnableTOTP(ctx, userID, in.Code)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to enable TOTP",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	return &gen.RecoveryCodesResponse{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) DisableTOTP(ctx context.Context, in *gen.TOTPCodeRequest) (*gen.UpdateResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received DisableTOTP request in microservice",
+		zap.String("request_id", requestID))
+
+	userID, err := h.sessionUserID(ctx, in.AuthHeader, in.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.usecase.DisableTOTP(ctx, userID, in.Code); err != nil {
+		logger.AccessLogger.Warn("Failed to disable TOTP",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	return &gen.UpdateResponse{
+		Response: "Two-factor authentication disabled",
+	}, nil
+}
+
+func (h *GrpcAuthHandler) UnlockUser(ctx context.Context, in *gen.UnlockUserRequest) (*gen.UpdateResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received UnlockUser request in microservice",
+		zap.String("request_id", requestID))
+
+	if err := h.validateCsrf(in.AuthHeader, in.SessionId); err != nil {
+		logger.AccessLogger.Warn("Failed to validate CSRF token", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	if err := h.usecase.UnlockUser(ctx, in.UserId); err != nil {
+		logger.AccessLogger.Warn("Failed to unlock user",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+	return &gen.UpdateResponse{
+		Response: "User unlocked",
+	}, nil
+}
+
+// sessionUserID проверяет CSRF-токен и возвращает владельца сессии
+func (h *GrpcAuthHandler) sessionUserID(ctx context.Context, authHeader string, sessionID string) (string, error) {
+	requestID := middleware.GetRequestID(ctx)
+	if err := h.validateCsrf(authHeader, sessionID); err != nil {
+		logger.AccessLogger.Warn("Failed to validate CSRF token", zap.String("request_id", requestID), zap.Error(err))
+		return "", err
+	}
+	userID, err := h.sessionService.GetUserID(ctx, sessionID)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to get user ID from session",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		return "", errors.New("failed to get user ID")
+	}
+	return userID, nil
+}
+
+func (h *GrpcAuthHandler) validateCsrf(authHeader string, sessionID string) error {
+	if authHeader == "" {
+		return errors.New("missing X-CSRF-Token header")
+	}
+	if _, err := h.jwtToken.Validate(strings.TrimPrefix(authHeader, "Bearer "), sessionID); err != nil {
+		return errors.New("invalid JWT token")
+	}
+	return nil
+}
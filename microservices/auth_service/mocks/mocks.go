@@ -8,12 +8,15 @@ import (
 	"github.com/golang-jwt/jwt"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
+	"time"
 )
 
 type MockJwtTokenService struct {
-	MockCreate            func(session_id string, tokenExpTime int64) (string, error)
-	MockValidate          func(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error)
-	MockParseSecretGetter func(token *jwt.Token) (interface{}, error)
+	MockCreate             func(session_id string, tokenExpTime int64) (string, error)
+	MockValidate           func(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error)
+	MockParseSecretGetter  func(token *jwt.Token) (interface{}, error)
+	MockCreateEmailToken   func(userID string, email string, tokenExpTime int64) (string, error)
+	MockValidateEmailToken func(tokenString string) (*middleware.JwtEmailClaims, error)
 }
 
 func (m *MockJwtTokenService) Create(session_id string, tokenExpTime int64) (string, error) {
@@ -28,11 +31,26 @@ func (m *MockJwtTokenService) ParseSecretGetter(token *jwt.Token) (interface{},
 	return m.MockParseSecretGetter(token)
 }
 
+func (m *MockJwtTokenService) CreateEmailToken(userID string, email string, tokenExpTime int64) (string, error) {
+	return m.MockCreateEmailToken(userID, email, tokenExpTime)
+}
+
+func (m *MockJwtTokenService) ValidateEmailToken(tokenString string) (*middleware.JwtEmailClaims, error) {
+	return m.MockValidateEmailToken(tokenString)
+}
+
 type MockServiceSession struct {
-	MockGetUserID      func(ctx context.Context, sessionID string) (string, error)
-	MockLogoutSession  func(ctx context.Context, sessionID string) error
-	MockCreateSession  func(ctx context.Context, user *domain.User) (string, error)
-	MockGetSessionData func(ctx context.Context, sessionID string) (*domain.SessionData, error)
+	MockGetUserID           func(ctx context.Context, sessionID string) (string, error)
+	MockLogoutSession       func(ctx context.Context, sessionID string) error
+	MockCreateSession       func(ctx context.Context, user *domain.User, client domain.SessionClient) (string, error)
+	MockGetSessionData      func(ctx context.Context, sessionID string) (*domain.SessionData, error)
+	MockGetUserSessions     func(ctx context.Context, sessionID string) ([]domain.ActiveSession, error)
+	MockRevokeSession       func(ctx context.Context, sessionID string, publicID string) error
+	MockRevokeOtherSessions func(ctx context.Context, sessionID string) error
+	MockRevokeUserSessions  func(ctx context.Context, userID string) error
+	MockMarkEmailVerified   func(ctx context.Context, userID string) error
+	MockMarkEmailUnverified func(ctx context.Context, userID string) error
+	MockUpdateUserRole      func(ctx context.Context, userID string, role string) error
 }
 
 func (m *MockServiceSession) GetUserID(ctx context.Context, sessionID string) (string, error) {
@@ -43,30 +61,71 @@ func (m *MockServiceSession) LogoutSession(ctx context.Context, sessionID string
 	return m.MockLogoutSession(ctx, sessionID)
 }
 
-func (m *MockServiceSession) CreateSession(ctx context.Context, user *domain.User) (string, error) {
-	return m.MockCreateSession(ctx, user)
+func (m *MockServiceSession) CreateSession(ctx context.Context, user *domain.User, client domain.SessionClient) (string, error) {
+	return m.MockCreateSession(ctx, user, client)
 }
 
 func (m *MockServiceSession) GetSessionData(ctx context.Context, sessionID string) (*domain.SessionData, error) {
 	return m.MockGetSessionData(ctx, sessionID)
 }
 
+func (m *MockServiceSession) GetUserSessions(ctx context.Context, sessionID string) ([]domain.ActiveSession, error) {
+	return m.MockGetUserSessions(ctx, sessionID)
+}
+
+func (m *MockServiceSession) RevokeSession(ctx context.Context, sessionID string, publicID string) error {
+	return m.MockRevokeSession(ctx, sessionID, publicID)
+}
+
+func (m *MockServiceSession) RevokeOtherSessions(ctx context.Context, sessionID string) error {
+	return m.MockRevokeOtherSessions(ctx, sessionID)
+}
+
+func (m *MockServiceSession) RevokeUserSessions(ctx context.Context, userID string) error {
+	return m.MockRevokeUserSessions(ctx, userID)
+}
+
+func (m *MockServiceSession) MarkEmailVerified(ctx context.Context, userID string) error {
+	return m.MockMarkEmailVerified(ctx, userID)
+}
+
+func (m *MockServiceSession) MarkEmailUnverified(ctx context.Context, userID string) error {
+	return m.MockMarkEmailUnverified(ctx, userID)
+}
+
+func (m *MockServiceSession) UpdateUserRole(ctx context.Context, userID string, role string) error {
+	return m.MockUpdateUserRole(ctx, userID, role)
+}
+
 type MockAuthUseCase struct {
-	MockRegisterUser      func(ctx context.Context, creds *domain.User) error
-	MockLoginUser         func(ctx context.Context, creds *domain.User) (*domain.User, error)
-	MockPutUser           func(ctx context.Context, creds *domain.User, userID string, avatar []byte) error
-	MockGetAllUser        func(ctx context.Context) ([]domain.User, error)
-	MockGetUserById       func(ctx context.Context, userID string) (*domain.User, error)
-	MockUpdateUserRegions func(ctx context.Context, regions domain.UpdateUserRegion, userId string) error
-	MockDeleteUserRegion  func(ctx context.Context, regionName string, userID string) error
+	MockRegisterUser            func(ctx context.Context, creds *domain.User) error
+	MockLoginUser               func(ctx context.Context, creds *domain.User, ip string) (*domain.User, error)
+	MockPutUser                 func(ctx context.Context, creds *domain.User, userID string, avatar []byte) error
+	MockGetAllUser              func(ctx context.Context) ([]domain.User, error)
+	MockGetUserById             func(ctx context.Context, userID string) (*domain.User, error)
+	MockUpdateUserRegions       func(ctx context.Context, regions domain.UpdateUserRegion, userId string) error
+	MockDeleteUserRegion        func(ctx context.Context, regionName string, userID string) error
+	MockChangePassword          func(ctx context.Context, userID string, oldPassword string, newPassword string) error
+	MockRequestPasswordReset    func(ctx context.Context, email string) error
+	MockResetPassword           func(ctx context.Context, token string, newPassword string) (string, error)
+	MockVerifyEmail             func(ctx context.Context, token string) (string, error)
+	MockResendVerificationEmail func(ctx context.Context, userID string) error
+	MockSetupTOTP               func(ctx context.Context, userID string) (*domain.TOTPSetupResponse, error)
+	MockEnableTOTP              func(ctx context.Context, userID string, code string) ([]string, error)
+	MockDisableTOTP             func(ctx context.Context, userID string, code string) error
+	MockCreateLoginChallenge    func(ctx context.Context, userID string) (string, error)
+	MockVerifySecondFactor      func(ctx context.Context, challenge string, code string, ip string) (*domain.User, error)
+	MockUnlockUser              func(ctx context.Context, userID string) error
+	MockStartOIDCLogin          func(ctx context.Context) (string, string, error)
+	MockFinishOIDCLogin         func(ctx context.Context, code string, state string) (*domain.User, error)
 }
 
 func (m *MockAuthUseCase) RegisterUser(ctx context.Context, creds *domain.User) error {
 	return m.MockRegisterUser(ctx, creds)
 }
 
-func (m *MockAuthUseCase) LoginUser(ctx context.Context, creds *domain.User) (*domain.User, error) {
-	return m.MockLoginUser(ctx, creds)
+func (m *MockAuthUseCase) LoginUser(ctx context.Context, creds *domain.User, ip string) (*domain.User, error) {
+	return m.MockLoginUser(ctx, creds, ip)
 }
 
 func (m *MockAuthUseCase) PutUser(ctx context.Context, creds *domain.User, userID string, avatar []byte) error {
@@ -89,16 +148,78 @@ func (m *MockAuthUseCase) GetUserById(ctx context.Context, userID string) (*doma
 	return m.MockGetUserById(ctx, userID)
 }
 
+func (m *MockAuthUseCase) ChangePassword(ctx context.Context, userID string, oldPassword string, newPassword string) error {
+	return m.MockChangePassword(ctx, userID, oldPassword, newPassword)
+}
+
+func (m *MockAuthUseCase) RequestPasswordReset(ctx context.Context, email string) error {
+	return m.MockRequestPasswordReset(ctx, email)
+}
+
+func (m *MockAuthUseCase) ResetPassword(ctx context.Context, token string, newPassword string) (string, error) {
+	return m.MockResetPassword(ctx, token, newPassword)
+}
+
+func (m *MockAuthUseCase) VerifyEmail(ctx context.Context, token string) (string, error) {
+	return m.MockVerifyEmail(ctx, token)
+}
+
+func (m *MockAuthUseCase) ResendVerificationEmail(ctx context.Context, userID string) error {
+	return m.MockResendVerificationEmail(ctx, userID)
+}
+
+func (m *MockAuthUseCase) SetupTOTP(ctx context.Context, userID string) (*domain.TOTPSetupResponse, error) {
+	return m.MockSetupTOTP(ctx, userID)
+}
+
+func (m *MockAuthUseCase) EnableTOTP(ctx context.Context, userID string, code string) ([]string, error) {
+	return m.MockEnableTOTP(ctx, userID, code)
+}
+
+func (m *MockAuthUseCase) DisableTOTP(ctx context.Context, userID string, code string) error {
+	return m.MockDisableTOTP(ctx, userID, code)
+}
+
+func (m *MockAuthUseCase) CreateLoginChallenge(ctx context.Context, userID string) (string, error) {
+	return m.MockCreateLoginChallenge(ctx, userID)
+}
+
+func (m *MockAuthUseCase) VerifySecondFactor(ctx context.Context, challenge string, code string, ip string) (*domain.User, error) {
+	return m.MockVerifySecondFactor(ctx, challenge, code, ip)
+}
+
+func (m *MockAuthUseCase) UnlockUser(ctx context.Context, userID string) error {
+	return m.MockUnlockUser(ctx, userID)
+}
+
+func (m *MockAuthUseCase) StartOIDCLogin(ctx context.Context) (string, string, error) {
+	return m.MockStartOIDCLogin(ctx)
+}
+
+func (m *MockAuthUseCase) FinishOIDCLogin(ctx context.Context, code string, state string) (*domain.User, error) {
+	return m.MockFinishOIDCLogin(ctx, code, state)
+}
+
 type MockAuthRepository struct {
-	GetUserByNameFunc    func(ctx context.Context, username string) (*domain.User, error)
-	CreateUserFunc       func(ctx context.Context, user *domain.User) error
-	SaveUserFunc         func(ctx context.Context, user *domain.User) error
-	PutUserFunc          func(ctx context.Context, user *domain.User, userID string) error
-	GetAllUserFunc       func(ctx context.Context) ([]domain.User, error)
-	GetUserByIdFunc      func(ctx context.Context, userID string) (*domain.User, error)
-	MockGetUserByEmail   func(ctx context.Context, email string) (*domain.User, error)
-	MockUpdateUserRegion func(ctx context.Context, region domain.UpdateUserRegion, userId string) error
-	MockDeleteUserRegion func(ctx context.Context, regionName string, userId string) error
+	GetUserByNameFunc      func(ctx context.Context, username string) (*domain.User, error)
+	CreateUserFunc         func(ctx context.Context, user *domain.User) error
+	SaveUserFunc           func(ctx context.Context, user *domain.User) error
+	PutUserFunc            func(ctx context.Context, user *domain.User, userID string) error
+	GetAllUserFunc         func(ctx context.Context) ([]domain.User, error)
+	GetUserByIdFunc        func(ctx context.Context, userID string) (*domain.User, error)
+	MockGetUserByEmail     func(ctx context.Context, email string) (*domain.User, error)
+	MockUpdateUserRegion   func(ctx context.Context, region domain.UpdateUserRegion, userId string) error
+	MockDeleteUserRegion   func(ctx context.Context, regionName string, userId string) error
+	MockUpdatePassword     func(ctx context.Context, userID string, hashedPassword string) error
+	MockMarkEmailVerified  func(ctx context.Context, userID string, email string) error
+	MockSetTOTPSecret      func(ctx context.Context, userID string, secret string) error
+	MockEnableTOTP         func(ctx context.Context, userID string, recoveryCodeHashes []string) error
+	MockDisableTOTP        func(ctx context.Context, userID string) error
+	MockUseRecoveryCode    func(ctx context.Context, userID string, codeHash string) error
+	MockUseTOTPStep        func(ctx context.Context, userID string, step int64) error
+	MockGetUserByIdentity  func(ctx context.Context, provider string, subject string) (*domain.User, error)
+	MockCreateIdentityUser func(ctx context.Context, user *domain.User, provider string, subject string) error
+	MockLinkIdentity       func(ctx context.Context, userID string, provider string, subject string) error
 }
 
 func (m *MockAuthRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
@@ -137,6 +258,98 @@ func (m *MockAuthRepository) DeleteUserRegion(ctx context.Context, regionName st
 	return m.MockDeleteUserRegion(ctx, regionName, userId)
 }
 
+func (m *MockAuthRepository) UpdatePassword(ctx context.Context, userID string, hashedPassword string) error {
+	return m.MockUpdatePassword(ctx, userID, hashedPassword)
+}
+
+func (m *MockAuthRepository) MarkEmailVerified(ctx context.Context, userID string, email string) error {
+	return m.MockMarkEmailVerified(ctx, userID, email)
+}
+
+func (m *MockAuthRepository) SetTOTPSecret(ctx context.Context, userID string, secret string) error {
+	return m.MockSetTOTPSecret(ctx, userID, secret)
+}
+
+func (m *MockAuthRepository) EnableTOTP(ctx context.Context, userID string, recoveryCodeHashes []string) error {
+	return m.MockEnableTOTP(ctx, userID, recoveryCodeHashes)
+}
+
+func (m *MockAuthRepository) DisableTOTP(ctx context.Context, userID string) error {
+	return m.MockDisableTOTP(ctx, userID)
+}
+
+func (m *MockAuthRepository) UseRecoveryCode(ctx context.Context, userID string, codeHash string) error {
+	return m.MockUseRecoveryCode(ctx, userID, codeHash)
+}
+
+func (m *MockAuthRepository) UseTOTPStep(ctx context.Context, userID string, step int64) error {
+	return m.MockUseTOTPStep(ctx, userID, step)
+}
+
+func (m *MockAuthRepository) GetUserByIdentity(ctx context.Context, provider string, subject string) (*domain.User, error) {
+	return m.MockGetUserByIdentity(ctx, provider, subject)
+}
+
+func (m *MockAuthRepository) CreateIdentityUser(ctx context.Context, user *domain.User, provider string, subject string) error {
+	return m.MockCreateIdentityUser(ctx, user, provider, subject)
+}
+
+func (m *MockAuthRepository) LinkIdentity(ctx context.Context, userID string, provider string, subject string) error {
+	return m.MockLinkIdentity(ctx, userID, provider, subject)
+}
+
+type MockTokenStore struct {
+	MockCreateToken  func(ctx context.Context, purpose string, userID string, ttl time.Duration) (string, error)
+	MockConsumeToken func(ctx context.Context, purpose string, token string) (string, error)
+}
+
+func (m *MockTokenStore) CreateToken(ctx context.Context, purpose string, userID string, ttl time.Duration) (string, error) {
+	return m.MockCreateToken(ctx, purpose, userID, ttl)
+}
+
+func (m *MockTokenStore) ConsumeToken(ctx context.Context, purpose string, token string) (string, error) {
+	return m.MockConsumeToken(ctx, purpose, token)
+}
+
+type MockLoginLimiter struct {
+	MockCheck           func(ctx context.Context, username string, ip string) (time.Duration, error)
+	MockRegisterFailure func(ctx context.Context, username string, ip string) error
+	MockReset           func(ctx context.Context, username string) error
+}
+
+func (m *MockLoginLimiter) Check(ctx context.Context, username string, ip string) (time.Duration, error) {
+	return m.MockCheck(ctx, username, ip)
+}
+
+func (m *MockLoginLimiter) RegisterFailure(ctx context.Context, username string, ip string) error {
+	return m.MockRegisterFailure(ctx, username, ip)
+}
+
+func (m *MockLoginLimiter) Reset(ctx context.Context, username string) error {
+	return m.MockReset(ctx, username)
+}
+
+type MockOIDCStateStore struct {
+	MockSave    func(ctx context.Context, state string, data domain.OIDCState, ttl time.Duration) error
+	MockConsume func(ctx context.Context, state string) (*domain.OIDCState, error)
+}
+
+func (m *MockOIDCStateStore) Save(ctx context.Context, state string, data domain.OIDCState, ttl time.Duration) error {
+	return m.MockSave(ctx, state, data, ttl)
+}
+
+func (m *MockOIDCStateStore) Consume(ctx context.Context, state string) (*domain.OIDCState, error) {
+	return m.MockConsume(ctx, state)
+}
+
+type MockMailSender struct {
+	MockSend func(ctx context.Context, to string, subject string, body string) error
+}
+
+func (m *MockMailSender) Send(ctx context.Context, to string, subject string, body string) error {
+	return m.MockSend(ctx, to, subject, body)
+}
+
 type MockMinioService struct {
 	UploadFileFunc func(file []byte, contentType string, id string) (string, error)
 	DeleteFileFunc func(path string) error
@@ -174,7 +387,7 @@ func (m *MockGrpcClient) GetUserById(ctx context.Context, in *gen.GetUserByIdReq
 	args := m.Called(ctx, in, opts)
 	return args.Get(0).(*gen.GetUserByIdResponse), args.Error(1)
 }
-func (m *MockGrpcClient) GetAllUsers(ctx context.Context, in *gen.Empty, opts ...grpc.CallOption) (*gen.AllUsersResponse, error) {
+func (m *MockGrpcClient) GetAllUsers(ctx context.Context, in *gen.GetAllUsersRequest, opts ...grpc.CallOption) (*gen.AllUsersResponse, error) {
 	args := m.Called(ctx, in, opts)
 	return args.Get(0).(*gen.AllUsersResponse), args.Error(1)
 }
@@ -196,3 +409,78 @@ func (m *MockGrpcClient) DeleteUserRegions(ctx context.Context, in *gen.DeleteUs
 	args := m.Called(ctx, in, opts)
 	return args.Get(0).(*gen.UpdateResponse), args.Error(1)
 }
+
+func (m *MockGrpcClient) GetSessions(ctx context.Context, in *gen.GetSessionsRequest, opts ...grpc.CallOption) (*gen.SessionsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.SessionsResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) RevokeSession(ctx context.Context, in *gen.RevokeSessionRequest, opts ...grpc.CallOption) (*gen.UpdateResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.UpdateResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) RevokeOtherSessions(ctx context.Context, in *gen.RevokeOtherSessionsRequest, opts ...grpc.CallOption) (*gen.UpdateResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.UpdateResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) ChangePassword(ctx context.Context, in *gen.ChangePasswordRequest, opts ...grpc.CallOption) (*gen.UpdateResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.UpdateResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) ForgotPassword(ctx context.Context, in *gen.ForgotPasswordRequest, opts ...grpc.CallOption) (*gen.UpdateResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.UpdateResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) ResetPassword(ctx context.Context, in *gen.ResetPasswordRequest, opts ...grpc.CallOption) (*gen.UpdateResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.UpdateResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) VerifyEmail(ctx context.Context, in *gen.VerifyEmailRequest, opts ...grpc.CallOption) (*gen.UpdateResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.UpdateResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) ResendVerificationEmail(ctx context.Context, in *gen.ResendVerificationEmailRequest, opts ...grpc.CallOption) (*gen.UpdateResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.UpdateResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) VerifySecondFactor(ctx context.Context, in *gen.VerifySecondFactorRequest, opts ...grpc.CallOption) (*gen.UserResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.UserResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) SetupTOTP(ctx context.Context, in *gen.SetupTOTPRequest, opts ...grpc.CallOption) (*gen.SetupTOTPResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.SetupTOTPResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) EnableTOTP(ctx context.Context, in *gen.TOTPCodeRequest, opts ...grpc.CallOption) (*gen.RecoveryCodesResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.RecoveryCodesResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) DisableTOTP(ctx context.Context, in *gen.TOTPCodeRequest, opts ...grpc.CallOption) (*gen.UpdateResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.UpdateResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) UnlockUser(ctx context.Context, in *gen.UnlockUserRequest, opts ...grpc.CallOption) (*gen.UpdateResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.UpdateResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) StartOIDCLogin(ctx context.Context, in *gen.StartOIDCLoginRequest, opts ...grpc.CallOption) (*gen.StartOIDCLoginResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.StartOIDCLoginResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) FinishOIDCLogin(ctx context.Context, in *gen.FinishOIDCLoginRequest, opts ...grpc.CallOption) (*gen.UserResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.UserResponse), args.Error(1)
+}
@@ -84,7 +84,16 @@ func (r *authRepository) PutUser(ctx context.Context, creds *domain.User, userID
 		metrics.RepoRequestDuration.WithLabelValues("PutUser").Observe(duration)
 	}()
 
-	if err := r.db.Model(&domain.User{}).Where("UUID = ?", userID).Updates(creds).Error; err != nil {
+	// новый адрес нужно подтвердить заново
+	if creds.Email != "" {
+		if err := r.db.Exec("UPDATE users SET \"emailVerified\" = false WHERE uuid = ? AND email <> ?", userID, creds.Email).Error; err != nil {
+			logger.DBLogger.Error("Error resetting email verification", zap.String("request_id", requestID), zap.String("userID", userID), zap.Error(err))
+			return errors.New("error updating user")
+		}
+	}
+
+	// пароль хранится хешем и меняется только через ChangePassword
+	if err := r.db.Model(&domain.User{}).Where("UUID = ?", userID).Omit("password").Updates(creds).Error; err != nil {
 		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
 			logger.DBLogger.Warn("Unique constraint violation", zap.String("request_id", requestID), zap.String("userID", userID), zap.Error(err))
 			return errors.New("username or email already exists")
@@ -302,3 +311,344 @@ func (r *authRepository) DeleteUserRegion(ctx context.Context, regionName string
 
 	return nil
 }
+
+func (r *authRepository) UpdatePassword(ctx context.Context, userID string, hashedPassword string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("UpdatePassword called", zap.String("request_id", requestID), zap.String("userID", userID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("UpdatePassword", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("UpdatePassword", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("UpdatePassword").Observe(duration)
+	}()
+
+	result := r.db.WithContext(ctx).Model(&domain.User{}).Where("uuid = ?", userID).Update("password", hashedPassword)
+	if result.Error != nil {
+		logger.DBLogger.Error("Error updating password", zap.String("request_id", requestID), zap.String("userID", userID), zap.Error(result.Error))
+		err = errors.New("error updating user")
+		return err
+	}
+	if result.RowsAffected == 0 {
+		err = errors.New("user not found")
+		return err
+	}
+
+	logger.DBLogger.Info("Successfully updated password", zap.String("request_id", requestID), zap.String("userID", userID))
+	return nil
+}
+
+func (r *authRepository) MarkEmailVerified(ctx context.Context, userID string, email string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("MarkEmailVerified called", zap.String("request_id", requestID), zap.String("userID", userID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("MarkEmailVerified", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("MarkEmailVerified", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("MarkEmailVerified").Observe(duration)
+	}()
+
+	result := r.db.WithContext(ctx).Exec("UPDATE users SET \"emailVerified\" = true WHERE uuid = ? AND email = ?", userID, email)
+	if result.Error != nil {
+		logger.DBLogger.Error("Error verifying email", zap.String("request_id", requestID), zap.String("userID", userID), zap.Error(result.Error))
+		err = errors.New("error updating user")
+		return err
+	}
+	// письмо отправлено на адрес, который с тех пор сменился
+	if result.RowsAffected == 0 {
+		err = errors.New("invalid or expired token")
+		return err
+	}
+
+	logger.DBLogger.Info("Successfully verified email", zap.String("request_id", requestID), zap.String("userID", userID))
+	return nil
+}
+
+func (r *authRepository) SetTOTPSecret(ctx context.Context, userID string, secret string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("SetTOTPSecret called", zap.String("request_id", requestID), zap.String("userID", userID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("SetTOTPSecret", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("SetTOTPSecret", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("SetTOTPSecret").Observe(duration)
+	}()
+
+	// включённый второй фактор так перезаписать нельзя, только через DisableTOTP
+	result := r.db.WithContext(ctx).Exec("UPDATE users SET \"totpSecret\" = ? WHERE uuid = ? AND \"totpEnabled\" = false", secret, userID)
+	if result.Error != nil {
+		logger.DBLogger.Error("Error saving TOTP secret", zap.String("request_id", requestID), zap.String("userID", userID), zap.Error(result.Error))
+		err = errors.New("error updating user")
+		return err
+	}
+	if result.RowsAffected == 0 {
+		err = errors.New("two-factor authentication already enabled")
+		return err
+	}
+
+	logger.DBLogger.Info("Successfully saved TOTP secret", zap.String("request_id", requestID), zap.String("userID", userID))
+	return nil
+}
+
+func (r *authRepository) EnableTOTP(ctx context.Context, userID string, recoveryCodeHashes []string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("EnableTOTP called", zap.String("request_id", requestID), zap.String("userID", userID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("EnableTOTP", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("EnableTOTP", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("EnableTOTP").Observe(duration)
+	}()
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec("UPDATE users SET \"totpEnabled\" = true WHERE uuid = ? AND \"totpSecret\" <> ''", userID)
+		if result.Error != nil {
+			logger.DBLogger.Error("Error enabling TOTP", zap.String("request_id", requestID), zap.String("userID", userID), zap.Error(result.Error))
+			return errors.New("error updating user")
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("two-factor setup not started")
+		}
+		if err := tx.Where("\"userId\" = ?", userID).Delete(&domain.RecoveryCode{}).Error; err != nil {
+			logger.DBLogger.Error("Error deleting recovery codes", zap.String("request_id", requestID), zap.String("userID", userID), zap.Error(err))
+			return errors.New("error saving recovery codes")
+		}
+		codes := make([]domain.RecoveryCode, 0, len(recoveryCodeHashes))
+		for _, hash := range recoveryCodeHashes {
+			codes = append(codes, domain.RecoveryCode{UserID: userID, CodeHash: hash})
+		}
+		if err := tx.Omit("User").Create(&codes).Error; err != nil {
+			logger.DBLogger.Error("Error saving recovery codes", zap.String("request_id", requestID), zap.String("userID", userID), zap.Error(err))
+			return errors.New("error saving recovery codes")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.DBLogger.Info("Successfully enabled TOTP", zap.String("request_id", requestID), zap.String("userID", userID))
+	return nil
+}
+
+func (r *authRepository) DisableTOTP(ctx context.Context, userID string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("DisableTOTP called", zap.String("request_id", requestID), zap.String("userID", userID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("DisableTOTP", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("DisableTOTP", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("DisableTOTP").Observe(duration)
+	}()
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("UPDATE users SET \"totpEnabled\" = false, \"totpSecret\" = '' WHERE uuid = ?", userID).Error; err != nil {
+			logger.DBLogger.Error("Error disabling TOTP", zap.String("request_id", requestID), zap.String("userID", userID), zap.Error(err))
+			return errors.New("error updating user")
+		}
+		if err := tx.Where("\"userId\" = ?", userID).Delete(&domain.RecoveryCode{}).Error; err != nil {
+			logger.DBLogger.Error("Error deleting recovery codes", zap.String("request_id", requestID), zap.String("userID", userID), zap.Error(err))
+			return errors.New("error updating user")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.DBLogger.Info("Successfully disabled TOTP", zap.String("request_id", requestID), zap.String("userID", userID))
+	return nil
+}
+
+func (r *authRepository) UseRecoveryCode(ctx context.Context, userID string, codeHash string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("UseRecoveryCode called", zap.String("request_id", requestID), zap.String("userID", userID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("UseRecoveryCode", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("UseRecoveryCode", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("UseRecoveryCode").Observe(duration)
+	}()
+
+	result := r.db.WithContext(ctx).Exec("UPDATE recovery_codes SET \"usedAt\" = ? WHERE \"userId\" = ? AND \"codeHash\" = ? AND \"usedAt\" IS NULL", time.Now(), userID, codeHash)
+	if result.Error != nil {
+		logger.DBLogger.Error("Error using recovery code", zap.String("request_id", requestID), zap.String("userID", userID), zap.Error(result.Error))
+		err = errors.New("error updating user")
+		return err
+	}
+	if result.RowsAffected == 0 {
+		err = errors.New("invalid code")
+		return err
+	}
+
+	logger.DBLogger.Info("Recovery code used", zap.String("request_id", requestID), zap.String("userID", userID))
+	return nil
+}
+
+func (r *authRepository) UseTOTPStep(ctx context.Context, userID string, step int64) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("UseTOTPStep called", zap.String("request_id", requestID), zap.String("userID", userID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("UseTOTPStep", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("UseTOTPStep", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("UseTOTPStep").Observe(duration)
+	}()
+
+	// сравнение и запись одним запросом, чтобы один код не прошёл в двух параллельных запросах
+	result := r.db.WithContext(ctx).Exec("UPDATE users SET \"totpLastStep\" = ? WHERE uuid = ? AND \"totpLastStep\" < ?", step, userID, step)
+	if result.Error != nil {
+		logger.DBLogger.Error("Error saving TOTP step", zap.String("request_id", requestID), zap.String("userID", userID), zap.Error(result.Error))
+		err = errors.New("error updating user")
+		return err
+	}
+	if result.RowsAffected == 0 {
+		err = errors.New("invalid code")
+		return err
+	}
+
+	logger.DBLogger.Info("TOTP step used", zap.String("request_id", requestID), zap.String("userID", userID))
+	return nil
+}
+
+func (r *authRepository) GetUserByIdentity(ctx context.Context, provider string, subject string) (*domain.User, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("GetUserByIdentity called", zap.String("request_id", requestID), zap.String("provider", provider))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("GetUserByIdentity", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("GetUserByIdentity", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("GetUserByIdentity").Observe(duration)
+	}()
+
+	var user domain.User
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN user_identities ON user_identities.\"userId\" = users.uuid").
+		Where("user_identities.provider = ? AND user_identities.subject = ?", provider, subject).
+		First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.DBLogger.Info("Identity is not linked", zap.String("request_id", requestID), zap.String("provider", provider))
+			return nil, errors.New("user not found")
+		}
+		logger.DBLogger.Error("Error fetching user by identity", zap.String("request_id", requestID), zap.String("provider", provider), zap.Error(err))
+		return nil, errors.New("error fetching user by identity")
+	}
+
+	logger.DBLogger.Info("Successfully fetched user by identity", zap.String("request_id", requestID), zap.String("userID", user.UUID))
+	return &user, nil
+}
+
+func (r *authRepository) CreateIdentityUser(ctx context.Context, user *domain.User, provider string, subject string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("CreateIdentityUser called", zap.String("request_id", requestID), zap.String("username", user.Username), zap.String("provider", provider))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("CreateIdentityUser", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("CreateIdentityUser", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("CreateIdentityUser").Observe(duration)
+	}()
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+				logger.DBLogger.Warn("Unique constraint violation", zap.String("request_id", requestID), zap.String("username", user.Username), zap.Error(err))
+				return errors.New("username or email already exists")
+			}
+			logger.DBLogger.Error("Error creating user", zap.String("request_id", requestID), zap.String("username", user.Username), zap.Error(err))
+			return errors.New("error creating user")
+		}
+		// почту уже подтвердил провайдер
+		if err := tx.Exec("UPDATE users SET \"emailVerified\" = true WHERE uuid = ?", user.UUID).Error; err != nil {
+			logger.DBLogger.Error("Error verifying email", zap.String("request_id", requestID), zap.String("userID", user.UUID), zap.Error(err))
+			return errors.New("error creating user")
+		}
+		identity := domain.UserIdentity{UserID: user.UUID, Provider: provider, Subject: subject}
+		if err := tx.Omit("User").Create(&identity).Error; err != nil {
+			logger.DBLogger.Error("Error linking identity", zap.String("request_id", requestID), zap.String("userID", user.UUID), zap.Error(err))
+			return errors.New("error linking identity")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	user.EmailVerified = true
+
+	logger.DBLogger.Info("Successfully created identity user", zap.String("request_id", requestID), zap.String("userID", user.UUID))
+	return nil
+}
+
+func (r *authRepository) LinkIdentity(ctx context.Context, userID string, provider string, subject string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("LinkIdentity called", zap.String("request_id", requestID), zap.String("userID", userID), zap.String("provider", provider))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("LinkIdentity", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("LinkIdentity", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("LinkIdentity").Observe(duration)
+	}()
+
+	identity := domain.UserIdentity{UserID: userID, Provider: provider, Subject: subject}
+	if err = r.db.WithContext(ctx).Omit("User").Create(&identity).Error; err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+			logger.DBLogger.Warn("Identity already linked", zap.String("request_id", requestID), zap.String("userID", userID), zap.Error(err))
+			err = errors.New("identity already linked")
+			return err
+		}
+		logger.DBLogger.Error("Error linking identity", zap.String("request_id", requestID), zap.String("userID", userID), zap.Error(err))
+		err = errors.New("error linking identity")
+		return err
+	}
+
+	logger.DBLogger.Info("Successfully linked identity", zap.String("request_id", requestID), zap.String("userID", userID))
+	return nil
+}
@@ -526,16 +526,18 @@ func TestAuthRepository_PutUser(t *testing.T) {
 		Birthdate:  time.Now(),
 		IsHost:     true,
 	}
+	// смена почты сбрасывает её подтверждение
+	resetVerification := regexp.QuoteMeta(`UPDATE users SET "emailVerified" = false WHERE uuid = $1 AND email <> $2`)
 
-	// Тест-кейс 1: Успешное обновление пользователя
+	// Тест-кейс 1: Успешное обновление пользователя, пароль не записывается
 	t.Run("Successfully update user", func(t *testing.T) {
+		mock.ExpectExec(resetVerification).WithArgs(userID, creds.Email).WillReturnResult(sqlmock.NewResult(0, 0))
 		mock.ExpectBegin()
 
 		// Первый UPDATE запрос
-		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "username"=$1,"password"=$2,"email"=$3,"name"=$4,"score"=$5,"avatar"=$6,"sex"=$7,"guestCount"=$8,"birthDate"=$9,"isHost"=$10 WHERE UUID = $11`)).
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "username"=$1,"email"=$2,"name"=$3,"score"=$4,"avatar"=$5,"sex"=$6,"guestCount"=$7,"birthDate"=$8,"isHost"=$9 WHERE UUID = $10`)).
 			WithArgs(
 				creds.Username,
-				creds.Password,
 				creds.Email,
 				creds.Name,
 				creds.Score,
@@ -564,11 +566,11 @@ func TestAuthRepository_PutUser(t *testing.T) {
 
 	// Тест-кейс 2: Ошибка обновления пользователя
 	t.Run("Error updating user", func(t *testing.T) {
+		mock.ExpectExec(resetVerification).WithArgs(userID, creds.Email).WillReturnResult(sqlmock.NewResult(0, 0))
 		mock.ExpectBegin()
-		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "username"=$1,"password"=$2,"email"=$3,"name"=$4,"score"=$5,"avatar"=$6,"sex"=$7,"guestCount"=$8,"birthDate"=$9,"isHost"=$10 WHERE UUID = $11`)).
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "username"=$1,"email"=$2,"name"=$3,"score"=$4,"avatar"=$5,"sex"=$6,"guestCount"=$7,"birthDate"=$8,"isHost"=$9 WHERE UUID = $10`)).
 			WithArgs(
 				creds.Username,
-				creds.Password,
 				creds.Email,
 				creds.Name,
 				creds.Score,
@@ -589,11 +591,11 @@ func TestAuthRepository_PutUser(t *testing.T) {
 
 	// Тест-кейс 3: Ошибка обновления пользователя
 	t.Run("Error on Update isHost", func(t *testing.T) {
+		mock.ExpectExec(resetVerification).WithArgs(userID, creds.Email).WillReturnResult(sqlmock.NewResult(0, 0))
 		mock.ExpectBegin()
-		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "username"=$1,"password"=$2,"email"=$3,"name"=$4,"score"=$5,"avatar"=$6,"sex"=$7,"guestCount"=$8,"birthDate"=$9,"isHost"=$10 WHERE UUID = $11`)).
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "username"=$1,"email"=$2,"name"=$3,"score"=$4,"avatar"=$5,"sex"=$6,"guestCount"=$7,"birthDate"=$8,"isHost"=$9 WHERE UUID = $10`)).
 			WithArgs(
 				creds.Username,
-				creds.Password,
 				creds.Email,
 				creds.Name,
 				creds.Score,
@@ -706,3 +708,315 @@ func TestGetUserByEmail(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
+func TestAuthRepository_UpdatePassword(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock := setupTestDB(t)
+	repo := NewAuthRepository(db)
+	ctx := context.TODO()
+	query := regexp.QuoteMeta(`UPDATE "users" SET "password"=$1 WHERE uuid = $2`)
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(query).WithArgs("hashed", "test-uuid").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.UpdatePassword(ctx, "test-uuid", "hashed")
+		assert.NoError(t, err)
+	})
+
+	t.Run("User Not Found", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(query).WithArgs("hashed", "missing").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := repo.UpdatePassword(ctx, "missing", "hashed")
+		assert.EqualError(t, err, "user not found")
+	})
+
+	t.Run("Database Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(query).WithArgs("hashed", "test-uuid").WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		err := repo.UpdatePassword(ctx, "test-uuid", "hashed")
+		assert.EqualError(t, err, "error updating user")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthRepository_MarkEmailVerified(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock := setupTestDB(t)
+	repo := NewAuthRepository(db)
+	ctx := context.TODO()
+	query := regexp.QuoteMeta(`UPDATE users SET "emailVerified" = true WHERE uuid = $1 AND email = $2`)
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs("test-uuid", "test@example.com").WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.MarkEmailVerified(ctx, "test-uuid", "test@example.com")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Email Changed", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs("test-uuid", "old@example.com").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.MarkEmailVerified(ctx, "test-uuid", "old@example.com")
+		assert.EqualError(t, err, "invalid or expired token")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthRepository_EnableTOTP(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock := setupTestDB(t)
+	repo := NewAuthRepository(db)
+	ctx := context.TODO()
+	enableQuery := regexp.QuoteMeta(`UPDATE users SET "totpEnabled" = true WHERE uuid = $1 AND "totpSecret" <> ''`)
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(enableQuery).WithArgs("test-uuid").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "recovery_codes" WHERE "userId" = $1`)).WithArgs("test-uuid").WillReturnResult(sqlmock.NewResult(0, 10))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "recovery_codes" ("userId","codeHash","usedAt") VALUES ($1,$2,$3),($4,$5,$6) RETURNING "id"`)).
+			WithArgs("test-uuid", "hash1", nil, "test-uuid", "hash2", nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+		mock.ExpectCommit()
+
+		err := repo.EnableTOTP(ctx, "test-uuid", []string{"hash1", "hash2"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Setup Not Started", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(enableQuery).WithArgs("test-uuid").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := repo.EnableTOTP(ctx, "test-uuid", []string{"hash1"})
+		assert.EqualError(t, err, "two-factor setup not started")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthRepository_UseRecoveryCode(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock := setupTestDB(t)
+	repo := NewAuthRepository(db)
+	ctx := context.TODO()
+	query := regexp.QuoteMeta(`UPDATE recovery_codes SET "usedAt" = $1 WHERE "userId" = $2 AND "codeHash" = $3 AND "usedAt" IS NULL`)
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs(sqlmock.AnyArg(), "test-uuid", "hash1").WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.UseRecoveryCode(ctx, "test-uuid", "hash1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Already Used", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs(sqlmock.AnyArg(), "test-uuid", "hash1").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.UseRecoveryCode(ctx, "test-uuid", "hash1")
+		assert.EqualError(t, err, "invalid code")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthRepository_UseTOTPStep(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock := setupTestDB(t)
+	repo := NewAuthRepository(db)
+	ctx := context.TODO()
+	query := regexp.QuoteMeta(`UPDATE users SET "totpLastStep" = $1 WHERE uuid = $2 AND "totpLastStep" < $3`)
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs(int64(100), "test-uuid", int64(100)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.UseTOTPStep(ctx, "test-uuid", 100)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Replayed Step", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs(int64(100), "test-uuid", int64(100)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.UseTOTPStep(ctx, "test-uuid", 100)
+		assert.EqualError(t, err, "invalid code")
+	})
+
+	t.Run("Database Error", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs(int64(101), "test-uuid", int64(101)).WillReturnError(errors.New("db error"))
+
+		err := repo.UseTOTPStep(ctx, "test-uuid", 101)
+		assert.EqualError(t, err, "error updating user")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthRepository_GetUserByIdentity(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock := setupTestDB(t)
+	repo := NewAuthRepository(db)
+	ctx := context.TODO()
+	query := `SELECT .* FROM "users" JOIN user_identities ON user_identities."userId" = users.uuid WHERE user_identities.provider = \$1 AND user_identities.subject = \$2`
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs("https://issuer", "sub1", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"uuid", "username"}).AddRow("test-uuid", "testuser"))
+
+		user, err := repo.GetUserByIdentity(ctx, "https://issuer", "sub1")
+		require.NoError(t, err)
+		assert.Equal(t, "test-uuid", user.UUID)
+	})
+
+	t.Run("Not Linked", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs("https://issuer", "sub2", 1).WillReturnError(gorm.ErrRecordNotFound)
+
+		_, err := repo.GetUserByIdentity(ctx, "https://issuer", "sub2")
+		assert.EqualError(t, err, "user not found")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthRepository_CreateIdentityUser(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock := setupTestDB(t)
+	repo := NewAuthRepository(db)
+	ctx := context.TODO()
+	user := &domain.User{Username: "newuser", Password: "hash", Email: "new@example.com", Name: "newuser"}
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO "users" .* RETURNING "uuid"`).
+			WillReturnRows(sqlmock.NewRows([]string{"uuid"}).AddRow("test-uuid"))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE users SET "emailVerified" = true WHERE uuid = $1`)).WithArgs("test-uuid").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "user_identities" ("userId","provider","subject") VALUES ($1,$2,$3) RETURNING "createdAt","id"`)).
+			WithArgs("test-uuid", "https://issuer", "sub1").
+			WillReturnRows(sqlmock.NewRows([]string{"createdAt", "id"}).AddRow(time.Now(), 1))
+		mock.ExpectCommit()
+
+		err := repo.CreateIdentityUser(ctx, user, "https://issuer", "sub1")
+		require.NoError(t, err)
+		assert.Equal(t, "test-uuid", user.UUID)
+		assert.True(t, user.EmailVerified)
+	})
+
+	t.Run("Username Taken", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO "users" .* RETURNING "uuid"`).
+			WillReturnError(errors.New(`duplicate key value violates unique constraint "users_username_key"`))
+		mock.ExpectRollback()
+
+		err := repo.CreateIdentityUser(ctx, &domain.User{Username: "newuser"}, "https://issuer", "sub2")
+		assert.EqualError(t, err, "username or email already exists")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthRepository_LinkIdentity(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock := setupTestDB(t)
+	repo := NewAuthRepository(db)
+	ctx := context.TODO()
+	query := regexp.QuoteMeta(`INSERT INTO "user_identities" ("userId","provider","subject") VALUES ($1,$2,$3) RETURNING "createdAt","id"`)
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(query).WithArgs("test-uuid", "https://issuer", "sub1").
+			WillReturnRows(sqlmock.NewRows([]string{"createdAt", "id"}).AddRow(time.Now(), 1))
+		mock.ExpectCommit()
+
+		err := repo.LinkIdentity(ctx, "test-uuid", "https://issuer", "sub1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Already Linked", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(query).WithArgs("other-uuid", "https://issuer", "sub1").
+			WillReturnError(errors.New(`duplicate key value violates unique constraint "idx_identity_subject"`))
+		mock.ExpectRollback()
+
+		err := repo.LinkIdentity(ctx, "other-uuid", "https://issuer", "sub1")
+		assert.EqualError(t, err, "identity already linked")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/metrics"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// Пороги блокировки. После порога каждая следующая ошибка удваивает блокировку
+const (
+	loginFailureWindow = time.Hour
+	maxUserFailures    = 5
+	// за одним IP бывает много пользователей, например за NAT
+	maxIPFailures   = 20
+	baseLoginLock   = time.Minute
+	maxLoginLock    = time.Hour
+	loginScopeUser  = "username"
+	loginScopeIP    = "ip"
+	loginFailPrefix = "login_fail:"
+	loginLockPrefix = "login_lock:"
+)
+
+type redisLoginLimiter struct {
+	client *redis.Client
+}
+
+func NewRedisLoginLimiter(client *redis.Client) domain.LoginLimiter {
+	return &redisLoginLimiter{
+		client: client,
+	}
+}
+
+type loginScope struct {
+	name      string
+	value     string
+	threshold int64
+}
+
+func loginScopes(username string, ip string) []loginScope {
+	scopes := []loginScope{{name: loginScopeUser, value: username, threshold: maxUserFailures}}
+	if ip != "" {
+		scopes = append(scopes, loginScope{name: loginScopeIP, value: ip, threshold: maxIPFailures})
+	}
+	return scopes
+}
+
+func (s loginScope) failKey() string {
+	return loginFailPrefix + s.name + ":" + s.value
+}
+
+func (s loginScope) lockKey() string {
+	return loginLockPrefix + s.name + ":" + s.value
+}
+
+// lockDuration - блокировка после failures ошибок, удваивается с каждой ошибкой сверх порога
+func lockDuration(failures int64, threshold int64) time.Duration {
+	if failures < threshold {
+		return 0
+	}
+	lock := baseLoginLock
+	for i := threshold; i < failures && lock < maxLoginLock; i++ {
+		lock *= 2
+	}
+	if lock > maxLoginLock {
+		lock = maxLoginLock
+	}
+	return lock
+}
+
+func (l *redisLoginLimiter) Check(ctx context.Context, username string, ip string) (time.Duration, error) {
+	requestID := middleware.GetRequestID(ctx)
+	var remaining time.Duration
+	for _, scope := range loginScopes(username, ip) {
+		ttl, err := l.client.PTTL(ctx, scope.lockKey()).Result()
+		if err != nil {
+			logger.AccessLogger.Error("Failed to check login lock", zap.String("request_id", requestID), zap.String("scope", scope.name), zap.Error(err))
+			return 0, errors.New("failed to check login attempts")
+		}
+		if ttl <= 0 {
+			continue
+		}
+		metrics.LoginRejectedTotal.WithLabelValues(scope.name).Inc()
+		if ttl > remaining {
+			remaining = ttl
+		}
+	}
+	return remaining, nil
+}
+
+func (l *redisLoginLimiter) RegisterFailure(ctx context.Context, username string, ip string) error {
+	requestID := middleware.GetRequestID(ctx)
+	for _, scope := range loginScopes(username, ip) {
+		metrics.LoginFailuresTotal.WithLabelValues(scope.name).Inc()
+		failures, err := l.client.Incr(ctx, scope.failKey()).Result()
+		if err != nil {
+			logger.AccessLogger.Error("Failed to count login failure", zap.String("request_id", requestID), zap.String("scope", scope.name), zap.Error(err))
+			return errors.New("failed to save login attempt")
+		}
+		// счётчик живёт, пока ошибки продолжаются, окно отсчитывается от последней
+		if err = l.client.Expire(ctx, scope.failKey(), loginFailureWindow).Err(); err != nil {
+			logger.AccessLogger.Error("Failed to set login failure TTL", zap.String("request_id", requestID), zap.String("scope", scope.name), zap.Error(err))
+			return errors.New("failed to save login attempt")
+		}
+
+		lock := lockDuration(failures, scope.threshold)
+		if lock == 0 {
+			continue
+		}
+		if err = l.client.Set(ctx, scope.lockKey(), failures, lock).Err(); err != nil {
+			logger.AccessLogger.Error("Failed to lock login", zap.String("request_id", requestID), zap.String("scope", scope.name), zap.Error(err))
+			return errors.New("failed to save login attempt")
+		}
+		metrics.LoginLockoutsTotal.WithLabelValues(scope.name).Inc()
+		logger.AccessLogger.Warn("Login locked",
+			zap.String("request_id", requestID),
+			zap.String("scope", scope.name),
+			zap.Int64("failures", failures),
+			zap.Duration("duration", lock))
+	}
+	return nil
+}
+
+func (l *redisLoginLimiter) Reset(ctx context.Context, username string) error {
+	requestID := middleware.GetRequestID(ctx)
+	scope := loginScopes(username, "")[0]
+	if err := l.client.Del(ctx, scope.failKey(), scope.lockKey()).Err(); err != nil {
+		logger.AccessLogger.Error("Failed to reset login attempts", zap.String("request_id", requestID), zap.Error(err))
+		return errors.New("failed to reset login attempts")
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockDuration(t *testing.T) {
+	assert.Equal(t, time.Duration(0), lockDuration(4, 5))
+	assert.Equal(t, time.Minute, lockDuration(5, 5))
+	assert.Equal(t, 2*time.Minute, lockDuration(6, 5))
+	assert.Equal(t, 32*time.Minute, lockDuration(10, 5))
+	assert.Equal(t, time.Hour, lockDuration(11, 5))
+	assert.Equal(t, time.Hour, lockDuration(500, 5))
+}
+
+func TestRedisLoginLimiter(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	fakeRedis := miniredis.RunT(t)
+	limiter := NewRedisLoginLimiter(redis.NewClient(&redis.Options{Addr: fakeRedis.Addr()}))
+	ctx := context.Background()
+
+	t.Run("Username Lockout With Backoff", func(t *testing.T) {
+		for i := 0; i < maxUserFailures-1; i++ {
+			require.NoError(t, limiter.RegisterFailure(ctx, "victim", "10.0.0.1"))
+		}
+		locked, err := limiter.Check(ctx, "victim", "10.0.0.2")
+		require.NoError(t, err)
+		assert.Zero(t, locked)
+
+		require.NoError(t, limiter.RegisterFailure(ctx, "victim", "10.0.0.1"))
+		locked, err = limiter.Check(ctx, "victim", "10.0.0.2")
+		require.NoError(t, err)
+		assert.Equal(t, baseLoginLock, locked)
+
+		// блокировка истекла, следующая ошибка блокирует вдвое дольше
+		fakeRedis.FastForward(baseLoginLock)
+		locked, err = limiter.Check(ctx, "victim", "10.0.0.2")
+		require.NoError(t, err)
+		assert.Zero(t, locked)
+		require.NoError(t, limiter.RegisterFailure(ctx, "victim", "10.0.0.1"))
+		locked, err = limiter.Check(ctx, "victim", "")
+		require.NoError(t, err)
+		assert.Equal(t, 2*baseLoginLock, locked)
+
+		// другой логин с другого адреса не затронут
+		locked, err = limiter.Check(ctx, "someone", "10.0.0.2")
+		require.NoError(t, err)
+		assert.Zero(t, locked)
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		require.NoError(t, limiter.Reset(ctx, "victim"))
+		locked, err := limiter.Check(ctx, "victim", "")
+		require.NoError(t, err)
+		assert.Zero(t, locked)
+		assert.False(t, fakeRedis.Exists(loginFailPrefix+loginScopeUser+":victim"))
+	})
+
+	t.Run("IP Lockout Across Usernames", func(t *testing.T) {
+		fakeRedis.FlushAll()
+		for i := 0; i < maxIPFailures; i++ {
+			require.NoError(t, limiter.RegisterFailure(ctx, "user"+string(rune('a'+i)), "10.0.0.9"))
+		}
+		locked, err := limiter.Check(ctx, "fresh", "10.0.0.9")
+		require.NoError(t, err)
+		assert.Equal(t, baseLoginLock, locked)
+
+		locked, err = limiter.Check(ctx, "fresh", "10.0.0.10")
+		require.NoError(t, err)
+		assert.Zero(t, locked)
+	})
+
+	t.Run("Failures Forgotten After Window", func(t *testing.T) {
+		fakeRedis.FlushAll()
+		for i := 0; i < maxUserFailures-1; i++ {
+			require.NoError(t, limiter.RegisterFailure(ctx, "forgetful", ""))
+		}
+		fakeRedis.FastForward(loginFailureWindow + time.Second)
+		require.NoError(t, limiter.RegisterFailure(ctx, "forgetful", ""))
+		locked, err := limiter.Check(ctx, "forgetful", "")
+		require.NoError(t, err)
+		assert.Zero(t, locked)
+	})
+}
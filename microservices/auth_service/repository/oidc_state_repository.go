@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+type redisOIDCStateStore struct {
+	client *redis.Client
+}
+
+func NewRedisOIDCStateStore(client *redis.Client) domain.OIDCStateStore {
+	return &redisOIDCStateStore{
+		client: client,
+	}
+}
+
+func (s *redisOIDCStateStore) Save(ctx context.Context, state string, data domain.OIDCState, ttl time.Duration) error {
+	requestID := middleware.GetRequestID(ctx)
+	payload, err := data.MarshalJSON()
+	if err != nil {
+		logger.AccessLogger.Error("Failed to encode oidc state", zap.String("request_id", requestID), zap.Error(err))
+		return errors.New("failed to save login state")
+	}
+	if err = s.client.Set(ctx, tokenKey("oidc_state", state), payload, ttl).Err(); err != nil {
+		logger.AccessLogger.Error("Failed to save oidc state", zap.String("request_id", requestID), zap.Error(err))
+		return errors.New("failed to save login state")
+	}
+	return nil
+}
+
+func (s *redisOIDCStateStore) Consume(ctx context.Context, state string) (*domain.OIDCState, error) {
+	requestID := middleware.GetRequestID(ctx)
+	payload, err := s.client.GetDel(ctx, tokenKey("oidc_state", state)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, errors.New("invalid or expired login state")
+	}
+	if err != nil {
+		logger.AccessLogger.Error("Failed to consume oidc state", zap.String("request_id", requestID), zap.Error(err))
+		return nil, errors.New("failed to get login state")
+	}
+	var data domain.OIDCState
+	if err = data.UnmarshalJSON(payload); err != nil {
+		logger.AccessLogger.Error("Failed to decode oidc state", zap.String("request_id", requestID), zap.Error(err))
+		return nil, errors.New("failed to get login state")
+	}
+	return &data, nil
+}
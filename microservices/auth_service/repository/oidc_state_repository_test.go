@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisOIDCStateStore(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	fakeRedis := miniredis.RunT(t)
+	store := NewRedisOIDCStateStore(redis.NewClient(&redis.Options{Addr: fakeRedis.Addr()}))
+	ctx := context.Background()
+	data := domain.OIDCState{Nonce: "nonce1", CodeVerifier: "verifier1"}
+
+	t.Run("Single Use", func(t *testing.T) {
+		require.NoError(t, store.Save(ctx, "state1", data, time.Minute))
+
+		saved, err := store.Consume(ctx, "state1")
+		require.NoError(t, err)
+		assert.Equal(t, data, *saved)
+
+		_, err = store.Consume(ctx, "state1")
+		assert.EqualError(t, err, "invalid or expired login state")
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		require.NoError(t, store.Save(ctx, "state2", data, time.Minute))
+		fakeRedis.FastForward(2 * time.Minute)
+
+		_, err := store.Consume(ctx, "state2")
+		assert.EqualError(t, err, "invalid or expired login state")
+	})
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+type redisTokenStore struct {
+	client *redis.Client
+}
+
+func NewRedisTokenStore(client *redis.Client) domain.AuthTokenStore {
+	return &redisTokenStore{
+		client: client,
+	}
+}
+
+// в Redis лежит только хэш токена, сам токен знает лишь получатель письма
+func tokenKey(purpose string, token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return "auth_token:" + purpose + ":" + hex.EncodeToString(sum[:])
+}
+
+func (s *redisTokenStore) CreateToken(ctx context.Context, purpose string, userID string, ttl time.Duration) (string, error) {
+	requestID := middleware.GetRequestID(ctx)
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		logger.AccessLogger.Error("Failed to generate token", zap.String("request_id", requestID), zap.Error(err))
+		return "", errors.New("failed to generate token")
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	if err := s.client.Set(ctx, tokenKey(purpose, token), userID, ttl).Err(); err != nil {
+		logger.AccessLogger.Error("Failed to save token", zap.String("request_id", requestID), zap.String("purpose", purpose), zap.Error(err))
+		return "", errors.New("failed to save token")
+	}
+	return token, nil
+}
+
+func (s *redisTokenStore) ConsumeToken(ctx context.Context, purpose string, token string) (string, error) {
+	requestID := middleware.GetRequestID(ctx)
+	userID, err := s.client.GetDel(ctx, tokenKey(purpose, token)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", errors.New("invalid or expired token")
+	}
+	if err != nil {
+		logger.AccessLogger.Error("Failed to consume token", zap.String("request_id", requestID), zap.String("purpose", purpose), zap.Error(err))
+		return "", errors.New("failed to get token")
+	}
+	return userID, nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisTokenStore(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	fakeRedis := miniredis.RunT(t)
+	store := NewRedisTokenStore(redis.NewClient(&redis.Options{Addr: fakeRedis.Addr()}))
+	ctx := context.Background()
+
+	t.Run("Single Use", func(t *testing.T) {
+		token, err := store.CreateToken(ctx, domain.TokenPasswordReset, "user1", time.Hour)
+		require.NoError(t, err)
+		// сам токен в Redis не хранится
+		for _, key := range fakeRedis.Keys() {
+			assert.NotContains(t, key, token)
+		}
+
+		userID, err := store.ConsumeToken(ctx, domain.TokenPasswordReset, token)
+		require.NoError(t, err)
+		assert.Equal(t, "user1", userID)
+
+		_, err = store.ConsumeToken(ctx, domain.TokenPasswordReset, token)
+		assert.EqualError(t, err, "invalid or expired token")
+	})
+
+	t.Run("Purpose Mismatch", func(t *testing.T) {
+		token, err := store.CreateToken(ctx, domain.TokenPasswordReset, "user1", time.Hour)
+		require.NoError(t, err)
+
+		_, err = store.ConsumeToken(ctx, "other", token)
+		assert.EqualError(t, err, "invalid or expired token")
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		token, err := store.CreateToken(ctx, domain.TokenPasswordReset, "user1", time.Minute)
+		require.NoError(t, err)
+		fakeRedis.FastForward(2 * time.Minute)
+
+		_, err = store.ConsumeToken(ctx, domain.TokenPasswordReset, token)
+		assert.EqualError(t, err, "invalid or expired token")
+	})
+}
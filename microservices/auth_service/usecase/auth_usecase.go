@@ -4,35 +4,92 @@ import (
 	"2024_2_FIGHT-CLUB/domain"
 	"2024_2_FIGHT-CLUB/internal/service/images"
 	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/mailer"
+	"2024_2_FIGHT-CLUB/internal/service/metrics"
 	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/oidc"
+	"2024_2_FIGHT-CLUB/internal/service/totp"
 	"2024_2_FIGHT-CLUB/internal/service/validation"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
 	"errors"
 	"github.com/mailru/easyjson"
 	"go.uber.org/zap"
 	"net/http"
+	"net/url"
 	"regexp"
+	"strings"
+	"time"
 )
 
 type AuthUseCase interface {
 	RegisterUser(ctx context.Context, creds *domain.User) error
-	LoginUser(ctx context.Context, creds *domain.User) (*domain.User, error)
+	// LoginUser учитывает неудачные попытки по логину и по ip клиента
+	LoginUser(ctx context.Context, creds *domain.User, ip string) (*domain.User, error)
 	PutUser(ctx context.Context, creds *domain.User, userID string, avatar []byte) error
 	GetAllUser(ctx context.Context) ([]domain.User, error)
 	GetUserById(ctx context.Context, userID string) (*domain.User, error)
 	UpdateUserRegions(ctx context.Context, regions domain.UpdateUserRegion, userId string) error
 	DeleteUserRegion(ctx context.Context, regionName string, userID string) error
+	ChangePassword(ctx context.Context, userID string, oldPassword string, newPassword string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	// ResetPassword возвращает id пользователя, чтобы завершить его сессии
+	ResetPassword(ctx context.Context, token string, newPassword string) (string, error)
+	// VerifyEmail возвращает id пользователя, чтобы обновить его сессии
+	VerifyEmail(ctx context.Context, token string) (string, error)
+	ResendVerificationEmail(ctx context.Context, userID string) error
+	SetupTOTP(ctx context.Context, userID string) (*domain.TOTPSetupResponse, error)
+	// EnableTOTP возвращает коды восстановления, они показываются пользователю один раз
+	EnableTOTP(ctx context.Context, userID string, code string) ([]string, error)
+	DisableTOTP(ctx context.Context, userID string, code string) error
+	// CreateLoginChallenge выдаётся вместо сессии после проверки пароля, если включён второй фактор
+	CreateLoginChallenge(ctx context.Context, userID string) (string, error)
+	VerifySecondFactor(ctx context.Context, challenge string, code string, ip string) (*domain.User, error)
+	// UnlockUser снимает блокировку входа после перебора пароля
+	UnlockUser(ctx context.Context, userID string) error
+	// StartOIDCLogin возвращает адрес страницы входа у провайдера и state, который нужно привязать к браузеру
+	StartOIDCLogin(ctx context.Context) (string, string, error)
+	// FinishOIDCLogin находит, привязывает по подтверждённой почте или создаёт пользователя внешнего аккаунта
+	FinishOIDCLogin(ctx context.Context, code string, state string) (*domain.User, error)
 }
 
+const (
+	passwordResetTTL     = 30 * time.Minute
+	emailVerificationTTL = 24 * time.Hour
+	loginChallengeTTL    = 5 * time.Minute
+	totpIssuer           = "FightClub"
+	recoveryCodesCount   = 10
+	oidcStateTTL         = 10 * time.Minute
+)
+
 type authUseCase struct {
 	authRepository domain.AuthRepository
 	minioService   images.MinioServiceInterface
+	tokenStore     domain.AuthTokenStore
+	loginLimiter   domain.LoginLimiter
+	mailSender     mailer.Sender
+	jwtToken       middleware.JwtTokenService
+	// адрес фронтенда для ссылок в письмах
+	appURL string
+	// nil, если вход через провайдера не настроен
+	oidcProvider oidc.Provider
+	oidcStates   domain.OIDCStateStore
 }
 
-func NewAuthUseCase(authRepository domain.AuthRepository, minioService images.MinioServiceInterface) AuthUseCase {
+func NewAuthUseCase(authRepository domain.AuthRepository, minioService images.MinioServiceInterface, tokenStore domain.AuthTokenStore, loginLimiter domain.LoginLimiter, mailSender mailer.Sender, jwtToken middleware.JwtTokenService, appURL string, oidcProvider oidc.Provider, oidcStates domain.OIDCStateStore) AuthUseCase {
 	return &authUseCase{
 		authRepository: authRepository,
 		minioService:   minioService,
+		tokenStore:     tokenStore,
+		loginLimiter:   loginLimiter,
+		mailSender:     mailSender,
+		jwtToken:       jwtToken,
+		appURL:         appURL,
+		oidcProvider:   oidcProvider,
+		oidcStates:     oidcStates,
 	}
 }
 
@@ -102,10 +159,17 @@ func (uc *authUseCase) RegisterUser(ctx context.Context, creds *domain.User) err
 		return err
 	}
 
-	return uc.authRepository.SaveUser(ctx, creds)
+	if err = uc.authRepository.SaveUser(ctx, creds); err != nil {
+		return err
+	}
+	// регистрация не должна падать из-за почты, письмо можно запросить повторно
+	if err = uc.sendVerificationEmail(ctx, creds); err != nil {
+		logger.AccessLogger.Warn("Failed to send verification email", zap.String("request_id", requestID), zap.Error(err))
+	}
+	return nil
 }
 
-func (uc *authUseCase) LoginUser(ctx context.Context, creds *domain.User) (*domain.User, error) {
+func (uc *authUseCase) LoginUser(ctx context.Context, creds *domain.User, ip string) (*domain.User, error) {
 	requestID := middleware.GetRequestID(ctx)
 	const maxLen = 255
 	validCharPattern := regexp.MustCompile(`^[a-zA-Zа-яА-Я0-9@.,\s]*$`)
@@ -145,18 +209,60 @@ func (uc *authUseCase) LoginUser(ctx context.Context, creds *domain.User) (*doma
 		return nil, errors.New(string(errorResponseJSON))
 	}
 
+	locked, err := uc.loginLimiter.Check(ctx, creds.Username, ip)
+	if err != nil {
+		return nil, err
+	}
+	if locked > 0 {
+		logger.AccessLogger.Warn("Login attempt during lockout",
+			zap.String("request_id", requestID),
+			zap.String("username", creds.Username),
+			zap.Duration("remaining", locked))
+		return nil, errors.New("too many login attempts")
+	}
+
+	// несуществующий логин тоже считается ошибкой, иначе перебор логинов не ограничен
 	requestedUser, err := uc.authRepository.GetUserByName(ctx, creds.Username)
 	if err != nil || requestedUser == nil {
+		uc.registerLoginFailure(ctx, creds.Username, ip)
 		return nil, errors.New("user not found")
 	}
 
 	if !middleware.CheckPassword(requestedUser.Password, creds.Password) {
+		uc.registerLoginFailure(ctx, creds.Username, ip)
 		return nil, errors.New("invalid credentials")
 	}
 
+	// при втором факторе счётчик сбросится только после верного кода, иначе код можно перебирать,
+	// каждый раз заново вводя известный пароль
+	if !requestedUser.TOTPEnabled {
+		uc.resetLoginFailures(ctx, creds.Username)
+	}
+
+	if requestedUser.IsBanned {
+		return nil, errors.New("user is banned")
+	}
+
 	return requestedUser, nil
 }
 
+// registerLoginFailure не прерывает вход: клиент и так получит ошибку неверных данных
+func (uc *authUseCase) registerLoginFailure(ctx context.Context, username string, ip string) {
+	if err := uc.loginLimiter.RegisterFailure(ctx, username, ip); err != nil {
+		logger.AccessLogger.Warn("Failed to register login failure",
+			zap.String("request_id", middleware.GetRequestID(ctx)),
+			zap.Error(err))
+	}
+}
+
+func (uc *authUseCase) resetLoginFailures(ctx context.Context, username string) {
+	if err := uc.loginLimiter.Reset(ctx, username); err != nil {
+		logger.AccessLogger.Warn("Failed to reset login attempts",
+			zap.String("request_id", middleware.GetRequestID(ctx)),
+			zap.Error(err))
+	}
+}
+
 func (uc *authUseCase) PutUser(ctx context.Context, creds *domain.User, userID string, avatar []byte) error {
 	requestID := middleware.GetRequestID(ctx)
 	const maxLen = 255
@@ -167,7 +273,7 @@ func (uc *authUseCase) PutUser(ctx context.Context, creds *domain.User, userID s
 		return errors.New("input contains invalid characters")
 	}
 
-	if len(creds.Username) > maxLen || len(creds.Email) > maxLen || len(creds.Password) > maxLen || len(creds.Name) > maxLen || len(creds.Avatar) > maxLen || len(creds.UUID) > maxLen {
+	if len(creds.Username) > maxLen || len(creds.Email) > maxLen || len(creds.Name) > maxLen || len(creds.Avatar) > maxLen || len(creds.UUID) > maxLen {
 		logger.AccessLogger.Warn("Input exceeds character limit", zap.String("request_id", requestID))
 		return errors.New("input exceeds character limit")
 	}
@@ -190,9 +296,6 @@ func (uc *authUseCase) PutUser(ctx context.Context, creds *domain.User, userID s
 	if !validation.ValidateEmail(creds.Email) && len(creds.Email) > 0 {
 		wrongFields = append(wrongFields, "email")
 	}
-	if !validation.ValidatePassword(creds.Password) && len(creds.Password) > 0 {
-		wrongFields = append(wrongFields, "password")
-	}
 	if !validation.ValidateName(creds.Name) && len(creds.Name) > 0 {
 		wrongFields = append(wrongFields, "name")
 	}
@@ -205,6 +308,13 @@ func (uc *authUseCase) PutUser(ctx context.Context, creds *domain.User, userID s
 		return errors.New(string(errorResponseJSON))
 	}
 
+	current, err := uc.authRepository.GetUserById(ctx, userID)
+	if err != nil {
+		return err
+	}
+	// новый адрес нужно подтвердить заново, письмо уходит после сохранения
+	emailChanged := creds.Email != "" && creds.Email != current.Email
+
 	if avatar != nil {
 		contentType := http.DetectContentType(avatar[:512])
 
@@ -217,7 +327,7 @@ func (uc *authUseCase) PutUser(ctx context.Context, creds *domain.User, userID s
 		creds.Avatar = "/images/" + uploadedPath
 	}
 
-	err := uc.authRepository.PutUser(ctx, creds, userID)
+	err = uc.authRepository.PutUser(ctx, creds, userID)
 	if err != nil {
 		ok := uc.minioService.DeleteFile(creds.Avatar)
 		if ok != nil {
@@ -225,6 +335,12 @@ func (uc *authUseCase) PutUser(ctx context.Context, creds *domain.User, userID s
 		}
 		return err
 	}
+	// как и при регистрации, письмо можно запросить повторно
+	if emailChanged {
+		if err = uc.sendVerificationEmail(ctx, &domain.User{UUID: userID, Email: creds.Email}); err != nil {
+			logger.AccessLogger.Warn("Failed to send verification email", zap.String("request_id", requestID), zap.Error(err))
+		}
+	}
 	return nil
 }
 
@@ -301,3 +417,418 @@ func (u *authUseCase) DeleteUserRegion(ctx context.Context, regionName string, u
 	)
 	return nil
 }
+
+func (uc *authUseCase) ChangePassword(ctx context.Context, userID string, oldPassword string, newPassword string) error {
+	requestID := middleware.GetRequestID(ctx)
+	if oldPassword == "" || newPassword == "" {
+		return errors.New("old and new passwords are required")
+	}
+	if !validation.ValidatePassword(newPassword) {
+		return errors.New("invalid new password")
+	}
+
+	user, err := uc.authRepository.GetUserById(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !middleware.CheckPassword(user.Password, oldPassword) {
+		logger.AccessLogger.Warn("Wrong old password", zap.String("request_id", requestID), zap.String("userID", userID))
+		return errors.New("wrong old password")
+	}
+
+	return uc.setPassword(ctx, userID, newPassword)
+}
+
+func (uc *authUseCase) RequestPasswordReset(ctx context.Context, email string) error {
+	requestID := middleware.GetRequestID(ctx)
+	if !validation.ValidateEmail(email) {
+		return errors.New("invalid email")
+	}
+
+	// о несуществующем адресе не сообщаем, чтобы нельзя было перебирать email
+	user, err := uc.authRepository.GetUserByEmail(ctx, email)
+	if err != nil || user == nil {
+		logger.AccessLogger.Info("Password reset requested for unknown email", zap.String("request_id", requestID))
+		return nil
+	}
+
+	token, err := uc.tokenStore.CreateToken(ctx, domain.TokenPasswordReset, user.UUID, passwordResetTTL)
+	if err != nil {
+		return err
+	}
+	link := uc.appURL + "/reset-password?token=" + url.QueryEscape(token)
+	body := "Чтобы задать новый пароль, перейдите по ссылке:\n" + link +
+		"\n\nСсылка действует 30 минут. Если вы не запрашивали сброс пароля, просто проигнорируйте это письмо."
+	if err = uc.mailSender.Send(ctx, user.Email, "Сброс пароля", body); err != nil {
+		logger.AccessLogger.Error("Failed to send password reset email", zap.String("request_id", requestID), zap.Error(err))
+		return errors.New("failed to send email")
+	}
+	return nil
+}
+
+func (uc *authUseCase) ResetPassword(ctx context.Context, token string, newPassword string) (string, error) {
+	if token == "" || newPassword == "" {
+		return "", errors.New("token and new password are required")
+	}
+	// пароль проверяется до погашения токена, чтобы по той же ссылке можно было повторить попытку
+	if !validation.ValidatePassword(newPassword) {
+		return "", errors.New("invalid new password")
+	}
+
+	userID, err := uc.tokenStore.ConsumeToken(ctx, domain.TokenPasswordReset, token)
+	if err != nil {
+		return "", err
+	}
+	if err = uc.setPassword(ctx, userID, newPassword); err != nil {
+		return "", err
+	}
+	return userID, nil
+}
+
+func (uc *authUseCase) setPassword(ctx context.Context, userID string, password string) error {
+	hashedPassword, err := middleware.HashPassword(password)
+	if err != nil {
+		return errors.New("failed to hash password")
+	}
+	return uc.authRepository.UpdatePassword(ctx, userID, hashedPassword)
+}
+
+func (uc *authUseCase) VerifyEmail(ctx context.Context, token string) (string, error) {
+	requestID := middleware.GetRequestID(ctx)
+	claims, err := uc.jwtToken.ValidateEmailToken(token)
+	if err != nil {
+		logger.AccessLogger.Warn("Invalid email verification token", zap.String("request_id", requestID), zap.Error(err))
+		return "", errors.New("invalid or expired token")
+	}
+	if err = uc.authRepository.MarkEmailVerified(ctx, claims.Subject, claims.Email); err != nil {
+		return "", err
+	}
+	return claims.Subject, nil
+}
+
+func (uc *authUseCase) ResendVerificationEmail(ctx context.Context, userID string) error {
+	user, err := uc.authRepository.GetUserById(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.EmailVerified {
+		return errors.New("email already verified")
+	}
+	return uc.sendVerificationEmail(ctx, user)
+}
+
+func (uc *authUseCase) sendVerificationEmail(ctx context.Context, user *domain.User) error {
+	token, err := uc.jwtToken.CreateEmailToken(user.UUID, user.Email, time.Now().Add(emailVerificationTTL).Unix())
+	if err != nil {
+		return errors.New("failed to generate token")
+	}
+	link := uc.appURL + "/verify-email?token=" + url.QueryEscape(token)
+	body := "Чтобы подтвердить адрес почты, перейдите по ссылке:\n" + link + "\n\nСсылка действует 24 часа."
+	if err = uc.mailSender.Send(ctx, user.Email, "Подтверждение почты", body); err != nil {
+		return errors.New("failed to send email")
+	}
+	return nil
+}
+
+func (uc *authUseCase) SetupTOTP(ctx context.Context, userID string) (*domain.TOTPSetupResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	user, err := uc.authRepository.GetUserById(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.TOTPEnabled {
+		return nil, errors.New("two-factor authentication already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		logger.AccessLogger.Error("Failed to generate TOTP secret", zap.String("request_id", requestID), zap.Error(err))
+		return nil, errors.New("failed to generate secret")
+	}
+	if err = uc.authRepository.SetTOTPSecret(ctx, userID, secret); err != nil {
+		return nil, err
+	}
+	return &domain.TOTPSetupResponse{
+		Secret: secret,
+		URI:    totp.URI(totpIssuer, user.Username, secret),
+	}, nil
+}
+
+func (uc *authUseCase) EnableTOTP(ctx context.Context, userID string, code string) ([]string, error) {
+	requestID := middleware.GetRequestID(ctx)
+	user, err := uc.authRepository.GetUserById(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.TOTPEnabled {
+		return nil, errors.New("two-factor authentication already enabled")
+	}
+	if user.TOTPSecret == "" {
+		return nil, errors.New("two-factor setup not started")
+	}
+	step, ok := totp.ValidateStep(user.TOTPSecret, code, time.Now())
+	if !ok {
+		return nil, errors.New("invalid code")
+	}
+	if err = uc.authRepository.UseTOTPStep(ctx, userID, step); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, recoveryCodesCount)
+	hashes := make([]string, 0, recoveryCodesCount)
+	for i := 0; i < recoveryCodesCount; i++ {
+		recoveryCode, err := generateRecoveryCode()
+		if err != nil {
+			logger.AccessLogger.Error("Failed to generate recovery code", zap.String("request_id", requestID), zap.Error(err))
+			return nil, errors.New("failed to generate recovery codes")
+		}
+		codes = append(codes, recoveryCode)
+		hashes = append(hashes, hashRecoveryCode(recoveryCode))
+	}
+	if err = uc.authRepository.EnableTOTP(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (uc *authUseCase) DisableTOTP(ctx context.Context, userID string, code string) error {
+	user, err := uc.authRepository.GetUserById(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.TOTPEnabled {
+		return errors.New("two-factor authentication not enabled")
+	}
+	if err = uc.checkSecondFactor(ctx, user, code); err != nil {
+		return err
+	}
+	return uc.authRepository.DisableTOTP(ctx, userID)
+}
+
+func (uc *authUseCase) CreateLoginChallenge(ctx context.Context, userID string) (string, error) {
+	return uc.tokenStore.CreateToken(ctx, domain.TokenLoginChallenge, userID, loginChallengeTTL)
+}
+
+func (uc *authUseCase) VerifySecondFactor(ctx context.Context, challenge string, code string, ip string) (*domain.User, error) {
+	requestID := middleware.GetRequestID(ctx)
+	if challenge == "" || code == "" {
+		return nil, errors.New("challenge and code are required")
+	}
+	// челлендж одноразовый: после неверного кода нужно снова ввести пароль,
+	// поэтому код нельзя перебирать без знания пароля
+	userID, err := uc.tokenStore.ConsumeToken(ctx, domain.TokenLoginChallenge, challenge)
+	if err != nil {
+		return nil, err
+	}
+	user, err := uc.authRepository.GetUserById(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsBanned {
+		return nil, errors.New("user is banned")
+	}
+	if err = uc.checkSecondFactor(ctx, user, code); err != nil {
+		logger.AccessLogger.Warn("Invalid second factor", zap.String("request_id", requestID), zap.String("userID", userID))
+		uc.registerLoginFailure(ctx, user.Username, ip)
+		return nil, err
+	}
+	uc.resetLoginFailures(ctx, user.Username)
+	return user, nil
+}
+
+func (uc *authUseCase) UnlockUser(ctx context.Context, userID string) error {
+	requestID := middleware.GetRequestID(ctx)
+	user, err := uc.authRepository.GetUserById(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err = uc.loginLimiter.Reset(ctx, user.Username); err != nil {
+		return err
+	}
+	metrics.LoginUnlocksTotal.Inc()
+	logger.AccessLogger.Info("Login unlocked by admin", zap.String("request_id", requestID), zap.String("userID", userID))
+	return nil
+}
+
+func (uc *authUseCase) StartOIDCLogin(ctx context.Context) (string, string, error) {
+	requestID := middleware.GetRequestID(ctx)
+	if uc.oidcProvider == nil {
+		return "", "", errors.New("oidc login is not configured")
+	}
+	state, errState := oidc.RandomString()
+	nonce, errNonce := oidc.RandomString()
+	verifier, errVerifier := oidc.RandomString()
+	if errState != nil || errNonce != nil || errVerifier != nil {
+		logger.AccessLogger.Error("Failed to generate oidc state", zap.String("request_id", requestID))
+		return "", "", errors.New("failed to start oidc login")
+	}
+
+	if err := uc.oidcStates.Save(ctx, state, domain.OIDCState{Nonce: nonce, CodeVerifier: verifier}, oidcStateTTL); err != nil {
+		return "", "", err
+	}
+	authURL, err := uc.oidcProvider.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return "", "", err
+	}
+	return authURL, state, nil
+}
+
+func (uc *authUseCase) FinishOIDCLogin(ctx context.Context, code string, state string) (*domain.User, error) {
+	requestID := middleware.GetRequestID(ctx)
+	if uc.oidcProvider == nil {
+		return nil, errors.New("oidc login is not configured")
+	}
+	if code == "" || state == "" {
+		return nil, errors.New("code and state are required")
+	}
+	// state одноразовый и хранит nonce и code_verifier, поэтому подставить чужой код не выйдет
+	saved, err := uc.oidcStates.Consume(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := uc.oidcProvider.Exchange(ctx, code, saved.CodeVerifier, saved.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.oidcUser(ctx, claims)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to resolve oidc user",
+			zap.String("request_id", requestID),
+			zap.String("provider", uc.oidcProvider.Issuer()),
+			zap.Error(err))
+		return nil, err
+	}
+	if user.IsBanned {
+		return nil, errors.New("user is banned")
+	}
+	return user, nil
+}
+
+// oidcUser ищет пользователя по привязанному аккаунту, затем по почте, иначе регистрирует нового
+func (uc *authUseCase) oidcUser(ctx context.Context, claims *oidc.Claims) (*domain.User, error) {
+	requestID := middleware.GetRequestID(ctx)
+	provider := uc.oidcProvider.Issuer()
+	user, err := uc.authRepository.GetUserByIdentity(ctx, provider, claims.Subject)
+	if err == nil {
+		return user, nil
+	}
+	if err.Error() != "user not found" {
+		return nil, err
+	}
+
+	// привязка по неподтверждённой почте отдала бы чужой аккаунт тому, кто указал его адрес у провайдера
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, errors.New("email is not verified by provider")
+	}
+	user, err = uc.authRepository.GetUserByEmail(ctx, claims.Email)
+	if err == nil {
+		if !user.EmailVerified {
+			return nil, errors.New("email already registered")
+		}
+		if err = uc.authRepository.LinkIdentity(ctx, user.UUID, provider, claims.Subject); err != nil {
+			return nil, err
+		}
+		logger.AccessLogger.Info("Linked oidc identity by email", zap.String("request_id", requestID), zap.String("userID", user.UUID))
+		return user, nil
+	}
+	if err.Error() != "user not found" {
+		return nil, err
+	}
+
+	username, err := uc.oidcUsername(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	// пароль никто не знает, при необходимости его можно задать через восстановление по почте
+	password, err := oidc.RandomString()
+	if err != nil {
+		return nil, errors.New("failed to create user")
+	}
+	hashedPassword, err := middleware.HashPassword(password)
+	if err != nil {
+		return nil, errors.New("failed to hash password")
+	}
+	name := strings.TrimSpace(claims.Name)
+	if !validation.ValidateName(name) {
+		name = username
+	}
+	user = &domain.User{
+		Username: username,
+		Password: hashedPassword,
+		Email:    claims.Email,
+		Name:     name,
+	}
+	if err = uc.authRepository.CreateIdentityUser(ctx, user, provider, claims.Subject); err != nil {
+		return nil, err
+	}
+	logger.AccessLogger.Info("Registered user via oidc", zap.String("request_id", requestID), zap.String("userID", user.UUID))
+	return user, nil
+}
+
+// oidcUsername подбирает свободный логин из preferred_username или почты
+func (uc *authUseCase) oidcUsername(ctx context.Context, claims *oidc.Claims) (string, error) {
+	const maxBaseLen = 14
+	source := claims.PreferredUsername
+	if source == "" {
+		source, _, _ = strings.Cut(claims.Email, "@")
+	}
+	base := []rune(strings.Trim(oidcUsernamePattern.ReplaceAllString(source, ""), "-_."))
+	if len(base) > maxBaseLen {
+		base = base[:maxBaseLen]
+	}
+
+	candidate := string(base)
+	for attempt := 0; attempt < 5; attempt++ {
+		if attempt > 0 || !validation.ValidateLogin(candidate) {
+			suffix := make([]byte, 2)
+			if _, err := rand.Read(suffix); err != nil {
+				return "", errors.New("failed to create user")
+			}
+			candidate = strings.Trim(string(base), "-_.")
+			if candidate == "" {
+				candidate = "user"
+			}
+			candidate += "_" + hex.EncodeToString(suffix)
+		}
+		if existing, _ := uc.authRepository.GetUserByName(ctx, candidate); existing == nil {
+			return candidate, nil
+		}
+	}
+	return "", errors.New("failed to create user")
+}
+
+// checkSecondFactor принимает ещё не использованный код из приложения или неиспользованный код восстановления
+func (uc *authUseCase) checkSecondFactor(ctx context.Context, user *domain.User, code string) error {
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		step, ok := totp.ValidateStep(user.TOTPSecret, code, time.Now())
+		if !ok {
+			return errors.New("invalid code")
+		}
+		// код действует ещё минуту после ввода, поэтому принятый шаг повторно не засчитывается
+		return uc.authRepository.UseTOTPStep(ctx, user.UUID, step)
+	}
+	if err := uc.authRepository.UseRecoveryCode(ctx, user.UUID, hashRecoveryCode(code)); err != nil {
+		return errors.New("invalid code")
+	}
+	return nil
+}
+
+var oidcUsernamePattern = regexp.MustCompile(`[^A-Za-zА-Яа-яЁё0-9-_.]`)
+
+// generateRecoveryCode возвращает код вида xxxxx-xxxxx из 50 случайных бит
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, 7)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(base32.StdEncoding.EncodeToString(b))[:10]
+	return code[:5] + "-" + code[5:], nil
+}
+
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
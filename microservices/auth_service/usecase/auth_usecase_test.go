@@ -4,6 +4,10 @@ import (
 	"2024_2_FIGHT-CLUB/domain"
 	"2024_2_FIGHT-CLUB/internal/service/logger"
 	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/oidc"
+	"2024_2_FIGHT-CLUB/internal/service/oidc/oidctest"
+	"2024_2_FIGHT-CLUB/internal/service/totp"
+	"2024_2_FIGHT-CLUB/internal/service/validation"
 	"2024_2_FIGHT-CLUB/microservices/auth_service/mocks"
 	"bytes"
 	"context"
@@ -15,7 +19,10 @@ import (
 	"image/jpeg"
 	"image/png"
 	"log"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 )
 
 // GenerateImage создает изображение с указанным форматом и размером
@@ -61,8 +68,16 @@ func TestRegisterUser(t *testing.T) {
 
 	mockAuthRepo := &mocks.MockAuthRepository{}
 	mockMinioService := &mocks.MockMinioService{}
+	jwtToken, _ := middleware.NewJwtToken("secret")
+	var sentTo string
+	mailSender := &mocks.MockMailSender{
+		MockSend: func(ctx context.Context, to string, subject string, body string) error {
+			sentTo = to
+			return nil
+		},
+	}
 
-	uc := NewAuthUseCase(mockAuthRepo, mockMinioService)
+	uc := NewAuthUseCase(mockAuthRepo, mockMinioService, nil, nil, mailSender, jwtToken, "", nil, nil)
 	ctx := context.TODO()
 
 	// Тест-кейс 1: Успешная регистрация
@@ -91,6 +106,7 @@ func TestRegisterUser(t *testing.T) {
 
 		err := uc.RegisterUser(ctx, creds)
 		assert.NoError(t, err)
+		assert.Equal(t, "test@example.com", sentTo)
 	})
 
 	// Тест-кейс 2: Неправильные символы в Avatar или UUID
@@ -254,6 +270,20 @@ func TestRegisterUser(t *testing.T) {
 	})
 }
 
+func newAllowAllLimiter() *mocks.MockLoginLimiter {
+	return &mocks.MockLoginLimiter{
+		MockCheck: func(ctx context.Context, username string, ip string) (time.Duration, error) {
+			return 0, nil
+		},
+		MockRegisterFailure: func(ctx context.Context, username string, ip string) error {
+			return nil
+		},
+		MockReset: func(ctx context.Context, username string) error {
+			return nil
+		},
+	}
+}
+
 func TestLoginUser(t *testing.T) {
 	if err := logger.InitLoggers(); err != nil {
 		log.Fatalf("Failed to initialize loggers: %v", err)
@@ -266,7 +296,7 @@ func TestLoginUser(t *testing.T) {
 	}()
 	mockAuthRepo := &mocks.MockAuthRepository{}
 
-	uc := NewAuthUseCase(mockAuthRepo, nil)
+	uc := NewAuthUseCase(mockAuthRepo, nil, nil, newAllowAllLimiter(), nil, nil, "", nil, nil)
 	ctx := context.TODO()
 
 	// Тест-кейс 1: Успешный вход
@@ -284,7 +314,7 @@ func TestLoginUser(t *testing.T) {
 			Password: "password",
 		}
 
-		user, err := uc.LoginUser(ctx, creds)
+		user, err := uc.LoginUser(ctx, creds, "127.0.0.1")
 		assert.NoError(t, err)
 		assert.NotNil(t, user)
 		assert.Equal(t, "testuser", user.Username)
@@ -313,7 +343,7 @@ func TestLoginUser(t *testing.T) {
 			Password: "invalid<>password$%^",
 		}
 
-		user, err := uc.LoginUser(ctx, creds)
+		user, err := uc.LoginUser(ctx, creds, "127.0.0.1")
 		assert.Error(t, err)
 		assert.Nil(t, user)
 		assert.Contains(t, err.Error(), "{\"error\":\"Incorrect data forms\",\"wrongFields\":[\"username\",\"password\"]}")
@@ -331,7 +361,7 @@ func TestLoginUser(t *testing.T) {
 			Password: "password",
 		}
 
-		user, err := uc.LoginUser(ctx, creds)
+		user, err := uc.LoginUser(ctx, creds, "127.0.0.1")
 		assert.Error(t, err)
 		assert.Nil(t, user)
 		assert.Contains(t, err.Error(), "input exceeds character limit")
@@ -344,7 +374,7 @@ func TestLoginUser(t *testing.T) {
 			Password: "",
 		}
 
-		user, err := uc.LoginUser(ctx, creds)
+		user, err := uc.LoginUser(ctx, creds, "127.0.0.1")
 		assert.Error(t, err)
 		assert.Nil(t, user)
 		assert.Contains(t, err.Error(), "username and password are required")
@@ -357,7 +387,7 @@ func TestLoginUser(t *testing.T) {
 			Password: "12345", // Слишком простой пароль
 		}
 
-		user, err := uc.LoginUser(ctx, creds)
+		user, err := uc.LoginUser(ctx, creds, "127.0.0.1")
 		assert.Error(t, err)
 		assert.Nil(t, user)
 		assert.Contains(t, err.Error(), "username")
@@ -375,7 +405,7 @@ func TestLoginUser(t *testing.T) {
 			Password: "password",
 		}
 
-		user, err := uc.LoginUser(ctx, creds)
+		user, err := uc.LoginUser(ctx, creds, "127.0.0.1")
 		assert.Error(t, err)
 		assert.Nil(t, user)
 		assert.Equal(t, "user not found", err.Error())
@@ -396,12 +426,34 @@ func TestLoginUser(t *testing.T) {
 			Password: "wrongpassword",
 		}
 
-		user, err := uc.LoginUser(ctx, creds)
+		user, err := uc.LoginUser(ctx, creds, "127.0.0.1")
 		assert.Error(t, err)
 		assert.Nil(t, user)
 		assert.Equal(t, "invalid credentials", err.Error())
 	})
 
+	// Тест-кейс 8.1: Пользователь заблокирован модератором
+	t.Run("Banned User", func(t *testing.T) {
+		mockAuthRepo.GetUserByNameFunc = func(ctx context.Context, username string) (*domain.User, error) {
+			password, _ := middleware.HashPassword("password")
+			return &domain.User{
+				Username: "testuser",
+				Password: password,
+				IsBanned: true,
+			}, nil
+		}
+
+		creds := &domain.User{
+			Username: "testuser",
+			Password: "password",
+		}
+
+		user, err := uc.LoginUser(ctx, creds, "127.0.0.1")
+		assert.Error(t, err)
+		assert.Nil(t, user)
+		assert.Equal(t, "user is banned", err.Error())
+	})
+
 	// Тест-кейс 9: Ошибка получения пользователя из базы данных
 	t.Run("Database Error", func(t *testing.T) {
 		mockAuthRepo.GetUserByNameFunc = func(ctx context.Context, username string) (*domain.User, error) {
@@ -413,7 +465,7 @@ func TestLoginUser(t *testing.T) {
 			Password: "password",
 		}
 
-		user, err := uc.LoginUser(ctx, creds)
+		user, err := uc.LoginUser(ctx, creds, "127.0.0.1")
 		assert.Error(t, err)
 		assert.Nil(t, user)
 		assert.Equal(t, "user not found", err.Error())
@@ -438,7 +490,7 @@ func TestLoginUser(t *testing.T) {
 			UUID:     "valid-uuid",
 		}
 
-		user, err := uc.LoginUser(ctx, creds)
+		user, err := uc.LoginUser(ctx, creds, "127.0.0.1")
 		assert.Error(t, err)
 		assert.Nil(t, user)
 		assert.Contains(t, err.Error(), "input contains invalid characters")
@@ -456,10 +508,22 @@ func TestPutUser(t *testing.T) {
 		}
 	}()
 
-	mockAuthRepo := &mocks.MockAuthRepository{}
+	mockAuthRepo := &mocks.MockAuthRepository{
+		GetUserByIdFunc: func(ctx context.Context, userID string) (*domain.User, error) {
+			return &domain.User{UUID: userID, Email: "test@example.com", EmailVerified: true}, nil
+		},
+	}
 	mockMinioService := &mocks.MockMinioService{}
+	jwtToken, _ := middleware.NewJwtToken("secret")
+	var sentTo []string
+	mailSender := &mocks.MockMailSender{
+		MockSend: func(ctx context.Context, to string, subject string, body string) error {
+			sentTo = append(sentTo, to)
+			return nil
+		},
+	}
 
-	uc := NewAuthUseCase(mockAuthRepo, mockMinioService)
+	uc := NewAuthUseCase(mockAuthRepo, mockMinioService, nil, nil, mailSender, jwtToken, "", nil, nil)
 	ctx := context.TODO()
 
 	validAvatar, _ := GenerateImage("jpeg", 2000, 2000)
@@ -606,7 +670,6 @@ func TestPutUser(t *testing.T) {
 	t.Run("Validation Errors", func(t *testing.T) {
 		creds = &domain.User{
 			Username: "u",             // Слишком короткий логин
-			Password: "123",           // Слишком простой пароль
 			Email:    "invalid_email", // Некорректный email
 			Name:     "Invalid<>Name", // Некорректное имя
 		}
@@ -615,14 +678,44 @@ func TestPutUser(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "username")
 		assert.Contains(t, err.Error(), "email")
-		assert.Contains(t, err.Error(), "password")
 		assert.Contains(t, err.Error(), "name")
 	})
+
+	t.Run("Email Changed", func(t *testing.T) {
+		mockAuthRepo.PutUserFunc = func(ctx context.Context, user *domain.User, userID string) error {
+			return nil
+		}
+		sentTo = nil
+
+		err := uc.PutUser(ctx, &domain.User{Email: "new@example.com"}, userID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"new@example.com"}, sentTo)
+	})
+
+	t.Run("Same Email Not Reverified", func(t *testing.T) {
+		mockAuthRepo.PutUserFunc = func(ctx context.Context, user *domain.User, userID string) error {
+			return nil
+		}
+		sentTo = nil
+
+		err := uc.PutUser(ctx, &domain.User{Email: "test@example.com", Name: "Test User"}, userID, nil)
+		require.NoError(t, err)
+		assert.Empty(t, sentTo)
+	})
+
+	t.Run("User Not Found", func(t *testing.T) {
+		mockAuthRepo.GetUserByIdFunc = func(ctx context.Context, userID string) (*domain.User, error) {
+			return nil, errors.New("user not found")
+		}
+
+		err := uc.PutUser(ctx, &domain.User{Email: "new@example.com"}, userID, nil)
+		assert.EqualError(t, err, "user not found")
+	})
 }
 
 func TestGetAllUser(t *testing.T) {
 	mockAuthRepo := &mocks.MockAuthRepository{}
-	uc := NewAuthUseCase(mockAuthRepo, nil)
+	uc := NewAuthUseCase(mockAuthRepo, nil, nil, nil, nil, nil, "", nil, nil)
 	ctx := context.TODO()
 
 	// Тест-кейс 1: Успешное получение всех пользователей
@@ -656,7 +749,7 @@ func TestGetUserById(t *testing.T) {
 	}()
 
 	mockAuthRepo := &mocks.MockAuthRepository{}
-	uc := NewAuthUseCase(mockAuthRepo, nil)
+	uc := NewAuthUseCase(mockAuthRepo, nil, nil, nil, nil, nil, "", nil, nil)
 	ctx := context.TODO()
 
 	// Успешный тест-кейс
@@ -722,3 +815,642 @@ func TestGetUserById(t *testing.T) {
 		assert.Contains(t, err.Error(), "repository error")
 	})
 }
+
+func TestChangePassword(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	oldHash, _ := middleware.HashPassword("oldPassword1")
+	mockAuthRepo := &mocks.MockAuthRepository{
+		GetUserByIdFunc: func(ctx context.Context, userID string) (*domain.User, error) {
+			return &domain.User{UUID: userID, Password: oldHash}, nil
+		},
+	}
+	uc := NewAuthUseCase(mockAuthRepo, nil, nil, nil, nil, nil, "", nil, nil)
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		var saved string
+		mockAuthRepo.MockUpdatePassword = func(ctx context.Context, userID string, hashedPassword string) error {
+			saved = hashedPassword
+			return nil
+		}
+
+		err := uc.ChangePassword(ctx, "user1", "oldPassword1", "newPassword1")
+		assert.NoError(t, err)
+		assert.True(t, middleware.CheckPassword(saved, "newPassword1"))
+	})
+
+	t.Run("Wrong Old Password", func(t *testing.T) {
+		err := uc.ChangePassword(ctx, "user1", "notMyPassword", "newPassword1")
+		assert.EqualError(t, err, "wrong old password")
+	})
+
+	t.Run("Invalid New Password", func(t *testing.T) {
+		err := uc.ChangePassword(ctx, "user1", "oldPassword1", "1")
+		assert.EqualError(t, err, "invalid new password")
+	})
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockAuthRepo := &mocks.MockAuthRepository{}
+	tokenStore := &mocks.MockTokenStore{
+		MockCreateToken: func(ctx context.Context, purpose string, userID string, ttl time.Duration) (string, error) {
+			assert.Equal(t, domain.TokenPasswordReset, purpose)
+			assert.Equal(t, "user1", userID)
+			return "reset-token", nil
+		},
+	}
+	var sentTo, sentBody string
+	mailSender := &mocks.MockMailSender{
+		MockSend: func(ctx context.Context, to string, subject string, body string) error {
+			sentTo, sentBody = to, body
+			return nil
+		},
+	}
+	uc := NewAuthUseCase(mockAuthRepo, nil, tokenStore, nil, mailSender, nil, "https://example.com", nil, nil)
+	ctx := context.TODO()
+
+	t.Run("Link Sent", func(t *testing.T) {
+		mockAuthRepo.MockGetUserByEmail = func(ctx context.Context, email string) (*domain.User, error) {
+			return &domain.User{UUID: "user1", Email: email}, nil
+		}
+
+		err := uc.RequestPasswordReset(ctx, "test@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "test@example.com", sentTo)
+		assert.Contains(t, sentBody, "https://example.com/reset-password?token=reset-token")
+	})
+
+	t.Run("Unknown Email", func(t *testing.T) {
+		sentTo = ""
+		mockAuthRepo.MockGetUserByEmail = func(ctx context.Context, email string) (*domain.User, error) {
+			return nil, errors.New("user not found")
+		}
+
+		err := uc.RequestPasswordReset(ctx, "nobody@example.com")
+		assert.NoError(t, err)
+		assert.Empty(t, sentTo)
+	})
+
+	t.Run("Mail Failure", func(t *testing.T) {
+		mockAuthRepo.MockGetUserByEmail = func(ctx context.Context, email string) (*domain.User, error) {
+			return &domain.User{UUID: "user1", Email: email}, nil
+		}
+		mailSender.MockSend = func(ctx context.Context, to string, subject string, body string) error {
+			return errors.New("smtp is down")
+		}
+
+		err := uc.RequestPasswordReset(ctx, "test@example.com")
+		assert.EqualError(t, err, "failed to send email")
+	})
+}
+
+func TestResetPassword(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	consumed := false
+	tokenStore := &mocks.MockTokenStore{
+		MockConsumeToken: func(ctx context.Context, purpose string, token string) (string, error) {
+			if token != "reset-token" || consumed {
+				return "", errors.New("invalid or expired token")
+			}
+			consumed = true
+			return "user1", nil
+		},
+	}
+	var updatedUser string
+	mockAuthRepo := &mocks.MockAuthRepository{
+		MockUpdatePassword: func(ctx context.Context, userID string, hashedPassword string) error {
+			updatedUser = userID
+			return nil
+		},
+	}
+	uc := NewAuthUseCase(mockAuthRepo, nil, tokenStore, nil, nil, nil, "", nil, nil)
+	ctx := context.TODO()
+
+	t.Run("Invalid Password Keeps Token", func(t *testing.T) {
+		_, err := uc.ResetPassword(ctx, "reset-token", "1")
+		assert.EqualError(t, err, "invalid new password")
+		assert.False(t, consumed)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		userID, err := uc.ResetPassword(ctx, "reset-token", "newPassword1")
+		require.NoError(t, err)
+		assert.Equal(t, "user1", userID)
+		assert.Equal(t, "user1", updatedUser)
+	})
+
+	t.Run("Token Is Single Use", func(t *testing.T) {
+		_, err := uc.ResetPassword(ctx, "reset-token", "newPassword1")
+		assert.EqualError(t, err, "invalid or expired token")
+	})
+}
+
+func TestVerifyEmail(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	jwtToken, _ := middleware.NewJwtToken("secret")
+	var sentBody string
+	mailSender := &mocks.MockMailSender{
+		MockSend: func(ctx context.Context, to string, subject string, body string) error {
+			sentBody = body
+			return nil
+		},
+	}
+	mockAuthRepo := &mocks.MockAuthRepository{
+		GetUserByIdFunc: func(ctx context.Context, userID string) (*domain.User, error) {
+			return &domain.User{UUID: userID, Email: "test@example.com"}, nil
+		},
+		MockMarkEmailVerified: func(ctx context.Context, userID string, email string) error {
+			if email != "test@example.com" {
+				return errors.New("invalid or expired token")
+			}
+			return nil
+		},
+	}
+	uc := NewAuthUseCase(mockAuthRepo, nil, nil, nil, mailSender, jwtToken, "https://example.com", nil, nil)
+	ctx := context.TODO()
+
+	t.Run("Link From Email", func(t *testing.T) {
+		require.NoError(t, uc.ResendVerificationEmail(ctx, "user1"))
+		prefix := "https://example.com/verify-email?token="
+		start := strings.Index(sentBody, prefix)
+		require.NotEqual(t, -1, start)
+		token := strings.Fields(sentBody[start+len(prefix):])[0]
+
+		userID, err := uc.VerifyEmail(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, "user1", userID)
+	})
+
+	t.Run("Email Changed Since", func(t *testing.T) {
+		token, _ := jwtToken.CreateEmailToken("user1", "old@example.com", time.Now().Add(time.Hour).Unix())
+		_, err := uc.VerifyEmail(ctx, token)
+		assert.EqualError(t, err, "invalid or expired token")
+	})
+
+	t.Run("CSRF Token Rejected", func(t *testing.T) {
+		token, _ := jwtToken.Create("session", time.Now().Add(time.Hour).Unix())
+		_, err := uc.VerifyEmail(ctx, token)
+		assert.EqualError(t, err, "invalid or expired token")
+	})
+
+	t.Run("Already Verified", func(t *testing.T) {
+		mockAuthRepo.GetUserByIdFunc = func(ctx context.Context, userID string) (*domain.User, error) {
+			return &domain.User{UUID: userID, EmailVerified: true}, nil
+		}
+		err := uc.ResendVerificationEmail(ctx, "user1")
+		assert.EqualError(t, err, "email already verified")
+	})
+}
+
+func TestTwoFactor(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	user := &domain.User{UUID: "user1", Username: "host"}
+	var savedHashes []string
+	mockAuthRepo := &mocks.MockAuthRepository{
+		GetUserByIdFunc: func(ctx context.Context, userID string) (*domain.User, error) {
+			copied := *user
+			return &copied, nil
+		},
+		MockSetTOTPSecret: func(ctx context.Context, userID string, secret string) error {
+			user.TOTPSecret = secret
+			return nil
+		},
+		MockEnableTOTP: func(ctx context.Context, userID string, recoveryCodeHashes []string) error {
+			user.TOTPEnabled = true
+			savedHashes = recoveryCodeHashes
+			return nil
+		},
+		MockUseRecoveryCode: func(ctx context.Context, userID string, codeHash string) error {
+			for i, hash := range savedHashes {
+				if hash == codeHash {
+					savedHashes = append(savedHashes[:i], savedHashes[i+1:]...)
+					return nil
+				}
+			}
+			return errors.New("invalid code")
+		},
+		MockUseTOTPStep: func(ctx context.Context, userID string, step int64) error {
+			if step <= user.TOTPLastStep {
+				return errors.New("invalid code")
+			}
+			user.TOTPLastStep = step
+			return nil
+		},
+	}
+	challenges := map[string]string{}
+	tokenStore := &mocks.MockTokenStore{
+		MockCreateToken: func(ctx context.Context, purpose string, userID string, ttl time.Duration) (string, error) {
+			assert.Equal(t, domain.TokenLoginChallenge, purpose)
+			challenges["challenge"] = userID
+			return "challenge", nil
+		},
+		MockConsumeToken: func(ctx context.Context, purpose string, token string) (string, error) {
+			userID, ok := challenges[token]
+			if !ok {
+				return "", errors.New("invalid or expired token")
+			}
+			delete(challenges, token)
+			return userID, nil
+		},
+	}
+	uc := NewAuthUseCase(mockAuthRepo, nil, tokenStore, newAllowAllLimiter(), nil, nil, "", nil, nil)
+	ctx := context.TODO()
+
+	t.Run("Enable Before Setup", func(t *testing.T) {
+		_, err := uc.EnableTOTP(ctx, "user1", "123456")
+		assert.EqualError(t, err, "two-factor setup not started")
+	})
+
+	setup, err := uc.SetupTOTP(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, user.TOTPSecret, setup.Secret)
+	assert.True(t, strings.HasPrefix(setup.URI, "otpauth://totp/FightClub:host?"))
+
+	t.Run("Enable With Wrong Code", func(t *testing.T) {
+		_, err := uc.EnableTOTP(ctx, "user1", "12345")
+		assert.EqualError(t, err, "invalid code")
+		assert.False(t, user.TOTPEnabled)
+	})
+
+	code, err := totp.Code(setup.Secret, time.Now())
+	require.NoError(t, err)
+	recoveryCodes, err := uc.EnableTOTP(ctx, "user1", code)
+	require.NoError(t, err)
+	require.Len(t, recoveryCodes, 10)
+	require.Len(t, savedHashes, 10)
+	assert.NotContains(t, savedHashes, recoveryCodes[0])
+	assert.True(t, user.TOTPEnabled)
+
+	t.Run("Setup Again Rejected", func(t *testing.T) {
+		_, err := uc.SetupTOTP(ctx, "user1")
+		assert.EqualError(t, err, "two-factor authentication already enabled")
+	})
+
+	t.Run("Code From Enable Not Reused", func(t *testing.T) {
+		challenge, _ := uc.CreateLoginChallenge(ctx, "user1")
+		_, err := uc.VerifySecondFactor(ctx, challenge, code, "127.0.0.1")
+		assert.EqualError(t, err, "invalid code")
+	})
+
+	// код следующего шага принимается с учётом расхождения часов
+	code, err = totp.Code(setup.Secret, time.Now().Add(totp.Period*time.Second))
+	require.NoError(t, err)
+
+	t.Run("Login With TOTP", func(t *testing.T) {
+		challenge, err := uc.CreateLoginChallenge(ctx, "user1")
+		require.NoError(t, err)
+		loggedIn, err := uc.VerifySecondFactor(ctx, challenge, code, "127.0.0.1")
+		require.NoError(t, err)
+		assert.Equal(t, "user1", loggedIn.UUID)
+
+		_, err = uc.VerifySecondFactor(ctx, challenge, code, "127.0.0.1")
+		assert.EqualError(t, err, "invalid or expired token")
+	})
+
+	t.Run("Replayed TOTP", func(t *testing.T) {
+		challenge, _ := uc.CreateLoginChallenge(ctx, "user1")
+		_, err := uc.VerifySecondFactor(ctx, challenge, code, "127.0.0.1")
+		assert.EqualError(t, err, "invalid code")
+	})
+
+	t.Run("Wrong Code Burns Challenge", func(t *testing.T) {
+		challenge, _ := uc.CreateLoginChallenge(ctx, "user1")
+		_, err := uc.VerifySecondFactor(ctx, challenge, "000000", "127.0.0.1")
+		assert.EqualError(t, err, "invalid code")
+		_, err = uc.VerifySecondFactor(ctx, challenge, code, "127.0.0.1")
+		assert.EqualError(t, err, "invalid or expired token")
+	})
+
+	t.Run("Login With Recovery Code", func(t *testing.T) {
+		challenge, _ := uc.CreateLoginChallenge(ctx, "user1")
+		_, err := uc.VerifySecondFactor(ctx, challenge, strings.ToUpper(recoveryCodes[0]), "127.0.0.1")
+		require.NoError(t, err)
+
+		challenge, _ = uc.CreateLoginChallenge(ctx, "user1")
+		_, err = uc.VerifySecondFactor(ctx, challenge, recoveryCodes[0], "127.0.0.1")
+		assert.EqualError(t, err, "invalid code")
+	})
+
+	t.Run("Banned User", func(t *testing.T) {
+		user.IsBanned = true
+		defer func() { user.IsBanned = false }()
+		challenge, _ := uc.CreateLoginChallenge(ctx, "user1")
+		_, err := uc.VerifySecondFactor(ctx, challenge, code, "127.0.0.1")
+		assert.EqualError(t, err, "user is banned")
+	})
+
+	t.Run("Disable", func(t *testing.T) {
+		disabled := false
+		mockAuthRepo.MockDisableTOTP = func(ctx context.Context, userID string) error {
+			disabled = true
+			return nil
+		}
+		err := uc.DisableTOTP(ctx, "user1", "000000")
+		assert.EqualError(t, err, "invalid code")
+		assert.False(t, disabled)
+
+		require.NoError(t, uc.DisableTOTP(ctx, "user1", recoveryCodes[1]))
+		assert.True(t, disabled)
+	})
+}
+
+func TestLoginUser_Lockout(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	password, _ := middleware.HashPassword("password")
+	mockAuthRepo := &mocks.MockAuthRepository{
+		GetUserByNameFunc: func(ctx context.Context, username string) (*domain.User, error) {
+			if username != "testuser" {
+				return nil, errors.New("user not found")
+			}
+			return &domain.User{UUID: "user1", Username: username, Password: password}, nil
+		},
+		GetUserByIdFunc: func(ctx context.Context, userID string) (*domain.User, error) {
+			return &domain.User{UUID: userID, Username: "testuser"}, nil
+		},
+	}
+	var failures []string
+	var resets []string
+	var locked time.Duration
+	limiter := &mocks.MockLoginLimiter{
+		MockCheck: func(ctx context.Context, username string, ip string) (time.Duration, error) {
+			return locked, nil
+		},
+		MockRegisterFailure: func(ctx context.Context, username string, ip string) error {
+			failures = append(failures, username+"@"+ip)
+			return nil
+		},
+		MockReset: func(ctx context.Context, username string) error {
+			resets = append(resets, username)
+			return nil
+		},
+	}
+	uc := NewAuthUseCase(mockAuthRepo, nil, nil, limiter, nil, nil, "", nil, nil)
+	ctx := context.TODO()
+
+	t.Run("Failures Counted", func(t *testing.T) {
+		_, err := uc.LoginUser(ctx, &domain.User{Username: "testuser", Password: "wrongpass"}, "10.0.0.1")
+		assert.EqualError(t, err, "invalid credentials")
+		_, err = uc.LoginUser(ctx, &domain.User{Username: "nobody", Password: "password"}, "10.0.0.1")
+		assert.EqualError(t, err, "user not found")
+		assert.Equal(t, []string{"testuser@10.0.0.1", "nobody@10.0.0.1"}, failures)
+		assert.Empty(t, resets)
+	})
+
+	t.Run("Success Resets Counter", func(t *testing.T) {
+		_, err := uc.LoginUser(ctx, &domain.User{Username: "testuser", Password: "password"}, "10.0.0.1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"testuser"}, resets)
+	})
+
+	t.Run("Locked Out", func(t *testing.T) {
+		locked = time.Minute
+		defer func() { locked = 0 }()
+		_, err := uc.LoginUser(ctx, &domain.User{Username: "testuser", Password: "password"}, "10.0.0.1")
+		assert.EqualError(t, err, "too many login attempts")
+	})
+
+	t.Run("Admin Unlock", func(t *testing.T) {
+		resets = nil
+		require.NoError(t, uc.UnlockUser(ctx, "user1"))
+		assert.Equal(t, []string{"testuser"}, resets)
+	})
+}
+
+func TestOIDCLogin(t *testing.T) {
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	fake := oidctest.NewProvider(t, "fight-club", "secret")
+	provider := oidc.NewProvider(oidc.Config{
+		Issuer:       fake.Issuer(),
+		ClientID:     "fight-club",
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost:3000/oidc/callback",
+	}, fake.Server.Client())
+
+	usersByID := map[string]*domain.User{
+		"verified":   {UUID: "verified", Username: "verified", Email: "verified@example.com", EmailVerified: true},
+		"unverified": {UUID: "unverified", Username: "unverified", Email: "unverified@example.com"},
+		"banned":     {UUID: "banned", Username: "banned", Email: "banned@example.com", EmailVerified: true, IsBanned: true},
+	}
+	identities := map[string]string{}
+	mockAuthRepo := &mocks.MockAuthRepository{
+		MockGetUserByIdentity: func(ctx context.Context, provider string, subject string) (*domain.User, error) {
+			assert.Equal(t, fake.Issuer(), provider)
+			if userID, ok := identities[subject]; ok {
+				return usersByID[userID], nil
+			}
+			return nil, errors.New("user not found")
+		},
+		MockGetUserByEmail: func(ctx context.Context, email string) (*domain.User, error) {
+			for _, user := range usersByID {
+				if user.Email == email {
+					return user, nil
+				}
+			}
+			return nil, errors.New("user not found")
+		},
+		GetUserByNameFunc: func(ctx context.Context, username string) (*domain.User, error) {
+			for _, user := range usersByID {
+				if user.Username == username {
+					return user, nil
+				}
+			}
+			return nil, errors.New("user not found")
+		},
+		MockLinkIdentity: func(ctx context.Context, userID string, provider string, subject string) error {
+			identities[subject] = userID
+			return nil
+		},
+		MockCreateIdentityUser: func(ctx context.Context, user *domain.User, provider string, subject string) error {
+			user.UUID = "created-" + subject
+			user.EmailVerified = true
+			usersByID[user.UUID] = user
+			identities[subject] = user.UUID
+			return nil
+		},
+	}
+	states := map[string]domain.OIDCState{}
+	stateStore := &mocks.MockOIDCStateStore{
+		MockSave: func(ctx context.Context, state string, data domain.OIDCState, ttl time.Duration) error {
+			states[state] = data
+			return nil
+		},
+		MockConsume: func(ctx context.Context, state string) (*domain.OIDCState, error) {
+			data, ok := states[state]
+			if !ok {
+				return nil, errors.New("invalid or expired login state")
+			}
+			delete(states, state)
+			return &data, nil
+		},
+	}
+	uc := NewAuthUseCase(mockAuthRepo, nil, nil, nil, nil, nil, "", provider, stateStore)
+	ctx := context.TODO()
+
+	// login проходит страницу провайдера так же, как браузер: nonce и code_challenge берутся из ссылки
+	login := func(identity oidctest.Identity) (string, string) {
+		authURL, state, err := uc.StartOIDCLogin(ctx)
+		require.NoError(t, err)
+		parsed, err := url.Parse(authURL)
+		require.NoError(t, err)
+		query := parsed.Query()
+		require.Equal(t, state, query.Get("state"))
+		return fake.Authorize(identity, query.Get("nonce"), query.Get("code_challenge")), state
+	}
+
+	t.Run("Not Configured", func(t *testing.T) {
+		disabled := NewAuthUseCase(mockAuthRepo, nil, nil, nil, nil, nil, "", nil, nil)
+		_, _, err := disabled.StartOIDCLogin(ctx)
+		assert.EqualError(t, err, "oidc login is not configured")
+	})
+
+	t.Run("Register New User", func(t *testing.T) {
+		code, state := login(oidctest.Identity{Subject: "new", Email: "new@example.com", EmailVerified: true, PreferredUsername: "new.user", Name: "New User"})
+		user, err := uc.FinishOIDCLogin(ctx, code, state)
+		require.NoError(t, err)
+		assert.Equal(t, "created-new", user.UUID)
+		assert.Equal(t, "new.user", user.Username)
+		assert.Equal(t, "New User", user.Name)
+		assert.Equal(t, "new@example.com", user.Email)
+		assert.NotEmpty(t, user.Password)
+
+		code, state = login(oidctest.Identity{Subject: "new", Email: "changed@example.com", EmailVerified: true})
+		again, err := uc.FinishOIDCLogin(ctx, code, state)
+		require.NoError(t, err)
+		assert.Equal(t, "created-new", again.UUID)
+	})
+
+	t.Run("Taken Username", func(t *testing.T) {
+		code, state := login(oidctest.Identity{Subject: "second", Email: "second@example.com", EmailVerified: true, PreferredUsername: "verified"})
+		user, err := uc.FinishOIDCLogin(ctx, code, state)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(user.Username, "verified_"))
+		assert.Equal(t, user.Username, user.Name)
+	})
+
+	t.Run("Link By Verified Email", func(t *testing.T) {
+		code, state := login(oidctest.Identity{Subject: "linked", Email: "verified@example.com", EmailVerified: true})
+		user, err := uc.FinishOIDCLogin(ctx, code, state)
+		require.NoError(t, err)
+		assert.Equal(t, "verified", user.UUID)
+		assert.Equal(t, "verified", identities["linked"])
+	})
+
+	t.Run("Local Email Not Verified", func(t *testing.T) {
+		code, state := login(oidctest.Identity{Subject: "squatter", Email: "unverified@example.com", EmailVerified: true})
+		_, err := uc.FinishOIDCLogin(ctx, code, state)
+		assert.EqualError(t, err, "email already registered")
+		assert.NotContains(t, identities, "squatter")
+	})
+
+	t.Run("Provider Email Not Verified", func(t *testing.T) {
+		code, state := login(oidctest.Identity{Subject: "attacker", Email: "verified@example.com"})
+		_, err := uc.FinishOIDCLogin(ctx, code, state)
+		assert.EqualError(t, err, "email is not verified by provider")
+		assert.NotContains(t, identities, "attacker")
+	})
+
+	t.Run("Banned User", func(t *testing.T) {
+		code, state := login(oidctest.Identity{Subject: "banned", Email: "banned@example.com", EmailVerified: true})
+		_, err := uc.FinishOIDCLogin(ctx, code, state)
+		assert.EqualError(t, err, "user is banned")
+	})
+
+	t.Run("State Used Twice", func(t *testing.T) {
+		code, state := login(oidctest.Identity{Subject: "new", EmailVerified: true})
+		_, err := uc.FinishOIDCLogin(ctx, code, state)
+		require.NoError(t, err)
+		_, err = uc.FinishOIDCLogin(ctx, code, state)
+		assert.EqualError(t, err, "invalid or expired login state")
+	})
+
+	t.Run("Foreign State", func(t *testing.T) {
+		code, _ := login(oidctest.Identity{Subject: "new", EmailVerified: true})
+		_, otherState := login(oidctest.Identity{Subject: "new", EmailVerified: true})
+		_, err := uc.FinishOIDCLogin(ctx, code, otherState)
+		assert.ErrorIs(t, err, oidc.ErrExchange)
+	})
+
+	t.Run("Missing Code", func(t *testing.T) {
+		_, err := uc.FinishOIDCLogin(ctx, "", "state")
+		assert.EqualError(t, err, "code and state are required")
+	})
+}
+
+func TestOIDCUsername(t *testing.T) {
+	mockAuthRepo := &mocks.MockAuthRepository{
+		GetUserByNameFunc: func(ctx context.Context, username string) (*domain.User, error) {
+			return nil, errors.New("user not found")
+		},
+	}
+	uc := &authUseCase{authRepository: mockAuthRepo}
+	tests := []oidc.Claims{
+		{PreferredUsername: "john.smith"},
+		{Email: "x@example.com"},
+		{PreferredUsername: "-=very long name from provider=-"},
+		{PreferredUsername: "Иван Петров"},
+		{},
+	}
+	for _, claims := range tests {
+		username, err := uc.oidcUsername(context.TODO(), &claims)
+		require.NoError(t, err)
+		assert.True(t, validation.ValidateLogin(username), username)
+		assert.LessOrEqual(t, len([]rune(username)), 20, username)
+	}
+}
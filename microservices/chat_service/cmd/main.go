@@ -0,0 +1,87 @@
+package main
+
+import (
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/metrics"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/session"
+	grpcChat "2024_2_FIGHT-CLUB/microservices/chat_service/controller"
+	generatedChat "2024_2_FIGHT-CLUB/microservices/chat_service/controller/gen"
+	chatRepository "2024_2_FIGHT-CLUB/microservices/chat_service/repository"
+	chatUseCase "2024_2_FIGHT-CLUB/microservices/chat_service/usecase"
+	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"log"
+	"net"
+	"net/http"
+	"os"
+)
+
+func main() {
+	// Загрузка переменных окружения
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found")
+	}
+
+	// Инициализация зависимостей
+	middleware.InitRedis()
+	redisStore := session.NewRedisSessionStore(middleware.RedisClient)
+	db := middleware.DbConnect()
+
+	// Инициализация метрик
+	metrics.InitMetrics()
+	metrics.InitRepoMetric()
+	// Экспозиция метрик на порту 9094
+	go func() {
+		http.Handle("/api/metrics", promhttp.Handler())
+		log.Println("Metrics server is running on :9094")
+		if err := http.ListenAndServe(":9094", nil); err != nil {
+			log.Fatalf("Failed to start metrics server: %v", err)
+		}
+	}()
+
+	// Инициализация логгеров
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		if err := logger.SyncLoggers(); err != nil {
+			log.Fatalf("Failed to sync loggers: %v", err)
+		}
+	}()
+
+	sessionService := session.NewSessionService(redisStore)
+	chatsRepository := chatRepository.NewChatRepository(db)
+	chatsUseCase := chatUseCase.NewChatService(chatsRepository)
+	hub := grpcChat.NewHub(middleware.RedisClient)
+	defer hub.Close()
+	chatServer := grpcChat.NewGrpcChatHandler(sessionService, chatsUseCase, hub)
+
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(middleware.ChainUnaryInterceptors(
+			middleware.RecoveryInterceptor,     // интерсептор для обработки паники
+			middleware.UnaryMetricsInterceptor, // интерсептор для метрик
+			// интерсептор для проверки ролей
+			middleware.RoleInterceptor(sessionService, grpcChat.MethodRoles),
+			// интерсептор для проверки подтверждения почты
+			middleware.EmailVerifiedInterceptor(sessionService, grpcChat.VerifiedMethods),
+		)),
+		grpc.ChainStreamInterceptor(
+			middleware.StreamRecoveryInterceptor, // паника в потоке Subscribe
+			middleware.StreamMetricsInterceptor,  // метрики потоков
+		),
+	)
+	generatedChat.RegisterChatServiceServer(grpcServer, chatServer)
+
+	// Запуск gRPC сервера
+	listener, err := net.Listen("tcp", os.Getenv("CHAT_SERVICE_ADDRESS"))
+	if err != nil {
+		log.Fatalf("Failed to listen on address: %s %v", os.Getenv("CHAT_SERVICE_ADDRESS"), err)
+	}
+
+	log.Printf("ChatService is running on address: %s", os.Getenv("CHAT_SERVICE_ADDRESS"))
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Fatalf("Failed to serve gRPC server: %v", err)
+	}
+}
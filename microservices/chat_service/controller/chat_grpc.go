@@ -0,0 +1,324 @@
+package controller
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/pagination"
+	"2024_2_FIGHT-CLUB/internal/service/session"
+	"2024_2_FIGHT-CLUB/microservices/chat_service/controller/gen"
+	"2024_2_FIGHT-CLUB/microservices/chat_service/usecase"
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"time"
+)
+
+// MethodRoles - минимальные роли для методов сервиса, проверяются middleware.RoleInterceptor
+var MethodRoles = map[string]string{
+	gen.ChatService_GetChats_FullMethodName:      domain.RoleGuest,
+	gen.ChatService_GetMessages_FullMethodName:   domain.RoleGuest,
+	gen.ChatService_SendMessage_FullMethodName:   domain.RoleGuest,
+	gen.ChatService_MarkDelivered_FullMethodName: domain.RoleGuest,
+	gen.ChatService_MarkChatRead_FullMethodName:  domain.RoleGuest,
+	gen.ChatService_SendTyping_FullMethodName:    domain.RoleGuest,
+	gen.ChatService_GetPresence_FullMethodName:   domain.RoleGuest,
+}
+
+// VerifiedMethods - методы, недоступные до подтверждения почты, проверяются middleware.EmailVerifiedInterceptor
+var VerifiedMethods = map[string]bool{
+	gen.ChatService_SendMessage_FullMethodName: true,
+}
+
+type GrpcChatHandler struct {
+	gen.ChatServiceServer
+	sessionService session.InterfaceSession
+	usecase        usecase.ChatUseCase
+	hub            *Hub
+}
+
+func NewGrpcChatHandler(sessionService session.InterfaceSession, usecase usecase.ChatUseCase, hub *Hub) *GrpcChatHandler {
+	return &GrpcChatHandler{
+		sessionService: sessionService,
+		usecase:        usecase,
+		hub:            hub,
+	}
+}
+
+const timeLayout = time.RFC3339Nano
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(timeLayout)
+}
+
+func convertMessage(msg *domain.Message) *gen.Message {
+	return &gen.Message{
+		Id:          int32(msg.ID),
+		SenderId:    msg.SenderID,
+		ReceiverId:  msg.ReceiverID,
+		Content:     msg.Content,
+		CreatedAt:   msg.CreatedAt.Format(timeLayout),
+		DeliveredAt: formatTime(msg.DeliveredAt),
+		ReadAt:      formatTime(msg.ReadAt),
+	}
+}
+
+func (h *GrpcChatHandler) userID(ctx context.Context, sessionID string) (string, error) {
+	userID, err := h.sessionService.GetUserID(ctx, sessionID)
+	if err != nil || userID == "" {
+		return "", errors.New("session not found")
+	}
+	return userID, nil
+}
+
+func (h *GrpcChatHandler) GetChats(ctx context.Context, in *gen.GetChatsRequest) (*gen.GetChatsResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received GetChats request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	var cursor *domain.ChatCursor
+	if in.Cursor != "" {
+		cursor = &domain.ChatCursor{}
+		if err := pagination.DecodeCursor(in.Cursor, cursor); err != nil {
+			logger.AccessLogger.Warn("Failed to parse cursor",
+				zap.String("request_id", requestID),
+				zap.Error(err))
+			return nil, err
+		}
+	}
+
+	userID, err := h.userID(ctx, in.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	chats, nextCursor, err := h.usecase.GetAllChats(ctx, userID, cursor)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get chats",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	response := &gen.GetChatsResponse{NextCursor: nextCursor}
+	for _, chat := range chats {
+		response.Chats = append(response.Chats, &gen.Chat{
+			LastMessage:  chat.LastMessage,
+			LastDate:     chat.LastDate.Format(timeLayout),
+			AuthorName:   chat.AuthorName,
+			AuthorAvatar: chat.AuthorAvatar,
+			AuthorUuid:   chat.AuthorUUID,
+			UnreadCount:  int32(chat.UnreadCount),
+		})
+	}
+	return response, nil
+}
+
+func (h *GrpcChatHandler) GetMessages(ctx context.Context, in *gen.GetMessagesRequest) (*gen.GetMessagesResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received GetMessages request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	lastTime := time.Now()
+	if in.LastTime != "" {
+		parsed, err := time.Parse(timeLayout, in.LastTime)
+		if err != nil {
+			return nil, errors.New("failed to parse lastTime")
+		}
+		lastTime = parsed
+	}
+
+	userID, err := h.userID(ctx, in.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := h.usecase.GetChat(ctx, userID, in.PartnerId, lastTime)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to get messages",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	response := &gen.GetMessagesResponse{}
+	for _, msg := range messages {
+		response.Messages = append(response.Messages, convertMessage(msg))
+	}
+	return response, nil
+}
+
+// SendMessage сохраняет сообщение и публикует его в канал получателя,
+// откуда его заберёт реплика, к которой получатель подключён
+func (h *GrpcChatHandler) SendMessage(ctx context.Context, in *gen.SendMessageRequest) (*gen.Message, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received SendMessage request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	userID, err := h.userID(ctx, in.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := h.usecase.SendNewMessage(ctx, in.ReceiverId, userID, in.Content)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to send message",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	message := convertMessage(saved)
+	h.publish(ctx, saved.ReceiverID, &gen.ChatEvent{Event: &gen.ChatEvent_Message{Message: message}})
+	return message, nil
+}
+
+// MarkDelivered отмечает доставку сообщения получателю из сессии и сообщает об этом автору
+func (h *GrpcChatHandler) MarkDelivered(ctx context.Context, in *gen.MarkDeliveredRequest) (*gen.Receipt, error) {
+	requestID := middleware.GetRequestID(ctx)
+	userID, err := h.userID(ctx, in.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveredAt, senderID, count, err := h.usecase.MarkDelivered(ctx, int(in.MessageId), userID)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to mark message delivered",
+			zap.String("request_id", requestID),
+			zap.Int32("message_id", in.MessageId),
+			zap.Error(err))
+		return nil, err
+	}
+
+	receipt := &gen.Receipt{
+		Type:      domain.ChatReceiptDelivered,
+		MessageId: in.MessageId,
+		UserId:    userID,
+		At:        deliveredAt.Format(timeLayout),
+	}
+	// автора берём из базы, повторная отметка или чужое сообщение событий не порождают
+	if count > 0 {
+		h.publish(ctx, senderID, &gen.ChatEvent{Event: &gen.ChatEvent_Delivered{Delivered: receipt}})
+	}
+	return receipt, nil
+}
+
+// MarkChatRead отмечает переписку с partnerId прочитанной и сообщает об этом собеседнику.
+// В ответе userId - собеседник, в событии для собеседника - прочитавший
+func (h *GrpcChatHandler) MarkChatRead(ctx context.Context, in *gen.MarkChatReadRequest) (*gen.Receipt, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received MarkChatRead request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	userID, err := h.userID(ctx, in.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	readAt, count, err := h.usecase.MarkChatRead(ctx, userID, in.PartnerId)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to mark chat read",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	if count > 0 {
+		h.publish(ctx, in.PartnerId, &gen.ChatEvent{Event: &gen.ChatEvent_Read{Read: &gen.Receipt{
+			Type:   domain.ChatReceiptRead,
+			UserId: userID,
+			At:     readAt.Format(timeLayout),
+		}}})
+	}
+	return &gen.Receipt{
+		Type:   domain.ChatReceiptRead,
+		UserId: in.PartnerId,
+		At:     readAt.Format(timeLayout),
+	}, nil
+}
+
+func (h *GrpcChatHandler) SendTyping(ctx context.Context, in *gen.SendTypingRequest) (*gen.SendTypingResponse, error) {
+	userID, err := h.userID(ctx, in.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	if in.PartnerId == "" || in.PartnerId == userID {
+		return nil, errors.New("invalid chat partner")
+	}
+
+	// собеседник увидит, кто печатает
+	h.publish(ctx, in.PartnerId, &gen.ChatEvent{Event: &gen.ChatEvent_Typing{Typing: &gen.Typing{
+		UserId: userID,
+		Typing: in.Typing,
+	}}})
+	return &gen.SendTypingResponse{}, nil
+}
+
+func (h *GrpcChatHandler) GetPresence(ctx context.Context, in *gen.GetPresenceRequest) (*gen.Presence, error) {
+	if _, err := h.userID(ctx, in.SessionId); err != nil {
+		return nil, err
+	}
+
+	online, err := h.hub.IsOnline(ctx, in.UserId)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to check presence",
+			zap.String("user_id", in.UserId),
+			zap.Error(err))
+		return nil, errors.New("error checking presence")
+	}
+	return &gen.Presence{
+		UserId: in.UserId,
+		Online: online,
+	}, nil
+}
+
+// Subscribe держит поток, пока клиент не отключится. Заголовки отправляются после
+// подписки на Redis, так что клиент, дождавшийся их, уже не пропустит события
+func (h *GrpcChatHandler) Subscribe(in *gen.SubscribeRequest, stream grpc.ServerStreamingServer[gen.ChatEvent]) error {
+	ctx := stream.Context()
+	userID, err := h.userID(ctx, in.SessionId)
+	if err != nil {
+		return err
+	}
+
+	events, err := h.hub.Subscribe(ctx, userID)
+	if err != nil {
+		logger.AccessLogger.Error("Failed to subscribe to user channel",
+			zap.String("user_id", userID),
+			zap.Error(err))
+		return errors.New("error subscribing to chat")
+	}
+	defer h.hub.Unsubscribe(context.Background(), userID, events)
+
+	if err = stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-events:
+			if err = stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (h *GrpcChatHandler) publish(ctx context.Context, userID string, event *gen.ChatEvent) {
+	if err := h.hub.Publish(ctx, userID, event); err != nil {
+		logger.AccessLogger.Warn("Failed to publish chat event",
+			zap.String("user_id", userID),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,268 @@
+package controller
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/microservices/chat_service/controller/gen"
+	"2024_2_FIGHT-CLUB/microservices/chat_service/mocks"
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newReplica поднимает отдельный экземпляр сервиса со своим подключением к общему Redis.
+// Идентификатор сессии в тестах совпадает с идентификатором пользователя
+func newReplica(t *testing.T, addr string, useCase *mocks.MockChatUseCase) gen.ChatServiceClient {
+	rdb := redis.NewClient(&redis.Options{Addr: addr})
+	sessions := &mocks.MockServiceSession{
+		MockGetUserID: func(ctx context.Context, sessionID string) (string, error) {
+			if sessionID == "" {
+				return "", errors.New("session not found")
+			}
+			return sessionID, nil
+		},
+	}
+	hub := NewHub(rdb)
+	server := grpc.NewServer(grpc.ChainStreamInterceptor(middleware.StreamRecoveryInterceptor))
+	gen.RegisterChatServiceServer(server, NewGrpcChatHandler(sessions, useCase, hub))
+
+	listener := bufconn.Listen(1 << 20)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		server.Stop()
+		_ = hub.Close()
+		_ = rdb.Close()
+	})
+	return gen.NewChatServiceClient(conn)
+}
+
+func subscribe(t *testing.T, client gen.ChatServiceClient, userID string) gen.ChatService_SubscribeClient {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	stream, err := client.Subscribe(ctx, &gen.SubscribeRequest{SessionId: userID})
+	require.NoError(t, err)
+	// заголовки приходят после подписки на Redis
+	header, err := stream.Header()
+	require.NoError(t, err)
+	require.NotNil(t, header)
+	return stream
+}
+
+func recvEvent(t *testing.T, stream gen.ChatService_SubscribeClient) *gen.ChatEvent {
+	received := make(chan *gen.ChatEvent, 1)
+	go func() {
+		event, err := stream.Recv()
+		if err == nil {
+			received <- event
+		}
+	}()
+	select {
+	case event := <-received:
+		return event
+	case <-time.After(2 * time.Second):
+		t.Fatal("no event received")
+		return nil
+	}
+}
+
+func TestGrpcChatHandler_DeliversAcrossReplicas(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	fakeRedis := miniredis.RunT(t)
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 123456000, time.UTC)
+	var undelivered int64 = 1
+	useCase := &mocks.MockChatUseCase{
+		MockSendNewMessage: func(ctx context.Context, receiver string, sender string, message string) (*domain.Message, error) {
+			return &domain.Message{ID: 7, SenderID: sender, ReceiverID: receiver, Content: message, CreatedAt: createdAt}, nil
+		},
+		MockMarkDelivered: func(ctx context.Context, messageID int, receiverID string) (time.Time, string, int64, error) {
+			assert.Equal(t, 7, messageID)
+			assert.Equal(t, "bob", receiverID)
+			count := undelivered
+			undelivered = 0
+			return createdAt.Add(time.Second), "alice", count, nil
+		},
+	}
+
+	replicaA := newReplica(t, fakeRedis.Addr(), useCase)
+	replicaB := newReplica(t, fakeRedis.Addr(), useCase)
+
+	aliceEvents := subscribe(t, replicaA, "alice")
+	bobEvents := subscribe(t, replicaB, "bob")
+
+	sent, err := replicaA.SendMessage(context.Background(), &gen.SendMessageRequest{
+		SessionId:  "alice",
+		ReceiverId: "bob",
+		Content:    "hello from A",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(7), sent.Id)
+	assert.Equal(t, "alice", sent.SenderId)
+	assert.Equal(t, createdAt.Format(time.RFC3339Nano), sent.CreatedAt)
+
+	message := recvEvent(t, bobEvents).GetMessage()
+	require.NotNil(t, message)
+	assert.Equal(t, "hello from A", message.Content)
+	assert.Equal(t, "alice", message.SenderId)
+
+	_, err = replicaB.MarkDelivered(context.Background(), &gen.MarkDeliveredRequest{
+		SessionId: "bob",
+		MessageId: message.Id,
+	})
+	require.NoError(t, err)
+
+	delivered := recvEvent(t, aliceEvents).GetDelivered()
+	require.NotNil(t, delivered)
+	assert.Equal(t, domain.ChatReceiptDelivered, delivered.Type)
+	assert.Equal(t, int32(7), delivered.MessageId)
+	assert.Equal(t, "bob", delivered.UserId)
+
+	// повторная отметка ничего не обновляет, и автор не получает второе событие
+	_, err = replicaB.MarkDelivered(context.Background(), &gen.MarkDeliveredRequest{
+		SessionId: "bob",
+		MessageId: message.Id,
+	})
+	require.NoError(t, err)
+	_, err = replicaB.SendTyping(context.Background(), &gen.SendTypingRequest{SessionId: "bob", PartnerId: "alice", Typing: true})
+	require.NoError(t, err)
+	typing := recvEvent(t, aliceEvents).GetTyping()
+	require.NotNil(t, typing)
+	assert.Equal(t, "bob", typing.UserId)
+}
+
+func TestGrpcChatHandler_MarkChatRead(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	fakeRedis := miniredis.RunT(t)
+	readAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	var unread int64 = 2
+	useCase := &mocks.MockChatUseCase{
+		MockMarkChatRead: func(ctx context.Context, readerID string, partnerID string) (time.Time, int64, error) {
+			count := unread
+			unread = 0
+			return readAt, count, nil
+		},
+	}
+	client := newReplica(t, fakeRedis.Addr(), useCase)
+	aliceEvents := subscribe(t, client, "alice")
+
+	receipt, err := client.MarkChatRead(context.Background(), &gen.MarkChatReadRequest{SessionId: "bob", PartnerId: "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.ChatReceiptRead, receipt.Type)
+	assert.Equal(t, "alice", receipt.UserId)
+	assert.Equal(t, readAt.Format(time.RFC3339Nano), receipt.At)
+
+	read := recvEvent(t, aliceEvents).GetRead()
+	require.NotNil(t, read)
+	assert.Equal(t, "bob", read.UserId)
+
+	// повторное прочтение ничего не меняет, и собеседник не получает событие
+	_, err = client.MarkChatRead(context.Background(), &gen.MarkChatReadRequest{SessionId: "bob", PartnerId: "alice"})
+	require.NoError(t, err)
+	_, err = client.SendTyping(context.Background(), &gen.SendTypingRequest{SessionId: "bob", PartnerId: "alice", Typing: true})
+	require.NoError(t, err)
+	typing := recvEvent(t, aliceEvents).GetTyping()
+	require.NotNil(t, typing)
+	assert.Equal(t, "bob", typing.UserId)
+}
+
+func TestGrpcChatHandler_PresenceFollowsSubscription(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	fakeRedis := miniredis.RunT(t)
+	client := newReplica(t, fakeRedis.Addr(), &mocks.MockChatUseCase{})
+
+	presence, err := client.GetPresence(context.Background(), &gen.GetPresenceRequest{SessionId: "alice", UserId: "bob"})
+	require.NoError(t, err)
+	assert.False(t, presence.Online)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := client.Subscribe(ctx, &gen.SubscribeRequest{SessionId: "bob"})
+	require.NoError(t, err)
+	_, err = stream.Header()
+	require.NoError(t, err)
+
+	presence, err = client.GetPresence(context.Background(), &gen.GetPresenceRequest{SessionId: "alice", UserId: "bob"})
+	require.NoError(t, err)
+	assert.True(t, presence.Online)
+
+	cancel()
+	require.Eventually(t, func() bool {
+		return fakeRedis.PubSubNumSub(userChannel("bob"))[userChannel("bob")] == 0
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestGrpcChatHandler_Errors(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	fakeRedis := miniredis.RunT(t)
+	client := newReplica(t, fakeRedis.Addr(), &mocks.MockChatUseCase{})
+
+	t.Run("subscribe without session", func(t *testing.T) {
+		stream, err := client.Subscribe(context.Background(), &gen.SubscribeRequest{})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, "session not found", status.Convert(err).Message())
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := client.GetChats(context.Background(), &gen.GetChatsRequest{SessionId: "alice", Cursor: "%%%"})
+		assert.Equal(t, "invalid cursor", status.Convert(err).Message())
+	})
+
+	t.Run("typing to self", func(t *testing.T) {
+		_, err := client.SendTyping(context.Background(), &gen.SendTypingRequest{SessionId: "alice", PartnerId: "alice"})
+		assert.Equal(t, "invalid chat partner", status.Convert(err).Message())
+	})
+
+	t.Run("invalid lastTime", func(t *testing.T) {
+		_, err := client.GetMessages(context.Background(), &gen.GetMessagesRequest{SessionId: "alice", PartnerId: "bob", LastTime: "yesterday"})
+		assert.Equal(t, "failed to parse lastTime", status.Convert(err).Message())
+	})
+}
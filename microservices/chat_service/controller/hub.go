@@ -0,0 +1,193 @@
+package controller
+
+import (
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/microservices/chat_service/controller/gen"
+	"context"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"strings"
+	"sync"
+)
+
+const (
+	eventBufferSize = 256
+	// События доставляются через Redis: каждая реплика подписана на каналы пользователей, у которых открыт Subscribe
+	userChannelPrefix = "chat:user:"
+)
+
+func userChannel(userID string) string {
+	return userChannelPrefix + userID
+}
+
+// Hub раздаёт события из Redis потокам Subscribe этой реплики
+type Hub struct {
+	redis         *redis.Client
+	pubsub        *redis.PubSub
+	subscriptions map[string]*userSubscription
+	mu            sync.Mutex
+	// redisMu упорядочивает появление и исчезновение подписок вместе с командами SUBSCRIBE/UNSUBSCRIBE,
+	// иначе отписка старого соединения может уйти в Redis после подписки нового
+	redisMu sync.Mutex
+}
+
+// userSubscription - потоки одного пользователя на реплике. ready закрывается,
+// когда Redis подтвердил подписку на канал
+type userSubscription struct {
+	streams   map[chan *gen.ChatEvent]struct{}
+	ready     chan struct{}
+	confirmed bool
+}
+
+func NewHub(redisClient *redis.Client) *Hub {
+	h := &Hub{
+		redis:         redisClient,
+		pubsub:        redisClient.Subscribe(context.Background()),
+		subscriptions: make(map[string]*userSubscription),
+	}
+	go h.listen()
+	return h
+}
+
+// Subscribe возвращает канал событий пользователя, когда подписка уже действует.
+// У пользователя может быть несколько соединений, на Redis-канал реплика подписывается один раз
+func (h *Hub) Subscribe(ctx context.Context, userID string) (chan *gen.ChatEvent, error) {
+	events := make(chan *gen.ChatEvent, eventBufferSize)
+
+	h.redisMu.Lock()
+	h.mu.Lock()
+	subscription, ok := h.subscriptions[userID]
+	if !ok {
+		subscription = &userSubscription{
+			streams: make(map[chan *gen.ChatEvent]struct{}),
+			ready:   make(chan struct{}),
+		}
+		h.subscriptions[userID] = subscription
+	}
+	subscription.streams[events] = struct{}{}
+	h.mu.Unlock()
+
+	if !ok {
+		if err := h.pubsub.Subscribe(ctx, userChannel(userID)); err != nil {
+			h.mu.Lock()
+			delete(h.subscriptions, userID)
+			h.mu.Unlock()
+			h.redisMu.Unlock()
+			return nil, err
+		}
+	}
+	h.redisMu.Unlock()
+
+	select {
+	case <-subscription.ready:
+		return events, nil
+	case <-ctx.Done():
+		h.Unsubscribe(context.Background(), userID, events)
+		return nil, ctx.Err()
+	}
+}
+
+func (h *Hub) Unsubscribe(ctx context.Context, userID string, events chan *gen.ChatEvent) {
+	h.redisMu.Lock()
+	defer h.redisMu.Unlock()
+
+	h.mu.Lock()
+	subscription := h.subscriptions[userID]
+	if subscription == nil {
+		h.mu.Unlock()
+		return
+	}
+	if _, ok := subscription.streams[events]; !ok {
+		h.mu.Unlock()
+		return
+	}
+	delete(subscription.streams, events)
+	close(events)
+	last := len(subscription.streams) == 0
+	if last {
+		delete(h.subscriptions, userID)
+	}
+	h.mu.Unlock()
+
+	if last {
+		if err := h.pubsub.Unsubscribe(ctx, userChannel(userID)); err != nil {
+			logger.AccessLogger.Warn("Failed to unsubscribe from user channel",
+				zap.String("user_id", userID),
+				zap.Error(err))
+		}
+	}
+}
+
+// Publish отправляет событие всем соединениям пользователя на любой реплике
+func (h *Hub) Publish(ctx context.Context, userID string, event *gen.ChatEvent) error {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return h.redis.Publish(ctx, userChannel(userID), payload).Err()
+}
+
+// IsOnline проверяет, подписана ли на канал пользователя хоть одна реплика
+func (h *Hub) IsOnline(ctx context.Context, userID string) (bool, error) {
+	channel := userChannel(userID)
+	subscribers, err := h.redis.PubSubNumSub(ctx, channel).Result()
+	if err != nil {
+		return false, err
+	}
+	return subscribers[channel] > 0, nil
+}
+
+func (h *Hub) listen() {
+	for received := range h.pubsub.ChannelWithSubscriptions(context.Background(), eventBufferSize) {
+		switch redisMsg := received.(type) {
+		case *redis.Subscription:
+			if redisMsg.Kind == "subscribe" {
+				h.confirm(strings.TrimPrefix(redisMsg.Channel, userChannelPrefix))
+			}
+		case *redis.Message:
+			h.dispatch(redisMsg)
+		}
+	}
+}
+
+func (h *Hub) confirm(userID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if subscription, ok := h.subscriptions[userID]; ok && !subscription.confirmed {
+		subscription.confirmed = true
+		close(subscription.ready)
+	}
+}
+
+func (h *Hub) dispatch(redisMsg *redis.Message) {
+	userID := strings.TrimPrefix(redisMsg.Channel, userChannelPrefix)
+
+	event := &gen.ChatEvent{}
+	if err := proto.Unmarshal([]byte(redisMsg.Payload), event); err != nil {
+		logger.AccessLogger.Warn("Failed to decode chat event from redis",
+			zap.String("channel", redisMsg.Channel),
+			zap.Error(err))
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	subscription, ok := h.subscriptions[userID]
+	if !ok {
+		return
+	}
+	for events := range subscription.streams {
+		select {
+		case events <- event:
+		default:
+			logger.AccessLogger.Warn("Event buffer is full, dropping event",
+				zap.String("user_id", userID))
+		}
+	}
+}
+
+// Close отписывает реплику от всех каналов чата
+func (h *Hub) Close() error {
+	return h.pubsub.Close()
+}
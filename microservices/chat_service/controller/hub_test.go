@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/microservices/chat_service/controller/gen"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub_ConcurrentReconnect(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	fakeRedis := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: fakeRedis.Addr()})
+	hub := NewHub(rdb)
+	t.Cleanup(func() {
+		_ = hub.Close()
+		_ = rdb.Close()
+	})
+	ctx := context.Background()
+
+	for i := 0; i < 1000; i++ {
+		old, err := hub.Subscribe(ctx, "bob")
+		require.NoError(t, err)
+
+		// старое соединение закрывается одновременно с открытием нового
+		var wg sync.WaitGroup
+		var current chan *gen.ChatEvent
+		start := make(chan struct{})
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			<-start
+			hub.Unsubscribe(ctx, "bob", old)
+		}()
+		go func() {
+			defer wg.Done()
+			<-start
+			current, err = hub.Subscribe(ctx, "bob")
+		}()
+		close(start)
+		wg.Wait()
+		require.NoError(t, err)
+
+		require.Equal(t, 1, fakeRedis.PubSubNumSub(userChannel("bob"))[userChannel("bob")])
+		require.NoError(t, hub.Publish(ctx, "bob", &gen.ChatEvent{Event: &gen.ChatEvent_Typing{Typing: &gen.Typing{UserId: "alice"}}}))
+		select {
+		case event := <-current:
+			require.Equal(t, "alice", event.GetTyping().UserId)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("reconnect %d lost the redis subscription", i)
+		}
+
+		hub.Unsubscribe(ctx, "bob", current)
+	}
+}
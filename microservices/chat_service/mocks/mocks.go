@@ -0,0 +1,93 @@
+package mocks
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"context"
+	"time"
+)
+
+type MockServiceSession struct {
+	MockGetUserID           func(ctx context.Context, sessionID string) (string, error)
+	MockLogoutSession       func(ctx context.Context, sessionID string) error
+	MockCreateSession       func(ctx context.Context, user *domain.User, client domain.SessionClient) (string, error)
+	MockGetSessionData      func(ctx context.Context, sessionID string) (*domain.SessionData, error)
+	MockGetUserSessions     func(ctx context.Context, sessionID string) ([]domain.ActiveSession, error)
+	MockRevokeSession       func(ctx context.Context, sessionID string, publicID string) error
+	MockRevokeOtherSessions func(ctx context.Context, sessionID string) error
+	MockRevokeUserSessions  func(ctx context.Context, userID string) error
+	MockMarkEmailVerified   func(ctx context.Context, userID string) error
+	MockMarkEmailUnverified func(ctx context.Context, userID string) error
+	MockUpdateUserRole      func(ctx context.Context, userID string, role string) error
+}
+
+func (m *MockServiceSession) GetUserID(ctx context.Context, sessionID string) (string, error) {
+	return m.MockGetUserID(ctx, sessionID)
+}
+
+func (m *MockServiceSession) LogoutSession(ctx context.Context, sessionID string) error {
+	return m.MockLogoutSession(ctx, sessionID)
+}
+
+func (m *MockServiceSession) CreateSession(ctx context.Context, user *domain.User, client domain.SessionClient) (string, error) {
+	return m.MockCreateSession(ctx, user, client)
+}
+
+func (m *MockServiceSession) GetSessionData(ctx context.Context, sessionID string) (*domain.SessionData, error) {
+	return m.MockGetSessionData(ctx, sessionID)
+}
+
+func (m *MockServiceSession) GetUserSessions(ctx context.Context, sessionID string) ([]domain.ActiveSession, error) {
+	return m.MockGetUserSessions(ctx, sessionID)
+}
+
+func (m *MockServiceSession) RevokeSession(ctx context.Context, sessionID string, publicID string) error {
+	return m.MockRevokeSession(ctx, sessionID, publicID)
+}
+
+func (m *MockServiceSession) RevokeOtherSessions(ctx context.Context, sessionID string) error {
+	return m.MockRevokeOtherSessions(ctx, sessionID)
+}
+
+func (m *MockServiceSession) RevokeUserSessions(ctx context.Context, userID string) error {
+	return m.MockRevokeUserSessions(ctx, userID)
+}
+
+func (m *MockServiceSession) MarkEmailVerified(ctx context.Context, userID string) error {
+	return m.MockMarkEmailVerified(ctx, userID)
+}
+
+func (m *MockServiceSession) MarkEmailUnverified(ctx context.Context, userID string) error {
+	return m.MockMarkEmailUnverified(ctx, userID)
+}
+
+func (m *MockServiceSession) UpdateUserRole(ctx context.Context, userID string, role string) error {
+	return m.MockUpdateUserRole(ctx, userID, role)
+}
+
+type MockChatUseCase struct {
+	MockGetAllChats    func(ctx context.Context, userID string, cursor *domain.ChatCursor) ([]*domain.Chat, string, error)
+	MockSendNewMessage func(ctx context.Context, receiver string, sender string, message string) (*domain.Message, error)
+	MockGetChat        func(ctx context.Context, userID1 string, userID2 string, lastSentTime time.Time) ([]*domain.Message, error)
+	MockMarkDelivered  func(ctx context.Context, messageID int, receiverID string) (time.Time, string, int64, error)
+	MockMarkChatRead   func(ctx context.Context, readerID string, partnerID string) (time.Time, int64, error)
+}
+
+func (m *MockChatUseCase) GetAllChats(ctx context.Context, userID string, cursor *domain.ChatCursor) ([]*domain.Chat, string, error) {
+	return m.MockGetAllChats(ctx, userID, cursor)
+}
+
+func (m *MockChatUseCase) SendNewMessage(ctx context.Context, receiver string, sender string, message string) (*domain.Message, error) {
+	return m.MockSendNewMessage(ctx, receiver, sender, message)
+}
+
+func (m *MockChatUseCase) GetChat(ctx context.Context, userID1 string, userID2 string, lastSentTime time.Time) ([]*domain.Message, error) {
+	return m.MockGetChat(ctx, userID1, userID2, lastSentTime)
+}
+
+func (m *MockChatUseCase) MarkDelivered(ctx context.Context, messageID int, receiverID string) (time.Time, string, int64, error) {
+	return m.MockMarkDelivered(ctx, messageID, receiverID)
+}
+
+func (m *MockChatUseCase) MarkChatRead(ctx context.Context, readerID string, partnerID string) (time.Time, int64, error) {
+	return m.MockMarkChatRead(ctx, readerID, partnerID)
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"time"
 
 	"go.uber.org/zap"
@@ -23,7 +24,7 @@ func NewChatRepository(db *gorm.DB) domain.ChatRepository {
 	}
 }
 
-func (cr *Repo) GetChats(ctx context.Context, userID string, lastUpdateTime time.Time) ([]*domain.Chat, error) {
+func (cr *Repo) GetChats(ctx context.Context, userID string, after *domain.ChatCursor, limit int) ([]*domain.Chat, error) {
 	start := time.Now()
 	requestID := middleware.GetRequestID(ctx)
 	logger.DBLogger.Info("GetChats called", zap.String("request_id", requestID))
@@ -45,7 +46,7 @@ func (cr *Repo) GetChats(ctx context.Context, userID string, lastUpdateTime time
 		CASE WHEN "senderId" = ? THEN "receiverId" ELSE "senderId" END AS related_user,
 		content,
 		"createdAt"`, userID).
-		Where("\"senderId\" = ? OR \"receiverId\" = ?", userID, userID).
+		Where("(\"senderId\" = ? OR \"receiverId\" = ?) AND NOT hidden", userID, userID).
 		Order("\"createdAt\" DESC")
 
 	latestMessagesQuery := cr.db.
@@ -54,9 +55,9 @@ func (cr *Repo) GetChats(ctx context.Context, userID string, lastUpdateTime time
 		Group("related_user")
 
 	// Соединяем это с основной таблицей для извлечения нужной информации
-	err = cr.db.
+	query := cr.db.
 		Table("(?) as latest_messages", latestMessagesQuery).
-		Joins("INNER JOIN messages ON messages.\"createdAt\" = latest_messages.max_date AND messages.\"senderId\" IN (?, latest_messages.related_user) AND messages.\"receiverId\" IN (?, latest_messages.related_user)", userID, userID).
+		Joins("INNER JOIN messages ON messages.\"createdAt\" = latest_messages.max_date AND messages.\"senderId\" IN (?, latest_messages.related_user) AND messages.\"receiverId\" IN (?, latest_messages.related_user) AND NOT messages.hidden", userID, userID).
 		Joins("INNER JOIN users ON latest_messages.related_user = users.uuid").
 		Select(`
 		latest_messages.related_user,
@@ -64,10 +65,17 @@ func (cr *Repo) GetChats(ctx context.Context, userID string, lastUpdateTime time
 		users.avatar AS "authorAvatar",
 		users.uuid AS "authorUuid",
 		messages.content AS "lastMessage",
-		messages."createdAt" AS "lastDate"`).
+		messages."createdAt" AS "lastDate",
+		(SELECT COUNT(*) FROM messages unread
+			WHERE unread."senderId" = latest_messages.related_user AND unread."receiverId" = ? AND unread."readAt" IS NULL AND NOT unread.hidden) AS "unreadCount"`, userID).
 		Order("\"lastDate\" DESC").
-		Limit(15).
-		Scan(&chats).Error
+		Order("\"authorUuid\" DESC")
+
+	if after != nil {
+		query = query.Where("(messages.\"createdAt\", users.uuid) < (?, ?)", after.LastDate, after.AuthorUUID)
+	}
+
+	err = query.Limit(limit).Scan(&chats).Error
 
 	if err != nil {
 		logger.DBLogger.Error("Error fetching chats", zap.String("request_id", requestID), zap.Error(err))
@@ -99,6 +107,7 @@ func (cr *Repo) GetMessages(ctx context.Context, userID1 string, userID2 string,
 	err = cr.db.
 		Where("(\"senderId\" = ? AND \"receiverId\" = ?) OR (\"senderId\" = ? AND \"receiverId\" = ?)", userID1, userID2, userID2, userID1).
 		Where("\"createdAt\" < ?", lastSentTime).
+		Where("NOT hidden").
 		Order("\"createdAt\" ASC").
 		Find(&messages).Error
 
@@ -114,7 +123,7 @@ func (cr *Repo) GetMessages(ctx context.Context, userID1 string, userID2 string,
 	return messages, nil
 }
 
-func (cr *Repo) SendNewMessage(ctx context.Context, receiver string, sender string, message string) error {
+func (cr *Repo) SendNewMessage(ctx context.Context, receiver string, sender string, message string) (*domain.Message, error) {
 	start := time.Now()
 	requestID := middleware.GetRequestID(ctx)
 	logger.DBLogger.Info("SendNewMessage called", zap.String("request_id", requestID))
@@ -138,9 +147,70 @@ func (cr *Repo) SendNewMessage(ctx context.Context, receiver string, sender stri
 	err = cr.db.Create(newMessage).Error
 	if err != nil {
 		logger.DBLogger.Error("Error sending message", zap.String("request_id", requestID), zap.Error(err))
-		return errors.New("error sending message")
+		return nil, errors.New("error sending message")
 	}
 
 	logger.DBLogger.Info("Successfully sent message", zap.String("request_id", requestID))
-	return nil
+	return newMessage, nil
+}
+
+// MarkDelivered возвращает автора сообщения из базы и число обновлённых строк (0, если сообщение уже доставлено или не адресовано receiverID)
+func (cr *Repo) MarkDelivered(ctx context.Context, messageID int, receiverID string, deliveredAt time.Time) (string, int64, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("MarkDelivered called", zap.String("request_id", requestID), zap.Int("messageID", messageID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("MarkDelivered", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("MarkDelivered", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("MarkDelivered").Observe(duration)
+	}()
+
+	var message domain.Message
+	result := cr.db.Model(&message).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "senderId"}}}).
+		Where("id = ? AND \"receiverId\" = ? AND \"deliveredAt\" IS NULL", messageID, receiverID).
+		Update("deliveredAt", deliveredAt)
+	if err = result.Error; err != nil {
+		logger.DBLogger.Error("Error marking message delivered", zap.String("request_id", requestID), zap.Error(err))
+		return "", 0, errors.New("error marking message delivered")
+	}
+
+	logger.DBLogger.Info("Successfully marked message delivered", zap.String("request_id", requestID), zap.Int64("count", result.RowsAffected))
+	return message.SenderID, result.RowsAffected, nil
+}
+
+func (cr *Repo) MarkChatRead(ctx context.Context, readerID string, partnerID string, readAt time.Time) (int64, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("MarkChatRead called", zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("MarkChatRead", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("MarkChatRead", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("MarkChatRead").Observe(duration)
+	}()
+
+	// прочитанное сообщение считается и доставленным
+	result := cr.db.Model(&domain.Message{}).
+		Where("\"senderId\" = ? AND \"receiverId\" = ? AND \"readAt\" IS NULL", partnerID, readerID).
+		Updates(map[string]interface{}{
+			"readAt":      readAt,
+			"deliveredAt": gorm.Expr("COALESCE(\"deliveredAt\", ?)", readAt),
+		})
+	if err = result.Error; err != nil {
+		logger.DBLogger.Error("Error marking chat read", zap.String("request_id", requestID), zap.Error(err))
+		return 0, errors.New("error marking chat read")
+	}
+
+	logger.DBLogger.Info("Successfully marked chat read", zap.String("request_id", requestID), zap.Int64("count", result.RowsAffected))
+	return result.RowsAffected, nil
 }
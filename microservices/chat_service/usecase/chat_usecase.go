@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/pagination"
+	"context"
+	"errors"
+	"time"
+)
+
+type ChatUseCase interface {
+	GetAllChats(ctx context.Context, userID string, cursor *domain.ChatCursor) ([]*domain.Chat, string, error)
+	SendNewMessage(ctx context.Context, receiver string, sender string, message string) (*domain.Message, error)
+	GetChat(ctx context.Context, userID1 string, userID2 string, lastSentTime time.Time) ([]*domain.Message, error)
+	MarkDelivered(ctx context.Context, messageID int, receiverID string) (time.Time, string, int64, error)
+	MarkChatRead(ctx context.Context, readerID string, partnerID string) (time.Time, int64, error)
+}
+
+type chatUseCase struct {
+	repo domain.ChatRepository
+}
+
+func NewChatService(repo domain.ChatRepository) ChatUseCase {
+	return &chatUseCase{
+		repo: repo,
+	}
+}
+
+const chatsPageSize = 15
+
+func (cs *chatUseCase) GetAllChats(ctx context.Context, userID string, cursor *domain.ChatCursor) ([]*domain.Chat, string, error) {
+	// одна лишняя запись показывает, есть ли следующая страница
+	chats, err := cs.repo.GetChats(ctx, userID, cursor, chatsPageSize+1)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextCursor string
+	if len(chats) > chatsPageSize {
+		chats = chats[:chatsPageSize]
+		last := chats[chatsPageSize-1]
+		nextCursor = pagination.EncodeCursor(domain.ChatCursor{
+			LastDate:   last.LastDate,
+			AuthorUUID: last.AuthorUUID,
+		})
+	}
+
+	return chats, nextCursor, nil
+}
+
+func (cs *chatUseCase) GetChat(ctx context.Context, userID1 string, userID2 string, lastSent time.Time) ([]*domain.Message, error) {
+	messages, err := cs.repo.GetMessages(ctx, userID1, userID2, lastSent)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (cs *chatUseCase) SendNewMessage(ctx context.Context, receiver string, sender string, message string) (*domain.Message, error) {
+	newMessage, err := cs.repo.SendNewMessage(ctx, receiver, sender, message)
+	if err != nil {
+		return nil, err
+	}
+	return newMessage, nil
+}
+
+func (cs *chatUseCase) MarkDelivered(ctx context.Context, messageID int, receiverID string) (time.Time, string, int64, error) {
+	deliveredAt := time.Now()
+	senderID, count, err := cs.repo.MarkDelivered(ctx, messageID, receiverID, deliveredAt)
+	if err != nil {
+		return time.Time{}, "", 0, err
+	}
+	return deliveredAt, senderID, count, nil
+}
+
+func (cs *chatUseCase) MarkChatRead(ctx context.Context, readerID string, partnerID string) (time.Time, int64, error) {
+	if partnerID == "" || partnerID == readerID {
+		return time.Time{}, 0, errors.New("invalid chat partner")
+	}
+	readAt := time.Now()
+	count, err := cs.repo.MarkChatRead(ctx, readerID, partnerID, readAt)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	return readAt, count, nil
+}
@@ -4,6 +4,7 @@ import (
 	"2024_2_FIGHT-CLUB/internal/service/logger"
 	"2024_2_FIGHT-CLUB/internal/service/metrics"
 	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/session"
 	grpcCity "2024_2_FIGHT-CLUB/microservices/city_service/controller"
 	generatedCity "2024_2_FIGHT-CLUB/microservices/city_service/controller/gen"
 	cityRepository "2024_2_FIGHT-CLUB/microservices/city_service/repository"
@@ -25,6 +26,7 @@ func main() {
 
 	// Инициализация зависимостей
 	middleware.InitRedis()
+	redisStore := session.NewRedisSessionStore(middleware.RedisClient)
 	db := middleware.DbConnect()
 
 	// Инициализация метрик
@@ -49,6 +51,7 @@ func main() {
 		}
 	}()
 
+	sessionService := session.NewSessionService(redisStore)
 	citiesRepository := cityRepository.NewCityRepository(db)
 	citiesUseCase := cityUseCase.NewCityUseCase(citiesRepository)
 	cityServer := grpcCity.NewGrpcCityHandler(citiesUseCase)
@@ -57,6 +60,8 @@ func main() {
 		grpc.UnaryInterceptor(middleware.ChainUnaryInterceptors(
 			middleware.RecoveryInterceptor,     // интерсептор для обработки паники
 			middleware.UnaryMetricsInterceptor, // интерсептор для метрик
+			// интерсептор для проверки ролей
+			middleware.RoleInterceptor(sessionService, grpcCity.MethodRoles),
 		)),
 	)
 	generatedCity.RegisterCityServiceServer(grpcServer, cityServer)
@@ -12,6 +12,10 @@ import (
 	"regexp"
 )
 
+// MethodRoles - минимальные роли для методов сервиса, проверяются middleware.RoleInterceptor.
+// Справочник городов публичный
+var MethodRoles = map[string]string{}
+
 type GrpcCityHandler struct {
 	gen.CityServiceServer
 	usecase usecase.CityUseCase
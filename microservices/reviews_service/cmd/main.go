@@ -0,0 +1,83 @@
+package main
+
+import (
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/metrics"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/session"
+	grpcReviews "2024_2_FIGHT-CLUB/microservices/reviews_service/controller"
+	generatedReviews "2024_2_FIGHT-CLUB/microservices/reviews_service/controller/gen"
+	reviewsRepository "2024_2_FIGHT-CLUB/microservices/reviews_service/repository"
+	reviewsUseCase "2024_2_FIGHT-CLUB/microservices/reviews_service/usecase"
+	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"log"
+	"net"
+	"net/http"
+	"os"
+)
+
+func main() {
+	// Загрузка переменных окружения
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found")
+	}
+
+	// Инициализация зависимостей
+	middleware.InitRedis()
+	redisStore := session.NewRedisSessionStore(middleware.RedisClient)
+	db := middleware.DbConnect()
+
+	// Инициализация метрик
+	metrics.InitMetrics()
+	metrics.InitRepoMetric()
+	// Экспозиция метрик на порту 9095
+	go func() {
+		http.Handle("/api/metrics", promhttp.Handler())
+		log.Println("Metrics server is running on :9095")
+		if err := http.ListenAndServe(":9095", nil); err != nil {
+			log.Fatalf("Failed to start metrics server: %v", err)
+		}
+	}()
+
+	// Инициализация логгеров
+	if err := logger.InitLoggers(); err != nil {
+		log.Fatalf("Failed to initialize loggers: %v", err)
+	}
+	defer func() {
+		if err := logger.SyncLoggers(); err != nil {
+			log.Fatalf("Failed to sync loggers: %v", err)
+		}
+	}()
+
+	sessionService := session.NewSessionService(redisStore)
+	jwtToken, err := middleware.NewJwtTokenFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to create JWT token: %v", err)
+	}
+	reviewRepository := reviewsRepository.NewReviewRepository(db)
+	reviewUseCase := reviewsUseCase.NewReviewUsecase(reviewRepository)
+	reviewsServer := grpcReviews.NewGrpcReviewsHandler(sessionService, reviewUseCase, jwtToken)
+
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(middleware.ChainUnaryInterceptors(
+			middleware.RecoveryInterceptor,     // интерсептор для обработки паники
+			middleware.UnaryMetricsInterceptor, // интерсептор для метрик
+			// интерсептор для проверки ролей
+			middleware.RoleInterceptor(sessionService, grpcReviews.MethodRoles),
+		)),
+	)
+	generatedReviews.RegisterReviewsServiceServer(grpcServer, reviewsServer)
+
+	// Запуск gRPC сервера
+	listener, err := net.Listen("tcp", os.Getenv("REVIEWS_SERVICE_ADDRESS"))
+	if err != nil {
+		log.Fatalf("Failed to listen on address: %s %v", os.Getenv("REVIEWS_SERVICE_ADDRESS"), err)
+	}
+
+	log.Printf("ReviewsService is running on address: %s", os.Getenv("REVIEWS_SERVICE_ADDRESS"))
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Fatalf("Failed to serve gRPC server: %v", err)
+	}
+}
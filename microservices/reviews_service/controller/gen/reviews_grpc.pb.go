@@ -0,0 +1,465 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: reviews.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReviewsService_CreateReview_FullMethodName     = "/reviews.ReviewsService/CreateReview"
+	ReviewsService_GetUserReviews_FullMethodName   = "/reviews.ReviewsService/GetUserReviews"
+	ReviewsService_GetAdReviews_FullMethodName     = "/reviews.ReviewsService/GetAdReviews"
+	ReviewsService_UpdateReview_FullMethodName     = "/reviews.ReviewsService/UpdateReview"
+	ReviewsService_DeleteReview_FullMethodName     = "/reviews.ReviewsService/DeleteReview"
+	ReviewsService_UpdateReviewByID_FullMethodName = "/reviews.ReviewsService/UpdateReviewByID"
+	ReviewsService_DeleteReviewByID_FullMethodName = "/reviews.ReviewsService/DeleteReviewByID"
+	ReviewsService_CreateReply_FullMethodName      = "/reviews.ReviewsService/CreateReply"
+	ReviewsService_UpdateReply_FullMethodName      = "/reviews.ReviewsService/UpdateReply"
+	ReviewsService_DeleteReply_FullMethodName      = "/reviews.ReviewsService/DeleteReply"
+)
+
+// ReviewsServiceClient is the client API for ReviewsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReviewsServiceClient interface {
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	GetUserReviews(ctx context.Context, in *GetUserReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error)
+	GetAdReviews(ctx context.Context, in *GetAdReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error)
+	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*ReviewsResponse, error)
+	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*ReviewsResponse, error)
+	// по id отзыва автор меняет и удаляет в том числе отзывы об объявлениях
+	UpdateReviewByID(ctx context.Context, in *UpdateReviewByIDRequest, opts ...grpc.CallOption) (*ReviewsResponse, error)
+	DeleteReviewByID(ctx context.Context, in *DeleteReviewByIDRequest, opts ...grpc.CallOption) (*ReviewsResponse, error)
+	CreateReply(ctx context.Context, in *ReplyRequest, opts ...grpc.CallOption) (*ReviewsResponse, error)
+	UpdateReply(ctx context.Context, in *ReplyRequest, opts ...grpc.CallOption) (*ReviewsResponse, error)
+	DeleteReply(ctx context.Context, in *DeleteReplyRequest, opts ...grpc.CallOption) (*ReviewsResponse, error)
+}
+
+type reviewsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReviewsServiceClient(cc grpc.ClientConnInterface) ReviewsServiceClient {
+	return &reviewsServiceClient{cc}
+}
+
+func (c *reviewsServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, ReviewsService_CreateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsServiceClient) GetUserReviews(ctx context.Context, in *GetUserReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewsService_GetUserReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsServiceClient) GetAdReviews(ctx context.Context, in *GetAdReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewsService_GetAdReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsServiceClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*ReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewsService_UpdateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsServiceClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*ReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewsService_DeleteReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsServiceClient) UpdateReviewByID(ctx context.Context, in *UpdateReviewByIDRequest, opts ...grpc.CallOption) (*ReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewsService_UpdateReviewByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsServiceClient) DeleteReviewByID(ctx context.Context, in *DeleteReviewByIDRequest, opts ...grpc.CallOption) (*ReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewsService_DeleteReviewByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsServiceClient) CreateReply(ctx context.Context, in *ReplyRequest, opts ...grpc.CallOption) (*ReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewsService_CreateReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsServiceClient) UpdateReply(ctx context.Context, in *ReplyRequest, opts ...grpc.CallOption) (*ReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewsService_UpdateReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsServiceClient) DeleteReply(ctx context.Context, in *DeleteReplyRequest, opts ...grpc.CallOption) (*ReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewsService_DeleteReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReviewsServiceServer is the server API for ReviewsService service.
+// All implementations must embed UnimplementedReviewsServiceServer
+// for forward compatibility.
+type ReviewsServiceServer interface {
+	CreateReview(context.Context, *CreateReviewRequest) (*ReviewResponse, error)
+	GetUserReviews(context.Context, *GetUserReviewsRequest) (*GetUserReviewsResponse, error)
+	GetAdReviews(context.Context, *GetAdReviewsRequest) (*GetUserReviewsResponse, error)
+	UpdateReview(context.Context, *UpdateReviewRequest) (*ReviewsResponse, error)
+	DeleteReview(context.Context, *DeleteReviewRequest) (*ReviewsResponse, error)
+	// по id отзыва автор меняет и удаляет в том числе отзывы об объявлениях
+	UpdateReviewByID(context.Context, *UpdateReviewByIDRequest) (*ReviewsResponse, error)
+	DeleteReviewByID(context.Context, *DeleteReviewByIDRequest) (*ReviewsResponse, error)
+	CreateReply(context.Context, *ReplyRequest) (*ReviewsResponse, error)
+	UpdateReply(context.Context, *ReplyRequest) (*ReviewsResponse, error)
+	DeleteReply(context.Context, *DeleteReplyRequest) (*ReviewsResponse, error)
+	mustEmbedUnimplementedReviewsServiceServer()
+}
+
+// UnimplementedReviewsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReviewsServiceServer struct{}
+
+func (UnimplementedReviewsServiceServer) CreateReview(context.Context, *CreateReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedReviewsServiceServer) GetUserReviews(context.Context, *GetUserReviewsRequest) (*GetUserReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserReviews not implemented")
+}
+func (UnimplementedReviewsServiceServer) GetAdReviews(context.Context, *GetAdReviewsRequest) (*GetUserReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdReviews not implemented")
+}
+func (UnimplementedReviewsServiceServer) UpdateReview(context.Context, *UpdateReviewRequest) (*ReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedReviewsServiceServer) DeleteReview(context.Context, *DeleteReviewRequest) (*ReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedReviewsServiceServer) UpdateReviewByID(context.Context, *UpdateReviewByIDRequest) (*ReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReviewByID not implemented")
+}
+func (UnimplementedReviewsServiceServer) DeleteReviewByID(context.Context, *DeleteReviewByIDRequest) (*ReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReviewByID not implemented")
+}
+func (UnimplementedReviewsServiceServer) CreateReply(context.Context, *ReplyRequest) (*ReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReply not implemented")
+}
+func (UnimplementedReviewsServiceServer) UpdateReply(context.Context, *ReplyRequest) (*ReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReply not implemented")
+}
+func (UnimplementedReviewsServiceServer) DeleteReply(context.Context, *DeleteReplyRequest) (*ReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReply not implemented")
+}
+func (UnimplementedReviewsServiceServer) mustEmbedUnimplementedReviewsServiceServer() {}
+func (UnimplementedReviewsServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeReviewsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewsServiceServer will
+// result in compilation errors.
+type UnsafeReviewsServiceServer interface {
+	mustEmbedUnimplementedReviewsServiceServer()
+}
+
+func RegisterReviewsServiceServer(s grpc.ServiceRegistrar, srv ReviewsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReviewsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReviewsService_ServiceDesc, srv)
+}
+
+func _ReviewsService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewsService_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).CreateReview(ctx, req.(*CreateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsService_GetUserReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).GetUserReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewsService_GetUserReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).GetUserReviews(ctx, req.(*GetUserReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsService_GetAdReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).GetAdReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewsService_GetAdReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).GetAdReviews(ctx, req.(*GetAdReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewsService_UpdateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).UpdateReview(ctx, req.(*UpdateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewsService_DeleteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsService_UpdateReviewByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).UpdateReviewByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewsService_UpdateReviewByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).UpdateReviewByID(ctx, req.(*UpdateReviewByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsService_DeleteReviewByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).DeleteReviewByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewsService_DeleteReviewByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).DeleteReviewByID(ctx, req.(*DeleteReviewByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsService_CreateReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).CreateReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewsService_CreateReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).CreateReply(ctx, req.(*ReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsService_UpdateReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).UpdateReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewsService_UpdateReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).UpdateReply(ctx, req.(*ReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsService_DeleteReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).DeleteReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewsService_DeleteReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).DeleteReply(ctx, req.(*DeleteReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReviewsService_ServiceDesc is the grpc.ServiceDesc for ReviewsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReviewsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reviews.ReviewsService",
+	HandlerType: (*ReviewsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateReview",
+			Handler:    _ReviewsService_CreateReview_Handler,
+		},
+		{
+			MethodName: "GetUserReviews",
+			Handler:    _ReviewsService_GetUserReviews_Handler,
+		},
+		{
+			MethodName: "GetAdReviews",
+			Handler:    _ReviewsService_GetAdReviews_Handler,
+		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _ReviewsService_UpdateReview_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _ReviewsService_DeleteReview_Handler,
+		},
+		{
+			MethodName: "UpdateReviewByID",
+			Handler:    _ReviewsService_UpdateReviewByID_Handler,
+		},
+		{
+			MethodName: "DeleteReviewByID",
+			Handler:    _ReviewsService_DeleteReviewByID_Handler,
+		},
+		{
+			MethodName: "CreateReply",
+			Handler:    _ReviewsService_CreateReply_Handler,
+		},
+		{
+			MethodName: "UpdateReply",
+			Handler:    _ReviewsService_UpdateReply_Handler,
+		},
+		{
+			MethodName: "DeleteReply",
+			Handler:    _ReviewsService_DeleteReply_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "reviews.proto",
+}
@@ -0,0 +1,336 @@
+package controller
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/pagination"
+	"2024_2_FIGHT-CLUB/internal/service/session"
+	"2024_2_FIGHT-CLUB/microservices/reviews_service/controller/gen"
+	"2024_2_FIGHT-CLUB/microservices/reviews_service/usecase"
+	"context"
+	"errors"
+	"github.com/microcosm-cc/bluemonday"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+// MethodRoles - минимальные роли для методов сервиса, проверяются middleware.RoleInterceptor
+var MethodRoles = map[string]string{
+	gen.ReviewsService_CreateReview_FullMethodName:     domain.RoleGuest,
+	gen.ReviewsService_UpdateReview_FullMethodName:     domain.RoleGuest,
+	gen.ReviewsService_DeleteReview_FullMethodName:     domain.RoleGuest,
+	gen.ReviewsService_UpdateReviewByID_FullMethodName: domain.RoleGuest,
+	gen.ReviewsService_DeleteReviewByID_FullMethodName: domain.RoleGuest,
+	gen.ReviewsService_CreateReply_FullMethodName:      domain.RoleGuest,
+	gen.ReviewsService_UpdateReply_FullMethodName:      domain.RoleGuest,
+	gen.ReviewsService_DeleteReply_FullMethodName:      domain.RoleGuest,
+}
+
+type GrpcReviewsHandler struct {
+	gen.ReviewsServiceServer
+	sessionService session.InterfaceSession
+	usecase        usecase.ReviewUsecase
+	jwtToken       middleware.JwtTokenService
+}
+
+func NewGrpcReviewsHandler(sessionService session.InterfaceSession, usecase usecase.ReviewUsecase, jwtToken middleware.JwtTokenService) *GrpcReviewsHandler {
+	return &GrpcReviewsHandler{
+		sessionService: sessionService,
+		usecase:        usecase,
+		jwtToken:       jwtToken,
+	}
+}
+
+const timeLayout = time.RFC3339Nano
+
+func convertReview(review *domain.Review) *gen.Review {
+	converted := &gen.Review{
+		Id:        int32(review.ID),
+		UserId:    review.UserID,
+		HostId:    review.HostID,
+		Title:     review.Title,
+		Text:      review.Text,
+		Rating:    int32(review.Rating),
+		CreatedAt: review.CreatedAt.Format(timeLayout),
+	}
+	if review.AdID != nil {
+		converted.AdId = *review.AdID
+	}
+	return converted
+}
+
+func convertUserReviews(reviews []domain.UserReviews, nextCursor string) *gen.GetUserReviewsResponse {
+	response := &gen.GetUserReviewsResponse{NextCursor: nextCursor}
+	for _, review := range reviews {
+		converted := &gen.UserReview{
+			Id:         int32(review.ID),
+			UserId:     review.UserID,
+			HostId:     review.HostID,
+			Title:      review.Title,
+			Text:       review.Text,
+			Rating:     int32(review.Rating),
+			CreatedAt:  review.CreatedAt.Format(timeLayout),
+			UserAvatar: review.UserAvatar,
+			UserName:   review.UserName,
+		}
+		if review.AdID != nil {
+			converted.AdId = *review.AdID
+		}
+		if review.Reply != nil {
+			converted.Reply = *review.Reply
+		}
+		if review.ReplyUpdatedAt != nil {
+			converted.ReplyUpdatedAt = review.ReplyUpdatedAt.Format(timeLayout)
+		}
+		response.Reviews = append(response.Reviews, converted)
+	}
+	return response
+}
+
+func decodeCursor(ctx context.Context, value string) (*domain.ReviewCursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+	cursor := &domain.ReviewCursor{}
+	if err := pagination.DecodeCursor(value, cursor); err != nil {
+		logger.AccessLogger.Warn("Failed to parse cursor", zap.String("request_id", middleware.GetRequestID(ctx)), zap.Error(err))
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// reviewFromProto переводит отзыв из запроса в domain, очищая пользовательский ввод
+func reviewFromProto(review *gen.Review) *domain.Review {
+	sanitizer := bluemonday.UGCPolicy()
+	if review == nil {
+		return &domain.Review{}
+	}
+	converted := &domain.Review{
+		Title:  sanitizer.Sanitize(review.Title),
+		Text:   sanitizer.Sanitize(review.Text),
+		HostID: sanitizer.Sanitize(review.HostId),
+		UserID: sanitizer.Sanitize(review.UserId),
+		Rating: int(review.Rating),
+	}
+	if review.AdId != "" {
+		adID := sanitizer.Sanitize(review.AdId)
+		converted.AdID = &adID
+	}
+	return converted
+}
+
+// authorize проверяет CSRF-токен и возвращает пользователя сессии
+func (h *GrpcReviewsHandler) authorize(ctx context.Context, sessionID, authHeader string) (string, error) {
+	requestID := middleware.GetRequestID(ctx)
+	if authHeader == "" {
+		logger.AccessLogger.Warn("Missing X-CSRF-Token header",
+			zap.String("request_id", requestID),
+			zap.Error(errors.New("missing X-CSRF-Token header")),
+		)
+		return "", errors.New("missing X-CSRF-Token header")
+	}
+
+	tokenString := strings.TrimPrefix(authHeader, "Bearer ")
+	if _, err := h.jwtToken.Validate(tokenString, sessionID); err != nil {
+		logger.AccessLogger.Warn("Invalid JWT token", zap.String("request_id", requestID), zap.Error(err))
+		return "", errors.New("invalid JWT token")
+	}
+
+	userID, err := h.sessionService.GetUserID(ctx, sessionID)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to get user ID", zap.String("request_id", requestID), zap.Error(err))
+		return "", err
+	}
+	return userID, nil
+}
+
+func (h *GrpcReviewsHandler) CreateReview(ctx context.Context, in *gen.CreateReviewRequest) (*gen.ReviewResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received CreateReview request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	userID, err := h.authorize(ctx, in.SessionId, in.AuthHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	review := reviewFromProto(in.Review)
+	if err = h.usecase.CreateReview(ctx, review, userID); err != nil {
+		logger.AccessLogger.Warn("Failed to create review", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	return &gen.ReviewResponse{Review: convertReview(review)}, nil
+}
+
+func (h *GrpcReviewsHandler) GetUserReviews(ctx context.Context, in *gen.GetUserReviewsRequest) (*gen.GetUserReviewsResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	sanitizer := bluemonday.UGCPolicy()
+	logger.AccessLogger.Info("Received GetUserReviews request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	cursor, err := decodeCursor(ctx, in.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, nextCursor, err := h.usecase.GetUserReviews(ctx, sanitizer.Sanitize(in.UserId), cursor)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to get user reviews", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	return convertUserReviews(reviews, nextCursor), nil
+}
+
+func (h *GrpcReviewsHandler) GetAdReviews(ctx context.Context, in *gen.GetAdReviewsRequest) (*gen.GetUserReviewsResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	sanitizer := bluemonday.UGCPolicy()
+	logger.AccessLogger.Info("Received GetAdReviews request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	cursor, err := decodeCursor(ctx, in.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, nextCursor, err := h.usecase.GetAdReviews(ctx, sanitizer.Sanitize(in.AdId), cursor)
+	if err != nil {
+		logger.AccessLogger.Warn("Failed to get ad reviews", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	return convertUserReviews(reviews, nextCursor), nil
+}
+
+func (h *GrpcReviewsHandler) UpdateReview(ctx context.Context, in *gen.UpdateReviewRequest) (*gen.ReviewsResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	sanitizer := bluemonday.UGCPolicy()
+	logger.AccessLogger.Info("Received UpdateReview request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	userID, err := h.authorize(ctx, in.SessionId, in.AuthHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.usecase.UpdateReview(ctx, userID, sanitizer.Sanitize(in.HostId), reviewFromProto(in.Review)); err != nil {
+		logger.AccessLogger.Warn("Failed to update review", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	return &gen.ReviewsResponse{Response: "updated successfully"}, nil
+}
+
+func (h *GrpcReviewsHandler) DeleteReview(ctx context.Context, in *gen.DeleteReviewRequest) (*gen.ReviewsResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	sanitizer := bluemonday.UGCPolicy()
+	logger.AccessLogger.Info("Received DeleteReview request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	userID, err := h.authorize(ctx, in.SessionId, in.AuthHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.usecase.DeleteReview(ctx, userID, sanitizer.Sanitize(in.HostId)); err != nil {
+		logger.AccessLogger.Warn("Failed to delete review", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	return &gen.ReviewsResponse{Response: "deleted successfully"}, nil
+}
+
+func (h *GrpcReviewsHandler) UpdateReviewByID(ctx context.Context, in *gen.UpdateReviewByIDRequest) (*gen.ReviewsResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received UpdateReviewByID request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	userID, err := h.authorize(ctx, in.SessionId, in.AuthHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.usecase.UpdateReviewByID(ctx, userID, int(in.ReviewId), reviewFromProto(in.Review)); err != nil {
+		logger.AccessLogger.Warn("Failed to update review", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	return &gen.ReviewsResponse{Response: "updated successfully"}, nil
+}
+
+func (h *GrpcReviewsHandler) DeleteReviewByID(ctx context.Context, in *gen.DeleteReviewByIDRequest) (*gen.ReviewsResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received DeleteReviewByID request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	userID, err := h.authorize(ctx, in.SessionId, in.AuthHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.usecase.DeleteReviewByID(ctx, userID, int(in.ReviewId)); err != nil {
+		logger.AccessLogger.Warn("Failed to delete review", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	return &gen.ReviewsResponse{Response: "deleted successfully"}, nil
+}
+
+func (h *GrpcReviewsHandler) CreateReply(ctx context.Context, in *gen.ReplyRequest) (*gen.ReviewsResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	sanitizer := bluemonday.UGCPolicy()
+	logger.AccessLogger.Info("Received CreateReply request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	userID, err := h.authorize(ctx, in.SessionId, in.AuthHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.usecase.CreateReply(ctx, userID, int(in.ReviewId), sanitizer.Sanitize(in.Text)); err != nil {
+		logger.AccessLogger.Warn("Failed to create reply", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	return &gen.ReviewsResponse{Response: "reply created successfully"}, nil
+}
+
+func (h *GrpcReviewsHandler) UpdateReply(ctx context.Context, in *gen.ReplyRequest) (*gen.ReviewsResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	sanitizer := bluemonday.UGCPolicy()
+	logger.AccessLogger.Info("Received UpdateReply request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	userID, err := h.authorize(ctx, in.SessionId, in.AuthHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.usecase.UpdateReply(ctx, userID, int(in.ReviewId), sanitizer.Sanitize(in.Text)); err != nil {
+		logger.AccessLogger.Warn("Failed to update reply", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	return &gen.ReviewsResponse{Response: "reply updated successfully"}, nil
+}
+
+func (h *GrpcReviewsHandler) DeleteReply(ctx context.Context, in *gen.DeleteReplyRequest) (*gen.ReviewsResponse, error) {
+	requestID := middleware.GetRequestID(ctx)
+	logger.AccessLogger.Info("Received DeleteReply request in microservice",
+		zap.String("request_id", requestID),
+	)
+
+	userID, err := h.authorize(ctx, in.SessionId, in.AuthHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.usecase.DeleteReply(ctx, userID, int(in.ReviewId)); err != nil {
+		logger.AccessLogger.Warn("Failed to delete reply", zap.String("request_id", requestID), zap.Error(err))
+		return nil, err
+	}
+	return &gen.ReviewsResponse{Response: "reply deleted successfully"}, nil
+}
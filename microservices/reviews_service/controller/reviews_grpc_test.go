@@ -0,0 +1,256 @@
+package controller
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/pagination"
+	"2024_2_FIGHT-CLUB/microservices/reviews_service/controller/gen"
+	"2024_2_FIGHT-CLUB/microservices/reviews_service/mocks"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHandler() (*GrpcReviewsHandler, *mocks.MockReviewsUsecase) {
+	jwtService := &mocks.MockJwtTokenService{
+		MockValidate: func(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error) {
+			if tokenString != "valid-token" {
+				return nil, errors.New("token invalid")
+			}
+			return &middleware.JwtCsrfClaims{}, nil
+		},
+	}
+	sessionService := &mocks.MockServiceSession{
+		MockGetUserID: func(ctx context.Context, sessionID string) (string, error) {
+			if sessionID == "" {
+				return "", errors.New("session not found")
+			}
+			return "test-user-id", nil
+		},
+	}
+	usecase := &mocks.MockReviewsUsecase{}
+	return NewGrpcReviewsHandler(sessionService, usecase, jwtService), usecase
+}
+
+func TestGrpcReviewsHandler_CreateReview(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	handler, usecase := newTestHandler()
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	usecase.MockCreateReview = func(ctx context.Context, review *domain.Review, userId string) error {
+		assert.Equal(t, "test-user-id", userId)
+		assert.Equal(t, "Great stay", review.Title)
+		review.ID = 3
+		review.UserID = userId
+		review.CreatedAt = createdAt
+		return nil
+	}
+
+	t.Run("success", func(t *testing.T) {
+		response, err := handler.CreateReview(context.Background(), &gen.CreateReviewRequest{
+			SessionId:  "session",
+			AuthHeader: "Bearer valid-token",
+			Review:     &gen.Review{Title: "Great stay<script>alert(1)</script>", HostId: "host", Rating: 5},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), response.Review.Id)
+		assert.Equal(t, "test-user-id", response.Review.UserId)
+		assert.Equal(t, createdAt.Format(timeLayout), response.Review.CreatedAt)
+	})
+
+	t.Run("ad review", func(t *testing.T) {
+		usecase.MockCreateReview = func(ctx context.Context, review *domain.Review, userId string) error {
+			require.NotNil(t, review.AdID)
+			assert.Equal(t, "ad-uuid", *review.AdID)
+			review.HostID = "host"
+			return nil
+		}
+		response, err := handler.CreateReview(context.Background(), &gen.CreateReviewRequest{
+			SessionId:  "session",
+			AuthHeader: "Bearer valid-token",
+			Review:     &gen.Review{Title: "Nice", AdId: "ad-uuid", Rating: 4},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ad-uuid", response.Review.AdId)
+		assert.Equal(t, "host", response.Review.HostId)
+	})
+
+	t.Run("missing csrf token", func(t *testing.T) {
+		_, err := handler.CreateReview(context.Background(), &gen.CreateReviewRequest{SessionId: "session"})
+		assert.EqualError(t, err, "missing X-CSRF-Token header")
+	})
+
+	t.Run("invalid jwt token", func(t *testing.T) {
+		_, err := handler.CreateReview(context.Background(), &gen.CreateReviewRequest{
+			SessionId:  "session",
+			AuthHeader: "Bearer invalid-token",
+		})
+		assert.EqualError(t, err, "invalid JWT token")
+	})
+
+	t.Run("session not found", func(t *testing.T) {
+		_, err := handler.CreateReview(context.Background(), &gen.CreateReviewRequest{
+			AuthHeader: "Bearer valid-token",
+		})
+		assert.EqualError(t, err, "session not found")
+	})
+}
+
+func TestGrpcReviewsHandler_GetUserReviews(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	handler, usecase := newTestHandler()
+	after := domain.ReviewCursor{CreatedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), ID: 10}
+	usecase.MockGetUserReviews = func(ctx context.Context, userId string, cursor *domain.ReviewCursor) ([]domain.UserReviews, string, error) {
+		assert.Equal(t, "host", userId)
+		require.NotNil(t, cursor)
+		assert.Equal(t, after.ID, cursor.ID)
+		return []domain.UserReviews{{ID: 9, Title: "ok", UserName: "alice"}}, "next", nil
+	}
+
+	t.Run("success", func(t *testing.T) {
+		response, err := handler.GetUserReviews(context.Background(), &gen.GetUserReviewsRequest{UserId: "host", Cursor: pagination.EncodeCursor(after)})
+		require.NoError(t, err)
+		require.Len(t, response.Reviews, 1)
+		assert.Equal(t, "alice", response.Reviews[0].UserName)
+		assert.Equal(t, "next", response.NextCursor)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := handler.GetUserReviews(context.Background(), &gen.GetUserReviewsRequest{UserId: "host", Cursor: "%%%"})
+		assert.EqualError(t, err, "invalid cursor")
+	})
+
+	t.Run("ad reviews", func(t *testing.T) {
+		adID := "ad-uuid"
+		usecase.MockGetAdReviews = func(ctx context.Context, adId string, cursor *domain.ReviewCursor) ([]domain.UserReviews, string, error) {
+			assert.Equal(t, adID, adId)
+			assert.Nil(t, cursor)
+			return []domain.UserReviews{{ID: 1, AdID: &adID, HostID: "host"}}, "", nil
+		}
+		response, err := handler.GetAdReviews(context.Background(), &gen.GetAdReviewsRequest{AdId: adID})
+		require.NoError(t, err)
+		require.Len(t, response.Reviews, 1)
+		assert.Equal(t, adID, response.Reviews[0].AdId)
+		assert.Empty(t, response.NextCursor)
+	})
+}
+
+func TestGrpcReviewsHandler_UpdateAndDelete(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	handler, usecase := newTestHandler()
+	usecase.MockUpdateReview = func(ctx context.Context, userID, hostID string, updatedReview *domain.Review) error {
+		assert.Equal(t, "test-user-id", userID)
+		assert.Equal(t, "host", hostID)
+		return nil
+	}
+	usecase.MockDeleteReview = func(ctx context.Context, userID, hostID string) error {
+		return errors.New("review not found")
+	}
+
+	updated, err := handler.UpdateReview(context.Background(), &gen.UpdateReviewRequest{
+		SessionId:  "session",
+		AuthHeader: "Bearer valid-token",
+		HostId:     "host",
+		Review:     &gen.Review{Title: "Updated", Rating: 4},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "updated successfully", updated.Response)
+
+	_, err = handler.DeleteReview(context.Background(), &gen.DeleteReviewRequest{
+		SessionId:  "session",
+		AuthHeader: "Bearer valid-token",
+		HostId:     "host",
+	})
+	assert.EqualError(t, err, "review not found")
+
+	_, err = handler.UpdateReview(context.Background(), &gen.UpdateReviewRequest{SessionId: "session", HostId: "host"})
+	assert.EqualError(t, err, "missing X-CSRF-Token header")
+}
+
+func TestGrpcReviewsHandler_Replies(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	handler, usecase := newTestHandler()
+	usecase.MockCreateReply = func(ctx context.Context, userID string, reviewID int, text string) error {
+		assert.Equal(t, "test-user-id", userID)
+		assert.Equal(t, 7, reviewID)
+		assert.Equal(t, "Thanks", text)
+		return nil
+	}
+	usecase.MockUpdateReply = func(ctx context.Context, userID string, reviewID int, text string) error {
+		return errors.New("only the reviewed host can reply")
+	}
+	usecase.MockDeleteReply = func(ctx context.Context, userID string, reviewID int) error {
+		assert.Equal(t, 7, reviewID)
+		return nil
+	}
+
+	created, err := handler.CreateReply(context.Background(), &gen.ReplyRequest{
+		SessionId:  "session",
+		AuthHeader: "Bearer valid-token",
+		ReviewId:   7,
+		Text:       "Thanks<script>alert(1)</script>",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "reply created successfully", created.Response)
+
+	_, err = handler.UpdateReply(context.Background(), &gen.ReplyRequest{
+		SessionId:  "session",
+		AuthHeader: "Bearer valid-token",
+		ReviewId:   7,
+		Text:       "Thanks",
+	})
+	assert.EqualError(t, err, "only the reviewed host can reply")
+
+	deleted, err := handler.DeleteReply(context.Background(), &gen.DeleteReplyRequest{
+		SessionId:  "session",
+		AuthHeader: "Bearer valid-token",
+		ReviewId:   7,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "reply deleted successfully", deleted.Response)
+
+	_, err = handler.DeleteReply(context.Background(), &gen.DeleteReplyRequest{SessionId: "session", ReviewId: 7})
+	assert.EqualError(t, err, "missing X-CSRF-Token header")
+}
+
+func TestConvertUserReviews_Reply(t *testing.T) {
+	reply := "Thanks"
+	repliedAt := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	response := convertUserReviews([]domain.UserReviews{{ID: 1, Reply: &reply, ReplyUpdatedAt: &repliedAt}, {ID: 2}}, "")
+	require.Len(t, response.Reviews, 2)
+	assert.Equal(t, reply, response.Reviews[0].Reply)
+	assert.Equal(t, repliedAt.Format(timeLayout), response.Reviews[0].ReplyUpdatedAt)
+	assert.Empty(t, response.Reviews[1].Reply)
+}
@@ -0,0 +1,258 @@
+package mocks
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/microservices/reviews_service/controller/gen"
+	"context"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"time"
+)
+
+type MockJwtTokenService struct {
+	MockCreate             func(session_id string, tokenExpTime int64) (string, error)
+	MockValidate           func(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error)
+	MockParseSecretGetter  func(token *jwt.Token) (interface{}, error)
+	MockCreateEmailToken   func(userID string, email string, tokenExpTime int64) (string, error)
+	MockValidateEmailToken func(tokenString string) (*middleware.JwtEmailClaims, error)
+}
+
+func (m *MockJwtTokenService) Create(session_id string, tokenExpTime int64) (string, error) {
+	return m.MockCreate(session_id, tokenExpTime)
+}
+
+func (m *MockJwtTokenService) Validate(tokenString string, expectedSessionId string) (*middleware.JwtCsrfClaims, error) {
+	return m.MockValidate(tokenString, expectedSessionId)
+}
+
+func (m *MockJwtTokenService) ParseSecretGetter(token *jwt.Token) (interface{}, error) {
+	return m.MockParseSecretGetter(token)
+}
+
+func (m *MockJwtTokenService) CreateEmailToken(userID string, email string, tokenExpTime int64) (string, error) {
+	return m.MockCreateEmailToken(userID, email, tokenExpTime)
+}
+
+func (m *MockJwtTokenService) ValidateEmailToken(tokenString string) (*middleware.JwtEmailClaims, error) {
+	return m.MockValidateEmailToken(tokenString)
+}
+
+type MockServiceSession struct {
+	MockGetUserID           func(ctx context.Context, sessionID string) (string, error)
+	MockLogoutSession       func(ctx context.Context, sessionID string) error
+	MockCreateSession       func(ctx context.Context, user *domain.User, client domain.SessionClient) (string, error)
+	MockGetSessionData      func(ctx context.Context, sessionID string) (*domain.SessionData, error)
+	MockGetUserSessions     func(ctx context.Context, sessionID string) ([]domain.ActiveSession, error)
+	MockRevokeSession       func(ctx context.Context, sessionID string, publicID string) error
+	MockRevokeOtherSessions func(ctx context.Context, sessionID string) error
+	MockRevokeUserSessions  func(ctx context.Context, userID string) error
+	MockMarkEmailVerified   func(ctx context.Context, userID string) error
+	MockMarkEmailUnverified func(ctx context.Context, userID string) error
+	MockUpdateUserRole      func(ctx context.Context, userID string, role string) error
+}
+
+func (m *MockServiceSession) GetUserID(ctx context.Context, sessionID string) (string, error) {
+	return m.MockGetUserID(ctx, sessionID)
+}
+
+func (m *MockServiceSession) LogoutSession(ctx context.Context, sessionID string) error {
+	return m.MockLogoutSession(ctx, sessionID)
+}
+
+func (m *MockServiceSession) CreateSession(ctx context.Context, user *domain.User, client domain.SessionClient) (string, error) {
+	return m.MockCreateSession(ctx, user, client)
+}
+
+func (m *MockServiceSession) GetSessionData(ctx context.Context, sessionID string) (*domain.SessionData, error) {
+	return m.MockGetSessionData(ctx, sessionID)
+}
+
+func (m *MockServiceSession) GetUserSessions(ctx context.Context, sessionID string) ([]domain.ActiveSession, error) {
+	return m.MockGetUserSessions(ctx, sessionID)
+}
+
+func (m *MockServiceSession) RevokeSession(ctx context.Context, sessionID string, publicID string) error {
+	return m.MockRevokeSession(ctx, sessionID, publicID)
+}
+
+func (m *MockServiceSession) RevokeOtherSessions(ctx context.Context, sessionID string) error {
+	return m.MockRevokeOtherSessions(ctx, sessionID)
+}
+
+func (m *MockServiceSession) RevokeUserSessions(ctx context.Context, userID string) error {
+	return m.MockRevokeUserSessions(ctx, userID)
+}
+
+func (m *MockServiceSession) MarkEmailVerified(ctx context.Context, userID string) error {
+	return m.MockMarkEmailVerified(ctx, userID)
+}
+
+func (m *MockServiceSession) MarkEmailUnverified(ctx context.Context, userID string) error {
+	return m.MockMarkEmailUnverified(ctx, userID)
+}
+
+func (m *MockServiceSession) UpdateUserRole(ctx context.Context, userID string, role string) error {
+	return m.MockUpdateUserRole(ctx, userID, role)
+}
+
+type MockReviewsUsecase struct {
+	MockCreateReview     func(ctx context.Context, review *domain.Review, userId string) error
+	MockGetUserReviews   func(ctx context.Context, userId string, cursor *domain.ReviewCursor) ([]domain.UserReviews, string, error)
+	MockGetAdReviews     func(ctx context.Context, adId string, cursor *domain.ReviewCursor) ([]domain.UserReviews, string, error)
+	MockUpdateReview     func(ctx context.Context, userID, hostID string, updatedReview *domain.Review) error
+	MockDeleteReview     func(ctx context.Context, userID, hostID string) error
+	MockUpdateReviewByID func(ctx context.Context, userID string, reviewID int, updatedReview *domain.Review) error
+	MockDeleteReviewByID func(ctx context.Context, userID string, reviewID int) error
+	MockCreateReply      func(ctx context.Context, userID string, reviewID int, text string) error
+	MockUpdateReply      func(ctx context.Context, userID string, reviewID int, text string) error
+	MockDeleteReply      func(ctx context.Context, userID string, reviewID int) error
+}
+
+func (m *MockReviewsUsecase) CreateReview(ctx context.Context, review *domain.Review, userId string) error {
+	return m.MockCreateReview(ctx, review, userId)
+}
+
+func (m *MockReviewsUsecase) GetUserReviews(ctx context.Context, userId string, cursor *domain.ReviewCursor) ([]domain.UserReviews, string, error) {
+	return m.MockGetUserReviews(ctx, userId, cursor)
+}
+
+func (m *MockReviewsUsecase) GetAdReviews(ctx context.Context, adId string, cursor *domain.ReviewCursor) ([]domain.UserReviews, string, error) {
+	return m.MockGetAdReviews(ctx, adId, cursor)
+}
+
+func (m *MockReviewsUsecase) UpdateReview(ctx context.Context, userID, hostID string, updatedReview *domain.Review) error {
+	return m.MockUpdateReview(ctx, userID, hostID, updatedReview)
+}
+
+func (m *MockReviewsUsecase) DeleteReview(ctx context.Context, userID, hostID string) error {
+	return m.MockDeleteReview(ctx, userID, hostID)
+}
+
+func (m *MockReviewsUsecase) UpdateReviewByID(ctx context.Context, userID string, reviewID int, updatedReview *domain.Review) error {
+	return m.MockUpdateReviewByID(ctx, userID, reviewID, updatedReview)
+}
+
+func (m *MockReviewsUsecase) DeleteReviewByID(ctx context.Context, userID string, reviewID int) error {
+	return m.MockDeleteReviewByID(ctx, userID, reviewID)
+}
+
+func (m *MockReviewsUsecase) CreateReply(ctx context.Context, userID string, reviewID int, text string) error {
+	return m.MockCreateReply(ctx, userID, reviewID, text)
+}
+
+func (m *MockReviewsUsecase) UpdateReply(ctx context.Context, userID string, reviewID int, text string) error {
+	return m.MockUpdateReply(ctx, userID, reviewID, text)
+}
+
+func (m *MockReviewsUsecase) DeleteReply(ctx context.Context, userID string, reviewID int) error {
+	return m.MockDeleteReply(ctx, userID, reviewID)
+}
+
+type MockReviewsRepository struct {
+	MockCreateReview         func(ctx context.Context, review *domain.Review) error
+	MockGetUserReviews       func(ctx context.Context, userID string, after *domain.ReviewCursor, limit int) ([]domain.UserReviews, error)
+	MockGetAdReviews         func(ctx context.Context, adID string, after *domain.ReviewCursor, limit int) ([]domain.UserReviews, error)
+	MockDeleteReview         func(ctx context.Context, userID, hostID string) error
+	MockUpdateReview         func(ctx context.Context, userID, hostID string, updatedReview *domain.Review) error
+	MockUpdateReviewByID     func(ctx context.Context, reviewID int, userID string, updatedReview *domain.Review) error
+	MockDeleteReviewByID     func(ctx context.Context, reviewID int, userID string) error
+	MockGetLastCompletedStay func(ctx context.Context, userID, hostID string, adID *string) (*time.Time, error)
+	MockGetReviewByID        func(ctx context.Context, reviewID int) (*domain.Review, error)
+	MockSetReviewReply       func(ctx context.Context, reviewID int, reply *string) error
+}
+
+func (m *MockReviewsRepository) CreateReview(ctx context.Context, review *domain.Review) error {
+	return m.MockCreateReview(ctx, review)
+}
+
+func (m *MockReviewsRepository) GetUserReviews(ctx context.Context, userID string, after *domain.ReviewCursor, limit int) ([]domain.UserReviews, error) {
+	return m.MockGetUserReviews(ctx, userID, after, limit)
+}
+
+func (m *MockReviewsRepository) GetAdReviews(ctx context.Context, adID string, after *domain.ReviewCursor, limit int) ([]domain.UserReviews, error) {
+	return m.MockGetAdReviews(ctx, adID, after, limit)
+}
+
+func (m *MockReviewsRepository) UpdateReview(ctx context.Context, userID, hostID string, updatedReview *domain.Review) error {
+	return m.MockUpdateReview(ctx, userID, hostID, updatedReview)
+}
+
+func (m *MockReviewsRepository) UpdateReviewByID(ctx context.Context, reviewID int, userID string, updatedReview *domain.Review) error {
+	return m.MockUpdateReviewByID(ctx, reviewID, userID, updatedReview)
+}
+
+func (m *MockReviewsRepository) DeleteReviewByID(ctx context.Context, reviewID int, userID string) error {
+	return m.MockDeleteReviewByID(ctx, reviewID, userID)
+}
+
+func (m *MockReviewsRepository) GetLastCompletedStay(ctx context.Context, userID, hostID string, adID *string) (*time.Time, error) {
+	return m.MockGetLastCompletedStay(ctx, userID, hostID, adID)
+}
+
+func (m *MockReviewsRepository) GetReviewByID(ctx context.Context, reviewID int) (*domain.Review, error) {
+	return m.MockGetReviewByID(ctx, reviewID)
+}
+
+func (m *MockReviewsRepository) SetReviewReply(ctx context.Context, reviewID int, reply *string) error {
+	return m.MockSetReviewReply(ctx, reviewID, reply)
+}
+
+func (m *MockReviewsRepository) DeleteReview(ctx context.Context, userID, hostID string) error {
+	return m.MockDeleteReview(ctx, userID, hostID)
+}
+
+type MockGrpcClient struct {
+	mock.Mock
+}
+
+func (m *MockGrpcClient) CreateReview(ctx context.Context, in *gen.CreateReviewRequest, opts ...grpc.CallOption) (*gen.ReviewResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.ReviewResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) GetUserReviews(ctx context.Context, in *gen.GetUserReviewsRequest, opts ...grpc.CallOption) (*gen.GetUserReviewsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.GetUserReviewsResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) GetAdReviews(ctx context.Context, in *gen.GetAdReviewsRequest, opts ...grpc.CallOption) (*gen.GetUserReviewsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.GetUserReviewsResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) UpdateReview(ctx context.Context, in *gen.UpdateReviewRequest, opts ...grpc.CallOption) (*gen.ReviewsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.ReviewsResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) DeleteReview(ctx context.Context, in *gen.DeleteReviewRequest, opts ...grpc.CallOption) (*gen.ReviewsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.ReviewsResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) UpdateReviewByID(ctx context.Context, in *gen.UpdateReviewByIDRequest, opts ...grpc.CallOption) (*gen.ReviewsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.ReviewsResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) DeleteReviewByID(ctx context.Context, in *gen.DeleteReviewByIDRequest, opts ...grpc.CallOption) (*gen.ReviewsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.ReviewsResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) CreateReply(ctx context.Context, in *gen.ReplyRequest, opts ...grpc.CallOption) (*gen.ReviewsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.ReviewsResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) UpdateReply(ctx context.Context, in *gen.ReplyRequest, opts ...grpc.CallOption) (*gen.ReviewsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.ReviewsResponse), args.Error(1)
+}
+
+func (m *MockGrpcClient) DeleteReply(ctx context.Context, in *gen.DeleteReplyRequest, opts ...grpc.CallOption) (*gen.ReviewsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*gen.ReviewsResponse), args.Error(1)
+}
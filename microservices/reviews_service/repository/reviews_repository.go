@@ -0,0 +1,539 @@
+package repository
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/metrics"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/scores"
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type ReviewRepository struct {
+	db *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) domain.ReviewRepository {
+	return &ReviewRepository{
+		db: db,
+	}
+}
+
+func (r *ReviewRepository) CreateReview(ctx context.Context, review *domain.Review) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("CreateReview called", zap.String("HostId", review.HostID), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("CreateReview", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("CreateReview", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("CreateReview").Observe(duration)
+	}()
+	// Отзыв об объявлении достаётся его хозяину, один отзыв на пару (пользователь, объявление)
+	existingCond := "\"userId\" = ? AND \"hostId\" = ? AND \"adId\" IS NULL"
+	existingArgs := []interface{}{review.UserID, review.HostID}
+	if review.AdID != nil {
+		var ad domain.Ad
+		if err = r.db.Where("uuid = ?", *review.AdID).First(&ad).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				logger.DBLogger.Warn("Ad not found", zap.String("adId", *review.AdID), zap.String("request_id", requestID))
+				err = errors.New("ad not found")
+				return err
+			}
+			logger.DBLogger.Error("Error finding ad", zap.String("adId", *review.AdID), zap.String("request_id", requestID), zap.Error(err))
+			err = errors.New("error finding ad")
+			return err
+		}
+		if ad.AuthorUUID == review.UserID {
+			err = errors.New("host and user are the same")
+			return err
+		}
+		review.HostID = ad.AuthorUUID
+		existingCond = "\"userId\" = ? AND \"adId\" = ?"
+		existingArgs = []interface{}{review.UserID, *review.AdID}
+	}
+
+	// withHostScore заодно проверяет, что хозяин существует
+	err = r.withHostScore(ctx, review.HostID, review.AdID, func(tx *gorm.DB) error {
+		//Проверка существует ли отзыва
+		var query domain.Review
+		if err := tx.Where(existingCond, existingArgs...).First(&query).Error; err == nil {
+			logger.DBLogger.Warn("Review already exists", zap.String("userId", review.UserID), zap.String("hostId", review.HostID), zap.String("request_id", requestID))
+			return errors.New("review already exist")
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.DBLogger.Error("Error finding review",
+				zap.String("userId", review.UserID),
+				zap.String("hostId", review.HostID),
+				zap.String("request_id", requestID),
+				zap.Error(err))
+			return errors.New("error finding review")
+		}
+
+		if err := tx.Create(review).Error; err != nil {
+			logger.DBLogger.Error("Error creating review", zap.String("userId", review.UserID), zap.String("request_id", requestID), zap.Error(err))
+			return errors.New("error creating review")
+		}
+		return nil
+	})
+	return err
+}
+
+func (r *ReviewRepository) GetUserReviews(ctx context.Context, userId string, after *domain.ReviewCursor, limit int) ([]domain.UserReviews, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("GetUserReviews called", zap.String("request_id", requestID), zap.String("userID", userId))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("GetUserReviews", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("GetUserReviews", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("GetUserReviews").Observe(duration)
+	}()
+	var user domain.User
+	var reviews []domain.UserReviews
+	if err := r.db.Where("uuid = ?", userId).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.DBLogger.Warn("User not found", zap.String("request_id", requestID), zap.String("userID", userId))
+			return nil, errors.New("user not found")
+		}
+		logger.DBLogger.Error("Error fetching user by ID", zap.String("request_id", requestID), zap.String("userID", userId), zap.Error(err))
+		return nil, errors.New("error fetching user by ID")
+	}
+
+	if err := r.reviewsPage("reviews.\"hostId\" = ?", userId, after, limit).Find(&reviews).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.DBLogger.Warn("No reviews found", zap.String("request_id", requestID), zap.String("userID", userId))
+			return nil, errors.New("no reviews found")
+		}
+		logger.DBLogger.Error("Error fetching reviews", zap.String("request_id", requestID), zap.String("userID", userId), zap.Error(err))
+		return nil, errors.New("error fetching reviews")
+	}
+
+	logger.DBLogger.Info("Successfully fetched user by ID", zap.String("request_id", requestID), zap.String("userID", userId))
+	return reviews, nil
+}
+
+func (r *ReviewRepository) GetAdReviews(ctx context.Context, adID string, after *domain.ReviewCursor, limit int) ([]domain.UserReviews, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("GetAdReviews called", zap.String("request_id", requestID), zap.String("adID", adID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("GetAdReviews", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("GetAdReviews", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("GetAdReviews").Observe(duration)
+	}()
+	var reviews []domain.UserReviews
+	if err = r.db.Where("uuid = ?", adID).First(&domain.Ad{}).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.DBLogger.Warn("Ad not found", zap.String("request_id", requestID), zap.String("adID", adID))
+			err = errors.New("ad not found")
+			return nil, err
+		}
+		logger.DBLogger.Error("Error finding ad", zap.String("request_id", requestID), zap.String("adID", adID), zap.Error(err))
+		err = errors.New("error finding ad")
+		return nil, err
+	}
+
+	if err = r.reviewsPage("reviews.\"adId\" = ?", adID, after, limit).Find(&reviews).Error; err != nil {
+		logger.DBLogger.Error("Error fetching reviews", zap.String("request_id", requestID), zap.String("adID", adID), zap.Error(err))
+		err = errors.New("error fetching reviews")
+		return nil, err
+	}
+
+	logger.DBLogger.Info("Successfully fetched ad reviews", zap.String("request_id", requestID), zap.String("adID", adID))
+	return reviews, nil
+}
+
+// reviewsPage добавляет к условию выборки автора отзыва и страницу в порядке (createdAt, id)
+func (r *ReviewRepository) reviewsPage(condition string, value string, after *domain.ReviewCursor, limit int) *gorm.DB {
+	query := r.db.Model(&domain.Review{}).
+		Select("reviews.*, users.avatar as \"UserAvatar\", users.name as \"UserName\"").
+		Joins("JOIN users ON reviews.\"userId\" = users.uuid").
+		Where(condition, value).
+		Where("NOT reviews.hidden")
+	if after != nil {
+		query = query.Where("(reviews.\"createdAt\", reviews.id) > (?, ?)", after.CreatedAt, after.ID)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	return query.
+		Order("reviews.\"createdAt\" ASC").
+		Order("reviews.id ASC")
+}
+
+func (r *ReviewRepository) DeleteReview(ctx context.Context, userID, hostID string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("DeleteReview called", zap.String("userID", userID), zap.String("hostID", hostID), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("DeleteReview", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("DeleteReview", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("DeleteReview").Observe(duration)
+	}()
+	err = r.withHostScore(ctx, hostID, nil, func(tx *gorm.DB) error {
+		var review domain.Review
+		if err := tx.Where("\"userId\" = ? AND \"hostId\" = ? AND \"adId\" IS NULL", userID, hostID).First(&review).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				logger.DBLogger.Warn("Review not found", zap.String("userID", userID), zap.String("hostID", hostID), zap.String("request_id", requestID))
+				return errors.New("review not found")
+			}
+			logger.DBLogger.Error("Error finding review", zap.String("userID", userID), zap.String("hostID", hostID), zap.String("request_id", requestID), zap.Error(err))
+			return errors.New("error finding review")
+		}
+
+		if err := tx.Delete(&review).Error; err != nil {
+			logger.DBLogger.Error("Error deleting review", zap.String("userID", userID), zap.String("hostID", hostID), zap.String("request_id", requestID), zap.Error(err))
+			return errors.New("error deleting review")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.DBLogger.Info("Review successfully deleted", zap.String("userID", userID), zap.String("hostID", hostID), zap.String("request_id", requestID))
+	return nil
+}
+
+func (r *ReviewRepository) UpdateReview(ctx context.Context, userID, hostID string, updatedReview *domain.Review) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("UpdateReview called", zap.String("userID", userID), zap.String("hostID", hostID), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("UpdateReview", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("UpdateReview", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("UpdateReview").Observe(duration)
+	}()
+	err = r.withHostScore(ctx, hostID, nil, func(tx *gorm.DB) error {
+		var existingReview domain.Review
+		if err := tx.Where("\"userId\" = ? AND \"hostId\" = ? AND \"adId\" IS NULL", userID, hostID).First(&existingReview).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				logger.DBLogger.Warn("Review not found", zap.String("userID", userID), zap.String("hostID", hostID), zap.String("request_id", requestID))
+				return errors.New("review not found")
+			}
+			logger.DBLogger.Error("Error finding review", zap.String("userID", userID), zap.String("hostID", hostID), zap.String("request_id", requestID), zap.Error(err))
+			return errors.New("error finding review")
+		}
+
+		// Обновляем только текст и рейтинг.
+		existingReview.Title = updatedReview.Title
+		existingReview.Text = updatedReview.Text
+		existingReview.Rating = updatedReview.Rating
+
+		if err := tx.Save(&existingReview).Error; err != nil {
+			logger.DBLogger.Error("Error updating review", zap.String("userID", userID), zap.String("hostID", hostID), zap.String("request_id", requestID), zap.Error(err))
+			return errors.New("error updating review")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.DBLogger.Info("Review successfully updated", zap.String("userID", userID), zap.String("hostID", hostID), zap.String("request_id", requestID))
+	return nil
+}
+
+// authorReview возвращает hostId и adId отзыва автора userID. Они не меняются,
+// поэтому их можно прочитать до блокировки хозяина в withHostScore
+func (r *ReviewRepository) authorReview(ctx context.Context, reviewID int, userID string) (*domain.Review, error) {
+	requestID := middleware.GetRequestID(ctx)
+	var review domain.Review
+	if err := r.db.Select("\"hostId\"", "\"adId\"").Where("id = ? AND \"userId\" = ?", reviewID, userID).First(&review).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.DBLogger.Warn("Review not found", zap.Int("reviewID", reviewID), zap.String("userID", userID), zap.String("request_id", requestID))
+			return nil, errors.New("review not found")
+		}
+		logger.DBLogger.Error("Error finding review", zap.Int("reviewID", reviewID), zap.String("request_id", requestID), zap.Error(err))
+		return nil, errors.New("error finding review")
+	}
+	return &review, nil
+}
+
+func (r *ReviewRepository) UpdateReviewByID(ctx context.Context, reviewID int, userID string, updatedReview *domain.Review) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("UpdateReviewByID called", zap.Int("reviewID", reviewID), zap.String("userID", userID), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("UpdateReviewByID", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("UpdateReviewByID", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("UpdateReviewByID").Observe(duration)
+	}()
+	review, err := r.authorReview(ctx, reviewID, userID)
+	if err != nil {
+		return err
+	}
+
+	err = r.withHostScore(ctx, review.HostID, review.AdID, func(tx *gorm.DB) error {
+		result := tx.Model(&domain.Review{}).
+			Where("id = ? AND \"userId\" = ?", reviewID, userID).
+			Updates(map[string]interface{}{
+				"title":  updatedReview.Title,
+				"text":   updatedReview.Text,
+				"rating": updatedReview.Rating,
+			})
+		if result.Error != nil {
+			logger.DBLogger.Error("Error updating review", zap.Int("reviewID", reviewID), zap.String("request_id", requestID), zap.Error(result.Error))
+			return errors.New("error updating review")
+		}
+		// отзыв удалили, пока ждали блокировку
+		if result.RowsAffected == 0 {
+			return errors.New("review not found")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.DBLogger.Info("Review successfully updated", zap.Int("reviewID", reviewID), zap.String("request_id", requestID))
+	return nil
+}
+
+func (r *ReviewRepository) DeleteReviewByID(ctx context.Context, reviewID int, userID string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("DeleteReviewByID called", zap.Int("reviewID", reviewID), zap.String("userID", userID), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("DeleteReviewByID", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("DeleteReviewByID", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("DeleteReviewByID").Observe(duration)
+	}()
+	review, err := r.authorReview(ctx, reviewID, userID)
+	if err != nil {
+		return err
+	}
+
+	err = r.withHostScore(ctx, review.HostID, review.AdID, func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND \"userId\" = ?", reviewID, userID).Delete(&domain.Review{})
+		if result.Error != nil {
+			logger.DBLogger.Error("Error deleting review", zap.Int("reviewID", reviewID), zap.String("request_id", requestID), zap.Error(result.Error))
+			return errors.New("error deleting review")
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("review not found")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.DBLogger.Info("Review successfully deleted", zap.Int("reviewID", reviewID), zap.String("request_id", requestID))
+	return nil
+}
+
+func (r *ReviewRepository) GetLastCompletedStay(ctx context.Context, userID, hostID string, adID *string) (*time.Time, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("GetLastCompletedStay called", zap.String("userID", userID), zap.String("hostID", hostID), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("GetLastCompletedStay", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("GetLastCompletedStay", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("GetLastCompletedStay").Observe(duration)
+	}()
+	// одобренное проживание после даты выезда тоже считается завершённым,
+	// иначе хозяин мог бы не давать оставлять отзывы, не отмечая заявки выполненными
+	query := r.db.Model(&domain.Booking{}).
+		Where("\"userId\" = ? AND (status = ? OR (status = ? AND \"dateTo\" < ?))",
+			userID, domain.BookingStatusCompleted, domain.BookingStatusApproved, time.Now())
+	if adID != nil {
+		query = query.Where("\"adId\" = ?", *adID)
+	} else {
+		query = query.Where("\"hostId\" = ?", hostID)
+	}
+
+	var booking domain.Booking
+	if err = query.Order("\"dateTo\" DESC").First(&booking).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = nil
+			return nil, nil
+		}
+		logger.DBLogger.Error("Error finding completed stay", zap.String("userID", userID), zap.String("request_id", requestID), zap.Error(err))
+		err = errors.New("error finding completed stay")
+		return nil, err
+	}
+	return &booking.DateTo, nil
+}
+
+// withHostScore меняет отзывы и пересчитывает оценки в одной транзакции под блокировкой строки хозяина
+func (r *ReviewRepository) withHostScore(ctx context.Context, hostID string, adID *string, write func(tx *gorm.DB) error) error {
+	requestID := middleware.GetRequestID(ctx)
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("uuid").Where("uuid = ?", hostID).First(&domain.User{}).Error; err != nil {
+			logger.DBLogger.Error("Error locking host", zap.String("hostId", hostID), zap.String("request_id", requestID), zap.Error(err))
+			return errors.New("error finding host")
+		}
+
+		if err := write(tx); err != nil {
+			return err
+		}
+
+		if adID != nil {
+			if err := r.updateAdScore(ctx, tx, *adID); err != nil {
+				logger.DBLogger.Error("Error updating ad score", zap.String("adId", *adID), zap.String("request_id", requestID), zap.Error(err))
+				return errors.New("error updating ad score")
+			}
+		}
+
+		if err := r.updateHostScore(ctx, tx, hostID); err != nil {
+			logger.DBLogger.Error("Error updating host score", zap.String("hostId", hostID), zap.String("request_id", requestID), zap.Error(err))
+			return errors.New("error updating host score")
+		}
+		return nil
+	})
+}
+
+// updateAdScore пересчитывает средний рейтинг и число отзывов объявления
+func (r *ReviewRepository) updateAdScore(ctx context.Context, tx *gorm.DB, adID string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("updateAdScore called", zap.String("adId", adID), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("updateAdScore", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("updateAdScore", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("updateAdScore").Observe(duration)
+	}()
+	if err = scores.UpdateAd(tx, adID); err != nil {
+		logger.DBLogger.Error("Failed to update ad score", zap.String("adId", adID), zap.String("request_id", requestID), zap.Error(err))
+		err = errors.New("failed to update ad score")
+		return err
+	}
+	return nil
+}
+
+// updateHostScore пересчитывает рейтинг, число отзывов и байесовскую оценку хозяина
+func (r *ReviewRepository) updateHostScore(ctx context.Context, tx *gorm.DB, hostID string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("updateHostScore called", zap.String("HostId", hostID), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("updateHostScore", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("updateHostScore", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("updateHostScore").Observe(duration)
+	}()
+	if err = scores.UpdateHost(tx, hostID); err != nil {
+		logger.DBLogger.Error("Failed to update host score", zap.String("hostId", hostID), zap.String("request_id", requestID), zap.Error(err))
+		err = errors.New("failed to update host score")
+		return err
+	}
+	return nil
+}
+
+func (r *ReviewRepository) GetReviewByID(ctx context.Context, reviewID int) (*domain.Review, error) {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("GetReviewByID called", zap.Int("reviewID", reviewID), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("GetReviewByID", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("GetReviewByID", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("GetReviewByID").Observe(duration)
+	}()
+	var review domain.Review
+	if err = r.db.Where("id = ?", reviewID).First(&review).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.DBLogger.Warn("Review not found", zap.Int("reviewID", reviewID), zap.String("request_id", requestID))
+			err = errors.New("review not found")
+			return nil, err
+		}
+		logger.DBLogger.Error("Error finding review", zap.Int("reviewID", reviewID), zap.String("request_id", requestID), zap.Error(err))
+		err = errors.New("error finding review")
+		return nil, err
+	}
+	return &review, nil
+}
+
+func (r *ReviewRepository) SetReviewReply(ctx context.Context, reviewID int, reply *string) error {
+	start := time.Now()
+	requestID := middleware.GetRequestID(ctx)
+	logger.DBLogger.Info("SetReviewReply called", zap.Int("reviewID", reviewID), zap.String("request_id", requestID))
+	var err error
+	defer func() {
+		if err != nil {
+			metrics.RepoErrorsTotal.WithLabelValues("SetReviewReply", "error", err.Error()).Inc()
+		} else {
+			metrics.RepoRequestTotal.WithLabelValues("SetReviewReply", "success").Inc()
+		}
+		duration := time.Since(start).Seconds()
+		metrics.RepoRequestDuration.WithLabelValues("SetReviewReply").Observe(duration)
+	}()
+	var replyUpdatedAt *time.Time
+	if reply != nil {
+		now := time.Now()
+		replyUpdatedAt = &now
+	}
+	result := r.db.Model(&domain.Review{}).Where("id = ?", reviewID).Updates(map[string]interface{}{
+		"reply":          reply,
+		"replyUpdatedAt": replyUpdatedAt,
+	})
+	if err = result.Error; err != nil {
+		logger.DBLogger.Error("Error updating review reply", zap.Int("reviewID", reviewID), zap.String("request_id", requestID), zap.Error(err))
+		err = errors.New("error updating review reply")
+		return err
+	}
+	if result.RowsAffected == 0 {
+		err = errors.New("review not found")
+		return err
+	}
+
+	logger.DBLogger.Info("Review reply successfully saved", zap.Int("reviewID", reviewID), zap.String("request_id", requestID))
+	return nil
+}
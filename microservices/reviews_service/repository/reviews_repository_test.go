@@ -0,0 +1,323 @@
+package repository
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func setupDBMock() (*gorm.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+	return gormDB, mock, err
+}
+
+func TestCreateReview_AdReview(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+	repo := NewReviewRepository(db)
+
+	adID := "ad-uuid"
+	review := &domain.Review{UserID: "guest", AdID: &adID, Title: "Nice", Text: "Clean flat", Rating: 4, CreatedAt: time.Now()}
+
+	mock.ExpectQuery(`SELECT \* FROM "ads" WHERE uuid = \$1`).
+		WithArgs(adID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"uuid", "authorUUID"}).AddRow(adID, "host"))
+	// запись и пересчёт оценок идут в одной транзакции под блокировкой хозяина
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "uuid" FROM "users" WHERE uuid = \$1 .* FOR UPDATE`).
+		WithArgs("host", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"uuid"}).AddRow("host"))
+	mock.ExpectQuery(`SELECT \* FROM "reviews" WHERE "userId" = \$1 AND "adId" = \$2`).
+		WithArgs("guest", adID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`INSERT INTO "reviews"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(`UPDATE ads SET \("reviewsRating", "reviewsCount"\) = \(\s*SELECT COALESCE\(ROUND\(AVG\(rating\)`).
+		WithArgs(adID, adID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// рейтинг хозяина считается и по отзывам о его объявлениях
+	mock.ExpectExec(`UPDATE users SET \(score, "reviewsCount", "weightedScore"\) = \(`).
+		WithArgs(domain.HostScorePriorWeight, domain.HostScorePriorMean, domain.HostScorePriorWeight, "host", "host").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, repo.CreateReview(context.Background(), review))
+	assert.Equal(t, "host", review.HostID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateReview_OwnAd(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+	repo := NewReviewRepository(db)
+
+	adID := "ad-uuid"
+	mock.ExpectQuery(`SELECT \* FROM "ads" WHERE uuid = \$1`).
+		WithArgs(adID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"uuid", "authorUUID"}).AddRow(adID, "host"))
+
+	err = repo.CreateReview(context.Background(), &domain.Review{UserID: "host", AdID: &adID, Rating: 5})
+	assert.EqualError(t, err, "host and user are the same")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAdReviews_AdNotFound(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+	repo := NewReviewRepository(db)
+
+	mock.ExpectQuery(`SELECT \* FROM "ads" WHERE uuid = \$1`).
+		WithArgs("missing", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"uuid"}))
+
+	_, err = repo.GetAdReviews(context.Background(), "missing", nil, 21)
+	assert.EqualError(t, err, "ad not found")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetLastCompletedStay(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+	repo := NewReviewRepository(db)
+
+	adID := "ad-uuid"
+	dateTo := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`SELECT \* FROM "requests" WHERE \("userId" = \$1 AND \(status = \$2 OR \(status = \$3 AND "dateTo" < \$4\)\)\) AND "adId" = \$5 ORDER BY "dateTo" DESC`).
+		WithArgs("guest", domain.BookingStatusCompleted, domain.BookingStatusApproved, sqlmock.AnyArg(), adID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "dateTo"}).AddRow(1, domain.BookingStatusApproved, dateTo))
+
+	found, err := repo.GetLastCompletedStay(context.Background(), "guest", "host", &adID)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.True(t, dateTo.Equal(*found))
+
+	mock.ExpectQuery(`SELECT \* FROM "requests" WHERE \("userId" = \$1 AND \(status = \$2 OR \(status = \$3 AND "dateTo" < \$4\)\)\) AND "hostId" = \$5`).
+		WithArgs("guest", domain.BookingStatusCompleted, domain.BookingStatusApproved, sqlmock.AnyArg(), "host", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	found, err = repo.GetLastCompletedStay(context.Background(), "guest", "host", nil)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetReviewReply(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+	repo := NewReviewRepository(db)
+
+	reply := "Thanks for staying!"
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "reviews" SET "reply"=\$1,"replyUpdatedAt"=\$2 WHERE id = \$3`).
+		WithArgs(reply, sqlmock.AnyArg(), 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, repo.SetReviewReply(context.Background(), 7, &reply))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "reviews" SET "reply"=\$1,"replyUpdatedAt"=\$2 WHERE id = \$3`).
+		WithArgs(nil, nil, 8).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	assert.EqualError(t, repo.SetReviewReply(context.Background(), 8, nil), "review not found")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteReview_RollsBackOnScoreError(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	db, mock, err := setupDBMock()
+	require.NoError(t, err)
+	repo := NewReviewRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "uuid" FROM "users" WHERE uuid = \$1 .* FOR UPDATE`).
+		WithArgs("host", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"uuid"}).AddRow("host"))
+	mock.ExpectQuery(`SELECT \* FROM "reviews" WHERE "userId" = \$1 AND "hostId" = \$2 AND "adId" IS NULL`).
+		WithArgs("guest", "host", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "userId", "hostId", "rating"}).AddRow(3, "guest", "host", 5))
+	mock.ExpectExec(`DELETE FROM "reviews" WHERE "reviews"."id" = \$1`).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE users SET \(score, "reviewsCount", "weightedScore"\)`).
+		WillReturnError(errors.New("connection reset"))
+	// удаление не должно остаться без пересчёта оценки
+	mock.ExpectRollback()
+
+	err = repo.DeleteReview(context.Background(), "guest", "host")
+	assert.EqualError(t, err, "error updating host score")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateReviewByID(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	t.Run("Ad Review", func(t *testing.T) {
+		db, mock, err := setupDBMock()
+		require.NoError(t, err)
+		repo := NewReviewRepository(db)
+
+		mock.ExpectQuery(`SELECT "hostId","adId" FROM "reviews" WHERE id = \$1 AND "userId" = \$2`).
+			WithArgs(7, "guest", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"hostId", "adId"}).AddRow("host", "ad-uuid"))
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT "uuid" FROM "users" WHERE uuid = \$1 .* FOR UPDATE`).
+			WithArgs("host", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"uuid"}).AddRow("host"))
+		mock.ExpectExec(`UPDATE "reviews" SET "rating"=\$1,"text"=\$2,"title"=\$3 WHERE id = \$4 AND "userId" = \$5`).
+			WithArgs(2, "Noisy at night", "Changed my mind", 7, "guest").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		// правка отзыва об объявлении пересчитывает и объявление, и хозяина
+		mock.ExpectExec(`UPDATE ads SET \("reviewsRating", "reviewsCount"\)`).
+			WithArgs("ad-uuid", "ad-uuid").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE users SET \(score, "reviewsCount", "weightedScore"\)`).
+			WithArgs(domain.HostScorePriorWeight, domain.HostScorePriorMean, domain.HostScorePriorWeight, "host", "host").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err = repo.UpdateReviewByID(context.Background(), 7, "guest", &domain.Review{Title: "Changed my mind", Text: "Noisy at night", Rating: 2})
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Not Author", func(t *testing.T) {
+		db, mock, err := setupDBMock()
+		require.NoError(t, err)
+		repo := NewReviewRepository(db)
+
+		mock.ExpectQuery(`SELECT "hostId","adId" FROM "reviews" WHERE id = \$1 AND "userId" = \$2`).
+			WithArgs(7, "stranger", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"hostId", "adId"}))
+
+		err = repo.UpdateReviewByID(context.Background(), 7, "stranger", &domain.Review{Title: "Spam", Text: "Spam", Rating: 1})
+		assert.EqualError(t, err, "review not found")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestDeleteReviewByID(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+
+	t.Run("Ad Review", func(t *testing.T) {
+		db, mock, err := setupDBMock()
+		require.NoError(t, err)
+		repo := NewReviewRepository(db)
+
+		mock.ExpectQuery(`SELECT "hostId","adId" FROM "reviews" WHERE id = \$1 AND "userId" = \$2`).
+			WithArgs(7, "guest", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"hostId", "adId"}).AddRow("host", "ad-uuid"))
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT "uuid" FROM "users" WHERE uuid = \$1 .* FOR UPDATE`).
+			WithArgs("host", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"uuid"}).AddRow("host"))
+		mock.ExpectExec(`DELETE FROM "reviews" WHERE id = \$1 AND "userId" = \$2`).
+			WithArgs(7, "guest").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE ads SET \("reviewsRating", "reviewsCount"\)`).
+			WithArgs("ad-uuid", "ad-uuid").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE users SET \(score, "reviewsCount", "weightedScore"\)`).
+			WithArgs(domain.HostScorePriorWeight, domain.HostScorePriorMean, domain.HostScorePriorWeight, "host", "host").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.DeleteReviewByID(context.Background(), 7, "guest"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Deleted Concurrently", func(t *testing.T) {
+		db, mock, err := setupDBMock()
+		require.NoError(t, err)
+		repo := NewReviewRepository(db)
+
+		mock.ExpectQuery(`SELECT "hostId","adId" FROM "reviews" WHERE id = \$1 AND "userId" = \$2`).
+			WithArgs(7, "guest", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"hostId", "adId"}).AddRow("host", nil))
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT "uuid" FROM "users" WHERE uuid = \$1 .* FOR UPDATE`).
+			WithArgs("host", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"uuid"}).AddRow("host"))
+		mock.ExpectExec(`DELETE FROM "reviews" WHERE id = \$1 AND "userId" = \$2`).
+			WithArgs(7, "guest").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err = repo.DeleteReviewByID(context.Background(), 7, "guest")
+		assert.EqualError(t, err, "review not found")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
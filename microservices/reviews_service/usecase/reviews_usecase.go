@@ -0,0 +1,294 @@
+package usecase
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/middleware"
+	"2024_2_FIGHT-CLUB/internal/service/pagination"
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type ReviewUsecase interface {
+	CreateReview(ctx context.Context, review *domain.Review, userId string) error
+	GetUserReviews(ctx context.Context, userId string, cursor *domain.ReviewCursor) ([]domain.UserReviews, string, error)
+	GetAdReviews(ctx context.Context, adId string, cursor *domain.ReviewCursor) ([]domain.UserReviews, string, error)
+	UpdateReview(ctx context.Context, userID, hostID string, updatedReview *domain.Review) error
+	DeleteReview(ctx context.Context, userID, hostID string) error
+	UpdateReviewByID(ctx context.Context, userID string, reviewID int, updatedReview *domain.Review) error
+	DeleteReviewByID(ctx context.Context, userID string, reviewID int) error
+	CreateReply(ctx context.Context, userID string, reviewID int, text string) error
+	UpdateReply(ctx context.Context, userID string, reviewID int, text string) error
+	DeleteReply(ctx context.Context, userID string, reviewID int) error
+}
+
+type reviewUsecase struct {
+	repository domain.ReviewRepository
+}
+
+func (r *reviewUsecase) UpdateReview(ctx context.Context, userID, hostID string, updatedReview *domain.Review) error {
+	if err := validateReviewUpdate(ctx, updatedReview); err != nil {
+		return err
+	}
+	err := r.repository.UpdateReview(ctx, userID, hostID, updatedReview)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *reviewUsecase) UpdateReviewByID(ctx context.Context, userID string, reviewID int, updatedReview *domain.Review) error {
+	if err := validateReviewUpdate(ctx, updatedReview); err != nil {
+		return err
+	}
+	return r.repository.UpdateReviewByID(ctx, reviewID, userID, updatedReview)
+}
+
+func (r *reviewUsecase) DeleteReviewByID(ctx context.Context, userID string, reviewID int) error {
+	return r.repository.DeleteReviewByID(ctx, reviewID, userID)
+}
+
+func validateReviewUpdate(ctx context.Context, updatedReview *domain.Review) error {
+	const maxLenTitle = 100
+	const maxLenText = 1000
+	const minScore, maxScore = 1, 5
+	requestID := middleware.GetRequestID(ctx)
+	validCharPattern := regexp.MustCompile(`^[a-zA-Zа-яА-Я0-9@.,\s\-!?:;_/()]*$`)
+	if !validCharPattern.MatchString(updatedReview.Title) ||
+		!validCharPattern.MatchString(updatedReview.Text) {
+		logger.AccessLogger.Warn("Input contains invalid characters", zap.String("request_id", requestID))
+		return errors.New("input contains invalid characters")
+	}
+
+	if updatedReview.Rating < minScore || updatedReview.Rating > maxScore {
+		logger.AccessLogger.Warn("Score out of range", zap.String("request_id", requestID))
+		return errors.New("score out of range")
+	}
+
+	if len(updatedReview.Title) > maxLenTitle || len(updatedReview.Text) > maxLenText {
+		logger.AccessLogger.Warn("Input exceeds character limit", zap.String("request_id", requestID))
+		return errors.New("input exceeds character limit")
+	}
+	return nil
+}
+
+func (r *reviewUsecase) DeleteReview(ctx context.Context, userID, hostID string) error {
+	requestID := middleware.GetRequestID(ctx)
+	const maxLen = 255
+	validCharPattern := regexp.MustCompile(`^[a-zA-Zа-яА-ЯёЁ0-9\s\-_]*$`)
+	if !validCharPattern.MatchString(hostID) {
+		logger.AccessLogger.Warn("Input contains invalid characters", zap.String("request_id", requestID))
+		return errors.New("input contains invalid characters")
+	}
+
+	if len(hostID) > maxLen {
+		logger.AccessLogger.Warn("Input exceeds character limit", zap.String("request_id", requestID))
+		return errors.New("input exceeds character limit")
+	}
+
+	err := r.repository.DeleteReview(ctx, userID, hostID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewReviewUsecase(repository domain.ReviewRepository) ReviewUsecase {
+	return &reviewUsecase{
+		repository: repository,
+	}
+}
+
+func (r *reviewUsecase) CreateReview(ctx context.Context, review *domain.Review, userId string) error {
+	const maxLenTitle = 100
+	const maxLenText = 1000
+	const minScore, maxScore = 1, 5
+	requestID := middleware.GetRequestID(ctx)
+	validCharPattern := regexp.MustCompile(`^[a-zA-Zа-яА-Я0-9@.,\s\-!?:;_/()]*$`)
+	if !validCharPattern.MatchString(review.Title) ||
+		!validCharPattern.MatchString(review.Text) {
+		logger.AccessLogger.Warn("Input contains invalid characters", zap.String("request_id", requestID))
+		return errors.New("input contains invalid characters")
+	}
+
+	if review.Rating < minScore || review.Rating > maxScore {
+		logger.AccessLogger.Warn("Score out of range", zap.String("request_id", requestID))
+		return errors.New("score out of range")
+	}
+
+	if len(review.Title) > maxLenTitle || len(review.Text) > maxLenText {
+		logger.AccessLogger.Warn("Input exceeds character limit", zap.String("request_id", requestID))
+		return errors.New("input exceeds character limit")
+	}
+	if review.HostID == userId {
+		return errors.New("host and user are the same")
+	}
+	if review.AdID != nil && !regexp.MustCompile(`^[a-zA-Z0-9\-]+$`).MatchString(*review.AdID) {
+		logger.AccessLogger.Warn("Input contains invalid characters", zap.String("request_id", requestID))
+		return errors.New("input contains invalid characters")
+	}
+
+	checkout, err := r.repository.GetLastCompletedStay(ctx, userId, review.HostID, review.AdID)
+	if err != nil {
+		return err
+	}
+	if checkout == nil {
+		logger.AccessLogger.Warn("No completed stay for review", zap.String("request_id", requestID))
+		return errors.New("no completed stay")
+	}
+	// окно считается от даты выезда, а не от момента, когда хозяин закрыл заявку
+	if time.Since(*checkout) > domain.ReviewWindow {
+		logger.AccessLogger.Warn("Review window closed", zap.String("request_id", requestID))
+		return errors.New("review window closed")
+	}
+
+	review.UserID = userId
+	review.CreatedAt = time.Now()
+	err = r.repository.CreateReview(ctx, review)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *reviewUsecase) CreateReply(ctx context.Context, userID string, reviewID int, text string) error {
+	requestID := middleware.GetRequestID(ctx)
+	if err := validateReply(ctx, text); err != nil {
+		return err
+	}
+	review, err := r.hostReview(ctx, userID, reviewID)
+	if err != nil {
+		return err
+	}
+	if review.Reply != nil {
+		logger.AccessLogger.Warn("Reply already exist", zap.String("request_id", requestID), zap.Int("reviewID", reviewID))
+		return errors.New("reply already exist")
+	}
+	return r.repository.SetReviewReply(ctx, reviewID, &text)
+}
+
+func (r *reviewUsecase) UpdateReply(ctx context.Context, userID string, reviewID int, text string) error {
+	requestID := middleware.GetRequestID(ctx)
+	if err := validateReply(ctx, text); err != nil {
+		return err
+	}
+	review, err := r.hostReview(ctx, userID, reviewID)
+	if err != nil {
+		return err
+	}
+	if review.Reply == nil {
+		logger.AccessLogger.Warn("Reply not found", zap.String("request_id", requestID), zap.Int("reviewID", reviewID))
+		return errors.New("reply not found")
+	}
+	return r.repository.SetReviewReply(ctx, reviewID, &text)
+}
+
+func (r *reviewUsecase) DeleteReply(ctx context.Context, userID string, reviewID int) error {
+	requestID := middleware.GetRequestID(ctx)
+	review, err := r.hostReview(ctx, userID, reviewID)
+	if err != nil {
+		return err
+	}
+	if review.Reply == nil {
+		logger.AccessLogger.Warn("Reply not found", zap.String("request_id", requestID), zap.Int("reviewID", reviewID))
+		return errors.New("reply not found")
+	}
+	return r.repository.SetReviewReply(ctx, reviewID, nil)
+}
+
+// hostReview возвращает отзыв, если userID - хозяин, о котором он написан
+func (r *reviewUsecase) hostReview(ctx context.Context, userID string, reviewID int) (*domain.Review, error) {
+	requestID := middleware.GetRequestID(ctx)
+	review, err := r.repository.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.HostID != userID {
+		logger.AccessLogger.Warn("Reply from not reviewed host", zap.String("request_id", requestID), zap.Int("reviewID", reviewID))
+		return nil, errors.New("only the reviewed host can reply")
+	}
+	return review, nil
+}
+
+func validateReply(ctx context.Context, text string) error {
+	const maxLenText = 1000
+	requestID := middleware.GetRequestID(ctx)
+	validCharPattern := regexp.MustCompile(`^[a-zA-Zа-яА-Я0-9@.,\s\-!?:;_/()]*$`)
+	if !validCharPattern.MatchString(text) {
+		logger.AccessLogger.Warn("Input contains invalid characters", zap.String("request_id", requestID))
+		return errors.New("input contains invalid characters")
+	}
+	if strings.TrimSpace(text) == "" {
+		logger.AccessLogger.Warn("Empty reply text", zap.String("request_id", requestID))
+		return errors.New("reply text is empty")
+	}
+	if len(text) > maxLenText {
+		logger.AccessLogger.Warn("Input exceeds character limit", zap.String("request_id", requestID))
+		return errors.New("input exceeds character limit")
+	}
+	return nil
+}
+
+const reviewsPageSize = 20
+
+func (r *reviewUsecase) GetUserReviews(ctx context.Context, userId string, cursor *domain.ReviewCursor) ([]domain.UserReviews, string, error) {
+	requestID := middleware.GetRequestID(ctx)
+	const maxLen = 255
+	validCharPattern := regexp.MustCompile(`^[a-zA-Zа-яА-ЯёЁ0-9\s\-_]*$`)
+	if !validCharPattern.MatchString(userId) {
+		logger.AccessLogger.Warn("Input contains invalid characters", zap.String("request_id", requestID))
+		return nil, "", errors.New("input contains invalid characters")
+	}
+
+	if len(userId) > maxLen {
+		logger.AccessLogger.Warn("Input exceeds character limit", zap.String("request_id", requestID))
+		return nil, "", errors.New("input exceeds character limit")
+	}
+
+	// одна лишняя запись показывает, есть ли следующая страница
+	reviews, err := r.repository.GetUserReviews(ctx, userId, cursor, reviewsPageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+	reviews, nextCursor := reviewsPage(reviews)
+	return reviews, nextCursor, nil
+}
+
+func (r *reviewUsecase) GetAdReviews(ctx context.Context, adId string, cursor *domain.ReviewCursor) ([]domain.UserReviews, string, error) {
+	requestID := middleware.GetRequestID(ctx)
+	const maxLen = 255
+	validCharPattern := regexp.MustCompile(`^[a-zA-Z0-9\-]*$`)
+	if !validCharPattern.MatchString(adId) {
+		logger.AccessLogger.Warn("Input contains invalid characters", zap.String("request_id", requestID))
+		return nil, "", errors.New("input contains invalid characters")
+	}
+
+	if len(adId) > maxLen {
+		logger.AccessLogger.Warn("Input exceeds character limit", zap.String("request_id", requestID))
+		return nil, "", errors.New("input exceeds character limit")
+	}
+
+	reviews, err := r.repository.GetAdReviews(ctx, adId, cursor, reviewsPageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+	reviews, nextCursor := reviewsPage(reviews)
+	return reviews, nextCursor, nil
+}
+
+// reviewsPage обрезает выборку до размера страницы и возвращает курсор следующей, если она есть
+func reviewsPage(reviews []domain.UserReviews) ([]domain.UserReviews, string) {
+	if len(reviews) <= reviewsPageSize {
+		return reviews, ""
+	}
+	reviews = reviews[:reviewsPageSize]
+	last := reviews[reviewsPageSize-1]
+	return reviews, pagination.EncodeCursor(domain.ReviewCursor{
+		CreatedAt: last.CreatedAt,
+		ID:        last.ID,
+	})
+}
@@ -0,0 +1,554 @@
+package usecase
+
+import (
+	"2024_2_FIGHT-CLUB/domain"
+	"2024_2_FIGHT-CLUB/internal/service/logger"
+	"2024_2_FIGHT-CLUB/internal/service/pagination"
+	"2024_2_FIGHT-CLUB/microservices/reviews_service/mocks"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCreateReview(t *testing.T) {
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	validReview := &domain.Review{
+		Title:  "Great Place!",
+		Text:   "I loved staying here. The hosts were wonderful.",
+		Rating: 5,
+		HostID: "host123",
+	}
+	mockRepo.MockGetLastCompletedStay = func(ctx context.Context, userID, hostID string, adID *string) (*time.Time, error) {
+		assert.Equal(t, "user123", userID)
+		assert.Equal(t, "host123", hostID)
+		assert.Nil(t, adID)
+		closeDate := time.Now().Add(-48 * time.Hour)
+		return &closeDate, nil
+	}
+	mockRepo.MockCreateReview = func(ctx context.Context, review *domain.Review) error {
+		return nil
+	}
+
+	userID := "user123"
+	ctx := context.Background()
+	err := reviewUsecase.CreateReview(ctx, validReview, userID)
+	assert.NoError(t, err)
+}
+
+func TestCreateReview_StayRequired(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+	mockRepo.MockCreateReview = func(ctx context.Context, review *domain.Review) error {
+		t.Fatal("review must not be created")
+		return nil
+	}
+
+	t.Run("no completed stay", func(t *testing.T) {
+		mockRepo.MockGetLastCompletedStay = func(ctx context.Context, userID, hostID string, adID *string) (*time.Time, error) {
+			return nil, nil
+		}
+		err := reviewUsecase.CreateReview(context.Background(), &domain.Review{Title: "Nice", HostID: "host123", Rating: 5}, "user123")
+		assert.EqualError(t, err, "no completed stay")
+	})
+
+	t.Run("review window closed", func(t *testing.T) {
+		mockRepo.MockGetLastCompletedStay = func(ctx context.Context, userID, hostID string, adID *string) (*time.Time, error) {
+			closeDate := time.Now().Add(-domain.ReviewWindow - 24*time.Hour)
+			return &closeDate, nil
+		}
+		err := reviewUsecase.CreateReview(context.Background(), &domain.Review{Title: "Nice", HostID: "host123", Rating: 5}, "user123")
+		assert.EqualError(t, err, "review window closed")
+	})
+
+	t.Run("stay of the reviewed ad", func(t *testing.T) {
+		adID := "ad-uuid"
+		mockRepo.MockGetLastCompletedStay = func(ctx context.Context, userID, hostID string, ad *string) (*time.Time, error) {
+			require.NotNil(t, ad)
+			assert.Equal(t, adID, *ad)
+			return nil, errors.New("error finding completed stay")
+		}
+		err := reviewUsecase.CreateReview(context.Background(), &domain.Review{Title: "Nice", AdID: &adID, Rating: 5}, "user123")
+		assert.EqualError(t, err, "error finding completed stay")
+	})
+}
+
+func TestCreateReview_InvalidInput(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	invalidReview := &domain.Review{
+		Title:  "Bad Title#$%",
+		Text:   "Bad Text%@#",
+		Rating: 10,
+		HostID: "host123",
+	}
+
+	userID := "user123"
+	ctx := context.Background()
+	err := reviewUsecase.CreateReview(ctx, invalidReview, userID)
+	assert.Error(t, err)
+}
+
+func TestUpdateReview(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	validReview := &domain.Review{
+		Title:  "Updated Title",
+		Text:   "Updated Text",
+		Rating: 4,
+	}
+	mockRepo.MockUpdateReview = func(ctx context.Context, userID, hostID string, updatedReview *domain.Review) error {
+		assert.Equal(t, "user123", userID)
+		assert.Equal(t, "host123", hostID)
+		assert.Equal(t, validReview.Title, updatedReview.Title)
+		assert.Equal(t, validReview.Text, updatedReview.Text)
+		assert.Equal(t, validReview.Rating, updatedReview.Rating)
+		return nil
+	}
+
+	ctx := context.Background()
+	err := reviewUsecase.UpdateReview(ctx, "user123", "host123", validReview)
+	assert.NoError(t, err)
+}
+
+func TestUpdateReview_InvalidInput(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	invalidReview := &domain.Review{
+		Title:  "Invalid Title#$%",
+		Text:   "Invalid Text%@#",
+		Rating: 0,
+	}
+
+	ctx := context.Background()
+	err := reviewUsecase.UpdateReview(ctx, "user123", "host123", invalidReview)
+	assert.Error(t, err)
+}
+
+func TestDeleteReview(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	mockRepo.MockDeleteReview = func(ctx context.Context, userID, hostID string) error {
+		assert.Equal(t, "user123", userID)
+		assert.Equal(t, "host123", hostID)
+		return nil
+	}
+
+	ctx := context.Background()
+	err := reviewUsecase.DeleteReview(ctx, "user123", "host123")
+	assert.NoError(t, err)
+}
+
+func TestDeleteReview_InvalidInput(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	ctx := context.Background()
+	err := reviewUsecase.DeleteReview(ctx, "user123", "!nv@lidH0stID")
+	assert.Error(t, err)
+}
+
+func TestUpdateReviewByID(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.MockUpdateReviewByID = func(ctx context.Context, reviewID int, userID string, updatedReview *domain.Review) error {
+			assert.Equal(t, 7, reviewID)
+			assert.Equal(t, "user123", userID)
+			assert.Equal(t, 3, updatedReview.Rating)
+			return nil
+		}
+		err := reviewUsecase.UpdateReviewByID(ctx, "user123", 7, &domain.Review{Title: "Updated Title", Text: "Updated Text", Rating: 3})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Score Out Of Range", func(t *testing.T) {
+		mockRepo.MockUpdateReviewByID = func(ctx context.Context, reviewID int, userID string, updatedReview *domain.Review) error {
+			t.Fatal("invalid review must not reach the repository")
+			return nil
+		}
+		err := reviewUsecase.UpdateReviewByID(ctx, "user123", 7, &domain.Review{Title: "Title", Text: "Text", Rating: 6})
+		assert.EqualError(t, err, "score out of range")
+	})
+
+	t.Run("Not Author", func(t *testing.T) {
+		mockRepo.MockUpdateReviewByID = func(ctx context.Context, reviewID int, userID string, updatedReview *domain.Review) error {
+			return errors.New("review not found")
+		}
+		err := reviewUsecase.UpdateReviewByID(ctx, "stranger", 7, &domain.Review{Title: "Title", Text: "Text", Rating: 3})
+		assert.EqualError(t, err, "review not found")
+	})
+}
+
+func TestDeleteReviewByID(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.MockDeleteReviewByID = func(ctx context.Context, reviewID int, userID string) error {
+			assert.Equal(t, 7, reviewID)
+			assert.Equal(t, "user123", userID)
+			return nil
+		}
+		assert.NoError(t, reviewUsecase.DeleteReviewByID(ctx, "user123", 7))
+	})
+
+	t.Run("Not Author", func(t *testing.T) {
+		mockRepo.MockDeleteReviewByID = func(ctx context.Context, reviewID int, userID string) error {
+			return errors.New("review not found")
+		}
+		assert.EqualError(t, reviewUsecase.DeleteReviewByID(ctx, "stranger", 7), "review not found")
+	})
+}
+
+func TestGetUserReviews(t *testing.T) {
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	expectedReviews := []domain.UserReviews{
+		{ID: 1, Title: "Review 1", Text: "Text 1"},
+		{ID: 2, Title: "Review 2", Text: "Text 2"},
+	}
+	mockRepo.MockGetUserReviews = func(ctx context.Context, userID string, after *domain.ReviewCursor, limit int) ([]domain.UserReviews, error) {
+		assert.Equal(t, "user123", userID)
+		return expectedReviews, nil
+	}
+
+	ctx := context.Background()
+	reviews, nextCursor, err := reviewUsecase.GetUserReviews(ctx, "user123", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedReviews, reviews)
+	assert.Empty(t, nextCursor)
+}
+
+func TestGetUserReviews_NextCursor(t *testing.T) {
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	after := &domain.ReviewCursor{CreatedAt: createdAt, ID: 7}
+	mockRepo.MockGetUserReviews = func(ctx context.Context, userID string, cursor *domain.ReviewCursor, limit int) ([]domain.UserReviews, error) {
+		assert.Equal(t, after, cursor)
+		reviews := make([]domain.UserReviews, limit)
+		for i := range reviews {
+			reviews[i] = domain.UserReviews{ID: 8 + i, CreatedAt: createdAt}
+		}
+		return reviews, nil
+	}
+
+	reviews, nextCursor, err := reviewUsecase.GetUserReviews(context.Background(), "user123", after)
+	assert.NoError(t, err)
+	assert.Len(t, reviews, reviewsPageSize)
+
+	var cursor domain.ReviewCursor
+	assert.NoError(t, pagination.DecodeCursor(nextCursor, &cursor))
+	assert.Equal(t, domain.ReviewCursor{CreatedAt: createdAt, ID: reviews[reviewsPageSize-1].ID}, cursor)
+}
+
+func TestGetUserReviews_InvalidInput(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	ctx := context.Background()
+	_, _, err := reviewUsecase.GetUserReviews(ctx, "user#123", nil) // Invalid userID
+	assert.Error(t, err)
+}
+
+func TestGetAdReviews_NextCursor(t *testing.T) {
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockRepo.MockGetAdReviews = func(ctx context.Context, adID string, cursor *domain.ReviewCursor, limit int) ([]domain.UserReviews, error) {
+		assert.Equal(t, "7d1f6a3e-0000-4000-8000-000000000001", adID)
+		assert.Equal(t, reviewsPageSize+1, limit)
+		reviews := make([]domain.UserReviews, limit)
+		for i := range reviews {
+			reviews[i] = domain.UserReviews{ID: i + 1, CreatedAt: createdAt}
+		}
+		return reviews, nil
+	}
+
+	reviews, nextCursor, err := reviewUsecase.GetAdReviews(context.Background(), "7d1f6a3e-0000-4000-8000-000000000001", nil)
+	assert.NoError(t, err)
+	assert.Len(t, reviews, reviewsPageSize)
+
+	var cursor domain.ReviewCursor
+	assert.NoError(t, pagination.DecodeCursor(nextCursor, &cursor))
+	assert.Equal(t, reviewsPageSize, cursor.ID)
+}
+
+func TestGetAdReviews_InvalidInput(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	reviewUsecase := NewReviewUsecase(&mocks.MockReviewsRepository{})
+
+	_, _, err := reviewUsecase.GetAdReviews(context.Background(), "ad' OR 1=1", nil)
+	assert.EqualError(t, err, "input contains invalid characters")
+}
+
+func TestUpdateReview_ScoreOutOfRange(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	outOfRangeReview := &domain.Review{
+		Title:  "Valid Title",
+		Text:   "Valid Text",
+		Rating: 6, // Вне допустимого диапазона [1, 5]
+	}
+
+	ctx := context.Background()
+	err := reviewUsecase.UpdateReview(ctx, "user123", "host123", outOfRangeReview)
+	assert.EqualError(t, err, "score out of range")
+}
+
+func TestUpdateReview_InputExceedsCharacterLimit(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	longText := make([]byte, 1001) // Больше 1000 символов
+	for i := range longText {
+		longText[i] = 'a'
+	}
+
+	longReview := &domain.Review{
+		Title:  "Valid Title",
+		Text:   string(longText), // Текст превышает лимит
+		Rating: 5,
+	}
+
+	ctx := context.Background()
+	err := reviewUsecase.UpdateReview(ctx, "user123", "host123", longReview)
+	assert.EqualError(t, err, "input exceeds character limit")
+}
+
+func TestCreateReview_ScoreOutOfRange(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	outOfRangeReview := &domain.Review{
+		Title:  "Valid Title",
+		Text:   "Valid Text",
+		Rating: 0, // Вне допустимого диапазона [1, 5]
+		HostID: "host123",
+	}
+
+	ctx := context.Background()
+	err := reviewUsecase.CreateReview(ctx, outOfRangeReview, "user123")
+	assert.EqualError(t, err, "score out of range")
+}
+
+func TestCreateReview_InputExceedsCharacterLimit(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	longTitle := make([]byte, 101) // Больше 100 символов
+	for i := range longTitle {
+		longTitle[i] = 'a'
+	}
+
+	longReview := &domain.Review{
+		Title:  string(longTitle), // Название превышает лимит
+		Text:   "Valid Text",
+		Rating: 5,
+		HostID: "host123",
+	}
+
+	ctx := context.Background()
+	err := reviewUsecase.CreateReview(ctx, longReview, "user123")
+	assert.EqualError(t, err, "input exceeds character limit")
+}
+
+func TestCreateReview_HostAndUserSame(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+
+	review := &domain.Review{
+		Title:  "Valid Title",
+		Text:   "Valid Text",
+		Rating: 5,
+		HostID: "user123", // Хост совпадает с пользователем
+	}
+
+	ctx := context.Background()
+	err := reviewUsecase.CreateReview(ctx, review, "user123")
+	assert.EqualError(t, err, "host and user are the same")
+}
+
+func TestReviewReplies(t *testing.T) {
+	require.NoError(t, logger.InitLoggers())
+	defer func() {
+		err := logger.SyncLoggers()
+		if err != nil {
+			return
+		}
+	}()
+	mockRepo := &mocks.MockReviewsRepository{}
+	reviewUsecase := NewReviewUsecase(mockRepo)
+	ctx := context.Background()
+
+	var saved *string
+	mockRepo.MockSetReviewReply = func(ctx context.Context, reviewID int, reply *string) error {
+		assert.Equal(t, 7, reviewID)
+		saved = reply
+		return nil
+	}
+
+	t.Run("create", func(t *testing.T) {
+		mockRepo.MockGetReviewByID = func(ctx context.Context, reviewID int) (*domain.Review, error) {
+			return &domain.Review{ID: reviewID, HostID: "host123"}, nil
+		}
+		require.NoError(t, reviewUsecase.CreateReply(ctx, "host123", 7, "Thanks for staying!"))
+		require.NotNil(t, saved)
+		assert.Equal(t, "Thanks for staying!", *saved)
+	})
+
+	t.Run("not the reviewed host", func(t *testing.T) {
+		err := reviewUsecase.CreateReply(ctx, "user123", 7, "Thanks")
+		assert.EqualError(t, err, "only the reviewed host can reply")
+		err = reviewUsecase.DeleteReply(ctx, "user123", 7)
+		assert.EqualError(t, err, "only the reviewed host can reply")
+	})
+
+	t.Run("reply already exist", func(t *testing.T) {
+		reply := "Old reply"
+		mockRepo.MockGetReviewByID = func(ctx context.Context, reviewID int) (*domain.Review, error) {
+			return &domain.Review{ID: reviewID, HostID: "host123", Reply: &reply}, nil
+		}
+		err := reviewUsecase.CreateReply(ctx, "host123", 7, "Thanks")
+		assert.EqualError(t, err, "reply already exist")
+
+		require.NoError(t, reviewUsecase.UpdateReply(ctx, "host123", 7, "New reply"))
+		assert.Equal(t, "New reply", *saved)
+
+		require.NoError(t, reviewUsecase.DeleteReply(ctx, "host123", 7))
+		assert.Nil(t, saved)
+	})
+
+	t.Run("reply not found", func(t *testing.T) {
+		mockRepo.MockGetReviewByID = func(ctx context.Context, reviewID int) (*domain.Review, error) {
+			return &domain.Review{ID: reviewID, HostID: "host123"}, nil
+		}
+		assert.EqualError(t, reviewUsecase.UpdateReply(ctx, "host123", 7, "Thanks"), "reply not found")
+		assert.EqualError(t, reviewUsecase.DeleteReply(ctx, "host123", 7), "reply not found")
+	})
+
+	t.Run("invalid text", func(t *testing.T) {
+		assert.EqualError(t, reviewUsecase.CreateReply(ctx, "host123", 7, "   "), "reply text is empty")
+		assert.EqualError(t, reviewUsecase.CreateReply(ctx, "host123", 7, "Bad%$#"), "input contains invalid characters")
+	})
+}